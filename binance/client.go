@@ -3,9 +3,6 @@ package binance
 import (
 	"bytes"
 	"context"
-	"crypto/hmac"
-	"crypto/sha256"
-	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -32,24 +29,32 @@ func NewClient(client *http.Client) *Client {
 
 type Client struct {
 	SecretKey  string
+	Signer     common.Signer
 	BaseURL    string
 	UserAgent  string
 	HTTPClient *http.Client
 	Debug      bool
 	TimeOffset int64
 
-	Logger *zap.SugaredLogger
+	Logger      *zap.SugaredLogger
+	RetryPolicy common.RetryPolicy
 }
 
-// Sign returns an HMAC SHA256 signature for the provided payload.
+// Sign returns the signature of the provided payload, made with Signer or,
+// when no signer is set, with HMAC SHA256 over SecretKey.
 func (c *Client) Sign(payload string) string {
-	h := hmac.New(sha256.New, []byte(c.SecretKey))
-	_, err := io.WriteString(h, payload)
+	signature, err := c.sign(payload)
 	if err != nil {
 		panic(err)
 	}
+	return signature
+}
 
-	return hex.EncodeToString(h.Sum(nil))
+func (c *Client) sign(payload string) (string, error) {
+	if c.Signer != nil {
+		return c.Signer.Sign(payload)
+	}
+	return common.NewHMACSigner(c.SecretKey).Sign(payload)
 }
 
 type request struct {
@@ -131,30 +136,65 @@ func (c *Client) parseRequest(r *request) (err error) {
 }
 
 func (c *Client) callAPI(ctx context.Context, r *request) (data []byte, err error) {
-	startedAt := time.Now()
 	service := r.service
 	ctx, span := common.StartRequestSpan(ctx, "go-binance/binance", service)
-	defer span.End()
+	defer func() { common.EndRequestSpan(span, err) }()
 
 	err = c.parseRequest(r)
 	if err != nil {
-		c.logAPIError(ctx, service, r, nil, startedAt, err)
+		c.logAPIError(ctx, service, r, nil, time.Now(), err)
 		return []byte{}, err
 	}
-	req, err := http.NewRequestWithContext(ctx, r.method, r.fullURL, r.body)
+
+	for attempt := 1; ; attempt++ {
+		var statusCode int
+		data, statusCode, err = c.doRequest(ctx, r)
+		// only GET requests are safe to repeat
+		if err == nil || c.RetryPolicy == nil || r.method != http.MethodGet {
+			return data, err
+		}
+		delay, ok := c.RetryPolicy.Retry(common.RetryRequest{
+			Service:    service,
+			Method:     r.method,
+			Endpoint:   r.endpoint,
+			Attempt:    attempt,
+			StatusCode: statusCode,
+			Err:        err,
+		})
+		if !ok {
+			return data, err
+		}
+		if serr := common.SleepContext(ctx, delay); serr != nil {
+			return data, err
+		}
+		if perr := c.parseRequest(r); perr != nil {
+			return data, err
+		}
+	}
+}
+
+// doRequest send r once, r must have been parsed
+func (c *Client) doRequest(ctx context.Context, r *request) (data []byte, statusCode int, err error) {
+	startedAt := time.Now()
+	var req *http.Request
+	var res *http.Response
+	defer func() { c.recordRequest(ctx, r, req, res, startedAt, err) }()
+	service := r.service
+
+	req, err = http.NewRequestWithContext(ctx, r.method, r.fullURL, r.body)
 	if err != nil {
 		c.logAPIError(ctx, service, r, nil, startedAt, err)
-		return []byte{}, err
+		return []byte{}, 0, err
 	}
 	req = req.WithContext(common.WithHTTPConnTrace(req.Context()))
 	req.Header = r.header
 
 	c.logAPIRequest(ctx, service, r, req)
 
-	res, err := c.HTTPClient.Do(req)
+	res, err = c.HTTPClient.Do(req)
 	if err != nil {
 		c.logAPIError(ctx, service, r, req, startedAt, err)
-		return []byte{}, err
+		return []byte{}, 0, err
 	}
 	defer func() {
 		cerr := res.Body.Close()
@@ -166,7 +206,7 @@ func (c *Client) callAPI(ctx context.Context, r *request) (data []byte, err erro
 	data, err = io.ReadAll(res.Body)
 	if err != nil {
 		c.logAPIError(ctx, service, r, req, startedAt, err)
-		return []byte{}, err
+		return []byte{}, res.StatusCode, err
 	}
 
 	c.logAPIResponse(ctx, service, r, req, res, data, startedAt)
@@ -174,10 +214,33 @@ func (c *Client) callAPI(ctx context.Context, r *request) (data []byte, err erro
 	if res.StatusCode >= http.StatusBadRequest {
 		apiErr := new(common.APIError)
 		_ = json.Unmarshal(data, apiErr)
+		apiErr.StatusCode = res.StatusCode
+		apiErr.Endpoint = r.endpoint
+		apiErr.Service = service
 		c.logAPIError(ctx, service, r, req, startedAt, apiErr)
-		return nil, apiErr
+		return nil, res.StatusCode, apiErr
+	}
+	return data, res.StatusCode, nil
+}
+
+// recordRequest record one attempt of r to the request span and metrics
+func (c *Client) recordRequest(ctx context.Context, r *request, req *http.Request, res *http.Response, startedAt time.Time, err error) {
+	result := common.RequestResult{
+		Service:  r.service,
+		Method:   r.method,
+		Endpoint: r.endpoint,
+		Duration: time.Since(startedAt),
+		Err:      err,
+	}
+	if req != nil {
+		ctx = req.Context()
+		result.Host = req.URL.Host
+	}
+	if res != nil {
+		result.StatusCode = res.StatusCode
+		result.Header = res.Header
 	}
-	return data, nil
+	common.RecordRequest(ctx, "go-binance/binance", result)
 }
 
 func (c *Client) logAPIRequest(ctx context.Context, service string, r *request, req *http.Request) {
@@ -216,7 +279,7 @@ func (c *Client) logAPIResponse(ctx context.Context, service string, r *request,
 		"event.duration", time.Since(startedAt),
 	}
 	if reused, ok := common.HTTPConnReused(req.Context()); ok {
-		fields = append(fields, "http.connection.reused", reused)
+		fields = append(fields, "binance.http.connection_reused", reused)
 	}
 	fields = common.AppendContextField(ctx, fields)
 
@@ -0,0 +1,270 @@
+package binancetest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/ward-cap/go-binance/common"
+)
+
+// CassetteMode define whether a cassette records or replays
+type CassetteMode int
+
+// Cassette modes
+const (
+	// ModeReplay serve the recorded interactions, unmatched requests fail
+	ModeReplay CassetteMode = iota
+	// ModeRecord send requests to the exchange and record them
+	ModeRecord
+	// ModeAuto replay when the cassette file exists, record otherwise
+	ModeAuto
+)
+
+// ErrCassetteMiss is returned in replay mode for a request without a
+// recorded interaction
+var ErrCassetteMiss = errors.New("binancetest: no recorded interaction")
+
+// volatileParams are removed from the recorded params and ignored when
+// matching, they change on every request
+var volatileParams = map[string]bool{
+	"signature":  true,
+	"timestamp":  true,
+	"recvWindow": true,
+}
+
+// listenKeyParam is masked in params and response bodies, a listen key gives
+// access to the account stream
+const listenKeyParam = "listenKey"
+
+var listenKeyField = regexp.MustCompile(`"listenKey"\s*:\s*"[^"]*"`)
+
+// CassetteRequest define a recorded request. Params merge the query string
+// and the form body, sorted, without the volatile params and with the listen
+// key masked.
+type CassetteRequest struct {
+	Method   string      `json:"method"`
+	Endpoint string      `json:"endpoint"`
+	Params   string      `json:"params"`
+	Header   http.Header `json:"header,omitempty"`
+}
+
+// CassetteResponse define a recorded response
+type CassetteResponse struct {
+	StatusCode int         `json:"statusCode"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body"`
+}
+
+// Interaction define a recorded request and its response
+type Interaction struct {
+	Request  CassetteRequest  `json:"request"`
+	Response CassetteResponse `json:"response"`
+}
+
+type cassetteFile struct {
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// Cassette is an http.RoundTripper recording exchange interactions to a file
+// and replaying them. Requests are matched on method, endpoint and params,
+// identical requests are replayed in the order they were recorded.
+type Cassette struct {
+	Path string
+	Mode CassetteMode
+	// Transport sends the requests in record mode, http.DefaultTransport
+	// when nil
+	Transport http.RoundTripper
+	// Redact is called on every interaction before it is recorded, for
+	// secrets found in payloads. API keys and listen keys are already masked.
+	Redact func(i *Interaction)
+
+	mu           sync.Mutex
+	interactions []*Interaction
+	used         []bool
+}
+
+// NewCassette init a cassette, the file at path is loaded unless recording
+func NewCassette(path string, mode CassetteMode) (*Cassette, error) {
+	c := &Cassette{Path: path, Mode: mode}
+	if mode == ModeRecord {
+		return c, nil
+	}
+	data, err := os.ReadFile(path)
+	if mode == ModeAuto && errors.Is(err, fs.ErrNotExist) {
+		c.Mode = ModeRecord
+		return c, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	file := new(cassetteFile)
+	if err = json.Unmarshal(data, file); err != nil {
+		return nil, fmt.Errorf("binancetest: cassette %s: %w", path, err)
+	}
+	c.Mode = ModeReplay
+	c.interactions = file.Interactions
+	c.used = make([]bool, len(file.Interactions))
+	return c, nil
+}
+
+// HTTPClient return an HTTP client using the cassette as transport
+func (c *Cassette) HTTPClient() *http.Client {
+	return &http.Client{Transport: c}
+}
+
+// Recording report whether requests reach the exchange
+func (c *Cassette) Recording() bool {
+	return c.Mode == ModeRecord
+}
+
+// RoundTrip implement http.RoundTripper
+func (c *Cassette) RoundTrip(req *http.Request) (*http.Response, error) {
+	params, err := requestParams(req)
+	if err != nil {
+		return nil, err
+	}
+	if c.Mode != ModeRecord {
+		return c.replay(req, params)
+	}
+
+	transport := c.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	res, err := transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := io.ReadAll(res.Body)
+	_ = res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	res.Body = io.NopCloser(bytes.NewReader(body))
+
+	i := &Interaction{
+		Request: CassetteRequest{
+			Method:   req.Method,
+			Endpoint: req.URL.Path,
+			Params:   params,
+			Header:   common.SanitizeHeaders(req.Header),
+		},
+		Response: CassetteResponse{
+			StatusCode: res.StatusCode,
+			Header:     common.SanitizeHeaders(res.Header),
+			Body:       redactBody(body),
+		},
+	}
+	if c.Redact != nil {
+		c.Redact(i)
+	}
+	c.mu.Lock()
+	c.interactions = append(c.interactions, i)
+	c.used = append(c.used, true)
+	c.mu.Unlock()
+	return res, nil
+}
+
+// Save write the recorded interactions to Path, it does nothing in replay mode
+func (c *Cassette) Save() error {
+	if c.Mode != ModeRecord {
+		return nil
+	}
+	c.mu.Lock()
+	data, err := json.MarshalIndent(cassetteFile{Interactions: c.interactions}, "", "  ")
+	c.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(c.Path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(c.Path, append(data, '\n'), 0o644)
+}
+
+func (c *Cassette) replay(req *http.Request, params string) (*http.Response, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var match *Interaction
+	for idx, i := range c.interactions {
+		if i.Request.Method != req.Method || i.Request.Endpoint != req.URL.Path || i.Request.Params != params {
+			continue
+		}
+		match = i
+		if !c.used[idx] {
+			c.used[idx] = true
+			break
+		}
+	}
+	if match == nil {
+		return nil, fmt.Errorf("%w for %s %s?%s", ErrCassetteMiss, req.Method, req.URL.Path, params)
+	}
+	header := match.Response.Header.Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", match.Response.StatusCode, http.StatusText(match.Response.StatusCode)),
+		StatusCode:    match.Response.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(strings.NewReader(match.Response.Body)),
+		ContentLength: int64(len(match.Response.Body)),
+		Request:       req,
+	}, nil
+}
+
+// requestParams merge the query string and form body of req, without the
+// volatile params and with the listen key masked. The body is left readable.
+func requestParams(req *http.Request) (string, error) {
+	params := url.Values{}
+	for k, v := range req.URL.Query() {
+		params[k] = v
+	}
+	if req.Body != nil && req.Body != http.NoBody {
+		body, err := io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return "", err
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+		if form, err := url.ParseQuery(string(body)); err == nil {
+			for k, v := range form {
+				params[k] = append(params[k], v...)
+			}
+		}
+	}
+	for k := range params {
+		if volatileParams[k] {
+			params.Del(k)
+		}
+	}
+	if len(params) == 0 {
+		return "", nil
+	}
+	sanitized, err := url.Parse(common.SanitizeURL("?"+params.Encode(), listenKeyParam))
+	if err != nil {
+		return "", err
+	}
+	return sanitized.RawQuery, nil
+}
+
+// redactBody mask the listen key returned by the user stream endpoints, the
+// replayed client then sends the masked key which matches the recorded params
+func redactBody(body []byte) string {
+	return listenKeyField.ReplaceAllString(string(body), `"listenKey":"***"`)
+}
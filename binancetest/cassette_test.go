@@ -0,0 +1,86 @@
+package binancetest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	binance "github.com/ward-cap/go-binance/services"
+)
+
+const testListenKey = "pqia91ma19a5s61cv6a81va65sdf19v8a65a1a5s61cv6a81va65sdf19v8a65a1"
+
+// newUserStreamExchange serve the spot listen key endpoints
+func newUserStreamExchange(t *testing.T) *httptest.Server {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Set-Cookie", "session=secret-session-cookie")
+		switch r.Method {
+		case http.MethodPost:
+			_, _ = w.Write([]byte(`{"listenKey":"` + testListenKey + `"}`))
+		default:
+			_, _ = w.Write([]byte(`{}`))
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func newCassetteClient(c *Cassette, baseURL string) *binance.Client {
+	client := binance.NewClient("cassette-api-key-1234", "secret", c.HTTPClient())
+	client.SetEnvironment(binance.Environment{BaseURL: baseURL})
+	return client
+}
+
+func TestCassetteRedactsListenKey(t *testing.T) {
+	exchange := newUserStreamExchange(t)
+	path := filepath.Join(t.TempDir(), "user_stream.json")
+
+	rec, err := NewCassette(path, ModeRecord)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := newCassetteClient(rec, exchange.URL)
+	listenKey, err := client.NewStartUserStreamService().Do(context.Background())
+	if err != nil {
+		t.Fatalf("start user stream: %v", err)
+	}
+	if listenKey != testListenKey {
+		t.Fatalf("listen key = %q, the live response must not be redacted", listenKey)
+	}
+	if err := client.NewKeepaliveUserStreamService().ListenKey(listenKey).Do(context.Background()); err != nil {
+		t.Fatalf("keepalive user stream: %v", err)
+	}
+	if err := rec.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, secret := range []string{testListenKey, "cassette-api-key-1234", "secret-session-cookie"} {
+		if strings.Contains(string(data), secret) {
+			t.Fatalf("cassette contains %q:\n%s", secret, data)
+		}
+	}
+
+	play, err := NewCassette(path, ModeReplay)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client = newCassetteClient(play, "http://replay.invalid")
+	listenKey, err = client.NewStartUserStreamService().Do(context.Background())
+	if err != nil {
+		t.Fatalf("replay start user stream: %v", err)
+	}
+	if listenKey != "***" {
+		t.Fatalf("replayed listen key = %q", listenKey)
+	}
+	if err := client.NewKeepaliveUserStreamService().ListenKey(listenKey).Do(context.Background()); err != nil {
+		t.Fatalf("replay keepalive user stream: %v", err)
+	}
+}
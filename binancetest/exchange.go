@@ -0,0 +1,480 @@
+package binancetest
+
+import (
+	"net/http"
+	"sort"
+
+	"github.com/shopspring/decimal"
+)
+
+// Order statuses
+const (
+	statusNew             = "NEW"
+	statusPartiallyFilled = "PARTIALLY_FILLED"
+	statusFilled          = "FILLED"
+	statusCanceled        = "CANCELED"
+	statusExpired         = "EXPIRED"
+)
+
+// Symbol define a tradable symbol
+type Symbol struct {
+	Name       string
+	BaseAsset  string
+	QuoteAsset string
+	// Status is reported in exchangeInfo, empty is TRADING. Orders are
+	// accepted whatever the status.
+	Status string
+	// TickSize, StepSize and MinNotional are reported in the exchangeInfo
+	// filters, orders are not validated against them
+	TickSize    decimal.Decimal
+	StepSize    decimal.Decimal
+	MinNotional decimal.Decimal
+}
+
+// Level define a price level of the scripted order book
+type Level struct {
+	Price    decimal.Decimal
+	Quantity decimal.Decimal
+}
+
+// NewLevel init a level from strings, it panics on invalid numbers
+func NewLevel(price, quantity string) Level {
+	return Level{Price: decimal.RequireFromString(price), Quantity: decimal.RequireFromString(quantity)}
+}
+
+// Balance define the balance of an asset
+type Balance struct {
+	Free   decimal.Decimal
+	Locked decimal.Decimal
+}
+
+// Position define a futures position in one-way mode
+type Position struct {
+	Amount     decimal.Decimal
+	EntryPrice decimal.Decimal
+	Leverage   int
+}
+
+type book struct {
+	bids     []Level
+	asks     []Level
+	updateID int64
+}
+
+type fill struct {
+	price    decimal.Decimal
+	quantity decimal.Decimal
+	tradeID  int64
+}
+
+type order struct {
+	symbol        string
+	id            int64
+	clientOrderID string
+	side          string
+	orderType     string
+	timeInForce   string
+	price         decimal.Decimal
+	origQty       decimal.Decimal
+	executedQty   decimal.Decimal
+	cumQuote      decimal.Decimal
+	status        string
+	reduceOnly    bool
+	time          int64
+	updateTime    int64
+	// locked is the balance still held by a resting spot order
+	locked decimal.Decimal
+}
+
+func (o *order) open() bool {
+	return o.status == statusNew || o.status == statusPartiallyFilled
+}
+
+func (o *order) remaining() decimal.Decimal {
+	return o.origQty.Sub(o.executedQty)
+}
+
+func (o *order) avgPrice() decimal.Decimal {
+	if o.executedQty.IsZero() {
+		return decimal.Zero
+	}
+	return o.cumQuote.Div(o.executedQty)
+}
+
+type exchange struct {
+	market    Market
+	symbols   map[string]Symbol
+	books     map[string]*book
+	balances  map[string]*Balance
+	positions map[string]*Position
+	orders    []*order
+	nextID    int64
+	tradeID   int64
+}
+
+func newExchange(market Market) *exchange {
+	return &exchange{
+		market:    market,
+		symbols:   map[string]Symbol{},
+		books:     map[string]*book{},
+		balances:  map[string]*Balance{},
+		positions: map[string]*Position{},
+	}
+}
+
+func (e *exchange) balance(asset string) *Balance {
+	b, ok := e.balances[asset]
+	if !ok {
+		b = &Balance{}
+		e.balances[asset] = b
+	}
+	return b
+}
+
+func (e *exchange) position(symbol string) *Position {
+	p, ok := e.positions[symbol]
+	if !ok {
+		p = &Position{Leverage: 20}
+		e.positions[symbol] = p
+	}
+	return p
+}
+
+func (e *exchange) book(symbol string) *book {
+	b, ok := e.books[symbol]
+	if !ok {
+		b = &book{}
+		e.books[symbol] = b
+	}
+	return b
+}
+
+func (e *exchange) findOrder(symbol string, id int64, clientOrderID string) *order {
+	for _, o := range e.orders {
+		if o.symbol == symbol && ((id != 0 && o.id == id) || (id == 0 && clientOrderID != "" && o.clientOrderID == clientOrderID)) {
+			return o
+		}
+	}
+	return nil
+}
+
+// match return the fills of a taker order without touching the book. A zero
+// limit matches any price. When quoteQty is set the fills stop once it is spent.
+func (b *book) match(side string, qty, limit, quoteQty decimal.Decimal) []fill {
+	levels := b.asks
+	crosses := func(p decimal.Decimal) bool { return limit.IsZero() || p.LessThanOrEqual(limit) }
+	if side == "SELL" {
+		levels = b.bids
+		crosses = func(p decimal.Decimal) bool { return limit.IsZero() || p.GreaterThanOrEqual(limit) }
+	}
+	quoteMode := quoteQty.IsPositive()
+	var fills []fill
+	for _, level := range levels {
+		if !crosses(level.Price) {
+			break
+		}
+		q := level.Quantity
+		if quoteMode {
+			if affordable := quoteQty.Div(level.Price).Truncate(8); affordable.LessThan(q) {
+				q = affordable
+			}
+		} else if qty.LessThan(q) {
+			q = qty
+		}
+		if !q.IsPositive() {
+			break
+		}
+		fills = append(fills, fill{price: level.Price, quantity: q})
+		if quoteMode {
+			quoteQty = quoteQty.Sub(q.Mul(level.Price))
+		} else {
+			qty = qty.Sub(q)
+		}
+		if (quoteMode && !quoteQty.IsPositive()) || (!quoteMode && !qty.IsPositive()) {
+			break
+		}
+	}
+	return fills
+}
+
+// consume remove the liquidity taken by fills
+func (b *book) consume(side string, fills []fill) {
+	levels := &b.asks
+	if side == "SELL" {
+		levels = &b.bids
+	}
+	for _, f := range fills {
+		for i := range *levels {
+			if (*levels)[i].Price.Equal(f.price) {
+				(*levels)[i].Quantity = (*levels)[i].Quantity.Sub(f.quantity)
+				break
+			}
+		}
+	}
+	kept := (*levels)[:0]
+	for _, l := range *levels {
+		if l.Quantity.IsPositive() {
+			kept = append(kept, l)
+		}
+	}
+	*levels = kept
+	b.updateID++
+}
+
+func (b *book) set(bids, asks []Level) {
+	b.bids = append([]Level(nil), bids...)
+	b.asks = append([]Level(nil), asks...)
+	sort.Slice(b.bids, func(i, j int) bool { return b.bids[i].Price.GreaterThan(b.bids[j].Price) })
+	sort.Slice(b.asks, func(i, j int) bool { return b.asks[i].Price.LessThan(b.asks[j].Price) })
+	b.updateID++
+}
+
+// mid return the mid price, or the best price of the only side quoted
+func (b *book) mid() decimal.Decimal {
+	switch {
+	case len(b.bids) > 0 && len(b.asks) > 0:
+		return b.bids[0].Price.Add(b.asks[0].Price).Div(decimal.NewFromInt(2))
+	case len(b.bids) > 0:
+		return b.bids[0].Price
+	case len(b.asks) > 0:
+		return b.asks[0].Price
+	}
+	return decimal.Zero
+}
+
+func sumQuantity(fills []fill) (qty, quote decimal.Decimal) {
+	for _, f := range fills {
+		qty = qty.Add(f.quantity)
+		quote = quote.Add(f.quantity.Mul(f.price))
+	}
+	return qty, quote
+}
+
+// place validate o, reserve the spot balance it needs, fill it against the
+// book and keep it when it rests
+func (e *exchange) place(o *order, quoteQty decimal.Decimal) ([]fill, *apiError) {
+	sym, ok := e.symbols[o.symbol]
+	if !ok {
+		return nil, newAPIError(http.StatusBadRequest, -1121, "Invalid symbol.")
+	}
+	if o.clientOrderID != "" {
+		if existing := e.findOrder(o.symbol, 0, o.clientOrderID); existing != nil && existing.open() {
+			return nil, newAPIError(http.StatusBadRequest, -2010, "Duplicate order sent.")
+		}
+	}
+	if o.orderType == "LIMIT" || o.orderType == "LIMIT_MAKER" {
+		if !o.price.IsPositive() {
+			return nil, mandatoryParam("price")
+		}
+	} else {
+		o.price = decimal.Zero
+	}
+	if !o.origQty.IsPositive() && quoteQty.IsZero() {
+		return nil, mandatoryParam("quantity")
+	}
+
+	b := e.book(o.symbol)
+	fills := b.match(o.side, o.origQty, o.price, quoteQty)
+	filledQty, filledQuote := sumQuantity(fills)
+	if !quoteQty.IsZero() {
+		o.origQty = filledQty
+	}
+
+	switch {
+	case o.orderType == "LIMIT_MAKER" && len(fills) > 0:
+		return nil, newAPIError(http.StatusBadRequest, -2010, "Order would immediately match and take.")
+	case o.timeInForce == "FOK" && filledQty.LessThan(o.origQty):
+		o.status = statusExpired
+		e.orders = append(e.orders, o)
+		return nil, nil
+	}
+
+	if e.market == Spot {
+		if err := e.reserve(sym, o, filledQty, filledQuote); err != nil {
+			return nil, err
+		}
+	} else if err := e.checkReduceOnly(o); err != nil {
+		return nil, err
+	}
+
+	b.consume(o.side, fills)
+	e.apply(sym, o, fills)
+	switch {
+	case !o.remaining().IsPositive():
+		o.status = statusFilled
+	case o.orderType == "MARKET" || o.timeInForce == "IOC":
+		o.status = statusExpired
+	case filledQty.IsPositive():
+		o.status = statusPartiallyFilled
+	default:
+		o.status = statusNew
+	}
+	if !o.open() {
+		e.release(sym, o)
+	}
+	e.orders = append(e.orders, o)
+	return fills, nil
+}
+
+// reserve check the spot balance and lock what the order needs
+func (e *exchange) reserve(sym Symbol, o *order, filledQty, filledQuote decimal.Decimal) *apiError {
+	insufficient := newAPIError(http.StatusBadRequest, -2010, "Account has insufficient balance for requested action.")
+	if o.side == "BUY" {
+		need := filledQuote
+		if o.orderType != "MARKET" {
+			need = o.origQty.Mul(o.price)
+		}
+		quote := e.balance(sym.QuoteAsset)
+		if quote.Free.LessThan(need) {
+			return insufficient
+		}
+		if o.orderType != "MARKET" {
+			quote.Free = quote.Free.Sub(need)
+			quote.Locked = quote.Locked.Add(need)
+			o.locked = need
+		}
+		return nil
+	}
+	need := o.origQty
+	if o.orderType == "MARKET" {
+		need = filledQty
+	}
+	base := e.balance(sym.BaseAsset)
+	if base.Free.LessThan(need) {
+		return insufficient
+	}
+	if o.orderType != "MARKET" {
+		base.Free = base.Free.Sub(need)
+		base.Locked = base.Locked.Add(need)
+		o.locked = need
+	}
+	return nil
+}
+
+// checkReduceOnly reject reduce only orders that would open or grow a position
+func (e *exchange) checkReduceOnly(o *order) *apiError {
+	if !o.reduceOnly {
+		return nil
+	}
+	amount := e.position(o.symbol).Amount
+	qty := o.origQty
+	if o.side == "BUY" {
+		qty = qty.Neg()
+	}
+	if amount.IsZero() || amount.Sign() != qty.Sign() || qty.Abs().GreaterThan(amount.Abs()) {
+		return newAPIError(http.StatusBadRequest, -2022, "ReduceOnly Order is rejected.")
+	}
+	return nil
+}
+
+// apply book the fills of o on the balances or the position
+func (e *exchange) apply(sym Symbol, o *order, fills []fill) {
+	for i, f := range fills {
+		e.tradeID++
+		fills[i].tradeID = e.tradeID
+		quote := f.quantity.Mul(f.price)
+		o.executedQty = o.executedQty.Add(f.quantity)
+		o.cumQuote = o.cumQuote.Add(quote)
+
+		if e.market == Futures {
+			e.applyPosition(sym, o.symbol, o.side, f)
+			continue
+		}
+		base, quoteBal := e.balance(sym.BaseAsset), e.balance(sym.QuoteAsset)
+		if o.side == "BUY" {
+			base.Free = base.Free.Add(f.quantity)
+			if o.orderType == "MARKET" {
+				quoteBal.Free = quoteBal.Free.Sub(quote)
+				continue
+			}
+			held := f.quantity.Mul(o.price)
+			quoteBal.Locked = quoteBal.Locked.Sub(held)
+			quoteBal.Free = quoteBal.Free.Add(held.Sub(quote))
+			o.locked = o.locked.Sub(held)
+			continue
+		}
+		quoteBal.Free = quoteBal.Free.Add(quote)
+		if o.orderType == "MARKET" {
+			base.Free = base.Free.Sub(f.quantity)
+			continue
+		}
+		base.Locked = base.Locked.Sub(f.quantity)
+		o.locked = o.locked.Sub(f.quantity)
+	}
+}
+
+// applyPosition update the position with a fill, the realized profit is
+// booked on the quote asset balance
+func (e *exchange) applyPosition(sym Symbol, symbol, side string, f fill) {
+	p := e.position(symbol)
+	qty := f.quantity
+	if side == "SELL" {
+		qty = qty.Neg()
+	}
+	if p.Amount.IsZero() || p.Amount.Sign() == qty.Sign() {
+		total := p.Amount.Add(qty)
+		p.EntryPrice = p.EntryPrice.Mul(p.Amount.Abs()).Add(f.price.Mul(qty.Abs())).Div(total.Abs())
+		p.Amount = total
+		return
+	}
+	closed := decimal.Min(p.Amount.Abs(), qty.Abs())
+	pnl := f.price.Sub(p.EntryPrice).Mul(closed)
+	if p.Amount.IsNegative() {
+		pnl = pnl.Neg()
+	}
+	wallet := e.balance(sym.QuoteAsset)
+	wallet.Free = wallet.Free.Add(pnl)
+
+	p.Amount = p.Amount.Add(qty)
+	switch {
+	case p.Amount.IsZero():
+		p.EntryPrice = decimal.Zero
+	case p.Amount.Sign() == qty.Sign():
+		p.EntryPrice = f.price
+	}
+}
+
+// release unlock the spot balance still held by o
+func (e *exchange) release(sym Symbol, o *order) {
+	if e.market != Spot || !o.locked.IsPositive() {
+		return
+	}
+	asset := sym.BaseAsset
+	if o.side == "BUY" {
+		asset = sym.QuoteAsset
+	}
+	b := e.balance(asset)
+	b.Locked = b.Locked.Sub(o.locked)
+	b.Free = b.Free.Add(o.locked)
+	o.locked = decimal.Zero
+}
+
+// cancel cancel an open order
+func (e *exchange) cancel(o *order, now int64) {
+	o.status = statusCanceled
+	o.updateTime = now
+	e.release(e.symbols[o.symbol], o)
+}
+
+// rematch fill the resting orders of symbol against a new book
+func (e *exchange) rematch(symbol string, now int64) {
+	sym := e.symbols[symbol]
+	b := e.book(symbol)
+	for _, o := range e.orders {
+		if o.symbol != symbol || !o.open() {
+			continue
+		}
+		fills := b.match(o.side, o.remaining(), o.price, decimal.Zero)
+		if len(fills) == 0 {
+			continue
+		}
+		b.consume(o.side, fills)
+		e.apply(sym, o, fills)
+		o.updateTime = now
+		o.status = statusPartiallyFilled
+		if !o.remaining().IsPositive() {
+			o.status = statusFilled
+			e.release(sym, o)
+		}
+	}
+}
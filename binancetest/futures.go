@@ -0,0 +1,265 @@
+package binancetest
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/shopspring/decimal"
+)
+
+func (s *Server) registerFutures() {
+	e := s.markets[Futures]
+	s.handle(http.MethodGet, "/fapi/v1/ping", false, func(*http.Request, url.Values) (any, *apiError) {
+		return map[string]any{}, nil
+	})
+	s.handle(http.MethodGet, "/fapi/v1/time", false, func(*http.Request, url.Values) (any, *apiError) {
+		return map[string]any{"serverTime": s.now().UnixMilli()}, nil
+	})
+	s.handle(http.MethodGet, "/fapi/v1/exchangeInfo", false, func(*http.Request, url.Values) (any, *apiError) {
+		return s.exchangeInfo(e), nil
+	})
+	s.handle(http.MethodGet, "/fapi/v1/depth", false, func(_ *http.Request, p url.Values) (any, *apiError) {
+		return s.depth(e, p)
+	})
+	s.handle(http.MethodGet, "/fapi/v1/ticker/price", false, func(_ *http.Request, p url.Values) (any, *apiError) {
+		return s.tickerPrice(e, p)
+	})
+	s.handle(http.MethodGet, "/fapi/v2/ticker/price", false, func(_ *http.Request, p url.Values) (any, *apiError) {
+		return s.tickerPrice(e, p)
+	})
+	s.handle(http.MethodPost, "/fapi/v1/order", true, func(_ *http.Request, p url.Values) (any, *apiError) {
+		o, _, err := s.newOrder(e, p)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := e.place(o, decimal.Zero); err != nil {
+			return nil, err
+		}
+		return futuresOrder(o), nil
+	})
+	s.handle(http.MethodGet, "/fapi/v1/order", true, func(_ *http.Request, p url.Values) (any, *apiError) {
+		o, err := lookupOrder(e, p, newAPIError(http.StatusBadRequest, -2013, "Order does not exist."))
+		if err != nil {
+			return nil, err
+		}
+		return futuresOrder(o), nil
+	})
+	s.handle(http.MethodDelete, "/fapi/v1/order", true, func(_ *http.Request, p url.Values) (any, *apiError) {
+		unknown := newAPIError(http.StatusBadRequest, -2011, "Unknown order sent.")
+		o, err := lookupOrder(e, p, unknown)
+		if err != nil {
+			return nil, err
+		}
+		if !o.open() {
+			return nil, unknown
+		}
+		e.cancel(o, s.now().UnixMilli())
+		return futuresOrder(o), nil
+	})
+	s.handle(http.MethodGet, "/fapi/v1/openOrders", true, func(_ *http.Request, p url.Values) (any, *apiError) {
+		res := []map[string]any{}
+		for _, o := range filterOrders(e, p.Get("symbol"), true) {
+			res = append(res, futuresOrder(o))
+		}
+		return res, nil
+	})
+	s.handle(http.MethodDelete, "/fapi/v1/allOpenOrders", true, func(_ *http.Request, p url.Values) (any, *apiError) {
+		if p.Get("symbol") == "" {
+			return nil, mandatoryParam("symbol")
+		}
+		for _, o := range filterOrders(e, p.Get("symbol"), true) {
+			e.cancel(o, s.now().UnixMilli())
+		}
+		return map[string]any{"code": 200, "msg": "The operation of cancel all open order is done."}, nil
+	})
+	s.handle(http.MethodGet, "/fapi/v1/allOrders", true, func(_ *http.Request, p url.Values) (any, *apiError) {
+		if p.Get("symbol") == "" {
+			return nil, mandatoryParam("symbol")
+		}
+		res := []map[string]any{}
+		for _, o := range filterOrders(e, p.Get("symbol"), false) {
+			res = append(res, futuresOrder(o))
+		}
+		return res, nil
+	})
+	s.handle(http.MethodPost, "/fapi/v1/leverage", true, func(_ *http.Request, p url.Values) (any, *apiError) {
+		if p.Get("symbol") == "" {
+			return nil, mandatoryParam("symbol")
+		}
+		leverage, err := intParam(p, "leverage")
+		if err != nil {
+			return nil, err
+		}
+		if leverage < 1 || leverage > 125 {
+			return nil, newAPIError(http.StatusBadRequest, -4028, "Leverage "+strconv.FormatInt(leverage, 10)+" is not valid")
+		}
+		e.position(p.Get("symbol")).Leverage = int(leverage)
+		return map[string]any{"symbol": p.Get("symbol"), "leverage": leverage, "maxNotionalValue": "1000000"}, nil
+	})
+	s.handle(http.MethodGet, "/fapi/v2/balance", true, func(*http.Request, url.Values) (any, *apiError) {
+		res := []map[string]any{}
+		for _, asset := range sortedAssets(e) {
+			wallet := e.balances[asset].Free
+			pnl := e.unrealizedProfit(asset)
+			res = append(res, map[string]any{
+				"accountAlias":       "binancetest",
+				"asset":              asset,
+				"balance":            wallet.String(),
+				"crossWalletBalance": wallet.String(),
+				"crossUnPnl":         pnl.String(),
+				"availableBalance":   wallet.Add(pnl).String(),
+				"maxWithdrawAmount":  wallet.String(),
+				"marginAvailable":    true,
+				"updateTime":         s.now().UnixMilli(),
+			})
+		}
+		return res, nil
+	})
+	s.handle(http.MethodGet, "/fapi/v2/positionRisk", true, func(_ *http.Request, p url.Values) (any, *apiError) {
+		res := []map[string]any{}
+		for _, name := range sortedSymbols(e) {
+			if symbol := p.Get("symbol"); symbol != "" && symbol != name {
+				continue
+			}
+			pos := e.position(name)
+			mark := e.markPrice(name)
+			res = append(res, map[string]any{
+				"symbol":           name,
+				"positionAmt":      pos.Amount.String(),
+				"entryPrice":       pos.EntryPrice.String(),
+				"breakEvenPrice":   pos.EntryPrice.String(),
+				"markPrice":        mark.String(),
+				"unRealizedProfit": mark.Sub(pos.EntryPrice).Mul(pos.Amount).String(),
+				"liquidationPrice": "0",
+				"leverage":         strconv.Itoa(pos.Leverage),
+				"maxNotionalValue": "1000000",
+				"marginType":       "cross",
+				"isolatedMargin":   "0",
+				"isAutoAddMargin":  "false",
+				"positionSide":     "BOTH",
+				"notional":         mark.Mul(pos.Amount).String(),
+				"isolatedWallet":   "0",
+				"updateTime":       s.now().UnixMilli(),
+			})
+		}
+		return res, nil
+	})
+	s.handle(http.MethodGet, "/fapi/v2/account", true, func(*http.Request, url.Values) (any, *apiError) {
+		now := s.now().UnixMilli()
+		var totalWallet, totalPnl decimal.Decimal
+		assets := []map[string]any{}
+		for _, asset := range sortedAssets(e) {
+			wallet := e.balances[asset].Free
+			pnl := e.unrealizedProfit(asset)
+			totalWallet, totalPnl = totalWallet.Add(wallet), totalPnl.Add(pnl)
+			assets = append(assets, map[string]any{
+				"asset":                  asset,
+				"walletBalance":          wallet.String(),
+				"unrealizedProfit":       pnl.String(),
+				"marginBalance":          wallet.Add(pnl).String(),
+				"maintMargin":            "0",
+				"initialMargin":          "0",
+				"positionInitialMargin":  "0",
+				"openOrderInitialMargin": "0",
+				"crossWalletBalance":     wallet.String(),
+				"crossUnPnl":             pnl.String(),
+				"availableBalance":       wallet.Add(pnl).String(),
+				"maxWithdrawAmount":      wallet.String(),
+				"marginAvailable":        true,
+				"updateTime":             now,
+			})
+		}
+		positions := []map[string]any{}
+		for _, name := range sortedSymbols(e) {
+			pos := e.position(name)
+			mark := e.markPrice(name)
+			positions = append(positions, map[string]any{
+				"symbol":                 name,
+				"initialMargin":          "0",
+				"maintMargin":            "0",
+				"unrealizedProfit":       mark.Sub(pos.EntryPrice).Mul(pos.Amount).String(),
+				"positionInitialMargin":  "0",
+				"openOrderInitialMargin": "0",
+				"leverage":               strconv.Itoa(pos.Leverage),
+				"isolated":               false,
+				"entryPrice":             pos.EntryPrice.String(),
+				"maxNotional":            "1000000",
+				"positionSide":           "BOTH",
+				"positionAmt":            pos.Amount.String(),
+				"notional":               mark.Mul(pos.Amount).String(),
+				"updateTime":             now,
+			})
+		}
+		return map[string]any{
+			"feeTier":                     0,
+			"canTrade":                    true,
+			"canDeposit":                  true,
+			"canWithdraw":                 true,
+			"updateTime":                  now,
+			"multiAssetsMargin":           false,
+			"totalInitialMargin":          "0",
+			"totalMaintMargin":            "0",
+			"totalWalletBalance":          totalWallet.String(),
+			"totalUnrealizedProfit":       totalPnl.String(),
+			"totalMarginBalance":          totalWallet.Add(totalPnl).String(),
+			"totalPositionInitialMargin":  "0",
+			"totalOpenOrderInitialMargin": "0",
+			"totalCrossWalletBalance":     totalWallet.String(),
+			"totalCrossUnPnl":             totalPnl.String(),
+			"availableBalance":            totalWallet.Add(totalPnl).String(),
+			"maxWithdrawAmount":           totalWallet.String(),
+			"assets":                      assets,
+			"positions":                   positions,
+		}, nil
+	})
+}
+
+// markPrice return the book mid price, or the entry price without a book
+func (e *exchange) markPrice(symbol string) decimal.Decimal {
+	if mid := e.book(symbol).mid(); !mid.IsZero() {
+		return mid
+	}
+	return e.position(symbol).EntryPrice
+}
+
+// unrealizedProfit sum the profit of the open positions settled in asset
+func (e *exchange) unrealizedProfit(asset string) decimal.Decimal {
+	total := decimal.Zero
+	for symbol, pos := range e.positions {
+		if e.symbols[symbol].QuoteAsset != asset || pos.Amount.IsZero() {
+			continue
+		}
+		total = total.Add(e.markPrice(symbol).Sub(pos.EntryPrice).Mul(pos.Amount))
+	}
+	return total
+}
+
+func futuresOrder(o *order) map[string]any {
+	return map[string]any{
+		"symbol":        o.symbol,
+		"orderId":       o.id,
+		"clientOrderId": o.clientOrderID,
+		"price":         o.price.String(),
+		"reduceOnly":    o.reduceOnly,
+		"origQty":       o.origQty.String(),
+		"executedQty":   o.executedQty.String(),
+		"cumQty":        o.executedQty.String(),
+		"cumQuote":      o.cumQuote.String(),
+		"status":        o.status,
+		"timeInForce":   o.timeInForce,
+		"type":          o.orderType,
+		"origType":      o.orderType,
+		"side":          o.side,
+		"stopPrice":     "0",
+		"time":          o.time,
+		"updateTime":    o.updateTime,
+		"workingType":   "CONTRACT_PRICE",
+		"activatePrice": "0",
+		"priceRate":     "0",
+		"avgPrice":      o.avgPrice().String(),
+		"positionSide":  "BOTH",
+		"priceProtect":  false,
+		"closePosition": false,
+	}
+}
@@ -0,0 +1,361 @@
+// Package binancetest provides an in-process fake of the Binance spot and
+// futures REST APIs for deterministic tests.
+//
+// The server validates API keys, HMAC signatures and timestamps, keeps
+// balances, orders and positions in memory and fills orders against an order
+// book set by the test. Errors and latency can be injected per endpoint.
+//
+//	srv := binancetest.NewServer()
+//	defer srv.Close()
+//	srv.AddSymbol(binancetest.Spot, binancetest.Symbol{Name: "BTCUSDT", BaseAsset: "BTC", QuoteAsset: "USDT"})
+//	srv.SetBalance(binancetest.Spot, "USDT", decimal.NewFromInt(1000))
+//	srv.SetBook(binancetest.Spot, "BTCUSDT", nil, []binancetest.Level{binancetest.NewLevel("100", "5")})
+//	client := srv.NewSpotClient()
+//
+// Cassette records interactions with the real exchange to a file, with keys
+// and signatures redacted, and replays them so parsing of real payloads can
+// be pinned in regression tests.
+package binancetest
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ward-cap/go-binance/common"
+	"github.com/ward-cap/go-binance/futures"
+	binance "github.com/ward-cap/go-binance/services"
+)
+
+// Default credentials accepted by the server
+const (
+	DefaultAPIKey    = "binancetest-api-key"
+	DefaultSecretKey = "binancetest-secret-key"
+)
+
+// DefaultRecvWindow is applied to signed requests without recvWindow
+const DefaultRecvWindow = 5 * time.Second
+
+// Market define which API a symbol, balance or order belongs to
+type Market string
+
+// Markets
+const (
+	Spot    Market = "SPOT"
+	Futures Market = "FUTURES"
+)
+
+// Fault define an error or a delay injected into the matching requests
+type Fault struct {
+	// Method and Path select the requests, empty values match any
+	Method string
+	Path   string
+	// StatusCode, Code and Message define the error response, no error is
+	// returned when StatusCode is 0
+	StatusCode int
+	Code       int64
+	Message    string
+	Latency    time.Duration
+	// Executed let the request reach the exchange before the error is
+	// returned, as when the response of an executed order is lost
+	Executed bool
+	// Times is the number of requests affected, 0 means until ClearFaults
+	Times int
+}
+
+func (f *Fault) matches(r *http.Request) bool {
+	return (f.Method == "" || f.Method == r.Method) && (f.Path == "" || f.Path == r.URL.Path)
+}
+
+type route struct {
+	signed  bool
+	apiKey  bool
+	handler func(r *http.Request, p url.Values) (any, *apiError)
+}
+
+type apiError struct {
+	status  int
+	Code    int64  `json:"code"`
+	Message string `json:"msg"`
+}
+
+func newAPIError(status int, code int64, msg string) *apiError {
+	return &apiError{status: status, Code: code, Message: msg}
+}
+
+// Server is a fake Binance exchange serving the spot (/api) and futures
+// (/fapi) REST endpoints
+type Server struct {
+	*httptest.Server
+
+	APIKey     string
+	SecretKey  string
+	RecvWindow time.Duration
+
+	mu       sync.Mutex
+	now      func() time.Time
+	latency  time.Duration
+	faults   []*Fault
+	requests map[string]int
+	routes   map[string]route
+	markets  map[Market]*exchange
+}
+
+// NewServer start a fake exchange with the default credentials
+func NewServer() *Server {
+	s := &Server{
+		APIKey:     DefaultAPIKey,
+		SecretKey:  DefaultSecretKey,
+		RecvWindow: DefaultRecvWindow,
+		now:        time.Now,
+		requests:   map[string]int{},
+		markets: map[Market]*exchange{
+			Spot:    newExchange(Spot),
+			Futures: newExchange(Futures),
+		},
+	}
+	s.routes = map[string]route{}
+	s.registerSpot()
+	s.registerFutures()
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// NewSpotClient init a spot client talking to the server
+func (s *Server) NewSpotClient() *binance.Client {
+	c := binance.NewClient(s.APIKey, s.SecretKey, s.Client())
+	c.SetEnvironment(binance.Environment{BaseURL: s.URL})
+	return c
+}
+
+// NewFuturesClient init a futures client talking to the server
+func (s *Server) NewFuturesClient() *futures.Client {
+	c := futures.NewClient(s.APIKey, s.SecretKey, s.Client())
+	c.SetEnvironment(futures.Environment{BaseURL: s.URL})
+	return c
+}
+
+// SetClock replace the server clock, used for server time, timestamp checks
+// and order times
+func (s *Server) SetClock(now func() time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.now = now
+}
+
+// SetLatency delay every response by d
+func (s *Server) SetLatency(d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.latency = d
+}
+
+// Inject add a fault, faults are applied in the order they were added
+func (s *Server) Inject(f Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = append(s.faults, &f)
+}
+
+// InjectError make the next times requests to method and path fail with
+// code, sent with the status Binance uses for it: 429 for -1003 and -1015,
+// 401 for -1002, -2014 and -2015, 500 for -1000, 503 for -1001, -1006,
+// -1007, -1008 and -1016, and 400 for any other code such as -1013 or -1021.
+func (s *Server) InjectError(method, path string, code int64, msg string, times int) {
+	s.InjectErrorStatus(method, path, errorStatus(code), code, msg, times)
+}
+
+// InjectErrorStatus make the next times requests to method and path fail
+// with code and the given HTTP status
+func (s *Server) InjectErrorStatus(method, path string, status int, code int64, msg string, times int) {
+	s.Inject(Fault{Method: method, Path: path, StatusCode: status, Code: code, Message: msg, Times: times})
+}
+
+// errorStatus return the HTTP status Binance sends with code
+func errorStatus(code int64) int {
+	switch code {
+	case common.ErrCodeTooManyRequests, common.ErrCodeTooManyOrders:
+		return http.StatusTooManyRequests
+	case common.ErrCodeUnauthorized, common.ErrCodeBadAPIKeyFormat, common.ErrCodeRejectedMBXKey:
+		return http.StatusUnauthorized
+	case common.ErrCodeUnknown:
+		return http.StatusInternalServerError
+	case common.ErrCodeDisconnected, common.ErrCodeUnexpectedResponse, common.ErrCodeTimeout,
+		common.ErrCodeServerBusy, common.ErrCodeServiceShuttingDown:
+		return http.StatusServiceUnavailable
+	}
+	return http.StatusBadRequest
+}
+
+// ClearFaults remove every fault
+func (s *Server) ClearFaults() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = nil
+}
+
+// RequestCount return how many requests reached method and path
+func (s *Server) RequestCount(method, path string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requests[method+" "+path]
+}
+
+func (s *Server) handle(method, path string, signed bool, handler func(r *http.Request, p url.Values) (any, *apiError)) {
+	s.routes[method+" "+path] = route{signed: signed, apiKey: signed, handler: handler}
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	key := r.Method + " " + r.URL.Path
+
+	s.mu.Lock()
+	s.requests[key]++
+	delay := s.latency
+	var fault *Fault
+	for i, f := range s.faults {
+		if !f.matches(r) {
+			continue
+		}
+		delay += f.Latency
+		if f.StatusCode != 0 && fault == nil {
+			fault = f
+		}
+		if f.Times > 0 {
+			f.Times--
+			if f.Times == 0 {
+				s.faults[i] = nil
+			}
+		}
+	}
+	faults := s.faults[:0]
+	for _, f := range s.faults {
+		if f != nil {
+			faults = append(faults, f)
+		}
+	}
+	s.faults = faults
+	s.mu.Unlock()
+
+	if delay > 0 {
+		select {
+		case <-time.After(delay):
+		case <-r.Context().Done():
+			return
+		}
+	}
+	if fault != nil && !fault.Executed {
+		writeJSON(w, fault.StatusCode, &apiError{Code: fault.Code, Message: fault.Message})
+		return
+	}
+
+	rt, ok := s.routes[key]
+	if !ok {
+		writeJSON(w, http.StatusNotFound, newAPIError(http.StatusNotFound, -1000, "unknown endpoint "+key))
+		return
+	}
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, newAPIError(http.StatusBadRequest, -1000, err.Error()))
+		return
+	}
+	params, perr := parseParams(r.URL.RawQuery, string(body))
+	if perr != nil {
+		writeAPIError(w, perr)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if rt.apiKey && r.Header.Get("X-MBX-APIKEY") != s.APIKey {
+		writeAPIError(w, newAPIError(http.StatusUnauthorized, -2015, "Invalid API-key, IP, or permissions for action."))
+		return
+	}
+	if rt.signed {
+		if aerr := s.checkSignature(r.URL.RawQuery, string(body), params); aerr != nil {
+			writeAPIError(w, aerr)
+			return
+		}
+	}
+	res, aerr := rt.handler(r, params)
+	if fault != nil {
+		writeJSON(w, fault.StatusCode, &apiError{Code: fault.Code, Message: fault.Message})
+		return
+	}
+	if aerr != nil {
+		writeAPIError(w, aerr)
+		return
+	}
+	writeJSON(w, http.StatusOK, res)
+}
+
+// checkSignature verify the HMAC SHA256 signature over the query string and
+// body, and the timestamp against recvWindow
+func (s *Server) checkSignature(rawQuery, body string, p url.Values) *apiError {
+	signature := p.Get("signature")
+	if signature == "" {
+		return newAPIError(http.StatusBadRequest, -1102, "Mandatory parameter 'signature' was not sent, was empty/null, or malformed.")
+	}
+	var parts []string
+	for _, part := range strings.Split(rawQuery, "&") {
+		if part != "" && !strings.HasPrefix(part, "signature=") {
+			parts = append(parts, part)
+		}
+	}
+	mac := hmac.New(sha256.New, []byte(s.SecretKey))
+	mac.Write([]byte(strings.Join(parts, "&") + body))
+	if !hmac.Equal([]byte(hex.EncodeToString(mac.Sum(nil))), []byte(signature)) {
+		return newAPIError(http.StatusBadRequest, -1022, "Signature for this request is not valid.")
+	}
+
+	timestamp, err := strconv.ParseInt(p.Get("timestamp"), 10, 64)
+	if err != nil {
+		return newAPIError(http.StatusBadRequest, -1102, "Mandatory parameter 'timestamp' was not sent, was empty/null, or malformed.")
+	}
+	recvWindow := s.RecvWindow
+	if v := p.Get("recvWindow"); v != "" {
+		ms, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return newAPIError(http.StatusBadRequest, -1100, "Illegal characters found in parameter 'recvWindow'.")
+		}
+		recvWindow = time.Duration(ms) * time.Millisecond
+	}
+	now := s.now().UnixMilli()
+	if timestamp > now+1000 || now-timestamp > recvWindow.Milliseconds() {
+		return newAPIError(http.StatusBadRequest, -1021, "Timestamp for this request is outside of the recvWindow.")
+	}
+	return nil
+}
+
+func parseParams(rawQuery, body string) (url.Values, *apiError) {
+	p, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return nil, newAPIError(http.StatusBadRequest, -1100, err.Error())
+	}
+	form, err := url.ParseQuery(body)
+	if err != nil {
+		return nil, newAPIError(http.StatusBadRequest, -1100, err.Error())
+	}
+	for k, v := range form {
+		p[k] = v
+	}
+	return p, nil
+}
+
+func writeAPIError(w http.ResponseWriter, e *apiError) {
+	writeJSON(w, e.status, e)
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,191 @@
+package binancetest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/ward-cap/go-binance/common"
+	"github.com/ward-cap/go-binance/futures"
+	binance "github.com/ward-cap/go-binance/services"
+)
+
+func newTestServer(t *testing.T) *Server {
+	t.Helper()
+	srv := NewServer()
+	t.Cleanup(srv.Close)
+	srv.AddSymbol(Spot, Symbol{Name: "BTCUSDT", BaseAsset: "BTC", QuoteAsset: "USDT"})
+	srv.SetBalance(Spot, "USDT", decimal.NewFromInt(1000))
+	srv.SetBook(Spot, "BTCUSDT", nil, []Level{NewLevel("100", "5")})
+	return srv
+}
+
+func newMarketBuy(c *binance.Client, quantity string) *binance.CreateOrderService {
+	return c.NewCreateOrderService().Symbol("BTCUSDT").Side(binance.SideTypeBuy).
+		Type(binance.OrderTypeMarket).Quantity(quantity)
+}
+
+func TestSpotMarketOrder(t *testing.T) {
+	srv := newTestServer(t)
+	c := srv.NewSpotClient()
+
+	res, err := newMarketBuy(c, "2").Do(context.Background())
+	if err != nil {
+		t.Fatalf("CreateOrder: %v", err)
+	}
+	if res.Status != binance.OrderStatusTypeFilled || res.ExecutedQuantity != "2" || len(res.Fills) != 1 {
+		t.Fatalf("response = %+v", res)
+	}
+	if got := srv.Balance(Spot, "USDT").Free; !got.Equal(decimal.NewFromInt(800)) {
+		t.Fatalf("USDT free = %s, want 800", got)
+	}
+	if got := srv.Balance(Spot, "BTC").Free; !got.Equal(decimal.NewFromInt(2)) {
+		t.Fatalf("BTC free = %s, want 2", got)
+	}
+
+	order, err := c.NewGetOrderService().Symbol("BTCUSDT").OrderID(res.OrderID).Do(context.Background())
+	if err != nil {
+		t.Fatalf("GetOrder: %v", err)
+	}
+	if order.Status != binance.OrderStatusTypeFilled {
+		t.Fatalf("order = %+v", order)
+	}
+}
+
+func TestInjectError(t *testing.T) {
+	for _, tt := range []struct {
+		code   int64
+		status int
+		is     error
+	}{
+		{common.ErrCodeInvalidTimestamp, http.StatusBadRequest, common.ErrInvalidTimestamp},
+		{common.ErrCodeFilterFailure, http.StatusBadRequest, common.ErrFilterFailure},
+		{common.ErrCodeTooManyRequests, http.StatusTooManyRequests, common.ErrRateLimited},
+		{common.ErrCodeTimeout, http.StatusServiceUnavailable, nil},
+		{common.ErrCodeRejectedMBXKey, http.StatusUnauthorized, nil},
+	} {
+		srv := newTestServer(t)
+		c := srv.NewSpotClient()
+		srv.InjectError(http.MethodPost, "/api/v3/order", tt.code, "injected", 1)
+
+		_, err := newMarketBuy(c, "1").Do(context.Background())
+		var apiErr *common.APIError
+		if !errors.As(err, &apiErr) || apiErr.Code != tt.code || apiErr.Message != "injected" {
+			t.Fatalf("%d: error = %v", tt.code, err)
+		}
+		if apiErr.StatusCode != tt.status {
+			t.Fatalf("%d: status = %d, want %d", tt.code, apiErr.StatusCode, tt.status)
+		}
+		if tt.is != nil && !errors.Is(err, tt.is) {
+			t.Fatalf("%d: error %v is not %v", tt.code, err, tt.is)
+		}
+
+		// the fault is spent, the next request reaches the exchange
+		if _, err := newMarketBuy(c, "1").Do(context.Background()); err != nil {
+			t.Fatalf("%d: CreateOrder after the fault: %v", tt.code, err)
+		}
+	}
+}
+
+func TestInjectErrorStatus(t *testing.T) {
+	srv := newTestServer(t)
+	c := srv.NewSpotClient()
+	srv.InjectErrorStatus(http.MethodGet, "/api/v3/account", http.StatusTeapot, common.ErrCodeTooManyRequests, "banned", 0)
+
+	for i := 0; i < 2; i++ {
+		_, err := c.NewGetAccountService().Do(context.Background())
+		var apiErr *common.APIError
+		if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusTeapot {
+			t.Fatalf("error = %v, want status 418", err)
+		}
+	}
+	srv.ClearFaults()
+	if _, err := c.NewGetAccountService().Do(context.Background()); err != nil {
+		t.Fatalf("GetAccount after ClearFaults: %v", err)
+	}
+	if n := srv.RequestCount(http.MethodGet, "/api/v3/account"); n != 3 {
+		t.Fatalf("requests = %d, want 3", n)
+	}
+}
+
+func TestRetryLooksUpOrderBeforeResend(t *testing.T) {
+	srv := newTestServer(t)
+	c := srv.NewSpotClient()
+	c.RetryPolicy = &common.BackoffRetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond}
+	srv.InjectError(http.MethodPost, "/api/v3/order", common.ErrCodeTimeout, "Timeout waiting for response from backend server.", 1)
+
+	res, err := newMarketBuy(c, "1").NewClientOrderID("retry-1").Do(context.Background())
+	if err != nil {
+		t.Fatalf("CreateOrder: %v", err)
+	}
+	if res.ClientOrderID != "retry-1" || res.Recovered {
+		t.Fatalf("response = %+v", res)
+	}
+	if n := srv.RequestCount(http.MethodGet, "/api/v3/order"); n != 1 {
+		t.Fatalf("lookups = %d, want 1", n)
+	}
+	if n := srv.RequestCount(http.MethodPost, "/api/v3/order"); n != 2 {
+		t.Fatalf("placements = %d, want 2", n)
+	}
+	if got := srv.Balance(Spot, "BTC").Free; !got.Equal(decimal.NewFromInt(1)) {
+		t.Fatalf("BTC free = %s, want 1", got)
+	}
+}
+
+func TestFuturesRetryRecoversPlacedOrder(t *testing.T) {
+	srv := newTestServer(t)
+	srv.AddSymbol(Futures, Symbol{Name: "BTCUSDT", BaseAsset: "BTC", QuoteAsset: "USDT"})
+	srv.SetBalance(Futures, "USDT", decimal.NewFromInt(1000))
+	srv.SetBook(Futures, "BTCUSDT", nil, []Level{NewLevel("100", "5")})
+	c := srv.NewFuturesClient()
+	c.RetryPolicy = &common.BackoffRetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond}
+	srv.Inject(Fault{
+		Method:     http.MethodPost,
+		Path:       "/fapi/v1/order",
+		StatusCode: http.StatusServiceUnavailable,
+		Code:       common.ErrCodeTimeout,
+		Message:    "Timeout waiting for response from backend server.",
+		Executed:   true,
+		Times:      1,
+	})
+
+	res, err := c.NewCreateOrderService().Symbol("BTCUSDT").Side(futures.SideTypeBuy).
+		Type(futures.OrderTypeMarket).Quantity("1").NewClientOrderID("retry-1").Do(context.Background())
+	if err != nil {
+		t.Fatalf("CreateOrder: %v", err)
+	}
+	if !res.Recovered || res.ClientOrderID != "retry-1" || res.Status != futures.OrderStatusTypeFilled || res.ExecutedQuantity != "1" {
+		t.Fatalf("response = %+v", res)
+	}
+	if n := srv.RequestCount(http.MethodPost, "/fapi/v1/order"); n != 1 {
+		t.Fatalf("placements = %d, want 1", n)
+	}
+	if n := srv.RequestCount(http.MethodGet, "/fapi/v1/order"); n != 1 {
+		t.Fatalf("lookups = %d, want 1", n)
+	}
+}
+
+func TestSignatureAndTimestampChecks(t *testing.T) {
+	srv := newTestServer(t)
+	c := srv.NewSpotClient()
+
+	c.SecretKey = "wrong"
+	_, err := c.NewGetAccountService().Do(context.Background())
+	if !errors.Is(err, common.ErrUnauthorized) {
+		t.Fatalf("error = %v, want an auth error", err)
+	}
+	var apiErr *common.APIError
+	if !errors.As(err, &apiErr) || apiErr.Code != common.ErrCodeInvalidSignature {
+		t.Fatalf("error = %v, want -1022", err)
+	}
+
+	c.SecretKey = srv.SecretKey
+	srv.SetClock(func() time.Time { return time.Now().Add(time.Minute) })
+	_, err = c.NewGetAccountService().Do(context.Background())
+	if !errors.As(err, &apiErr) || apiErr.Code != common.ErrCodeInvalidTimestamp || apiErr.StatusCode != http.StatusBadRequest {
+		t.Fatalf("error = %v, want -1021 with status 400", err)
+	}
+}
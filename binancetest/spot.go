@@ -0,0 +1,152 @@
+package binancetest
+
+import (
+	"net/http"
+	"net/url"
+)
+
+func (s *Server) registerSpot() {
+	e := s.markets[Spot]
+	s.handle(http.MethodGet, "/api/v3/ping", false, func(*http.Request, url.Values) (any, *apiError) {
+		return map[string]any{}, nil
+	})
+	s.handle(http.MethodGet, "/api/v3/time", false, func(*http.Request, url.Values) (any, *apiError) {
+		return map[string]any{"serverTime": s.now().UnixMilli()}, nil
+	})
+	s.handle(http.MethodGet, "/api/v3/exchangeInfo", false, func(*http.Request, url.Values) (any, *apiError) {
+		return s.exchangeInfo(e), nil
+	})
+	s.handle(http.MethodGet, "/api/v3/depth", false, func(_ *http.Request, p url.Values) (any, *apiError) {
+		return s.depth(e, p)
+	})
+	s.handle(http.MethodGet, "/api/v3/ticker/price", false, func(_ *http.Request, p url.Values) (any, *apiError) {
+		return s.tickerPrice(e, p)
+	})
+	s.handle(http.MethodPost, "/api/v3/order", true, func(_ *http.Request, p url.Values) (any, *apiError) {
+		o, quoteQty, err := s.newOrder(e, p)
+		if err != nil {
+			return nil, err
+		}
+		fills, err := e.place(o, quoteQty)
+		if err != nil {
+			return nil, err
+		}
+		res := spotOrder(o)
+		delete(res, "time")
+		delete(res, "updateTime")
+		res["transactTime"] = o.time
+		res["fills"] = spotFills(fills)
+		return res, nil
+	})
+	s.handle(http.MethodGet, "/api/v3/order", true, func(_ *http.Request, p url.Values) (any, *apiError) {
+		o, err := lookupOrder(e, p, newAPIError(http.StatusBadRequest, -2013, "Order does not exist."))
+		if err != nil {
+			return nil, err
+		}
+		return spotOrder(o), nil
+	})
+	s.handle(http.MethodDelete, "/api/v3/order", true, func(_ *http.Request, p url.Values) (any, *apiError) {
+		unknown := newAPIError(http.StatusBadRequest, -2011, "Unknown order sent.")
+		o, err := lookupOrder(e, p, unknown)
+		if err != nil {
+			return nil, err
+		}
+		if !o.open() {
+			return nil, unknown
+		}
+		e.cancel(o, s.now().UnixMilli())
+		return spotCancel(o), nil
+	})
+	s.handle(http.MethodGet, "/api/v3/openOrders", true, func(_ *http.Request, p url.Values) (any, *apiError) {
+		res := []map[string]any{}
+		for _, o := range filterOrders(e, p.Get("symbol"), true) {
+			res = append(res, spotOrder(o))
+		}
+		return res, nil
+	})
+	s.handle(http.MethodDelete, "/api/v3/openOrders", true, func(_ *http.Request, p url.Values) (any, *apiError) {
+		if p.Get("symbol") == "" {
+			return nil, mandatoryParam("symbol")
+		}
+		res := []map[string]any{}
+		for _, o := range filterOrders(e, p.Get("symbol"), true) {
+			e.cancel(o, s.now().UnixMilli())
+			res = append(res, spotCancel(o))
+		}
+		return res, nil
+	})
+	s.handle(http.MethodGet, "/api/v3/allOrders", true, func(_ *http.Request, p url.Values) (any, *apiError) {
+		if p.Get("symbol") == "" {
+			return nil, mandatoryParam("symbol")
+		}
+		res := []map[string]any{}
+		for _, o := range filterOrders(e, p.Get("symbol"), false) {
+			res = append(res, spotOrder(o))
+		}
+		return res, nil
+	})
+	s.handle(http.MethodGet, "/api/v3/account", true, func(*http.Request, url.Values) (any, *apiError) {
+		balances := []map[string]any{}
+		for _, asset := range sortedAssets(e) {
+			b := e.balances[asset]
+			balances = append(balances, map[string]any{
+				"asset":  asset,
+				"free":   b.Free.String(),
+				"locked": b.Locked.String(),
+			})
+		}
+		return map[string]any{
+			"canTrade":    true,
+			"canWithdraw": true,
+			"canDeposit":  true,
+			"updateTime":  s.now().UnixMilli(),
+			"accountType": "SPOT",
+			"balances":    balances,
+			"permissions": []string{"SPOT"},
+		}, nil
+	})
+}
+
+func spotOrder(o *order) map[string]any {
+	return map[string]any{
+		"symbol":              o.symbol,
+		"orderId":             o.id,
+		"orderListId":         -1,
+		"clientOrderId":       o.clientOrderID,
+		"price":               o.price.String(),
+		"origQty":             o.origQty.String(),
+		"executedQty":         o.executedQty.String(),
+		"cummulativeQuoteQty": o.cumQuote.String(),
+		"status":              o.status,
+		"timeInForce":         o.timeInForce,
+		"type":                o.orderType,
+		"side":                o.side,
+		"stopPrice":           "0",
+		"icebergQty":          "0",
+		"time":                o.time,
+		"updateTime":          o.updateTime,
+		"isWorking":           true,
+		"origQuoteOrderQty":   "0",
+	}
+}
+
+func spotCancel(o *order) map[string]any {
+	res := spotOrder(o)
+	res["origClientOrderId"] = o.clientOrderID
+	res["transactTime"] = o.updateTime
+	return res
+}
+
+func spotFills(fills []fill) []map[string]any {
+	res := make([]map[string]any, len(fills))
+	for i, f := range fills {
+		res[i] = map[string]any{
+			"price":           f.price.String(),
+			"qty":             f.quantity.String(),
+			"commission":      "0",
+			"commissionAsset": "BNB",
+			"tradeId":         f.tradeID,
+		}
+	}
+	return res
+}
@@ -0,0 +1,310 @@
+package binancetest
+
+import (
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+
+	"github.com/shopspring/decimal"
+)
+
+// AddSymbol list a symbol on a market, zero filter values get defaults
+func (s *Server) AddSymbol(m Market, sym Symbol) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if sym.TickSize.IsZero() {
+		sym.TickSize = decimal.RequireFromString("0.01")
+	}
+	if sym.StepSize.IsZero() {
+		sym.StepSize = decimal.RequireFromString("0.00001")
+	}
+	if sym.MinNotional.IsZero() {
+		sym.MinNotional = decimal.NewFromInt(5)
+	}
+	s.markets[m].symbols[sym.Name] = sym
+}
+
+// RemoveSymbol delist a symbol from the exchangeInfo of a market
+func (s *Server) RemoveSymbol(m Market, name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.markets[m].symbols, name)
+}
+
+// SetBalance set the free balance of asset, for futures it is the wallet
+// balance of the margin asset
+func (s *Server) SetBalance(m Market, asset string, free decimal.Decimal) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.markets[m].balance(asset).Free = free
+}
+
+// Balance return the balance of asset
+func (s *Server) Balance(m Market, asset string) Balance {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return *s.markets[m].balance(asset)
+}
+
+// SetPosition set the futures position of symbol
+func (s *Server) SetPosition(symbol string, amount, entryPrice decimal.Decimal) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p := s.markets[Futures].position(symbol)
+	p.Amount, p.EntryPrice = amount, entryPrice
+}
+
+// Position return the futures position of symbol
+func (s *Server) Position(symbol string) Position {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return *s.markets[Futures].position(symbol)
+}
+
+// SetBook replace the order book of symbol. Resting orders crossing the new
+// book are filled against it.
+func (s *Server) SetBook(m Market, symbol string, bids, asks []Level) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e := s.markets[m]
+	e.book(symbol).set(bids, asks)
+	e.rematch(symbol, s.now().UnixMilli())
+}
+
+func decimalParam(p url.Values, key string, required bool) (decimal.Decimal, *apiError) {
+	v := p.Get(key)
+	if v == "" {
+		if required {
+			return decimal.Zero, mandatoryParam(key)
+		}
+		return decimal.Zero, nil
+	}
+	d, err := decimal.NewFromString(v)
+	if err != nil {
+		return decimal.Zero, newAPIError(http.StatusBadRequest, -1100, "Illegal characters found in parameter '"+key+"'.")
+	}
+	return d, nil
+}
+
+func intParam(p url.Values, key string) (int64, *apiError) {
+	v := p.Get(key)
+	if v == "" {
+		return 0, nil
+	}
+	n, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return 0, newAPIError(http.StatusBadRequest, -1100, "Illegal characters found in parameter '"+key+"'.")
+	}
+	return n, nil
+}
+
+func mandatoryParam(key string) *apiError {
+	return newAPIError(http.StatusBadRequest, -1102, "Mandatory parameter '"+key+"' was not sent, was empty/null, or malformed.")
+}
+
+// newOrder build an order from the order placement params
+func (s *Server) newOrder(e *exchange, p url.Values) (*order, decimal.Decimal, *apiError) {
+	for _, key := range []string{"symbol", "side", "type"} {
+		if p.Get(key) == "" {
+			return nil, decimal.Zero, mandatoryParam(key)
+		}
+	}
+	qty, err := decimalParam(p, "quantity", false)
+	if err != nil {
+		return nil, decimal.Zero, err
+	}
+	quoteQty, err := decimalParam(p, "quoteOrderQty", false)
+	if err != nil {
+		return nil, decimal.Zero, err
+	}
+	price, err := decimalParam(p, "price", false)
+	if err != nil {
+		return nil, decimal.Zero, err
+	}
+	e.nextID++
+	now := s.now().UnixMilli()
+	o := &order{
+		symbol:        p.Get("symbol"),
+		id:            e.nextID,
+		clientOrderID: p.Get("newClientOrderId"),
+		side:          p.Get("side"),
+		orderType:     p.Get("type"),
+		timeInForce:   p.Get("timeInForce"),
+		price:         price,
+		origQty:       qty,
+		reduceOnly:    p.Get("reduceOnly") == "true",
+		time:          now,
+		updateTime:    now,
+	}
+	if o.clientOrderID == "" {
+		o.clientOrderID = "binancetest-" + strconv.FormatInt(o.id, 10)
+	}
+	if o.side != "BUY" && o.side != "SELL" {
+		return nil, decimal.Zero, newAPIError(http.StatusBadRequest, -1117, "Invalid side.")
+	}
+	if o.orderType == "LIMIT" && o.timeInForce == "" {
+		return nil, decimal.Zero, mandatoryParam("timeInForce")
+	}
+	if o.orderType != "LIMIT" {
+		o.timeInForce = "GTC"
+	}
+	return o, quoteQty, nil
+}
+
+// lookupOrder find the order selected by orderId or origClientOrderId
+func lookupOrder(e *exchange, p url.Values, notFound *apiError) (*order, *apiError) {
+	if p.Get("symbol") == "" {
+		return nil, mandatoryParam("symbol")
+	}
+	id, err := intParam(p, "orderId")
+	if err != nil {
+		return nil, err
+	}
+	clientOrderID := p.Get("origClientOrderId")
+	if id == 0 && clientOrderID == "" {
+		return nil, newAPIError(http.StatusBadRequest, -1102, "Param 'origClientOrderId' or 'orderId' must be sent, but both were empty/null!")
+	}
+	o := e.findOrder(p.Get("symbol"), id, clientOrderID)
+	if o == nil {
+		return nil, notFound
+	}
+	return o, nil
+}
+
+func filterOrders(e *exchange, symbol string, open bool) []*order {
+	var orders []*order
+	for _, o := range e.orders {
+		if (symbol == "" || o.symbol == symbol) && (!open || o.open()) {
+			orders = append(orders, o)
+		}
+	}
+	return orders
+}
+
+func levels(levels []Level, limit int) [][2]string {
+	if limit > 0 && len(levels) > limit {
+		levels = levels[:limit]
+	}
+	res := make([][2]string, len(levels))
+	for i, l := range levels {
+		res[i] = [2]string{l.Price.String(), l.Quantity.String()}
+	}
+	return res
+}
+
+func (s *Server) depth(e *exchange, p url.Values) (any, *apiError) {
+	if p.Get("symbol") == "" {
+		return nil, mandatoryParam("symbol")
+	}
+	if _, ok := e.symbols[p.Get("symbol")]; !ok {
+		return nil, newAPIError(http.StatusBadRequest, -1121, "Invalid symbol.")
+	}
+	limit, err := intParam(p, "limit")
+	if err != nil {
+		return nil, err
+	}
+	if limit == 0 {
+		limit = 100
+	}
+	b := e.book(p.Get("symbol"))
+	now := s.now().UnixMilli()
+	res := map[string]any{
+		"lastUpdateId": b.updateID,
+		"bids":         levels(b.bids, int(limit)),
+		"asks":         levels(b.asks, int(limit)),
+	}
+	if e.market == Futures {
+		res["E"], res["T"] = now, now
+	}
+	return res, nil
+}
+
+func (s *Server) tickerPrice(e *exchange, p url.Values) (any, *apiError) {
+	price := func(symbol string) map[string]any {
+		res := map[string]any{"symbol": symbol, "price": e.book(symbol).mid().String()}
+		if e.market == Futures {
+			res["time"] = s.now().UnixMilli()
+		}
+		return res
+	}
+	if symbol := p.Get("symbol"); symbol != "" {
+		if _, ok := e.symbols[symbol]; !ok {
+			return nil, newAPIError(http.StatusBadRequest, -1121, "Invalid symbol.")
+		}
+		return price(symbol), nil
+	}
+	res := []map[string]any{}
+	for _, name := range sortedSymbols(e) {
+		res = append(res, price(name))
+	}
+	return res, nil
+}
+
+func (s *Server) exchangeInfo(e *exchange) any {
+	symbols := []map[string]any{}
+	for _, name := range sortedSymbols(e) {
+		sym := e.symbols[name]
+		filters := []map[string]any{
+			{"filterType": "PRICE_FILTER", "minPrice": sym.TickSize.String(), "maxPrice": "1000000", "tickSize": sym.TickSize.String()},
+			{"filterType": "LOT_SIZE", "minQty": sym.StepSize.String(), "maxQty": "9000", "stepSize": sym.StepSize.String()},
+		}
+		status := sym.Status
+		if status == "" {
+			status = "TRADING"
+		}
+		info := map[string]any{
+			"symbol":     name,
+			"status":     status,
+			"baseAsset":  sym.BaseAsset,
+			"quoteAsset": sym.QuoteAsset,
+		}
+		if e.market == Spot {
+			filters = append(filters, map[string]any{"filterType": "NOTIONAL", "minNotional": sym.MinNotional.String(), "maxNotional": "9000000"})
+			info["orderTypes"] = []string{"LIMIT", "LIMIT_MAKER", "MARKET"}
+			info["isSpotTradingAllowed"] = true
+			info["quoteOrderQtyMarketAllowed"] = true
+			info["permissions"] = []string{"SPOT"}
+		} else {
+			filters = append(filters, map[string]any{"filterType": "MIN_NOTIONAL", "notional": sym.MinNotional.String()})
+			info["pair"] = name
+			info["contractType"] = "PERPETUAL"
+			info["marginAsset"] = sym.QuoteAsset
+			info["orderType"] = []string{"LIMIT", "MARKET"}
+			info["timeInForce"] = []string{"GTC", "IOC", "FOK", "GTX"}
+			info["pricePrecision"] = -int(sym.TickSize.Exponent())
+			info["quantityPrecision"] = -int(sym.StepSize.Exponent())
+		}
+		info["filters"] = filters
+		symbols = append(symbols, info)
+	}
+	return map[string]any{
+		"timezone":   "UTC",
+		"serverTime": s.now().UnixMilli(),
+		"rateLimits": []map[string]any{
+			{"rateLimitType": "REQUEST_WEIGHT", "interval": "MINUTE", "intervalNum": 1, "limit": 6000},
+			{"rateLimitType": "ORDERS", "interval": "SECOND", "intervalNum": 10, "limit": 100},
+			{"rateLimitType": "ORDERS", "interval": "DAY", "intervalNum": 1, "limit": 200000},
+		},
+		"exchangeFilters": []any{},
+		"symbols":         symbols,
+	}
+}
+
+func sortedSymbols(e *exchange) []string {
+	names := make([]string, 0, len(e.symbols))
+	for name := range e.symbols {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func sortedAssets(e *exchange) []string {
+	assets := make([]string, 0, len(e.balances))
+	for asset := range e.balances {
+		assets = append(assets, asset)
+	}
+	sort.Strings(assets)
+	return assets
+}
@@ -0,0 +1,172 @@
+package common
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Default settings of the clock synchronizer
+const (
+	DefaultClockSyncInterval  = 5 * time.Minute
+	DefaultClockSyncSmoothing = 0.2
+	DefaultClockMinResync     = time.Second
+)
+
+// ServerTimeFunc return the server time in milliseconds
+type ServerTimeFunc func(ctx context.Context) (int64, error)
+
+// ClockSample define one measure of the offset to the server clock
+type ClockSample struct {
+	// Offset is local time minus server time in milliseconds, taken at the
+	// midpoint of the round trip
+	Offset int64
+	RTT    time.Duration
+}
+
+// MeasureTimeOffset sample the offset of the local clock to the server clock.
+// The server time is assumed to be read halfway through the round trip.
+func MeasureTimeOffset(ctx context.Context, serverTime ServerTimeFunc) (ClockSample, error) {
+	sent := time.Now()
+	st, err := serverTime(ctx)
+	if err != nil {
+		return ClockSample{}, err
+	}
+	rtt := time.Since(sent)
+	return ClockSample{
+		Offset: sent.Add(rtt/2).UnixMilli() - st,
+		RTT:    rtt,
+	}, nil
+}
+
+// ClockSyncStats define the drift metrics of a ClockSync
+type ClockSyncStats struct {
+	// Offset is the smoothed offset in milliseconds
+	Offset int64
+	// LastSample is the offset measured by the last sync
+	LastSample ClockSample
+	// Drift is the last sample minus the smoothed offset before it
+	Drift int64
+	// MaxDrift is the largest absolute drift seen
+	MaxDrift int64
+	LastSync time.Time
+	Syncs    int64
+	Resyncs  int64
+	Failures int64
+}
+
+// ClockSync keeps a time offset in line with the server clock. The offset is
+// stored atomically so it can be shared with request signing.
+type ClockSync struct {
+	Interval time.Duration
+	// Smoothing is the weight of a new sample in the moving average, 1
+	// disables smoothing
+	Smoothing float64
+	// MinResync is the minimum time between two resyncs
+	MinResync time.Duration
+	OnError   func(err error)
+
+	serverTime ServerTimeFunc
+	offset     *int64
+
+	mu    sync.Mutex
+	stats ClockSyncStats
+}
+
+// NewClockSync init a clock synchronizer writing to offset
+func NewClockSync(serverTime ServerTimeFunc, offset *int64) *ClockSync {
+	return &ClockSync{
+		Interval:   DefaultClockSyncInterval,
+		Smoothing:  DefaultClockSyncSmoothing,
+		MinResync:  DefaultClockMinResync,
+		serverTime: serverTime,
+		offset:     offset,
+	}
+}
+
+// Sync take a sample and fold it into the smoothed offset. The first sample
+// is applied as is.
+func (s *ClockSync) Sync(ctx context.Context) error {
+	return s.sync(ctx, false)
+}
+
+// Resync take a sample and apply it without smoothing, used when the server
+// rejected a timestamp. Calls within MinResync of the last sync are skipped.
+func (s *ClockSync) Resync(ctx context.Context) error {
+	s.mu.Lock()
+	recent := time.Since(s.stats.LastSync) < s.MinResync
+	s.mu.Unlock()
+	if recent {
+		return nil
+	}
+	return s.sync(ctx, true)
+}
+
+// Run sync every Interval until ctx is done
+func (s *ClockSync) Run(ctx context.Context) {
+	interval := s.Interval
+	if interval <= 0 {
+		interval = DefaultClockSyncInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.Sync(ctx); err != nil && s.OnError != nil && ctx.Err() == nil {
+				s.OnError(err)
+			}
+		}
+	}
+}
+
+// Offset return the current offset in milliseconds
+func (s *ClockSync) Offset() int64 {
+	return atomic.LoadInt64(s.offset)
+}
+
+// Stats return the drift metrics
+func (s *ClockSync) Stats() ClockSyncStats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stats := s.stats
+	stats.Offset = atomic.LoadInt64(s.offset)
+	return stats
+}
+
+func (s *ClockSync) sync(ctx context.Context, force bool) error {
+	sample, err := MeasureTimeOffset(ctx, s.serverTime)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err != nil {
+		s.stats.Failures++
+		return err
+	}
+
+	current := atomic.LoadInt64(s.offset)
+	offset := sample.Offset
+	if s.stats.Syncs > 0 && !force && s.Smoothing > 0 && s.Smoothing < 1 {
+		offset = current + int64(s.Smoothing*float64(sample.Offset-current))
+	}
+	atomic.StoreInt64(s.offset, offset)
+
+	drift := sample.Offset - current
+	if s.stats.Syncs == 0 {
+		drift = 0
+	}
+	if abs := max(drift, -drift); abs > s.stats.MaxDrift {
+		s.stats.MaxDrift = abs
+	}
+	s.stats.Drift = drift
+	s.stats.LastSample = sample
+	s.stats.LastSync = time.Now()
+	s.stats.Syncs++
+	if force {
+		s.stats.Resyncs++
+	}
+	return nil
+}
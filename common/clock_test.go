@@ -0,0 +1,121 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// fakeServerClock is a server clock lag milliseconds behind the local clock
+type fakeServerClock struct {
+	lag   int64
+	err   error
+	calls int
+}
+
+func (c *fakeServerClock) serverTime(context.Context) (int64, error) {
+	c.calls++
+	if c.err != nil {
+		return 0, c.err
+	}
+	return time.Now().UnixMilli() - c.lag, nil
+}
+
+// near report whether got is within the few milliseconds a sample may be off
+func near(got, want int64) bool {
+	return got >= want-5 && got <= want+5
+}
+
+func TestClockSyncSmoothing(t *testing.T) {
+	clock := &fakeServerClock{lag: 1000}
+	var offset int64
+	s := NewClockSync(clock.serverTime, &offset)
+	s.MinResync = 0
+
+	// the first sample is applied as is
+	if err := s.Sync(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if !near(offset, 1000) {
+		t.Fatalf("offset after the first sync = %d, want 1000", offset)
+	}
+
+	// later samples move the offset by Smoothing of the difference
+	clock.lag = 2000
+	if err := s.Sync(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if !near(offset, 1200) {
+		t.Fatalf("offset after a smoothed sync = %d, want 1200", offset)
+	}
+	if stats := s.Stats(); !near(stats.Drift, 1000) || stats.Resyncs != 0 || stats.Syncs != 2 {
+		t.Fatalf("stats = %+v", stats)
+	}
+
+	// a resync applies the sample without smoothing
+	if err := s.Resync(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if !near(offset, 2000) {
+		t.Fatalf("offset after a resync = %d, want 2000", offset)
+	}
+	if stats := s.Stats(); stats.Resyncs != 1 || stats.Syncs != 3 || !near(stats.MaxDrift, 1000) {
+		t.Fatalf("stats = %+v", stats)
+	}
+}
+
+func TestClockSyncNoSmoothing(t *testing.T) {
+	clock := &fakeServerClock{lag: 1000}
+	var offset int64
+	s := NewClockSync(clock.serverTime, &offset)
+	s.Smoothing = 1
+
+	for _, lag := range []int64{1000, -500} {
+		clock.lag = lag
+		if err := s.Sync(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+		if !near(offset, lag) {
+			t.Fatalf("offset = %d, want %d", offset, lag)
+		}
+	}
+}
+
+func TestClockSyncResyncSkippedWithinMinResync(t *testing.T) {
+	clock := &fakeServerClock{lag: 1000}
+	var offset int64
+	s := NewClockSync(clock.serverTime, &offset)
+	s.MinResync = time.Hour
+	if err := s.Sync(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	clock.lag = 5000
+	if err := s.Resync(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if clock.calls != 1 {
+		t.Fatalf("server time calls = %d, want the resync skipped", clock.calls)
+	}
+	if !near(offset, 1000) || s.Stats().Resyncs != 0 {
+		t.Fatalf("offset = %d, stats = %+v", offset, s.Stats())
+	}
+}
+
+func TestClockSyncFailure(t *testing.T) {
+	clock := &fakeServerClock{lag: 1000}
+	var offset int64
+	s := NewClockSync(clock.serverTime, &offset)
+	if err := s.Sync(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	clock.err = errors.New("unreachable")
+	if err := s.Sync(context.Background()); !errors.Is(err, clock.err) {
+		t.Fatalf("Sync error = %v", err)
+	}
+	if stats := s.Stats(); !near(offset, 1000) || stats.Failures != 1 || stats.Syncs != 1 {
+		t.Fatalf("offset = %d, stats = %+v", offset, stats)
+	}
+}
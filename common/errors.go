@@ -1,14 +1,176 @@
 package common
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// ErrorCategory define the kind of failure an API error code stands for
+type ErrorCategory string
+
+// Error categories
+const (
+	ErrorCategoryServer         ErrorCategory = "SERVER"
+	ErrorCategoryRateLimit      ErrorCategory = "RATE_LIMIT"
+	ErrorCategoryAuth           ErrorCategory = "AUTH"
+	ErrorCategoryRequest        ErrorCategory = "REQUEST"
+	ErrorCategoryOrderRejected  ErrorCategory = "ORDER_REJECTED"
+	ErrorCategoryCancelRejected ErrorCategory = "CANCEL_REJECTED"
+	ErrorCategoryNoSuchOrder    ErrorCategory = "NO_SUCH_ORDER"
+	ErrorCategoryFuturesRule    ErrorCategory = "FUTURES_RULE"
+	ErrorCategoryUnknown        ErrorCategory = "UNKNOWN"
+)
+
+// API error codes
+const (
+	ErrCodeUnknown              = -1000
+	ErrCodeDisconnected         = -1001
+	ErrCodeUnauthorized         = -1002
+	ErrCodeTooManyRequests      = -1003
+	ErrCodeUnexpectedResponse   = -1006
+	ErrCodeTimeout              = -1007
+	ErrCodeServerBusy           = -1008
+	ErrCodeFilterFailure        = -1013
+	ErrCodeTooManyOrders        = -1015
+	ErrCodeServiceShuttingDown  = -1016
+	ErrCodeInvalidTimestamp     = -1021
+	ErrCodeInvalidSignature     = -1022
+	ErrCodeListenKeyNotExist    = -1125
+	ErrCodeNewOrderRejected     = -2010
+	ErrCodeCancelRejected       = -2011
+	ErrCodeNoSuchOrder          = -2013
+	ErrCodeBadAPIKeyFormat      = -2014
+	ErrCodeRejectedMBXKey       = -2015
+	ErrCodeBalanceNotSufficient = -2018
+	ErrCodeMarginNotSufficient  = -2019
+	ErrCodeOrderWouldTrigger    = -2021
+	ErrCodeReduceOnlyReject     = -2022
+	ErrCodeInvalidLeverage      = -4028
+	ErrCodeOrderNotModified     = -5027
+)
+
+// insufficientBalanceMessage identify the spot -2010 rejects caused by the
+// account balance
+const insufficientBalanceMessage = "insufficient balance"
+
+// Sentinel errors matched by errors.Is against an *APIError. A category
+// sentinel matches every code of its category, the others match one kind
+// of failure.
+var (
+	ErrServer              = errors.New("binance: server error")
+	ErrUnauthorized        = errors.New("binance: unauthorized")
+	ErrInvalidRequest      = errors.New("binance: invalid request")
+	ErrOrderRejected       = errors.New("binance: order rejected")
+	ErrCancelRejected      = errors.New("binance: cancel rejected")
+	ErrFuturesRule         = errors.New("binance: futures trading rule violated")
+	ErrIPBanned            = errors.New("binance: IP banned")
+	ErrInvalidTimestamp    = errors.New("binance: timestamp outside recvWindow")
+	ErrNoSuchOrder         = errors.New("binance: order does not exist")
+	ErrInsufficientBalance = errors.New("binance: insufficient balance")
+	ErrFilterFailure       = errors.New("binance: filter failure")
+)
 
 // Error return error code and message
 func (e APIError) Error() string {
 	return fmt.Sprintf("<APIError> code=%d, msg=%s", e.Code, e.Message)
 }
 
-//// IsAPIError check if e is an API error
-//func IsAPIError(e error) bool {
-//	_, ok := e.(*APIError)
-//	return ok
-//}
+// Category return the category of the error code, the HTTP status is used
+// for codes outside the documented ranges. Only the -10xx codes reporting a
+// server failure are SERVER, the others are mistakes of the request.
+func (e APIError) Category() ErrorCategory {
+	switch e.Code {
+	case ErrCodeUnknown, ErrCodeDisconnected, ErrCodeUnexpectedResponse, ErrCodeTimeout, ErrCodeServerBusy, ErrCodeServiceShuttingDown:
+		return ErrorCategoryServer
+	case ErrCodeTooManyRequests, ErrCodeTooManyOrders:
+		return ErrorCategoryRateLimit
+	case ErrCodeUnauthorized, ErrCodeInvalidSignature, ErrCodeBadAPIKeyFormat, ErrCodeRejectedMBXKey:
+		return ErrorCategoryAuth
+	case ErrCodeCancelRejected:
+		return ErrorCategoryCancelRejected
+	case ErrCodeNoSuchOrder:
+		return ErrorCategoryNoSuchOrder
+	}
+	switch {
+	case e.StatusCode == http.StatusTooManyRequests || e.StatusCode == http.StatusTeapot:
+		return ErrorCategoryRateLimit
+	case e.Code <= -1000 && e.Code > -2000:
+		return ErrorCategoryRequest
+	case e.Code <= -2000 && e.Code > -3000:
+		return ErrorCategoryOrderRejected
+	case e.Code <= -4000 && e.Code > -5000:
+		return ErrorCategoryFuturesRule
+	case e.Code <= -5000 && e.Code > -6000:
+		return ErrorCategoryOrderRejected
+	case e.StatusCode >= http.StatusInternalServerError:
+		return ErrorCategoryServer
+	case e.StatusCode == http.StatusUnauthorized || e.StatusCode == http.StatusForbidden:
+		return ErrorCategoryAuth
+	}
+	return ErrorCategoryUnknown
+}
+
+// ErrorType return the category, used as the error.type attribute of the
+// request spans and metrics
+func (e APIError) ErrorType() string {
+	return string(e.Category())
+}
+
+// Retryable report whether the request may succeed when sent again: the
+// server was unavailable or timed out, or the timestamp was outside
+// recvWindow. The outcome of an order placement that timed out is unknown,
+// so it must be checked before placing the order again.
+func (e APIError) Retryable() bool {
+	switch e.Code {
+	case ErrCodeDisconnected, ErrCodeTimeout, ErrCodeServerBusy, ErrCodeServiceShuttingDown, ErrCodeInvalidTimestamp:
+		return true
+	case ErrCodeUnknown:
+		return e.StatusCode >= http.StatusInternalServerError
+	}
+	return e.Code == 0 && e.StatusCode >= http.StatusInternalServerError
+}
+
+// Is make errors.Is match the sentinel errors of the code and category, as
+// well as an *APIError target with the same code
+func (e APIError) Is(target error) bool {
+	if t, ok := target.(*APIError); ok {
+		return t != nil && t.Code == e.Code
+	}
+	category := e.Category()
+	switch target {
+	case ErrServer:
+		return category == ErrorCategoryServer
+	case ErrRateLimited:
+		return category == ErrorCategoryRateLimit
+	case ErrIPBanned:
+		return e.StatusCode == http.StatusTeapot
+	case ErrUnauthorized:
+		return category == ErrorCategoryAuth
+	case ErrInvalidRequest:
+		return category == ErrorCategoryRequest
+	case ErrOrderRejected:
+		return category == ErrorCategoryOrderRejected
+	case ErrCancelRejected:
+		return category == ErrorCategoryCancelRejected
+	case ErrFuturesRule:
+		return category == ErrorCategoryFuturesRule
+	case ErrInvalidTimestamp:
+		return e.Code == ErrCodeInvalidTimestamp
+	case ErrNoSuchOrder:
+		return e.Code == ErrCodeNoSuchOrder
+	case ErrFilterFailure:
+		return e.Code == ErrCodeFilterFailure
+	case ErrInsufficientBalance:
+		return e.Code == ErrCodeBalanceNotSufficient || e.Code == ErrCodeMarginNotSufficient ||
+			(e.Code == ErrCodeNewOrderRejected && strings.Contains(strings.ToLower(e.Message), insufficientBalanceMessage))
+	}
+	return false
+}
+
+// IsAPIError check if e is an API error
+func IsAPIError(e error) bool {
+	var apiErr *APIError
+	return errors.As(e, &apiErr)
+}
@@ -0,0 +1,152 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestAPIErrorCategory(t *testing.T) {
+	for _, tt := range []struct {
+		code      int64
+		status    int
+		category  ErrorCategory
+		retryable bool
+	}{
+		{ErrCodeUnknown, http.StatusInternalServerError, ErrorCategoryServer, true},
+		{ErrCodeUnknown, http.StatusBadRequest, ErrorCategoryServer, false},
+		{ErrCodeDisconnected, http.StatusServiceUnavailable, ErrorCategoryServer, true},
+		{ErrCodeTimeout, http.StatusServiceUnavailable, ErrorCategoryServer, true},
+		{ErrCodeServerBusy, http.StatusServiceUnavailable, ErrorCategoryServer, true},
+		{ErrCodeServiceShuttingDown, http.StatusServiceUnavailable, ErrorCategoryServer, true},
+		{ErrCodeTooManyRequests, http.StatusTooManyRequests, ErrorCategoryRateLimit, false},
+		{ErrCodeTooManyOrders, http.StatusTooManyRequests, ErrorCategoryRateLimit, false},
+		{ErrCodeUnauthorized, http.StatusUnauthorized, ErrorCategoryAuth, false},
+		{ErrCodeInvalidSignature, http.StatusBadRequest, ErrorCategoryAuth, false},
+		{ErrCodeRejectedMBXKey, http.StatusUnauthorized, ErrorCategoryAuth, false},
+		{ErrCodeInvalidTimestamp, http.StatusBadRequest, ErrorCategoryRequest, true},
+		{ErrCodeFilterFailure, http.StatusBadRequest, ErrorCategoryRequest, false},
+		{-1102, http.StatusBadRequest, ErrorCategoryRequest, false},
+		{ErrCodeNewOrderRejected, http.StatusBadRequest, ErrorCategoryOrderRejected, false},
+		{ErrCodeCancelRejected, http.StatusBadRequest, ErrorCategoryCancelRejected, false},
+		{ErrCodeNoSuchOrder, http.StatusBadRequest, ErrorCategoryNoSuchOrder, false},
+		{ErrCodeInvalidLeverage, http.StatusBadRequest, ErrorCategoryFuturesRule, false},
+		{ErrCodeOrderNotModified, http.StatusBadRequest, ErrorCategoryOrderRejected, false},
+		// codes outside the documented ranges fall back on the status
+		{-3001, http.StatusTeapot, ErrorCategoryRateLimit, false},
+		{-3001, http.StatusBadGateway, ErrorCategoryServer, false},
+		{-3001, http.StatusForbidden, ErrorCategoryAuth, false},
+		{-3001, http.StatusBadRequest, ErrorCategoryUnknown, false},
+		{0, http.StatusBadGateway, ErrorCategoryServer, true},
+	} {
+		e := APIError{Code: tt.code, StatusCode: tt.status}
+		if got := e.Category(); got != tt.category {
+			t.Errorf("%d/%d: category = %s, want %s", tt.code, tt.status, got, tt.category)
+		}
+		if got := e.Retryable(); got != tt.retryable {
+			t.Errorf("%d/%d: retryable = %v, want %v", tt.code, tt.status, got, tt.retryable)
+		}
+	}
+}
+
+func TestAPIErrorIs(t *testing.T) {
+	for _, tt := range []struct {
+		err  *APIError
+		is   []error
+		isnt []error
+	}{
+		{
+			err:  &APIError{Code: ErrCodeTimeout, StatusCode: http.StatusServiceUnavailable},
+			is:   []error{ErrServer},
+			isnt: []error{ErrInvalidRequest, ErrRateLimited},
+		},
+		{
+			err:  &APIError{Code: ErrCodeTooManyRequests, StatusCode: http.StatusTeapot},
+			is:   []error{ErrRateLimited, ErrIPBanned},
+			isnt: []error{ErrServer},
+		},
+		{
+			err:  &APIError{Code: ErrCodeTooManyRequests, StatusCode: http.StatusTooManyRequests},
+			is:   []error{ErrRateLimited},
+			isnt: []error{ErrIPBanned},
+		},
+		{
+			err:  &APIError{Code: ErrCodeInvalidSignature, StatusCode: http.StatusBadRequest},
+			is:   []error{ErrUnauthorized},
+			isnt: []error{ErrInvalidRequest},
+		},
+		{
+			err:  &APIError{Code: ErrCodeInvalidTimestamp, StatusCode: http.StatusBadRequest},
+			is:   []error{ErrInvalidTimestamp, ErrInvalidRequest},
+			isnt: []error{ErrServer, ErrFilterFailure},
+		},
+		{
+			err:  &APIError{Code: ErrCodeFilterFailure, StatusCode: http.StatusBadRequest},
+			is:   []error{ErrFilterFailure, ErrInvalidRequest},
+			isnt: []error{ErrOrderRejected},
+		},
+		{
+			err:  &APIError{Code: ErrCodeNewOrderRejected, Message: "Account has insufficient balance for requested action.", StatusCode: http.StatusBadRequest},
+			is:   []error{ErrOrderRejected, ErrInsufficientBalance},
+			isnt: []error{ErrCancelRejected},
+		},
+		{
+			err:  &APIError{Code: ErrCodeNewOrderRejected, Message: "Order would immediately match and take.", StatusCode: http.StatusBadRequest},
+			is:   []error{ErrOrderRejected},
+			isnt: []error{ErrInsufficientBalance},
+		},
+		{
+			err: &APIError{Code: ErrCodeMarginNotSufficient, StatusCode: http.StatusBadRequest},
+			is:  []error{ErrOrderRejected, ErrInsufficientBalance},
+		},
+		{
+			err:  &APIError{Code: ErrCodeCancelRejected, StatusCode: http.StatusBadRequest},
+			is:   []error{ErrCancelRejected},
+			isnt: []error{ErrOrderRejected, ErrNoSuchOrder},
+		},
+		{
+			err:  &APIError{Code: ErrCodeNoSuchOrder, StatusCode: http.StatusBadRequest},
+			is:   []error{ErrNoSuchOrder},
+			isnt: []error{ErrOrderRejected},
+		},
+		{
+			err:  &APIError{Code: ErrCodeReduceOnlyReject, StatusCode: http.StatusBadRequest},
+			is:   []error{ErrOrderRejected},
+			isnt: []error{ErrFuturesRule},
+		},
+		{
+			err:  &APIError{Code: ErrCodeInvalidLeverage, StatusCode: http.StatusBadRequest},
+			is:   []error{ErrFuturesRule},
+			isnt: []error{ErrOrderRejected},
+		},
+	} {
+		// the sentinels match through wrapping
+		err := fmt.Errorf("create order: %w", tt.err)
+		for _, target := range tt.is {
+			if !errors.Is(err, target) {
+				t.Errorf("%d: error is not %v", tt.err.Code, target)
+			}
+		}
+		for _, target := range tt.isnt {
+			if errors.Is(err, target) {
+				t.Errorf("%d: error is %v", tt.err.Code, target)
+			}
+		}
+		if !errors.Is(err, &APIError{Code: tt.err.Code}) {
+			t.Errorf("%d: error does not match an *APIError with the same code", tt.err.Code)
+		}
+		if errors.Is(err, &APIError{Code: tt.err.Code - 1}) {
+			t.Errorf("%d: error matches an *APIError with another code", tt.err.Code)
+		}
+	}
+}
+
+func TestIsAPIError(t *testing.T) {
+	if !IsAPIError(fmt.Errorf("wrapped: %w", &APIError{Code: ErrCodeTimeout})) {
+		t.Fatal("wrapped *APIError not detected")
+	}
+	if IsAPIError(errors.New("network down")) {
+		t.Fatal("plain error detected as an API error")
+	}
+}
@@ -0,0 +1,151 @@
+package common
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+// RoundingMode define how a price or quantity is rounded to the tick or step
+// size of a filter
+type RoundingMode int
+
+// Rounding modes
+const (
+	// RoundDown round toward the lower multiple
+	RoundDown RoundingMode = iota
+	// RoundUp round toward the upper multiple
+	RoundUp
+	// RoundHalfUp round to the nearest multiple, halves up
+	RoundHalfUp
+)
+
+// Reasons of a FilterViolation
+const (
+	FilterReasonBelowMin = "below minimum"
+	FilterReasonAboveMax = "above maximum"
+	FilterReasonInvalid  = "is not a number"
+)
+
+// RoundToStep round value to min plus a multiple of step, the way Binance
+// checks tickSize and stepSize. A zero step leaves value unchanged.
+func RoundToStep(value, min, step decimal.Decimal, mode RoundingMode) decimal.Decimal {
+	if step.Sign() <= 0 {
+		return value
+	}
+	n := value.Sub(min).Div(step)
+	switch mode {
+	case RoundUp:
+		n = n.Ceil()
+	case RoundHalfUp:
+		n = n.Round(0)
+	default:
+		n = n.Floor()
+	}
+	return min.Add(n.Mul(step))
+}
+
+// FilterViolation define an exchange filter an order fails
+type FilterViolation struct {
+	// Filter is the filter type, e.g. LOT_SIZE
+	Filter string
+	// Field is the order parameter, e.g. quantity, or notional
+	Field  string
+	Value  decimal.Decimal
+	Limit  decimal.Decimal
+	Reason string
+}
+
+// String describe the violation, e.g. "LOT_SIZE: quantity 0.0001 below minimum 0.001"
+func (v FilterViolation) String() string {
+	if v.Reason == FilterReasonInvalid {
+		return fmt.Sprintf("%s: %s %s", v.Filter, v.Field, v.Reason)
+	}
+	return fmt.Sprintf("%s: %s %s %s %s", v.Filter, v.Field, v.Value, v.Reason, v.Limit)
+}
+
+// FilterError is returned by an order validator when an order fails the
+// exchange filters of its symbol, before the order is sent
+type FilterError struct {
+	Symbol     string
+	Violations []FilterViolation
+}
+
+// Error return the symbol and violations
+func (e *FilterError) Error() string {
+	violations := make([]string, len(e.Violations))
+	for i, v := range e.Violations {
+		violations[i] = v.String()
+	}
+	return fmt.Sprintf("<FilterError> symbol=%s, violations=[%s]", e.Symbol, strings.Join(violations, "; "))
+}
+
+// Is make errors.Is(err, ErrFilterFailure) match
+func (e *FilterError) Is(target error) bool {
+	return target == ErrFilterFailure
+}
+
+// FilterViolations collect the violations found while checking an order
+type FilterViolations []FilterViolation
+
+// Min add a violation when value is below min, a zero min is not checked
+func (vs *FilterViolations) Min(filter, field string, value, min decimal.Decimal) {
+	if min.Sign() > 0 && value.LessThan(min) {
+		*vs = append(*vs, FilterViolation{Filter: filter, Field: field, Value: value, Limit: min, Reason: FilterReasonBelowMin})
+	}
+}
+
+// Max add a violation when value is above max, a zero max is not checked
+func (vs *FilterViolations) Max(filter, field string, value, max decimal.Decimal) {
+	if max.Sign() > 0 && value.GreaterThan(max) {
+		*vs = append(*vs, FilterViolation{Filter: filter, Field: field, Value: value, Limit: max, Reason: FilterReasonAboveMax})
+	}
+}
+
+// Parse parse a decimal order parameter, adding a violation when it is not a number
+func (vs *FilterViolations) Parse(filter, field, value string) (decimal.Decimal, bool) {
+	d, err := decimal.NewFromString(value)
+	if err != nil {
+		*vs = append(*vs, FilterViolation{Filter: filter, Field: field, Reason: FilterReasonInvalid})
+		return decimal.Zero, false
+	}
+	return d, true
+}
+
+// Step round the order parameter *value to min plus a multiple of step and
+// check it against min and max. The rounded value is written back to *value
+// and returned, ok is false when value is nil or not a number.
+func (vs *FilterViolations) Step(filter, field string, value *string, min, max, step decimal.Decimal, mode RoundingMode) (d decimal.Decimal, ok bool) {
+	if value == nil {
+		return decimal.Zero, false
+	}
+	if d, ok = vs.Parse(filter, field, *value); !ok {
+		return d, false
+	}
+	if step.Sign() > 0 {
+		d = RoundToStep(d, min, step, mode)
+		*value = d.String()
+	}
+	vs.Min(filter, field, d, min)
+	vs.Max(filter, field, d, max)
+	return d, true
+}
+
+// Err return a *FilterError holding the violations, nil when there are none
+func (vs FilterViolations) Err(symbol string) error {
+	if len(vs) == 0 {
+		return nil
+	}
+	return &FilterError{Symbol: symbol, Violations: vs}
+}
+
+// ParseFilterDecimal parse a decimal filter value, an empty or invalid value
+// is zero so the bound it sets is not checked
+func ParseFilterDecimal(value string) decimal.Decimal {
+	d, err := decimal.NewFromString(value)
+	if err != nil {
+		return decimal.Zero
+	}
+	return d
+}
@@ -69,7 +69,9 @@ func StartRequestSpan(ctx context.Context, instrumentationName, spanName string)
 		spanName = "binance.api"
 	}
 
-	return otel.Tracer(instrumentationName).Start(ctx, spanName)
+	return otel.Tracer(instrumentationName).Start(ctx, spanName,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(AttrService.String(spanName)))
 }
 
 func SanitizeURL(raw string, hiddenParams ...string) string {
@@ -102,7 +104,8 @@ func SanitizeHeaders(header http.Header) http.Header {
 
 	cloned := header.Clone()
 	for key, values := range cloned {
-		if strings.EqualFold(key, "X-MBX-APIKEY") || strings.EqualFold(key, "Authorization") {
+		if strings.EqualFold(key, "X-MBX-APIKEY") || strings.EqualFold(key, "Authorization") ||
+			strings.EqualFold(key, "Cookie") || strings.EqualFold(key, "Set-Cookie") {
 			masked := make([]string, len(values))
 			for i, value := range values {
 				masked[i] = MaskAPIKey(value)
@@ -1,5 +1,7 @@
 package common
 
+import "encoding/json"
+
 //go:generate easyjson -all models.go
 
 // APIError define API error when response status is 4xx or 5xx
@@ -8,6 +10,15 @@ package common
 type APIError struct {
 	Code    int64  `json:"code"`
 	Message string `json:"msg"`
+	// Data is the detail some endpoints send with the error, e.g. the result
+	// of each step of a failed spot cancel-replace
+	Data json.RawMessage `json:"data,omitempty"`
+
+	// StatusCode, Endpoint and Service are filled by the client, Endpoint is
+	// the WebSocket API method for WebSocket API errors
+	StatusCode int    `json:"-"`
+	Endpoint   string `json:"-"`
+	Service    string `json:"-"`
 }
 
 // PriceLevel is a common structure for bids and asks in the
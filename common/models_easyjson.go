@@ -119,6 +119,14 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceCommon1(in *jlexer.Lexer, ou
 			} else {
 				out.Message = string(in.String())
 			}
+		case "data":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.Data).UnmarshalJSON(data))
+				}
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -143,6 +151,11 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceCommon1(out *jwriter.Writer,
 		out.RawString(prefix)
 		out.String(string(in.Message))
 	}
+	if len(in.Data) != 0 {
+		const prefix string = ",\"data\":"
+		out.RawString(prefix)
+		out.Raw((in.Data).MarshalJSON())
+	}
 	out.RawByte('}')
 }
 
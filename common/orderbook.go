@@ -0,0 +1,439 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// OrderBookMaxBuffered caps the diff events buffered while the book is not synced
+const OrderBookMaxBuffered = 10000
+
+var (
+	// ErrOrderBookNotSynced is returned by reads while the book is (re)syncing
+	ErrOrderBookNotSynced = errors.New("order book not synced")
+	// ErrOrderBookInsufficientDepth is returned when the book can not fill the requested size
+	ErrOrderBookInsufficientDepth = errors.New("order book depth insufficient")
+
+	errOrderBookGap = errors.New("order book update gap")
+)
+
+// OrderBookSide define a side of the order book
+type OrderBookSide int
+
+// Order book sides
+const (
+	OrderBookSideBid OrderBookSide = iota
+	OrderBookSideAsk
+)
+
+// OrderBookDiff define a diff depth update applied to the order book
+type OrderBookDiff struct {
+	Time          int64
+	FirstUpdateID int64
+	LastUpdateID  int64
+	// PrevLastUpdateID is the "pu" field of futures diff depth events, it is
+	// only checked when OrderBookConfig.CheckPrevUpdateID is set
+	PrevLastUpdateID int64
+	Bids             []PriceLevel
+	Asks             []PriceLevel
+}
+
+// OrderBookSnapshot define a point in time copy of the order book
+type OrderBookSnapshot struct {
+	LastUpdateID int64
+	// Time is the time of the last applied event or of the REST snapshot,
+	// 0 when the snapshot carries none as on spot
+	Time int64
+	Bids []PriceLevel
+	Asks []PriceLevel
+}
+
+// OrderBookConfig define how an order book fetches snapshots and checks
+// update continuity
+type OrderBookConfig struct {
+	Symbol string
+	// Snapshot fetch a REST depth snapshot
+	Snapshot func(ctx context.Context) (*OrderBookSnapshot, error)
+	// CheckPrevUpdateID apply the futures sequencing rules: an event follows
+	// the previous one when its pu equals the previous u
+	CheckPrevUpdateID bool
+	// OnError is called with snapshot failures and detected gaps
+	OnError func(err error)
+}
+
+type bookLevel struct {
+	price    decimal.Decimal
+	quantity decimal.Decimal
+}
+
+// bookSide keeps price levels sorted best first
+type bookSide struct {
+	levels []bookLevel
+	desc   bool
+}
+
+func (s *bookSide) search(price decimal.Decimal) int {
+	return sort.Search(len(s.levels), func(i int) bool {
+		if s.desc {
+			return s.levels[i].price.LessThanOrEqual(price)
+		}
+		return s.levels[i].price.GreaterThanOrEqual(price)
+	})
+}
+
+func (s *bookSide) set(price, quantity decimal.Decimal) {
+	i := s.search(price)
+	found := i < len(s.levels) && s.levels[i].price.Equal(price)
+	switch {
+	case quantity.IsZero():
+		if found {
+			s.levels = append(s.levels[:i], s.levels[i+1:]...)
+		}
+	case found:
+		s.levels[i].quantity = quantity
+	default:
+		s.levels = append(s.levels, bookLevel{})
+		copy(s.levels[i+1:], s.levels[i:])
+		s.levels[i] = bookLevel{price: price, quantity: quantity}
+	}
+}
+
+func (s *bookSide) priceLevels(limit int) []PriceLevel {
+	n := len(s.levels)
+	if limit > 0 && limit < n {
+		n = limit
+	}
+	res := make([]PriceLevel, n)
+	for i := 0; i < n; i++ {
+		res[i] = PriceLevel{Price: s.levels[i].price.String(), Quantity: s.levels[i].quantity.String()}
+	}
+	return res
+}
+
+// OrderBook is a local order book kept in sync from a diff depth stream and
+// REST snapshots, following the Binance "manage a local order book" rules.
+// Diff events are passed to Push; Run fetches the snapshot and fetches it
+// again whenever a gap in the update ids is detected. All reads are safe for
+// concurrent use.
+type OrderBook struct {
+	cfg OrderBookConfig
+
+	mu           sync.RWMutex
+	bids         bookSide
+	asks         bookSide
+	lastUpdateID int64
+	updateTime   int64
+	synced       bool
+	applied      bool
+	buffer       []OrderBookDiff
+
+	resync chan struct{}
+}
+
+// NewOrderBook init an order book, call Run to sync it
+func NewOrderBook(cfg OrderBookConfig) *OrderBook {
+	return &OrderBook{
+		cfg:    cfg,
+		bids:   bookSide{desc: true},
+		asks:   bookSide{},
+		resync: make(chan struct{}, 1),
+	}
+}
+
+// Symbol return the symbol of the book
+func (b *OrderBook) Symbol() string {
+	return b.cfg.Symbol
+}
+
+// Run sync the book from a snapshot and sync it again on every detected gap,
+// until ctx is done. Diff events must be pushed while Run is running.
+func (b *OrderBook) Run(ctx context.Context) error {
+	for {
+		if err := b.sync(ctx); err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-b.resync:
+		}
+	}
+}
+
+// Push apply a diff depth event, or buffer it while the book is syncing
+func (b *OrderBook) Push(diff OrderBookDiff) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if !b.synced {
+		b.bufferDiff(diff)
+		return
+	}
+	if err := b.apply(diff); err != nil {
+		b.synced = false
+		b.applied = false
+		b.buffer = b.buffer[:0]
+		b.bufferDiff(diff)
+		b.handleError(err)
+		select {
+		case b.resync <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// Synced report whether the book is in sync with the exchange
+func (b *OrderBook) Synced() bool {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.synced
+}
+
+// LastUpdateID return the update id of the last applied event
+func (b *OrderBook) LastUpdateID() int64 {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.lastUpdateID
+}
+
+// BestBid return the highest bid
+func (b *OrderBook) BestBid() (PriceLevel, error) {
+	return b.best(OrderBookSideBid)
+}
+
+// BestAsk return the lowest ask
+func (b *OrderBook) BestAsk() (PriceLevel, error) {
+	return b.best(OrderBookSideAsk)
+}
+
+// QuantityAtPrice return the quantity resting at exactly price
+func (b *OrderBook) QuantityAtPrice(side OrderBookSide, price decimal.Decimal) (decimal.Decimal, error) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	if !b.synced {
+		return decimal.Zero, ErrOrderBookNotSynced
+	}
+	s := b.side(side)
+	i := s.search(price)
+	if i < len(s.levels) && s.levels[i].price.Equal(price) {
+		return s.levels[i].quantity, nil
+	}
+	return decimal.Zero, nil
+}
+
+// DepthAtPrice return the total quantity of side priced at price or better,
+// i.e. bids at or above price and asks at or below price
+func (b *OrderBook) DepthAtPrice(side OrderBookSide, price decimal.Decimal) (decimal.Decimal, error) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	if !b.synced {
+		return decimal.Zero, ErrOrderBookNotSynced
+	}
+	s := b.side(side)
+	total := decimal.Zero
+	for _, level := range s.levels {
+		if (s.desc && level.price.LessThan(price)) || (!s.desc && level.price.GreaterThan(price)) {
+			break
+		}
+		total = total.Add(level.quantity)
+	}
+	return total, nil
+}
+
+// VWAP return the volume weighted average price of filling size against
+// side, starting from the best level. Buying fills against OrderBookSideAsk.
+func (b *OrderBook) VWAP(side OrderBookSide, size decimal.Decimal) (decimal.Decimal, error) {
+	if !size.IsPositive() {
+		return decimal.Zero, fmt.Errorf("order book vwap: size must be positive")
+	}
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	if !b.synced {
+		return decimal.Zero, ErrOrderBookNotSynced
+	}
+	remaining := size
+	notional := decimal.Zero
+	for _, level := range b.side(side).levels {
+		fill := decimal.Min(remaining, level.quantity)
+		notional = notional.Add(fill.Mul(level.price))
+		remaining = remaining.Sub(fill)
+		if remaining.IsZero() {
+			return notional.Div(size), nil
+		}
+	}
+	return decimal.Zero, ErrOrderBookInsufficientDepth
+}
+
+// Snapshot return a copy of the book, limit caps the levels per side when positive
+func (b *OrderBook) Snapshot(limit int) (*OrderBookSnapshot, error) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	if !b.synced {
+		return nil, ErrOrderBookNotSynced
+	}
+	return &OrderBookSnapshot{
+		LastUpdateID: b.lastUpdateID,
+		Time:         b.updateTime,
+		Bids:         b.bids.priceLevels(limit),
+		Asks:         b.asks.priceLevels(limit),
+	}, nil
+}
+
+func (b *OrderBook) best(side OrderBookSide) (PriceLevel, error) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	if !b.synced {
+		return PriceLevel{}, ErrOrderBookNotSynced
+	}
+	levels := b.side(side).priceLevels(1)
+	if len(levels) == 0 {
+		return PriceLevel{}, ErrOrderBookInsufficientDepth
+	}
+	return levels[0], nil
+}
+
+func (b *OrderBook) side(side OrderBookSide) *bookSide {
+	if side == OrderBookSideAsk {
+		return &b.asks
+	}
+	return &b.bids
+}
+
+// sync fetch snapshots until one lines up with the buffered events
+func (b *OrderBook) sync(ctx context.Context) error {
+	delay := DefaultWsReconnectMinDelay
+	for {
+		snapshot, err := b.cfg.Snapshot(ctx)
+		if err == nil {
+			if err = b.applySnapshot(snapshot); err == nil {
+				return nil
+			}
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		b.handleError(fmt.Errorf("order book %s snapshot: %w", b.cfg.Symbol, err))
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+		delay *= 2
+		if delay > DefaultWsReconnectMaxDelay {
+			delay = DefaultWsReconnectMaxDelay
+		}
+	}
+}
+
+func (b *OrderBook) applySnapshot(snapshot *OrderBookSnapshot) error {
+	bids := bookSide{desc: true}
+	asks := bookSide{}
+	if err := loadLevels(&bids, snapshot.Bids); err != nil {
+		return err
+	}
+	if err := loadLevels(&asks, snapshot.Asks); err != nil {
+		return err
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.bids, b.asks = bids, asks
+	b.lastUpdateID = snapshot.LastUpdateID
+	b.updateTime = snapshot.Time
+	b.applied = false
+	for _, diff := range b.buffer {
+		if err := b.apply(diff); err != nil {
+			// the snapshot is older than the first buffered event, keep
+			// buffering and fetch a newer one
+			b.synced = false
+			return err
+		}
+	}
+	b.buffer = b.buffer[:0]
+	b.synced = true
+	return nil
+}
+
+// apply check the sequencing of diff against the book and apply it, stale
+// events are dropped
+func (b *OrderBook) apply(diff OrderBookDiff) error {
+	last := b.lastUpdateID
+	if !b.applied {
+		if b.cfg.CheckPrevUpdateID {
+			if diff.LastUpdateID < last {
+				return nil
+			}
+			if diff.FirstUpdateID > last {
+				return fmt.Errorf("%w: first event U=%d after snapshot lastUpdateId=%d", errOrderBookGap, diff.FirstUpdateID, last)
+			}
+		} else {
+			if diff.LastUpdateID <= last {
+				return nil
+			}
+			if diff.FirstUpdateID > last+1 {
+				return fmt.Errorf("%w: first event U=%d after snapshot lastUpdateId=%d", errOrderBookGap, diff.FirstUpdateID, last)
+			}
+		}
+	} else {
+		if b.cfg.CheckPrevUpdateID {
+			if diff.PrevLastUpdateID != last {
+				return fmt.Errorf("%w: pu=%d, previous u=%d", errOrderBookGap, diff.PrevLastUpdateID, last)
+			}
+		} else {
+			if diff.LastUpdateID <= last {
+				return nil
+			}
+			if diff.FirstUpdateID != last+1 {
+				return fmt.Errorf("%w: U=%d, previous u=%d", errOrderBookGap, diff.FirstUpdateID, last)
+			}
+		}
+	}
+
+	if err := loadLevels(&b.bids, diff.Bids); err != nil {
+		return err
+	}
+	if err := loadLevels(&b.asks, diff.Asks); err != nil {
+		return err
+	}
+	b.lastUpdateID = diff.LastUpdateID
+	b.updateTime = diff.Time
+	b.applied = true
+	return nil
+}
+
+func (b *OrderBook) bufferDiff(diff OrderBookDiff) {
+	if len(b.buffer) >= OrderBookMaxBuffered {
+		b.buffer = b.buffer[1:]
+	}
+	b.buffer = append(b.buffer, diff)
+}
+
+func (b *OrderBook) handleError(err error) {
+	if b.cfg.OnError != nil {
+		b.cfg.OnError(err)
+	}
+}
+
+func loadLevels(side *bookSide, levels []PriceLevel) error {
+	for _, level := range levels {
+		price, err := decimal.NewFromString(level.Price)
+		if err != nil {
+			return err
+		}
+		quantity, err := decimal.NewFromString(level.Quantity)
+		if err != nil {
+			return err
+		}
+		side.set(price, quantity)
+	}
+	return nil
+}
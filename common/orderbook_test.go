@@ -0,0 +1,160 @@
+package common
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+// bookDiff return a diff setting the bid at 10 to quantity
+func bookDiff(first, last, prev int64, quantity string) OrderBookDiff {
+	return OrderBookDiff{
+		Time:             last * 1000,
+		FirstUpdateID:    first,
+		LastUpdateID:     last,
+		PrevLastUpdateID: prev,
+		Bids:             []PriceLevel{{Price: "10", Quantity: quantity}},
+	}
+}
+
+func TestOrderBookPush(t *testing.T) {
+	snapshot := &OrderBookSnapshot{
+		LastUpdateID: 100,
+		Bids:         []PriceLevel{{Price: "10", Quantity: "1"}},
+		Asks:         []PriceLevel{{Price: "11", Quantity: "1"}},
+	}
+	for _, tt := range []struct {
+		name     string
+		futures  bool
+		buffered []OrderBookDiff
+		pushed   []OrderBookDiff
+		synced   bool
+		last     int64
+		quantity string
+		gap      bool
+	}{
+		{
+			name:     "in order",
+			buffered: []OrderBookDiff{bookDiff(95, 101, 0, "2")},
+			pushed:   []OrderBookDiff{bookDiff(102, 103, 0, "3"), bookDiff(104, 104, 0, "4")},
+			synced:   true,
+			last:     104,
+			quantity: "4",
+		},
+		{
+			name:     "stale events dropped",
+			buffered: []OrderBookDiff{bookDiff(90, 95, 0, "5"), bookDiff(96, 100, 0, "6"), bookDiff(101, 102, 0, "2")},
+			pushed:   []OrderBookDiff{bookDiff(99, 102, 0, "7"), bookDiff(103, 103, 0, "3")},
+			synced:   true,
+			last:     103,
+			quantity: "3",
+		},
+		{
+			name:     "gap after the snapshot",
+			buffered: []OrderBookDiff{bookDiff(105, 106, 0, "2")},
+			synced:   false,
+			gap:      true,
+		},
+		{
+			name:     "gap between events",
+			buffered: []OrderBookDiff{bookDiff(101, 102, 0, "2")},
+			pushed:   []OrderBookDiff{bookDiff(104, 105, 0, "3")},
+			synced:   false,
+			gap:      true,
+		},
+		{
+			name:     "futures in order",
+			futures:  true,
+			buffered: []OrderBookDiff{bookDiff(90, 95, 89, "5"), bookDiff(96, 105, 95, "2")},
+			pushed:   []OrderBookDiff{bookDiff(106, 110, 105, "3"), bookDiff(111, 111, 110, "4")},
+			synced:   true,
+			last:     111,
+			quantity: "4",
+		},
+		{
+			name:     "futures gap after the snapshot",
+			futures:  true,
+			buffered: []OrderBookDiff{bookDiff(101, 105, 100, "2")},
+			synced:   false,
+			gap:      true,
+		},
+		{
+			name:     "futures pu mismatch",
+			futures:  true,
+			buffered: []OrderBookDiff{bookDiff(96, 105, 95, "2")},
+			pushed:   []OrderBookDiff{bookDiff(107, 110, 106, "3")},
+			synced:   false,
+			gap:      true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var gap bool
+			b := NewOrderBook(OrderBookConfig{
+				Symbol:            "BTCUSDT",
+				CheckPrevUpdateID: tt.futures,
+				OnError: func(err error) {
+					gap = gap || errors.Is(err, errOrderBookGap)
+				},
+			})
+			for _, diff := range tt.buffered {
+				b.Push(diff)
+			}
+			if err := b.applySnapshot(snapshot); err != nil {
+				gap = gap || errors.Is(err, errOrderBookGap)
+			}
+			for _, diff := range tt.pushed {
+				b.Push(diff)
+			}
+
+			if b.Synced() != tt.synced || gap != tt.gap {
+				t.Fatalf("synced = %v, gap = %v, want %v, %v", b.Synced(), gap, tt.synced, tt.gap)
+			}
+			if !tt.synced {
+				if _, err := b.BestBid(); !errors.Is(err, ErrOrderBookNotSynced) {
+					t.Fatalf("BestBid error = %v, want ErrOrderBookNotSynced", err)
+				}
+				return
+			}
+			if b.LastUpdateID() != tt.last {
+				t.Fatalf("last update id = %d, want %d", b.LastUpdateID(), tt.last)
+			}
+			got, err := b.QuantityAtPrice(OrderBookSideBid, decimal.NewFromInt(10))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.String() != tt.quantity {
+				t.Fatalf("bid quantity = %s, want %s", got, tt.quantity)
+			}
+			s, err := b.Snapshot(0)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if s.Time != tt.last*1000 {
+				t.Fatalf("time = %d, want the time of the last applied event", s.Time)
+			}
+		})
+	}
+}
+
+func TestOrderBookGapRequestsResync(t *testing.T) {
+	b := NewOrderBook(OrderBookConfig{Symbol: "BTCUSDT"})
+	if err := b.applySnapshot(&OrderBookSnapshot{LastUpdateID: 100}); err != nil {
+		t.Fatal(err)
+	}
+	b.Push(bookDiff(101, 101, 0, "1"))
+	b.Push(bookDiff(103, 103, 0, "2"))
+
+	select {
+	case <-b.resync:
+	default:
+		t.Fatal("resync not requested after a gap")
+	}
+	// the event that revealed the gap is kept for the next snapshot
+	if err := b.applySnapshot(&OrderBookSnapshot{LastUpdateID: 102}); err != nil {
+		t.Fatalf("resync snapshot: %v", err)
+	}
+	if !b.Synced() || b.LastUpdateID() != 103 {
+		t.Fatalf("synced = %v, last update id = %d", b.Synced(), b.LastUpdateID())
+	}
+}
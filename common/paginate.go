@@ -0,0 +1,143 @@
+package common
+
+import (
+	"context"
+	"iter"
+	"time"
+)
+
+// PageMode define how a Paginator moves through a window once a page is full
+type PageMode int
+
+// Page modes
+const (
+	// PageByTime narrow the window past the items of the full page. The page
+	// may be sorted oldest or newest first, the direction is detected from the
+	// first and last item.
+	PageByTime PageMode = iota
+	// PageByOffset keep the window and skip the items already returned
+	PageByOffset
+)
+
+// Page define the range a Paginator asks Fetch for
+type Page struct {
+	StartTime int64
+	EndTime   int64
+	Offset    int
+}
+
+// Paginator walk [StartTime, EndTime] in windows no longer than Window,
+// fetching each window page by page until a page has fewer than Limit items.
+// Items are deduplicated on Key, so pages overlapping on their edge time or
+// shifted by new items are returned once. In PageByTime mode items sharing
+// one time beyond a full page cannot be reached.
+type Paginator[T any, K comparable] struct {
+	// StartTime and EndTime bound the walk, both inclusive, in milliseconds
+	StartTime int64
+	EndTime   int64
+	// Window is the longest range the endpoint accepts, 0 for no limit
+	Window time.Duration
+	// Limit is the page size, a shorter page ends the window
+	Limit int
+	Mode  PageMode
+
+	// Fetch request one page
+	Fetch func(ctx context.Context, page Page) ([]T, error)
+	// Key identify an item
+	Key func(item T) K
+	// Time return the time of an item in milliseconds, PageByTime only
+	Time func(item T) int64
+}
+
+// All iterate over every item of the range. The first error, including the
+// cancellation of ctx, is yielded once and ends the iteration.
+func (p *Paginator[T, K]) All(ctx context.Context) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		window := p.Window.Milliseconds()
+		for start := p.StartTime; start <= p.EndTime; {
+			end := p.EndTime
+			if window > 0 && end-start >= window {
+				end = start + window - 1
+			}
+			if !p.walk(ctx, Page{StartTime: start, EndTime: end}, yield) {
+				return
+			}
+			start = end + 1
+		}
+	}
+}
+
+// walk yield the items of one window, it return false when the iteration
+// must stop
+func (p *Paginator[T, K]) walk(ctx context.Context, page Page, yield func(T, error) bool) bool {
+	var zero T
+	var desc bool
+	seen := map[K]struct{}{}
+	for {
+		if err := ctx.Err(); err != nil {
+			yield(zero, err)
+			return false
+		}
+		items, err := p.Fetch(ctx, page)
+		if err != nil {
+			yield(zero, err)
+			return false
+		}
+		for _, item := range items {
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+				return false
+			}
+			key := p.Key(item)
+			if _, ok := seen[key]; ok {
+				continue
+			}
+			seen[key] = struct{}{}
+			if !yield(item, nil) {
+				return false
+			}
+		}
+		if len(items) == 0 || len(items) < p.Limit {
+			return true
+		}
+
+		if p.Mode == PageByOffset {
+			page.Offset += len(items)
+			continue
+		}
+
+		// restart the window at the time of the last item, which is returned
+		// again with the items sharing its time. When the whole page shares
+		// one time it is stepped over in the direction of the previous pages,
+		// oldest first when none told it.
+		var edge int64
+		first, last := p.Time(items[0]), p.Time(items[len(items)-1])
+		if first != last {
+			desc = first > last
+		}
+		if !desc {
+			if last > page.StartTime {
+				page.StartTime = last
+			} else {
+				page.StartTime = last + 1
+			}
+			edge = page.StartTime
+		} else {
+			if last < page.EndTime {
+				page.EndTime = last
+			} else {
+				page.EndTime = last - 1
+			}
+			edge = page.EndTime
+		}
+		if page.StartTime > page.EndTime {
+			return true
+		}
+		seen = map[K]struct{}{}
+		for _, item := range items {
+			if p.Time(item) == edge {
+				seen[p.Key(item)] = struct{}{}
+			}
+		}
+	}
+}
@@ -0,0 +1,155 @@
+package common
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+)
+
+type pageItem struct {
+	id   int
+	time int64
+}
+
+// pageServer serve items sorted oldest first, or newest first when desc is
+// set, cutting each page at limit like the exchange does
+type pageServer struct {
+	items []pageItem
+	desc  bool
+	limit int
+	pages []Page
+}
+
+func (s *pageServer) fetch(_ context.Context, page Page) ([]pageItem, error) {
+	s.pages = append(s.pages, page)
+	var res []pageItem
+	for _, item := range s.items {
+		if item.time >= page.StartTime && item.time <= page.EndTime {
+			res = append(res, item)
+		}
+	}
+	if s.desc {
+		slices.Reverse(res)
+	}
+	if page.Offset >= len(res) {
+		return nil, nil
+	}
+	res = res[page.Offset:]
+	return res[:min(len(res), s.limit)], nil
+}
+
+func (s *pageServer) paginator(start, end int64, mode PageMode) *Paginator[pageItem, int] {
+	return &Paginator[pageItem, int]{
+		StartTime: start,
+		EndTime:   end,
+		Limit:     s.limit,
+		Mode:      mode,
+		Fetch:     s.fetch,
+		Key:       func(item pageItem) int { return item.id },
+		Time:      func(item pageItem) int64 { return item.time },
+	}
+}
+
+func collectPages(t *testing.T, p *Paginator[pageItem, int]) []int {
+	t.Helper()
+	var ids []int
+	for item, err := range p.All(context.Background()) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, item.id)
+	}
+	return ids
+}
+
+// pageItems have several items on the edges of 3 items pages
+var pageItems = []pageItem{
+	{1, 10}, {2, 20}, {3, 30}, {4, 30}, {5, 40}, {6, 50}, {7, 50}, {8, 50}, {9, 60},
+}
+
+func TestPaginatorByTimeAscending(t *testing.T) {
+	s := &pageServer{items: pageItems, limit: 3}
+	ids := collectPages(t, s.paginator(0, 100, PageByTime))
+	if want := []int{1, 2, 3, 4, 5, 6, 7, 8, 9}; !slices.Equal(ids, want) {
+		t.Fatalf("ids = %v, want %v", ids, want)
+	}
+	// each page restart at the time of the last item of the previous one
+	for i, page := range s.pages[1:] {
+		if page.EndTime != 100 || page.StartTime <= s.pages[i].StartTime {
+			t.Fatalf("page %d = %+v after %+v", i+1, page, s.pages[i])
+		}
+	}
+}
+
+func TestPaginatorByTimeDescending(t *testing.T) {
+	s := &pageServer{items: pageItems, desc: true, limit: 3}
+	ids := collectPages(t, s.paginator(0, 100, PageByTime))
+	if want := []int{9, 8, 7, 6, 5, 4, 3, 2, 1}; !slices.Equal(ids, want) {
+		t.Fatalf("ids = %v, want %v", ids, want)
+	}
+	// newest first pages narrow the end of the window instead of its start,
+	// the page of time 50 only is stepped over keeping that direction
+	want := []Page{
+		{StartTime: 0, EndTime: 100},
+		{StartTime: 0, EndTime: 50},
+		{StartTime: 0, EndTime: 49},
+		{StartTime: 0, EndTime: 30},
+		{StartTime: 0, EndTime: 20},
+	}
+	if !slices.Equal(s.pages, want) {
+		t.Fatalf("pages = %+v, want %+v", s.pages, want)
+	}
+}
+
+func TestPaginatorByTimeFullPageOnOneTime(t *testing.T) {
+	for _, desc := range []bool{false, true} {
+		items := []pageItem{{1, 10}, {2, 20}, {3, 20}, {4, 20}, {5, 30}}
+		s := &pageServer{items: items, desc: desc, limit: 3}
+		ids := collectPages(t, s.paginator(20, 30, PageByTime))
+		slices.Sort(ids)
+		// the page of time 20 is stepped over rather than fetched again
+		if want := []int{2, 3, 4, 5}; !slices.Equal(ids, want) {
+			t.Fatalf("desc %v: ids = %v, want %v", desc, ids, want)
+		}
+		if len(s.pages) > 3 {
+			t.Fatalf("desc %v: pages = %+v", desc, s.pages)
+		}
+	}
+}
+
+func TestPaginatorByOffsetSkipsShiftedItems(t *testing.T) {
+	s := &pageServer{items: pageItems[:5], limit: 3}
+	p := s.paginator(0, 100, PageByOffset)
+	fetch := p.Fetch
+	p.Fetch = func(ctx context.Context, page Page) ([]pageItem, error) {
+		items, err := fetch(ctx, page)
+		// a new item shift the next page by one
+		if page.Offset == 0 {
+			s.items = append([]pageItem{{0, 5}}, s.items...)
+		}
+		return items, err
+	}
+	ids := collectPages(t, p)
+	if want := []int{1, 2, 3, 4, 5}; !slices.Equal(ids, want) {
+		t.Fatalf("ids = %v, want %v", ids, want)
+	}
+}
+
+func TestPaginatorWindows(t *testing.T) {
+	s := &pageServer{items: pageItems, limit: 100}
+	p := s.paginator(0, 25, PageByTime)
+	p.Window = 10 * time.Millisecond
+	ids := collectPages(t, p)
+	if want := []int{1, 2}; !slices.Equal(ids, want) {
+		t.Fatalf("ids = %v, want %v", ids, want)
+	}
+	want := []Page{
+		{StartTime: 0, EndTime: 9},
+		{StartTime: 10, EndTime: 19},
+		{StartTime: 20, EndTime: 25},
+	}
+	if !slices.Equal(s.pages, want) {
+		t.Fatalf("pages = %+v, want %+v", s.pages, want)
+	}
+}
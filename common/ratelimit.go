@@ -0,0 +1,283 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Rate limit types reported by exchangeInfo
+const (
+	RateLimitTypeRequestWeight = "REQUEST_WEIGHT"
+	RateLimitTypeOrders        = "ORDERS"
+	RateLimitTypeRawRequests   = "RAW_REQUESTS"
+)
+
+// Backoff applied on 429 and 418 responses without a Retry-After header
+const (
+	DefaultRateLimitBackoff = time.Minute
+	DefaultIPBanBackoff     = 2 * time.Minute
+)
+
+// ErrRateLimited is matched by every *RateLimitError
+var ErrRateLimited = errors.New("rate limited")
+
+// RateLimitError is returned by RateLimiter.Wait in fail fast mode, and when
+// the wait would outlast the context deadline
+type RateLimitError struct {
+	RateLimitType string
+	RetryAfter    time.Duration
+}
+
+// Error return the limit type and wait time
+func (e *RateLimitError) Error() string {
+	return fmt.Sprintf("<RateLimitError> type=%s, retryAfter=%s", e.RateLimitType, e.RetryAfter)
+}
+
+// Is make errors.Is(err, ErrRateLimited) match
+func (e *RateLimitError) Is(target error) bool {
+	return target == ErrRateLimited
+}
+
+// RateLimit define a limit as listed in exchangeInfo
+type RateLimit struct {
+	RateLimitType string
+	Interval      string
+	IntervalNum   int64
+	Limit         int64
+}
+
+// RequestCost define what a request counts against the limits. Requests is
+// counted against RAW_REQUESTS, Weight against REQUEST_WEIGHT and Orders
+// against ORDERS. The zero RequestCost marks a request outside the API family
+// of the limits, e.g. /sapi on the spot client; such a request is neither
+// throttled nor counted, and its response does not update the limiter.
+type RequestCost struct {
+	Requests int64
+	Weight   int64
+	Orders   int64
+}
+
+type rateWindow struct {
+	rateLimitType string
+	interval      time.Duration
+	limit         int64
+	used          int64
+	resetAt       time.Time
+}
+
+func (w *rateWindow) roll(now time.Time) {
+	if !now.Before(w.resetAt) {
+		w.used = 0
+		w.resetAt = now.Truncate(w.interval).Add(w.interval)
+	}
+}
+
+func (w *rateWindow) cost(c RequestCost) int64 {
+	switch w.rateLimitType {
+	case RateLimitTypeRequestWeight:
+		return c.Weight
+	case RateLimitTypeOrders:
+		return c.Orders
+	case RateLimitTypeRawRequests:
+		return c.Requests
+	}
+	return 0
+}
+
+// RateLimiter throttles requests client side against the exchange limits.
+// Usage is counted locally when a request is sent and corrected from the
+// X-MBX-USED-WEIGHT-* and X-MBX-ORDER-COUNT-* headers of every response.
+// On 429 and 418 every request is held back until Retry-After has passed.
+type RateLimiter struct {
+	// FailFast return a *RateLimitError instead of waiting for the window to reset
+	FailFast bool
+
+	mu          sync.Mutex
+	windows     []*rateWindow
+	bannedUntil time.Time
+}
+
+// NewRateLimiter init a rate limiter with the limits of exchangeInfo
+func NewRateLimiter(limits []RateLimit, failFast bool) *RateLimiter {
+	l := &RateLimiter{FailFast: failFast}
+	l.SetLimits(limits)
+	return l
+}
+
+// SetLimits replace the limits, the usage of unchanged windows is kept
+func (l *RateLimiter) SetLimits(limits []RateLimit) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	windows := make([]*rateWindow, 0, len(limits))
+	for _, limit := range limits {
+		interval := rateLimitInterval(limit.Interval, limit.IntervalNum)
+		if interval <= 0 || limit.Limit <= 0 {
+			continue
+		}
+		w := &rateWindow{rateLimitType: limit.RateLimitType, interval: interval, limit: limit.Limit}
+		if old := l.window(limit.RateLimitType, interval); old != nil {
+			w.used, w.resetAt = old.used, old.resetAt
+		}
+		windows = append(windows, w)
+	}
+	l.windows = windows
+}
+
+// Wait reserve cost against every window, waiting until the windows that
+// are full reset. In fail fast mode a *RateLimitError is returned instead.
+func (l *RateLimiter) Wait(ctx context.Context, cost RequestCost) error {
+	for {
+		l.mu.Lock()
+		now := time.Now()
+		var (
+			wait          time.Duration
+			rateLimitType string
+		)
+		if now.Before(l.bannedUntil) {
+			wait, rateLimitType = l.bannedUntil.Sub(now), "BACKOFF"
+		} else {
+			for _, w := range l.windows {
+				w.roll(now)
+				n := w.cost(cost)
+				if n > 0 && w.used+n > w.limit {
+					if d := w.resetAt.Sub(now); d > wait {
+						wait, rateLimitType = d, w.rateLimitType
+					}
+				}
+			}
+		}
+		if wait <= 0 {
+			for _, w := range l.windows {
+				w.used += w.cost(cost)
+			}
+			l.mu.Unlock()
+			return nil
+		}
+		l.mu.Unlock()
+
+		err := &RateLimitError{RateLimitType: rateLimitType, RetryAfter: wait}
+		if l.FailFast {
+			return err
+		}
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < wait {
+			return err
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// Update record the usage reported by a response and start a global backoff
+// on 429 (too many requests) and 418 (IP banned)
+func (l *RateLimiter) Update(statusCode int, header http.Header) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := time.Now()
+
+	for key, values := range header {
+		if len(values) == 0 {
+			continue
+		}
+		rateLimitType, suffix, ok := usageHeader(key)
+		if !ok {
+			continue
+		}
+		interval := headerInterval(suffix)
+		used, err := strconv.ParseInt(values[0], 10, 64)
+		if interval <= 0 || err != nil {
+			continue
+		}
+		if w := l.window(rateLimitType, interval); w != nil {
+			w.roll(now)
+			if used > w.used {
+				w.used = used
+			}
+		}
+	}
+
+	if statusCode != http.StatusTooManyRequests && statusCode != http.StatusTeapot {
+		return
+	}
+	backoff := DefaultRateLimitBackoff
+	if statusCode == http.StatusTeapot {
+		backoff = DefaultIPBanBackoff
+	}
+	if seconds, err := strconv.ParseInt(header.Get("Retry-After"), 10, 64); err == nil && seconds > 0 {
+		backoff = time.Duration(seconds) * time.Second
+	}
+	if until := now.Add(backoff); until.After(l.bannedUntil) {
+		l.bannedUntil = until
+	}
+}
+
+// BannedUntil return the end of the current 429/418 backoff, zero when none
+func (l *RateLimiter) BannedUntil() time.Time {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if time.Now().Before(l.bannedUntil) {
+		return l.bannedUntil
+	}
+	return time.Time{}
+}
+
+func (l *RateLimiter) window(rateLimitType string, interval time.Duration) *rateWindow {
+	for _, w := range l.windows {
+		if w.rateLimitType == rateLimitType && w.interval == interval {
+			return w
+		}
+	}
+	return nil
+}
+
+func rateLimitInterval(interval string, num int64) time.Duration {
+	var unit time.Duration
+	switch interval {
+	case "SECOND":
+		unit = time.Second
+	case "MINUTE":
+		unit = time.Minute
+	case "HOUR":
+		unit = time.Hour
+	case "DAY":
+		unit = 24 * time.Hour
+	}
+	return unit * time.Duration(num)
+}
+
+// usageHeader split an X-MBX-USED-WEIGHT-* or X-MBX-ORDER-COUNT-* header key
+// into its rate limit type and interval suffix, e.g. 1M
+func usageHeader(key string) (rateLimitType, suffix string, ok bool) {
+	upper := strings.ToUpper(key)
+	switch {
+	case strings.HasPrefix(upper, "X-MBX-USED-WEIGHT-"):
+		return RateLimitTypeRequestWeight, strings.TrimPrefix(upper, "X-MBX-USED-WEIGHT-"), true
+	case strings.HasPrefix(upper, "X-MBX-ORDER-COUNT-"):
+		return RateLimitTypeOrders, strings.TrimPrefix(upper, "X-MBX-ORDER-COUNT-"), true
+	}
+	return "", "", false
+}
+
+// headerInterval parse the interval suffix of a usage header, e.g. 1M or 10S
+func headerInterval(suffix string) time.Duration {
+	if len(suffix) < 2 {
+		return 0
+	}
+	num, err := strconv.ParseInt(suffix[:len(suffix)-1], 10, 64)
+	if err != nil {
+		return 0
+	}
+	units := map[byte]string{'S': "SECOND", 'M': "MINUTE", 'H': "HOUR", 'D': "DAY"}
+	return rateLimitInterval(units[suffix[len(suffix)-1]], num)
+}
@@ -0,0 +1,130 @@
+package common
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// SecType define the security type of an endpoint
+type SecType int
+
+// Security types
+const (
+	SecTypeNone SecType = iota
+	SecTypeAPIKey
+	SecTypeSigned
+)
+
+// Params define request params by key
+type Params map[string]interface{}
+
+// Request define an API request
+type Request struct {
+	Method     string
+	Endpoint   string
+	Query      url.Values
+	Form       url.Values
+	RecvWindow time.Duration
+	SecType    SecType
+	Header     http.Header
+	Body       io.Reader
+	FullURL    string
+	Service    string
+	// Recovered is set by CallAPI when a retried order placement found the
+	// order already placed, the returned data is then the queried order
+	Recovered bool
+}
+
+// SetParam set param with key/value to query string
+func (r *Request) SetParam(key string, value interface{}) *Request {
+	if r.Query == nil {
+		r.Query = url.Values{}
+	}
+	r.Query.Set(key, fmt.Sprintf("%v", value))
+	return r
+}
+
+// SetParams set params with key/values to query string
+func (r *Request) SetParams(m Params) *Request {
+	for k, v := range m {
+		r.SetParam(k, v)
+	}
+	return r
+}
+
+// SetFormParam set param with key/value to request form body
+func (r *Request) SetFormParam(key string, value interface{}) *Request {
+	if r.Form == nil {
+		r.Form = url.Values{}
+	}
+	r.Form.Set(key, fmt.Sprintf("%v", value))
+	return r
+}
+
+// SetFormParams set params with key/values to request form body
+func (r *Request) SetFormParams(m Params) *Request {
+	for k, v := range m {
+		r.SetFormParam(k, v)
+	}
+	return r
+}
+
+// Validate initialize the query string and form of the request
+func (r *Request) Validate() (err error) {
+	if r.Query == nil {
+		r.Query = url.Values{}
+	}
+	if r.Form == nil {
+		r.Form = url.Values{}
+	}
+	return nil
+}
+
+// Param return the value of key from the form or the query string
+func (r *Request) Param(key string) string {
+	if v := r.Form.Get(key); v != "" {
+		return v
+	}
+	return r.Query.Get(key)
+}
+
+// RequestOption define option type for request
+type RequestOption func(*Request)
+
+// WithRecvWindow set recvWindow param for the request
+func WithRecvWindow(recvWindow time.Duration) RequestOption {
+	return func(r *Request) {
+		r.RecvWindow = recvWindow
+	}
+}
+
+// WithHeader set or add a header value to the request
+func WithHeader(key, value string, replace bool) RequestOption {
+	return func(r *Request) {
+		if r.Header == nil {
+			r.Header = http.Header{}
+		}
+		if replace {
+			r.Header.Set(key, value)
+		} else {
+			r.Header.Add(key, value)
+		}
+	}
+}
+
+// WithHeaders set or replace the headers of the request
+func WithHeaders(header http.Header) RequestOption {
+	return func(r *Request) {
+		r.Header = header.Clone()
+	}
+}
+
+// WithExtraForm add extra form data of the request
+func WithExtraForm(m map[string]any) RequestOption {
+	return func(r *Request) {
+		r.SetFormParams(m)
+	}
+}
@@ -0,0 +1,429 @@
+package common
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	timestampKey      = "timestamp"
+	signatureKey      = "signature"
+	recvWindowKey     = "recvWindow"
+	defaultRecvWindow = 15 * time.Second
+)
+
+// OrderLookup define how an order placement is found again before it is
+// retried. ClientOrderIDParam is the placement param carrying the client
+// order id, LookupParam is the param it is sent as in a GET request on the
+// same endpoint.
+type OrderLookup struct {
+	ClientOrderIDParam string
+	LookupParam        string
+}
+
+// RESTConfig define what a package supplies to RESTClient
+type RESTConfig struct {
+	// Package is reported in spans, metrics and log fields, e.g. "delivery"
+	Package string
+	// Cost return the rate limit cost of a request
+	Cost func(r *Request) RequestCost
+	// RetryOrders map the order placement endpoints that are retried when
+	// the order carries a client order id to the way the order is looked up
+	RetryOrders map[string]OrderLookup
+}
+
+// RESTClient signs and sends the REST requests of a package. It applies the
+// optional rate limiter, retry policy and clock sync; a package embeds it in
+// its Client and supplies its name, request costs and order lookups.
+type RESTClient struct {
+	APIKey      string
+	SecretKey   string
+	Signer      Signer
+	BaseURL     string
+	UserAgent   string
+	HTTPClient  *http.Client
+	Debug       bool
+	Logger      *zap.SugaredLogger
+	TimeOffset  int64
+	RateLimiter *RateLimiter
+	RetryPolicy RetryPolicy
+	ClockSync   *ClockSync
+
+	cfg RESTConfig
+}
+
+// NewRESTClient init REST client, http.DefaultClient is used when client is nil
+func NewRESTClient(cfg RESTConfig, apiKey, secretKey, baseURL string, client *http.Client) RESTClient {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return RESTClient{
+		APIKey:     apiKey,
+		SecretKey:  secretKey,
+		BaseURL:    baseURL,
+		UserAgent:  "Binance/golang",
+		HTTPClient: client,
+		cfg:        cfg,
+	}
+}
+
+// Sign returns the signature of the provided payload, made with Signer or,
+// when no signer is set, with HMAC SHA256 over SecretKey.
+func (c *RESTClient) Sign(payload string) string {
+	signature, err := c.Signature(payload)
+	if err != nil {
+		panic(err)
+	}
+	return signature
+}
+
+// Signature is Sign returning the signer error instead of panicking
+func (c *RESTClient) Signature(payload string) (string, error) {
+	if c.Signer != nil {
+		return c.Signer.Sign(payload)
+	}
+	return NewHMACSigner(c.SecretKey).Sign(payload)
+}
+
+// StartClockSync sync TimeOffset with serverTime, then keep syncing it every
+// interval until ctx is done. A -1021 error (timestamp outside recvWindow)
+// triggers an immediate resync. Call it before the client is shared between
+// goroutines.
+func (c *RESTClient) StartClockSync(ctx context.Context, interval time.Duration, serverTime ServerTimeFunc) (*ClockSync, error) {
+	clock := NewClockSync(serverTime, &c.TimeOffset)
+	if interval > 0 {
+		clock.Interval = interval
+	}
+	clock.OnError = func(err error) {
+		if c.Logger != nil {
+			c.Logger.Errorw("binance clock sync", "binance.package", c.cfg.Package, "error", err)
+		}
+	}
+	if err := clock.Sync(ctx); err != nil {
+		return nil, err
+	}
+	c.ClockSync = clock
+	go clock.Run(ctx)
+	return clock, nil
+}
+
+// CallAPI sign and send r, retrying it as the retry policy allows. When a
+// retried order placement is found already placed, data is the queried order
+// and r.Recovered is set.
+func (c *RESTClient) CallAPI(ctx context.Context, r *Request, opts ...RequestOption) (data []byte, header *http.Header, err error) {
+	service := r.Service
+	ctx, span := StartRequestSpan(ctx, c.instrumentationName(), service)
+	defer func() { EndRequestSpan(span, err) }()
+
+	err = c.parseRequest(r, opts...)
+	if err != nil {
+		c.logAPIError(ctx, service, r, nil, time.Now(), err)
+		return []byte{}, &http.Header{}, err
+	}
+
+	for attempt := 1; ; attempt++ {
+		var statusCode int
+		data, header, statusCode, err = c.doRequest(ctx, r)
+		if c.ClockSync != nil && isTimestampError(err) {
+			_ = c.ClockSync.Resync(ctx)
+		}
+		if err == nil || c.RetryPolicy == nil || !c.retrySafe(r, err) {
+			return data, header, err
+		}
+		delay, ok := c.RetryPolicy.Retry(RetryRequest{
+			Service:    service,
+			Method:     r.Method,
+			Endpoint:   r.Endpoint,
+			Attempt:    attempt,
+			StatusCode: statusCode,
+			Err:        err,
+		})
+		if !ok {
+			return data, header, err
+		}
+		if serr := SleepContext(ctx, delay); serr != nil {
+			return data, header, err
+		}
+		if r.Method != http.MethodGet && !isTimestampError(err) {
+			existing, found, lerr := c.lookupOrder(ctx, r)
+			if lerr != nil {
+				return data, header, err
+			}
+			if found {
+				r.Recovered = true
+				return existing, &http.Header{}, nil
+			}
+		}
+		// parse again for a fresh timestamp and signature
+		if perr := c.parseRequest(r); perr != nil {
+			return data, header, err
+		}
+	}
+}
+
+func (c *RESTClient) parseRequest(r *Request, opts ...RequestOption) (err error) {
+	// set request options from user
+	for _, opt := range opts {
+		opt(r)
+	}
+	err = r.Validate()
+	if err != nil {
+		return err
+	}
+
+	fullURL := fmt.Sprintf("%s%s", c.BaseURL, r.Endpoint)
+	if r.RecvWindow > 0 {
+		r.SetParam(recvWindowKey, r.RecvWindow.Milliseconds())
+	} else {
+		r.SetParam(recvWindowKey, defaultRecvWindow.Milliseconds())
+	}
+
+	if r.SecType == SecTypeSigned {
+		r.SetParam(timestampKey, time.Now().UnixMilli()-atomic.LoadInt64(&c.TimeOffset))
+	}
+	queryString := r.Query.Encode()
+	body := &bytes.Buffer{}
+	bodyString := r.Form.Encode()
+	header := http.Header{}
+	if r.Header != nil {
+		header = r.Header.Clone()
+	}
+	if bodyString != "" {
+		header.Set("Content-Type", "application/x-www-form-urlencoded")
+		body = bytes.NewBufferString(bodyString)
+	}
+	if r.SecType == SecTypeAPIKey || r.SecType == SecTypeSigned {
+		header.Set("X-MBX-APIKEY", c.APIKey)
+	}
+
+	if r.SecType == SecTypeSigned {
+		raw := fmt.Sprintf("%s%s", queryString, bodyString)
+		signature, err := c.Signature(raw)
+		if err != nil {
+			return err
+		}
+		v := url.Values{}
+		v.Set(signatureKey, signature)
+		if queryString == "" {
+			queryString = v.Encode()
+		} else {
+			queryString = fmt.Sprintf("%s&%s", queryString, v.Encode())
+		}
+	}
+	if queryString != "" {
+		fullURL = fmt.Sprintf("%s?%s", fullURL, queryString)
+	}
+
+	r.FullURL = fullURL
+	r.Header = header
+	r.Body = body
+	return nil
+}
+
+// doRequest send r once, r must have been parsed
+func (c *RESTClient) doRequest(ctx context.Context, r *Request) (data []byte, header *http.Header, statusCode int, err error) {
+	startedAt := time.Now()
+	var req *http.Request
+	var res *http.Response
+	defer func() { c.recordRequest(ctx, r, req, res, startedAt, err) }()
+	service := r.Service
+
+	var cost RequestCost
+	if c.RateLimiter != nil && c.cfg.Cost != nil {
+		cost = c.cfg.Cost(r)
+	}
+	limited := cost != RequestCost{}
+	if limited {
+		if err = c.RateLimiter.Wait(ctx, cost); err != nil {
+			c.logAPIError(ctx, service, r, nil, startedAt, err)
+			return []byte{}, &http.Header{}, 0, err
+		}
+	}
+
+	req, err = http.NewRequestWithContext(ctx, r.Method, r.FullURL, r.Body)
+	if err != nil {
+		c.logAPIError(ctx, service, r, nil, startedAt, err)
+		return []byte{}, &http.Header{}, 0, err
+	}
+	req = req.WithContext(WithHTTPConnTrace(req.Context()))
+	req.Header = r.Header
+
+	c.logAPIRequest(ctx, service, r, req)
+
+	res, err = c.HTTPClient.Do(req)
+	if err != nil {
+		c.logAPIError(ctx, service, r, req, startedAt, err)
+		return []byte{}, &http.Header{}, 0, err
+	}
+	if limited {
+		c.RateLimiter.Update(res.StatusCode, res.Header)
+	}
+	defer func() {
+		cerr := res.Body.Close()
+		if err == nil && cerr != nil {
+			err = cerr
+		}
+	}()
+
+	data, err = io.ReadAll(res.Body)
+	if err != nil {
+		c.logAPIError(ctx, service, r, req, startedAt, err)
+		return []byte{}, &http.Header{}, res.StatusCode, err
+	}
+	c.logAPIResponse(ctx, service, r, req, res, data, startedAt)
+
+	if res.StatusCode >= http.StatusBadRequest {
+		apiErr := new(APIError)
+		_ = json.Unmarshal(data, apiErr)
+		apiErr.StatusCode = res.StatusCode
+		apiErr.Endpoint = r.Endpoint
+		apiErr.Service = service
+		c.logAPIError(ctx, service, r, req, startedAt, apiErr)
+		return nil, &http.Header{}, res.StatusCode, apiErr
+	}
+	return data, &res.Header, res.StatusCode, nil
+}
+
+// retrySafe report whether r may be sent again after failing with err
+func (c *RESTClient) retrySafe(r *Request, err error) bool {
+	if r.Method == http.MethodGet || isTimestampError(err) {
+		return true
+	}
+	lookup, ok := c.cfg.RetryOrders[r.Endpoint]
+	return ok && r.Method == http.MethodPost && r.Param(lookup.ClientOrderIDParam) != ""
+}
+
+// lookupOrder query the order placed by r using its client order id, the
+// queried order is returned in place of the placement response
+func (c *RESTClient) lookupOrder(ctx context.Context, r *Request) (data []byte, found bool, err error) {
+	lookup := c.cfg.RetryOrders[r.Endpoint]
+	q := &Request{
+		Service:    r.Service,
+		Method:     http.MethodGet,
+		Endpoint:   r.Endpoint,
+		SecType:    SecTypeSigned,
+		RecvWindow: r.RecvWindow,
+	}
+	q.SetParam("symbol", r.Param("symbol"))
+	q.SetParam(lookup.LookupParam, r.Param(lookup.ClientOrderIDParam))
+	if err = c.parseRequest(q); err != nil {
+		return nil, false, err
+	}
+	data, _, _, err = c.doRequest(ctx, q)
+	if errors.Is(err, ErrNoSuchOrder) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return data, true, nil
+}
+
+// isTimestampError report whether the request was rejected for a timestamp
+// outside recvWindow, such a request was never executed
+func isTimestampError(err error) bool {
+	return errors.Is(err, ErrInvalidTimestamp)
+}
+
+func (c *RESTClient) instrumentationName() string {
+	return "go-binance/" + c.cfg.Package
+}
+
+// recordRequest record one attempt of r to the request span and metrics
+func (c *RESTClient) recordRequest(ctx context.Context, r *Request, req *http.Request, res *http.Response, startedAt time.Time, err error) {
+	result := RequestResult{
+		Service:  r.Service,
+		Method:   r.Method,
+		Endpoint: r.Endpoint,
+		Duration: time.Since(startedAt),
+		Err:      err,
+	}
+	if req != nil {
+		ctx = req.Context()
+		result.Host = req.URL.Host
+	}
+	if res != nil {
+		result.StatusCode = res.StatusCode
+		result.Header = res.Header
+	}
+	RecordRequest(ctx, c.instrumentationName(), result)
+}
+
+func (c *RESTClient) logAPIRequest(ctx context.Context, service string, r *Request, req *http.Request) {
+	if c == nil || c.Logger == nil || req == nil {
+		return
+	}
+
+	fields := []any{
+		"binance.package", c.cfg.Package,
+		"binance.service", service,
+		"http.method", r.Method,
+		"url.full", SanitizeURL(req.URL.String(), signatureKey),
+		"server.address", req.URL.Host,
+		"binance.api_key", MaskAPIKey(c.APIKey),
+		"http.request.header", SanitizeHeaders(req.Header),
+		"http.request.body", ReadBodyForLog(r.Body),
+	}
+	fields = AppendContextField(ctx, fields)
+
+	c.Logger.Debugw("binance api request", fields...)
+}
+
+func (c *RESTClient) logAPIResponse(ctx context.Context, service string, r *Request, req *http.Request, res *http.Response, data []byte, startedAt time.Time) {
+	if c == nil || c.Logger == nil || req == nil || res == nil {
+		return
+	}
+
+	fields := []any{
+		"binance.package", c.cfg.Package,
+		"binance.service", service,
+		"http.method", r.Method,
+		"url.full", SanitizeURL(req.URL.String(), signatureKey),
+		"server.address", req.URL.Host,
+		"http.response.status_code", res.StatusCode,
+		"http.response.header", SanitizeHeaders(res.Header),
+		"http.response.body", string(data),
+		"event.duration", time.Since(startedAt),
+	}
+	if reused, ok := HTTPConnReused(req.Context()); ok {
+		fields = append(fields, "binance.http.connection_reused", reused)
+	}
+	fields = AppendContextField(ctx, fields)
+
+	c.Logger.Debugw("binance api response", fields...)
+}
+
+func (c *RESTClient) logAPIError(ctx context.Context, service string, r *Request, req *http.Request, startedAt time.Time, err error) {
+	if c == nil || c.Logger == nil || err == nil {
+		return
+	}
+
+	serverAddress := ""
+	if req != nil && req.URL != nil {
+		serverAddress = req.URL.Host
+	}
+
+	fields := []any{
+		"binance.package", c.cfg.Package,
+		"binance.service", service,
+		"http.method", r.Method,
+		"url.full", SanitizeURL(r.FullURL, signatureKey),
+		"server.address", serverAddress,
+		"binance.api_key", MaskAPIKey(c.APIKey),
+		"event.duration", time.Since(startedAt),
+		"error", err,
+	}
+	fields = AppendContextField(ctx, fields)
+
+	c.Logger.Errorw("binance api error", fields...)
+}
@@ -0,0 +1,112 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"net/http"
+	"time"
+)
+
+// Default settings of the backoff retry policy
+const (
+	DefaultRetryMaxAttempts = 3
+	DefaultRetryBaseDelay   = 200 * time.Millisecond
+	DefaultRetryMaxDelay    = 5 * time.Second
+)
+
+// RetryRequest describe a failed attempt handed to a RetryPolicy
+type RetryRequest struct {
+	Service    string
+	Method     string
+	Endpoint   string
+	Attempt    int
+	StatusCode int
+	Err        error
+}
+
+// RetryPolicy decide whether a failed request is sent again. The client only
+// asks the policy about requests that are safe to repeat: GET requests, and
+// order placements carrying a client order id. After the delay returned by
+// the policy, an order placement is looked up by its client order id and
+// sent again only when the order is not found.
+type RetryPolicy interface {
+	// Retry return the delay before the next attempt, false to give up
+	Retry(r RetryRequest) (time.Duration, bool)
+}
+
+// BackoffRetryPolicy retries transient failures with exponential backoff and
+// full jitter
+type BackoffRetryPolicy struct {
+	// MaxAttempts include the first attempt
+	MaxAttempts int
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+	// Retryable classify the failure, IsRetryable is used when nil
+	Retryable func(r RetryRequest) bool
+}
+
+// NewBackoffRetryPolicy init a backoff retry policy with the default settings
+func NewBackoffRetryPolicy() *BackoffRetryPolicy {
+	return &BackoffRetryPolicy{
+		MaxAttempts: DefaultRetryMaxAttempts,
+		BaseDelay:   DefaultRetryBaseDelay,
+		MaxDelay:    DefaultRetryMaxDelay,
+	}
+}
+
+// Retry implement RetryPolicy
+func (p *BackoffRetryPolicy) Retry(r RetryRequest) (time.Duration, bool) {
+	if r.Attempt >= p.MaxAttempts {
+		return 0, false
+	}
+	retryable := p.Retryable
+	if retryable == nil {
+		retryable = func(r RetryRequest) bool {
+			return IsRetryable(r.StatusCode, r.Err)
+		}
+	}
+	if !retryable(r) {
+		return 0, false
+	}
+	delay := p.BaseDelay << (r.Attempt - 1)
+	if delay <= 0 || (p.MaxDelay > 0 && delay > p.MaxDelay) {
+		delay = p.MaxDelay
+	}
+	if delay <= 0 {
+		return 0, true
+	}
+	return rand.N(delay + 1), true
+}
+
+// IsRetryable report whether a failure is transient: a transport error, a
+// 5xx response, or an *APIError whose Retryable report true. Rate limit
+// responses are not retried.
+func IsRetryable(statusCode int, err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	if errors.Is(err, ErrRateLimited) {
+		return false
+	}
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.Retryable() || (apiErr.StatusCode == 0 && statusCode >= http.StatusInternalServerError)
+	}
+	return statusCode == 0 || statusCode >= http.StatusInternalServerError
+}
+
+// SleepContext wait for d or until ctx is done
+func SleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
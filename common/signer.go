@@ -0,0 +1,143 @@
+package common
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrInvalidPEM is returned when no private key block can be decoded
+	ErrInvalidPEM = errors.New("invalid PEM private key")
+	// ErrSessionLogonSigner is returned by SessionLogon when the client does
+	// not sign with an Ed25519 key, session.logon rejects HMAC and RSA
+	ErrSessionLogonSigner = errors.New("session.logon requires an Ed25519 signer")
+)
+
+// Signer sign the payload of a signed request
+type Signer interface {
+	Sign(payload string) (string, error)
+}
+
+// HMACSigner sign with HMAC SHA256 over the API secret key, the signature is
+// hex encoded
+type HMACSigner struct {
+	secret []byte
+}
+
+// NewHMACSigner init HMAC signer
+func NewHMACSigner(secretKey string) *HMACSigner {
+	return &HMACSigner{secret: []byte(secretKey)}
+}
+
+// Sign implement Signer
+func (s *HMACSigner) Sign(payload string) (string, error) {
+	h := hmac.New(sha256.New, s.secret)
+	if _, err := h.Write([]byte(payload)); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// RSASigner sign with RSA PKCS#1 v1.5 over SHA256, the signature is base64
+// encoded
+type RSASigner struct {
+	key *rsa.PrivateKey
+}
+
+// NewRSASigner init RSA signer
+func NewRSASigner(key *rsa.PrivateKey) *RSASigner {
+	return &RSASigner{key: key}
+}
+
+// NewRSASignerFromPEM init RSA signer from a PKCS#1 or PKCS#8 PEM private key
+func NewRSASignerFromPEM(data []byte) (*RSASigner, error) {
+	key, err := parsePEMPrivateKey(data)
+	if err != nil {
+		return nil, err
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("%w: %T is not an RSA key", ErrInvalidPEM, key)
+	}
+	return NewRSASigner(rsaKey), nil
+}
+
+// Sign implement Signer
+func (s *RSASigner) Sign(payload string) (string, error) {
+	digest := sha256.Sum256([]byte(payload))
+	sig, err := rsa.SignPKCS1v15(rand.Reader, s.key, crypto.SHA256, digest[:])
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(sig), nil
+}
+
+// Ed25519Signer sign with Ed25519, the signature is base64 encoded. Ed25519
+// keys are required to authenticate WebSocket API sessions.
+type Ed25519Signer struct {
+	key ed25519.PrivateKey
+}
+
+// NewEd25519Signer init Ed25519 signer
+func NewEd25519Signer(key ed25519.PrivateKey) *Ed25519Signer {
+	return &Ed25519Signer{key: key}
+}
+
+// NewEd25519SignerFromPEM init Ed25519 signer from a PKCS#8 PEM private key
+func NewEd25519SignerFromPEM(data []byte) (*Ed25519Signer, error) {
+	key, err := parsePEMPrivateKey(data)
+	if err != nil {
+		return nil, err
+	}
+	edKey, ok := key.(ed25519.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("%w: %T is not an Ed25519 key", ErrInvalidPEM, key)
+	}
+	return NewEd25519Signer(edKey), nil
+}
+
+// Sign implement Signer
+func (s *Ed25519Signer) Sign(payload string) (string, error) {
+	return base64.StdEncoding.EncodeToString(ed25519.Sign(s.key, []byte(payload))), nil
+}
+
+// NewSignerFromPEM init an RSA or Ed25519 signer depending on the PEM
+// private key type
+func NewSignerFromPEM(data []byte) (Signer, error) {
+	key, err := parsePEMPrivateKey(data)
+	if err != nil {
+		return nil, err
+	}
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		return NewRSASigner(k), nil
+	case ed25519.PrivateKey:
+		return NewEd25519Signer(k), nil
+	}
+	return nil, fmt.Errorf("%w: unsupported key type %T", ErrInvalidPEM, key)
+}
+
+func parsePEMPrivateKey(data []byte) (any, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, ErrInvalidPEM
+	}
+	if block.Type == "RSA PRIVATE KEY" {
+		return x509.ParsePKCS1PrivateKey(block.Bytes)
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidPEM, err)
+	}
+	return key, nil
+}
@@ -0,0 +1,159 @@
+package common
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"testing"
+)
+
+const signerTestPayload = "symbol=LTCBTC&side=BUY&type=LIMIT&timeInForce=GTC&quantity=1&price=0.1&recvWindow=5000&timestamp=1499827319559"
+
+func pemBlock(t *testing.T, blockType string, der []byte) []byte {
+	t.Helper()
+	return pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der})
+}
+
+func pkcs8PEM(t *testing.T, key any) []byte {
+	t.Helper()
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pemBlock(t, "PRIVATE KEY", der)
+}
+
+func TestHMACSigner(t *testing.T) {
+	// the example of the Binance API documentation
+	s := NewHMACSigner("NhqPtmdSJYdKjVHjA7PZj4Mge3R5YNiP1e3UZjInClVN65XAbvqqM6A7H5fATj0j")
+	sig, err := s.Sign(signerTestPayload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "c8db56825ae71d6d79447849e617115f4a920fa2acdcab2b053c4b2838bd6b71"; sig != want {
+		t.Fatalf("signature = %s, want %s", sig, want)
+	}
+}
+
+func TestRSASignerFromPEM(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	digest := sha256.Sum256([]byte(signerTestPayload))
+	want, err := rsa.SignPKCS1v15(nil, key, crypto.SHA256, digest[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, data := range map[string][]byte{
+		"pkcs1": pemBlock(t, "RSA PRIVATE KEY", x509.MarshalPKCS1PrivateKey(key)),
+		"pkcs8": pkcs8PEM(t, key),
+	} {
+		s, err := NewRSASignerFromPEM(data)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		sig, err := s.Sign(signerTestPayload)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		// PKCS#1 v1.5 signatures are deterministic
+		if sig != base64.StdEncoding.EncodeToString(want) {
+			t.Fatalf("%s: signature = %s", name, sig)
+		}
+
+		signer, err := NewSignerFromPEM(data)
+		if err != nil {
+			t.Fatalf("%s: NewSignerFromPEM: %v", name, err)
+		}
+		if _, ok := signer.(*RSASigner); !ok {
+			t.Fatalf("%s: NewSignerFromPEM = %T, want *RSASigner", name, signer)
+		}
+	}
+}
+
+func TestEd25519SignerFromPEM(t *testing.T) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := pkcs8PEM(t, priv)
+
+	s, err := NewEd25519SignerFromPEM(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig, err := s.Sign(signerTestPayload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	raw, err := base64.StdEncoding.DecodeString(sig)
+	if err != nil {
+		t.Fatalf("signature is not base64: %v", err)
+	}
+	if !ed25519.Verify(pub, []byte(signerTestPayload), raw) {
+		t.Fatal("signature does not verify")
+	}
+	if sig != base64.StdEncoding.EncodeToString(ed25519.Sign(priv, []byte(signerTestPayload))) {
+		t.Fatalf("signature = %s", sig)
+	}
+
+	signer, err := NewSignerFromPEM(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := signer.(*Ed25519Signer); !ok {
+		t.Fatalf("NewSignerFromPEM = %T, want *Ed25519Signer", signer)
+	}
+}
+
+func TestSignerFromInvalidPEM(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, load := range map[string]func() error{
+		"not pem": func() error {
+			_, err := NewSignerFromPEM([]byte("not a key"))
+			return err
+		},
+		"bad der": func() error {
+			_, err := NewSignerFromPEM(pemBlock(t, "PRIVATE KEY", []byte("garbage")))
+			return err
+		},
+		"ecdsa key": func() error {
+			_, err := NewSignerFromPEM(pkcs8PEM(t, ecKey))
+			return err
+		},
+		"ed25519 key as rsa": func() error {
+			_, err := NewRSASignerFromPEM(pkcs8PEM(t, edKey))
+			return err
+		},
+		"rsa key as ed25519": func() error {
+			_, err := NewEd25519SignerFromPEM(pkcs8PEM(t, rsaKey))
+			return err
+		},
+	} {
+		if err := load(); !errors.Is(err, ErrInvalidPEM) {
+			t.Fatalf("%s: error = %v, want ErrInvalidPEM", name, err)
+		}
+	}
+}
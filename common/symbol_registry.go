@@ -0,0 +1,229 @@
+package common
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+)
+
+// DefaultSymbolRefreshInterval is the default time between two exchangeInfo
+// refreshes of a symbol registry
+const DefaultSymbolRefreshInterval = time.Hour
+
+// SymbolEventType define the type of a symbol registry event
+type SymbolEventType string
+
+// Symbol event types
+const (
+	// SymbolEventAdded is emitted for a symbol new in exchangeInfo
+	SymbolEventAdded SymbolEventType = "ADDED"
+	// SymbolEventDelisted is emitted for a symbol no longer in exchangeInfo
+	SymbolEventDelisted SymbolEventType = "DELISTED"
+	// SymbolEventStatusChanged is emitted when the status of a symbol
+	// changes, e.g. TRADING to BREAK
+	SymbolEventStatusChanged SymbolEventType = "STATUS_CHANGED"
+)
+
+// SymbolEvent define a change of the symbols found by a refresh
+type SymbolEvent struct {
+	Type   SymbolEventType
+	Symbol string
+	// OldStatus is empty for SymbolEventAdded
+	OldStatus string
+	// NewStatus is empty for SymbolEventDelisted
+	NewStatus string
+}
+
+// SymbolEventHandler handle a symbol registry event
+type SymbolEventHandler func(event SymbolEvent)
+
+// SymbolKey define the fields a symbol registry indexes a symbol by
+type SymbolKey struct {
+	Symbol     string
+	BaseAsset  string
+	QuoteAsset string
+	Status     string
+}
+
+// SymbolRegistry keeps the symbols of exchangeInfo indexed by name, base
+// asset, quote asset and status, and refreshes them on an interval. All reads
+// are safe for concurrent use.
+type SymbolRegistry[T any] struct {
+	Interval time.Duration
+	// OnEvent is called for every symbol added, delisted or changing status
+	// on a refresh. The first load emits no events.
+	OnEvent SymbolEventHandler
+	OnError func(err error)
+
+	load func(ctx context.Context) ([]T, error)
+	key  func(T) SymbolKey
+
+	refreshMu sync.Mutex
+
+	mu         sync.RWMutex
+	symbols    []T
+	bySymbol   map[string]T
+	statuses   map[string]string
+	byBase     map[string][]T
+	byQuote    map[string][]T
+	byStatus   map[string][]T
+	updateTime time.Time
+}
+
+// NewSymbolRegistry init a symbol registry, load fetch the symbols of
+// exchangeInfo and key return the fields a symbol is indexed by
+func NewSymbolRegistry[T any](load func(ctx context.Context) ([]T, error), key func(T) SymbolKey) *SymbolRegistry[T] {
+	return &SymbolRegistry[T]{
+		Interval: DefaultSymbolRefreshInterval,
+		load:     load,
+		key:      key,
+	}
+}
+
+// Refresh load the symbols and replace the indexes, emitting an event for
+// every symbol added, delisted or changing status since the last load
+func (r *SymbolRegistry[T]) Refresh(ctx context.Context) error {
+	r.refreshMu.Lock()
+	defer r.refreshMu.Unlock()
+
+	symbols, err := r.load(ctx)
+	if err != nil {
+		return err
+	}
+
+	bySymbol := make(map[string]T, len(symbols))
+	statuses := make(map[string]string, len(symbols))
+	byBase := map[string][]T{}
+	byQuote := map[string][]T{}
+	byStatus := map[string][]T{}
+	for _, s := range symbols {
+		k := r.key(s)
+		bySymbol[k.Symbol] = s
+		statuses[k.Symbol] = k.Status
+		byBase[k.BaseAsset] = append(byBase[k.BaseAsset], s)
+		byQuote[k.QuoteAsset] = append(byQuote[k.QuoteAsset], s)
+		byStatus[k.Status] = append(byStatus[k.Status], s)
+	}
+
+	r.mu.Lock()
+	old, loaded := r.statuses, !r.updateTime.IsZero()
+	r.symbols = symbols
+	r.bySymbol = bySymbol
+	r.statuses = statuses
+	r.byBase = byBase
+	r.byQuote = byQuote
+	r.byStatus = byStatus
+	r.updateTime = time.Now()
+	r.mu.Unlock()
+
+	if loaded && r.OnEvent != nil {
+		for _, event := range diffSymbols(old, statuses, symbols, r.key) {
+			r.OnEvent(event)
+		}
+	}
+	return nil
+}
+
+// Run refresh every Interval until ctx is done. The registry is loaded first
+// when it is empty.
+func (r *SymbolRegistry[T]) Run(ctx context.Context) {
+	if !r.Loaded() {
+		r.refresh(ctx)
+	}
+	interval := r.Interval
+	if interval <= 0 {
+		interval = DefaultSymbolRefreshInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			r.refresh(ctx)
+		}
+	}
+}
+
+// Loaded report whether the symbols were loaded at least once
+func (r *SymbolRegistry[T]) Loaded() bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return !r.updateTime.IsZero()
+}
+
+// UpdateTime return the time of the last successful refresh
+func (r *SymbolRegistry[T]) UpdateTime() time.Time {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.updateTime
+}
+
+// Get return the symbol named symbol
+func (r *SymbolRegistry[T]) Get(symbol string) (T, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	s, ok := r.bySymbol[symbol]
+	return s, ok
+}
+
+// Symbols return all symbols in exchangeInfo order
+func (r *SymbolRegistry[T]) Symbols() []T {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return append([]T(nil), r.symbols...)
+}
+
+// ByBaseAsset return the symbols whose base asset is asset
+func (r *SymbolRegistry[T]) ByBaseAsset(asset string) []T {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return append([]T(nil), r.byBase[asset]...)
+}
+
+// ByQuoteAsset return the symbols whose quote asset is asset
+func (r *SymbolRegistry[T]) ByQuoteAsset(asset string) []T {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return append([]T(nil), r.byQuote[asset]...)
+}
+
+// ByStatus return the symbols whose status is status
+func (r *SymbolRegistry[T]) ByStatus(status string) []T {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return append([]T(nil), r.byStatus[status]...)
+}
+
+func (r *SymbolRegistry[T]) refresh(ctx context.Context) {
+	if err := r.Refresh(ctx); err != nil && r.OnError != nil && ctx.Err() == nil {
+		r.OnError(err)
+	}
+}
+
+// diffSymbols list the events between the old and new statuses, added and
+// changed symbols in exchangeInfo order, then delisted symbols
+func diffSymbols[T any](old, statuses map[string]string, symbols []T, key func(T) SymbolKey) []SymbolEvent {
+	var events []SymbolEvent
+	for _, s := range symbols {
+		name := key(s).Symbol
+		status := statuses[name]
+		oldStatus, ok := old[name]
+		switch {
+		case !ok:
+			events = append(events, SymbolEvent{Type: SymbolEventAdded, Symbol: name, NewStatus: status})
+		case oldStatus != status:
+			events = append(events, SymbolEvent{Type: SymbolEventStatusChanged, Symbol: name, OldStatus: oldStatus, NewStatus: status})
+		}
+	}
+	var delisted []SymbolEvent
+	for name, oldStatus := range old {
+		if _, ok := statuses[name]; !ok {
+			delisted = append(delisted, SymbolEvent{Type: SymbolEventDelisted, Symbol: name, OldStatus: oldStatus})
+		}
+	}
+	sort.Slice(delisted, func(i, j int) bool { return delisted[i].Symbol < delisted[j].Symbol })
+	return append(events, delisted...)
+}
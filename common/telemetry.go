@@ -0,0 +1,193 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Attribute keys of the request spans and metrics that have no semantic
+// convention
+const (
+	AttrService           = attribute.Key("binance.service")
+	AttrErrorCode         = attribute.Key("binance.error.code")
+	AttrRateLimitInterval = attribute.Key("binance.rate_limit.interval")
+	AttrConnectionReused  = attribute.Key("binance.http.connection_reused")
+)
+
+// Names of the request metrics
+const (
+	MetricRequestDuration = "http.client.request.duration"
+	MetricRequestErrors   = "binance.client.request.errors"
+	MetricUsedWeight      = "binance.client.used_weight"
+	MetricOrderCount      = "binance.client.order_count"
+	MetricConnections     = "binance.client.connections"
+)
+
+// requestDurationBuckets are the bucket boundaries in seconds recommended
+// by the HTTP semantic conventions
+var requestDurationBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.075, 0.1, 0.25, 0.5, 0.75, 1, 2.5, 5, 7.5, 10}
+
+// RequestMetrics define the OpenTelemetry instruments recording API requests:
+// the latency of every attempt, errors by Binance code, the used weight and
+// order count reported by response headers, and connections by reuse so the
+// reuse ratio can be charted
+type RequestMetrics struct {
+	duration    metric.Float64Histogram
+	errors      metric.Int64Counter
+	usedWeight  metric.Int64Gauge
+	orderCount  metric.Int64Gauge
+	connections metric.Int64Counter
+}
+
+// NewRequestMetrics create the request instruments on meter
+func NewRequestMetrics(meter metric.Meter) (*RequestMetrics, error) {
+	var m RequestMetrics
+	var err error
+	m.duration, err = meter.Float64Histogram(MetricRequestDuration,
+		metric.WithUnit("s"),
+		metric.WithDescription("Duration of API requests."),
+		metric.WithExplicitBucketBoundaries(requestDurationBuckets...))
+	if err != nil {
+		return nil, err
+	}
+	m.errors, err = meter.Int64Counter(MetricRequestErrors,
+		metric.WithUnit("{error}"),
+		metric.WithDescription("Number of failed API requests."))
+	if err != nil {
+		return nil, err
+	}
+	m.usedWeight, err = meter.Int64Gauge(MetricUsedWeight,
+		metric.WithUnit("{weight}"),
+		metric.WithDescription("Request weight used in the current interval, from the X-MBX-USED-WEIGHT-* headers."))
+	if err != nil {
+		return nil, err
+	}
+	m.orderCount, err = meter.Int64Gauge(MetricOrderCount,
+		metric.WithUnit("{order}"),
+		metric.WithDescription("Orders placed in the current interval, from the X-MBX-ORDER-COUNT-* headers."))
+	if err != nil {
+		return nil, err
+	}
+	m.connections, err = meter.Int64Counter(MetricConnections,
+		metric.WithUnit("{connection}"),
+		metric.WithDescription("Number of connections used by API requests, by reuse."))
+	if err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+// requestMetrics hold the RequestMetrics of each instrumentation name, created
+// on the global MeterProvider
+var requestMetrics sync.Map
+
+func requestMetricsOf(instrumentationName string) *RequestMetrics {
+	if m, ok := requestMetrics.Load(instrumentationName); ok {
+		return m.(*RequestMetrics)
+	}
+	m, err := NewRequestMetrics(otel.Meter(instrumentationName))
+	if err != nil {
+		otel.Handle(err)
+		return nil
+	}
+	actual, _ := requestMetrics.LoadOrStore(instrumentationName, m)
+	return actual.(*RequestMetrics)
+}
+
+// RequestResult define the outcome of one attempt of an API request
+type RequestResult struct {
+	Service  string
+	Method   string
+	Endpoint string
+	// Host is the server address, empty when the request was not built
+	Host string
+	// StatusCode is 0 when no response was received
+	StatusCode int
+	Header     http.Header
+	Duration   time.Duration
+	Err        error
+}
+
+// RecordRequest add the attributes of one request attempt to the span of ctx
+// and record it to the request metrics of instrumentationName on the global
+// MeterProvider. ctx should be the context of the sent *http.Request so the
+// connection reuse traced by WithHTTPConnTrace is recorded.
+func RecordRequest(ctx context.Context, instrumentationName string, res RequestResult) {
+	attrs := []attribute.KeyValue{
+		AttrService.String(res.Service),
+		semconv.HTTPRequestMethodKey.String(res.Method),
+		semconv.URLTemplate(res.Endpoint),
+	}
+	if res.Host != "" {
+		attrs = append(attrs, semconv.ServerAddress(res.Host))
+	}
+	if res.StatusCode > 0 {
+		attrs = append(attrs, semconv.HTTPResponseStatusCode(res.StatusCode))
+	}
+	var errAttrs []attribute.KeyValue
+	if res.Err != nil {
+		errAttrs = append(errAttrs, semconv.ErrorType(res.Err))
+		var apiErr *APIError
+		if errors.As(res.Err, &apiErr) && apiErr.Code != 0 {
+			errAttrs = append(errAttrs, AttrErrorCode.Int64(apiErr.Code))
+		}
+	}
+	reused, traced := HTTPConnReused(ctx)
+
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attrs...)
+	span.SetAttributes(errAttrs...)
+	if traced {
+		span.SetAttributes(AttrConnectionReused.Bool(reused))
+	}
+
+	m := requestMetricsOf(instrumentationName)
+	if m == nil {
+		return
+	}
+	opt := metric.WithAttributes(append(attrs, errAttrs...)...)
+	m.duration.Record(ctx, res.Duration.Seconds(), opt)
+	if res.Err != nil {
+		m.errors.Add(ctx, 1, opt)
+	}
+	if traced {
+		m.connections.Add(ctx, 1, metric.WithAttributes(AttrConnectionReused.Bool(reused)))
+	}
+	for key, values := range res.Header {
+		rateLimitType, suffix, ok := usageHeader(key)
+		if !ok || len(values) == 0 {
+			continue
+		}
+		used, err := strconv.ParseInt(values[0], 10, 64)
+		if err != nil {
+			continue
+		}
+		interval := metric.WithAttributes(AttrRateLimitInterval.String(strings.ToLower(suffix)))
+		if rateLimitType == RateLimitTypeRequestWeight {
+			m.usedWeight.Record(ctx, used, interval)
+		} else {
+			m.orderCount.Record(ctx, used, interval)
+		}
+	}
+}
+
+// EndRequestSpan record err on span, set its status to error and end it
+func EndRequestSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
@@ -0,0 +1,259 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// DefaultListenKeyKeepaliveInterval is how often the listen key is extended,
+// Binance expires a listen key 60 minutes after the last keepalive.
+const DefaultListenKeyKeepaliveInterval = 30 * time.Minute
+
+var errListenKeyExpired = errors.New("listen key expired")
+
+// UserStreamConfig define the endpoints and event parser of a user data
+// stream, the listen key lifecycle is handled by UserStream
+type UserStreamConfig[E any] struct {
+	// BaseWsURL is the stream host, the listen key is appended as /ws/<listenKey>
+	BaseWsURL string
+	Logger    *zap.SugaredLogger
+	// Package is reported in log fields, e.g. "services" or "futures"
+	Package string
+
+	KeepaliveInterval time.Duration
+	ReconnectMinDelay time.Duration
+	ReconnectMaxDelay time.Duration
+
+	// Start create a listen key
+	Start func(ctx context.Context) (string, error)
+	// Keepalive extend the listen key
+	Keepalive func(ctx context.Context, listenKey string) error
+	// Close delete the listen key
+	Close func(ctx context.Context, listenKey string) error
+	// Parse decode an event payload
+	Parse func(data []byte) (E, error)
+	// Expired tell whether the event announces the expiry of the listen key
+	Expired func(event E) bool
+}
+
+func (c UserStreamConfig[E]) withDefaults() UserStreamConfig[E] {
+	if c.KeepaliveInterval == 0 {
+		c.KeepaliveInterval = DefaultListenKeyKeepaliveInterval
+	}
+	if c.ReconnectMinDelay == 0 {
+		c.ReconnectMinDelay = DefaultWsReconnectMinDelay
+	}
+	if c.ReconnectMaxDelay == 0 {
+		c.ReconnectMaxDelay = DefaultWsReconnectMaxDelay
+	}
+	return c
+}
+
+// UserStream consumes a user data stream. It creates the listen key, keeps
+// it alive, and rotates it when Binance expires it. Events are delivered to
+// the handler and to the events channel, whichever are set.
+type UserStream[E any] struct {
+	cfg     UserStreamConfig[E]
+	handler func(event E)
+	events  chan<- E
+	onError WsErrHandler
+
+	mu        sync.Mutex
+	listenKey string
+}
+
+// NewUserStream init a user data stream, call Run to consume it
+func NewUserStream[E any](cfg UserStreamConfig[E], handler func(event E), onError WsErrHandler) *UserStream[E] {
+	return &UserStream[E]{
+		cfg:     cfg,
+		handler: handler,
+		onError: onError,
+	}
+}
+
+// KeepaliveInterval set the listen key keepalive interval
+func (s *UserStream[E]) KeepaliveInterval(interval time.Duration) *UserStream[E] {
+	s.cfg.KeepaliveInterval = interval
+	return s
+}
+
+// Events set a channel that receives every event after the handler. A send
+// blocks the stream until the channel is read or Run returns, so the reader
+// must keep up with the stream.
+func (s *UserStream[E]) Events(events chan<- E) *UserStream[E] {
+	s.events = events
+	return s
+}
+
+// ListenKey return the listen key in use, empty when not running
+func (s *UserStream[E]) ListenKey() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.listenKey
+}
+
+// Run create the listen key and deliver events until ctx is done. Only a
+// failure to create the first listen key is returned; later failures are
+// reported to the error handler and retried with exponential backoff. An
+// expired listen key is rotated right away. On return the listen key is
+// closed and ctx.Err() is returned.
+func (s *UserStream[E]) Run(ctx context.Context) error {
+	cfg := s.cfg.withDefaults()
+	listenKey, err := cfg.Start(ctx)
+	if err != nil {
+		return err
+	}
+	delay := cfg.ReconnectMinDelay
+	for {
+		s.setListenKey(listenKey)
+		connected, err := s.serve(ctx, cfg, listenKey)
+		if ctx.Err() != nil {
+			s.closeListenKey(cfg, listenKey)
+			s.setListenKey("")
+			return ctx.Err()
+		}
+		expired := errors.Is(err, errListenKeyExpired)
+		if !expired {
+			s.closeListenKey(cfg, listenKey)
+		}
+		s.setListenKey("")
+		s.handleError(fmt.Errorf("user data stream: %w", err))
+
+		if connected {
+			delay = cfg.ReconnectMinDelay
+		}
+		if !expired {
+			if err := s.wait(ctx, cfg, &delay); err != nil {
+				return err
+			}
+		}
+		listenKey, err = s.startListenKey(ctx, cfg, &delay)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// serve consume the stream of listenKey until it fails or expires, connected
+// reports whether the websocket was opened
+func (s *UserStream[E]) serve(ctx context.Context, cfg UserStreamConfig[E], listenKey string) (connected bool, err error) {
+	expired := make(chan struct{}, 1)
+	ws := NewWsStream(WsConfig{
+		Endpoint:          fmt.Sprintf("%s/ws/%s", cfg.BaseWsURL, listenKey),
+		Logger:            cfg.Logger,
+		Package:           cfg.Package,
+		ReconnectMinDelay: cfg.ReconnectMinDelay,
+		ReconnectMaxDelay: cfg.ReconnectMaxDelay,
+	}, func(_ string, data []byte) {
+		event, err := cfg.Parse(data)
+		if err != nil {
+			s.handleError(err)
+			return
+		}
+		if cfg.Expired != nil && cfg.Expired(event) {
+			select {
+			case expired <- struct{}{}:
+			default:
+			}
+		}
+		s.deliver(ctx, event)
+	}, s.handleError)
+	if err := ws.Connect(ctx); err != nil {
+		return false, err
+	}
+	defer ws.Close()
+
+	ticker := time.NewTicker(cfg.KeepaliveInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return true, ctx.Err()
+		case <-expired:
+			return true, errListenKeyExpired
+		case <-ws.Done():
+			return true, ErrWsClosed
+		case <-ticker.C:
+			err := cfg.Keepalive(ctx, listenKey)
+			if err == nil {
+				continue
+			}
+			var apiErr *APIError
+			if errors.As(err, &apiErr) && apiErr.Code == ErrCodeListenKeyNotExist {
+				return true, errListenKeyExpired
+			}
+			s.handleError(fmt.Errorf("keepalive listen key: %w", err))
+		}
+	}
+}
+
+func (s *UserStream[E]) deliver(ctx context.Context, event E) {
+	if s.handler != nil {
+		s.handler(event)
+	}
+	if s.events == nil {
+		return
+	}
+	select {
+	case s.events <- event:
+	case <-ctx.Done():
+	}
+}
+
+// startListenKey create a listen key, retrying with backoff until ctx is done
+func (s *UserStream[E]) startListenKey(ctx context.Context, cfg UserStreamConfig[E], delay *time.Duration) (string, error) {
+	for {
+		listenKey, err := cfg.Start(ctx)
+		if err == nil {
+			return listenKey, nil
+		}
+		if ctx.Err() != nil {
+			return "", ctx.Err()
+		}
+		s.handleError(fmt.Errorf("start listen key: %w", err))
+		if err := s.wait(ctx, cfg, delay); err != nil {
+			return "", err
+		}
+	}
+}
+
+// wait sleep for delay then double it up to the configured maximum
+func (s *UserStream[E]) wait(ctx context.Context, cfg UserStreamConfig[E], delay *time.Duration) error {
+	timer := time.NewTimer(*delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+	}
+	*delay *= 2
+	if *delay > cfg.ReconnectMaxDelay {
+		*delay = cfg.ReconnectMaxDelay
+	}
+	return nil
+}
+
+func (s *UserStream[E]) closeListenKey(cfg UserStreamConfig[E], listenKey string) {
+	ctx, cancel := context.WithTimeout(context.Background(), DefaultWsRequestTimeout)
+	defer cancel()
+	if err := cfg.Close(ctx, listenKey); err != nil {
+		s.handleError(fmt.Errorf("close listen key: %w", err))
+	}
+}
+
+func (s *UserStream[E]) setListenKey(listenKey string) {
+	s.mu.Lock()
+	s.listenKey = listenKey
+	s.mu.Unlock()
+}
+
+func (s *UserStream[E]) handleError(err error) {
+	if s.onError != nil {
+		s.onError(err)
+	}
+}
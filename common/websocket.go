@@ -0,0 +1,611 @@
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"go.uber.org/zap"
+)
+
+// Websocket stream defaults
+const (
+	DefaultWsPingInterval      = 30 * time.Second
+	DefaultWsReadTimeout       = 90 * time.Second
+	DefaultWsWriteTimeout      = 10 * time.Second
+	DefaultWsRequestTimeout    = 10 * time.Second
+	DefaultWsReconnectMinDelay = 500 * time.Millisecond
+	DefaultWsReconnectMaxDelay = 30 * time.Second
+	DefaultWsStreamsPerRequest = 200
+)
+
+var (
+	// ErrWsClosed is returned when the websocket stream has been closed by the user
+	ErrWsClosed = errors.New("websocket stream closed")
+	// ErrWsNotConnected is returned when a request needs a live connection
+	ErrWsNotConnected = errors.New("websocket stream not connected")
+)
+
+// WsMessageHandler handle the data payload of a combined stream message.
+// stream is empty when the server sends a raw (non combined) payload.
+type WsMessageHandler func(stream string, data []byte)
+
+// WsErrHandler handle errors raised while reading or reconnecting
+type WsErrHandler func(err error)
+
+// WsConfig define the settings of a websocket stream connection
+type WsConfig struct {
+	// Endpoint is the full combined stream URL, e.g. wss://stream.binance.com:9443/stream
+	Endpoint string
+	Dialer   *websocket.Dialer
+	Logger   *zap.SugaredLogger
+	// Package is reported in log fields, e.g. "services" or "futures"
+	Package string
+
+	PingInterval      time.Duration
+	ReadTimeout       time.Duration
+	WriteTimeout      time.Duration
+	RequestTimeout    time.Duration
+	ReconnectMinDelay time.Duration
+	ReconnectMaxDelay time.Duration
+	// StreamsPerRequest caps the number of streams sent in one SUBSCRIBE message
+	StreamsPerRequest int
+}
+
+func (c WsConfig) withDefaults() WsConfig {
+	if c.Dialer == nil {
+		c.Dialer = websocket.DefaultDialer
+	}
+	if c.PingInterval == 0 {
+		c.PingInterval = DefaultWsPingInterval
+	}
+	if c.ReadTimeout == 0 {
+		c.ReadTimeout = DefaultWsReadTimeout
+	}
+	if c.WriteTimeout == 0 {
+		c.WriteTimeout = DefaultWsWriteTimeout
+	}
+	if c.RequestTimeout == 0 {
+		c.RequestTimeout = DefaultWsRequestTimeout
+	}
+	if c.ReconnectMinDelay == 0 {
+		c.ReconnectMinDelay = DefaultWsReconnectMinDelay
+	}
+	if c.ReconnectMaxDelay == 0 {
+		c.ReconnectMaxDelay = DefaultWsReconnectMaxDelay
+	}
+	if c.StreamsPerRequest == 0 {
+		c.StreamsPerRequest = DefaultWsStreamsPerRequest
+	}
+	return c
+}
+
+type wsRequest struct {
+	Method string   `json:"method"`
+	Params []string `json:"params,omitempty"`
+	ID     int64    `json:"id"`
+}
+
+type wsEnvelope struct {
+	Stream  string          `json:"stream"`
+	Data    json.RawMessage `json:"data"`
+	ID      *int64          `json:"id"`
+	Error   *APIError       `json:"error"`
+	Code    int64           `json:"code"`
+	Message string          `json:"msg"`
+}
+
+// WsStream maintains a combined stream websocket connection. Streams can be
+// subscribed and unsubscribed at runtime; the connection is re-established
+// with exponential backoff and every active stream is subscribed again.
+type WsStream struct {
+	cfg       WsConfig
+	onMessage WsMessageHandler
+	onError   WsErrHandler
+
+	mu      sync.Mutex
+	conn    *websocket.Conn
+	streams map[string]struct{}
+	pending map[int64]chan error
+	started bool
+	closed  bool
+
+	writeMu sync.Mutex
+	nextID  atomic.Int64
+	closeCh chan struct{}
+	done    chan struct{}
+}
+
+// NewWsStream init a websocket stream, call Connect to open it
+func NewWsStream(cfg WsConfig, onMessage WsMessageHandler, onError WsErrHandler) *WsStream {
+	return &WsStream{
+		cfg:       cfg.withDefaults(),
+		onMessage: onMessage,
+		onError:   onError,
+		streams:   map[string]struct{}{},
+		pending:   map[int64]chan error{},
+		closeCh:   make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+}
+
+// Connect dial the endpoint, start the read loop and subscribe the streams
+// registered so far. Calling Connect on a running stream is a no-op.
+func (s *WsStream) Connect(ctx context.Context) error {
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return ErrWsClosed
+	}
+	if s.started {
+		s.mu.Unlock()
+		return nil
+	}
+	s.mu.Unlock()
+
+	conn, err := s.dial(ctx)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	if s.closed || s.started {
+		closed := s.closed
+		s.mu.Unlock()
+		_ = conn.Close()
+		if closed {
+			return ErrWsClosed
+		}
+		return nil
+	}
+	s.started = true
+	s.conn = conn
+	streams := s.streamList()
+	s.mu.Unlock()
+
+	go s.run(conn)
+
+	return s.subscribeAll(ctx, streams)
+}
+
+// Subscribe add streams to the connection. When the stream is not connected
+// yet, the streams are subscribed on Connect.
+func (s *WsStream) Subscribe(ctx context.Context, streams ...string) error {
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return ErrWsClosed
+	}
+	added := make([]string, 0, len(streams))
+	for _, stream := range streams {
+		if _, ok := s.streams[stream]; ok {
+			continue
+		}
+		s.streams[stream] = struct{}{}
+		added = append(added, stream)
+	}
+	connected := s.conn != nil
+	s.mu.Unlock()
+
+	if !connected || len(added) == 0 {
+		return nil
+	}
+	if err := s.subscribeAll(ctx, added); err != nil {
+		s.mu.Lock()
+		for _, stream := range added {
+			delete(s.streams, stream)
+		}
+		s.mu.Unlock()
+		return err
+	}
+	return nil
+}
+
+// Unsubscribe remove streams from the connection. A stream is dropped from
+// the subscribed set once the server acknowledged its UNSUBSCRIBE, streams
+// of a failed request stay subscribed.
+func (s *WsStream) Unsubscribe(ctx context.Context, streams ...string) error {
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return ErrWsClosed
+	}
+	removed := make([]string, 0, len(streams))
+	for _, stream := range streams {
+		if _, ok := s.streams[stream]; !ok {
+			continue
+		}
+		removed = append(removed, stream)
+	}
+	connected := s.conn != nil
+	if !connected {
+		s.forget(removed)
+	}
+	s.mu.Unlock()
+
+	if !connected || len(removed) == 0 {
+		return nil
+	}
+	for _, chunk := range chunkStreams(removed, s.cfg.StreamsPerRequest) {
+		if err := s.request(ctx, "UNSUBSCRIBE", chunk); err != nil {
+			return err
+		}
+		s.mu.Lock()
+		s.forget(chunk)
+		s.mu.Unlock()
+	}
+	return nil
+}
+
+// Streams return the currently subscribed streams
+func (s *WsStream) Streams() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.streamList()
+}
+
+// Done is closed once the stream has been closed and its read loop exited
+func (s *WsStream) Done() <-chan struct{} {
+	return s.done
+}
+
+// Close stop reconnecting and close the underlying connection
+func (s *WsStream) Close() error {
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return nil
+	}
+	s.closed = true
+	close(s.closeCh)
+	conn := s.conn
+	started := s.started
+	s.mu.Unlock()
+
+	var err error
+	if conn != nil {
+		_ = conn.WriteControl(websocket.CloseMessage,
+			websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""),
+			time.Now().Add(s.cfg.WriteTimeout))
+		err = conn.Close()
+	}
+	if started {
+		<-s.done
+	} else {
+		close(s.done)
+	}
+	return err
+}
+
+func (s *WsStream) dial(ctx context.Context) (*websocket.Conn, error) {
+	conn, _, err := s.cfg.Dialer.DialContext(ctx, s.cfg.Endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	s.logDebug("binance websocket connected")
+	return conn, nil
+}
+
+func (s *WsStream) run(conn *websocket.Conn) {
+	defer close(s.done)
+	for {
+		stopPing := s.keepAlive(conn)
+		err := s.readLoop(conn)
+		stopPing()
+		s.detach(conn, err)
+		if s.isClosed() {
+			return
+		}
+		s.handleError(fmt.Errorf("websocket disconnected: %w", err))
+
+		conn = s.reconnect()
+		if conn == nil {
+			return
+		}
+	}
+}
+
+func (s *WsStream) readLoop(conn *websocket.Conn) error {
+	extend := func() {
+		_ = conn.SetReadDeadline(time.Now().Add(s.cfg.ReadTimeout))
+	}
+	extend()
+	setDeadlineHandlers(conn, s.cfg.WriteTimeout, extend)
+
+	for {
+		_, message, err := conn.ReadMessage()
+		if err != nil {
+			return err
+		}
+		extend()
+		s.dispatch(message)
+	}
+}
+
+func (s *WsStream) dispatch(message []byte) {
+	var env wsEnvelope
+	if err := json.Unmarshal(message, &env); err != nil {
+		// array payloads of raw streams can not be enveloped
+		if s.onMessage != nil {
+			s.onMessage("", message)
+		}
+		return
+	}
+	if env.ID != nil {
+		apiErr := env.Error
+		if apiErr == nil && env.Code != 0 {
+			apiErr = &APIError{Code: env.Code, Message: env.Message}
+		}
+		s.resolve(*env.ID, apiErr)
+		return
+	}
+	if s.onMessage == nil {
+		return
+	}
+	if env.Stream == "" {
+		s.onMessage("", message)
+		return
+	}
+	s.onMessage(env.Stream, env.Data)
+}
+
+func (s *WsStream) keepAlive(conn *websocket.Conn) (stop func()) {
+	return startPinger(conn, s.cfg.PingInterval, s.cfg.WriteTimeout)
+}
+
+func (s *WsStream) reconnect() *websocket.Conn {
+	delay := s.cfg.ReconnectMinDelay
+	for {
+		timer := time.NewTimer(delay)
+		select {
+		case <-s.closeCh:
+			timer.Stop()
+			return nil
+		case <-timer.C:
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		go func() {
+			select {
+			case <-s.closeCh:
+				cancel()
+			case <-ctx.Done():
+			}
+		}()
+		conn, err := s.dial(ctx)
+		cancel()
+		if err == nil {
+			s.mu.Lock()
+			if s.closed {
+				s.mu.Unlock()
+				_ = conn.Close()
+				return nil
+			}
+			s.conn = conn
+			streams := s.streamList()
+			s.mu.Unlock()
+
+			go func() {
+				ctx, cancel := context.WithTimeout(context.Background(), s.cfg.RequestTimeout*time.Duration(len(streams)/s.cfg.StreamsPerRequest+1))
+				defer cancel()
+				if err := s.subscribeAll(ctx, streams); err != nil {
+					s.handleError(fmt.Errorf("websocket resubscribe: %w", err))
+				}
+			}()
+			return conn
+		}
+		if s.isClosed() {
+			return nil
+		}
+		s.handleError(fmt.Errorf("websocket reconnect: %w", err))
+
+		delay *= 2
+		if delay > s.cfg.ReconnectMaxDelay {
+			delay = s.cfg.ReconnectMaxDelay
+		}
+	}
+}
+
+func (s *WsStream) detach(conn *websocket.Conn, err error) {
+	s.mu.Lock()
+	if s.conn == conn {
+		s.conn = nil
+	}
+	pending := s.pending
+	s.pending = map[int64]chan error{}
+	s.mu.Unlock()
+
+	_ = conn.Close()
+	if err == nil {
+		err = ErrWsNotConnected
+	}
+	for _, ch := range pending {
+		ch <- err
+	}
+	s.logDebug("binance websocket disconnected", "error", err)
+}
+
+func (s *WsStream) subscribeAll(ctx context.Context, streams []string) error {
+	for _, chunk := range chunkStreams(streams, s.cfg.StreamsPerRequest) {
+		if err := s.request(ctx, "SUBSCRIBE", chunk); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *WsStream) request(ctx context.Context, method string, params []string) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	id := s.nextID.Add(1)
+	ch := make(chan error, 1)
+
+	s.mu.Lock()
+	conn := s.conn
+	if conn == nil {
+		s.mu.Unlock()
+		return ErrWsNotConnected
+	}
+	s.pending[id] = ch
+	s.mu.Unlock()
+
+	defer func() {
+		s.mu.Lock()
+		delete(s.pending, id)
+		s.mu.Unlock()
+	}()
+
+	if err := s.writeJSON(conn, wsRequest{Method: method, Params: params, ID: id}); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.cfg.RequestTimeout)
+	defer cancel()
+	select {
+	case err := <-ch:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (s *WsStream) resolve(id int64, apiErr *APIError) {
+	s.mu.Lock()
+	ch, ok := s.pending[id]
+	delete(s.pending, id)
+	s.mu.Unlock()
+	if !ok {
+		return
+	}
+	if apiErr != nil {
+		ch <- apiErr
+		return
+	}
+	ch <- nil
+}
+
+func (s *WsStream) writeJSON(conn *websocket.Conn, v any) error {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+	_ = conn.SetWriteDeadline(time.Now().Add(s.cfg.WriteTimeout))
+	return conn.WriteJSON(v)
+}
+
+// forget drop streams from the subscribed set, s.mu must be held
+func (s *WsStream) forget(streams []string) {
+	for _, stream := range streams {
+		delete(s.streams, stream)
+	}
+}
+
+func (s *WsStream) streamList() []string {
+	streams := make([]string, 0, len(s.streams))
+	for stream := range s.streams {
+		streams = append(streams, stream)
+	}
+	sort.Strings(streams)
+	return streams
+}
+
+func (s *WsStream) isClosed() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.closed
+}
+
+func (s *WsStream) handleError(err error) {
+	s.logError("binance websocket error", err)
+	if s.onError != nil {
+		s.onError(err)
+	}
+}
+
+func (s *WsStream) logDebug(msg string, fields ...any) {
+	if s.cfg.Logger == nil {
+		return
+	}
+	fields = append([]any{
+		"binance.package", s.cfg.Package,
+		"url.full", s.cfg.Endpoint,
+	}, fields...)
+	s.cfg.Logger.Debugw(msg, fields...)
+}
+
+func (s *WsStream) logError(msg string, err error) {
+	if s.cfg.Logger == nil {
+		return
+	}
+	s.cfg.Logger.Errorw(msg,
+		"binance.package", s.cfg.Package,
+		"url.full", s.cfg.Endpoint,
+		"error", err,
+	)
+}
+
+// setDeadlineHandlers answer server pings and extend the read deadline on
+// every ping and pong
+func setDeadlineHandlers(conn *websocket.Conn, writeTimeout time.Duration, extend func()) {
+	conn.SetPingHandler(func(appData string) error {
+		extend()
+		err := conn.WriteControl(websocket.PongMessage, []byte(appData), time.Now().Add(writeTimeout))
+		if errors.Is(err, websocket.ErrCloseSent) {
+			return nil
+		}
+		var netErr net.Error
+		if errors.As(err, &netErr) && netErr.Timeout() {
+			return nil
+		}
+		return err
+	})
+	conn.SetPongHandler(func(string) error {
+		extend()
+		return nil
+	})
+}
+
+// startPinger send a ping every interval until stop is called, a negative
+// interval disables it
+func startPinger(conn *websocket.Conn, interval, writeTimeout time.Duration) (stop func()) {
+	if interval < 0 {
+		return func() {}
+	}
+	stopCh := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stopCh:
+				return
+			case <-ticker.C:
+				if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(writeTimeout)); err != nil {
+					return
+				}
+			}
+		}
+	}()
+	return func() { close(stopCh) }
+}
+
+func chunkStreams(streams []string, size int) [][]string {
+	if len(streams) == 0 {
+		return nil
+	}
+	if size <= 0 {
+		size = len(streams)
+	}
+	chunks := make([][]string, 0, len(streams)/size+1)
+	for len(streams) > size {
+		chunks = append(chunks, streams[:size])
+		streams = streams[size:]
+	}
+	return append(chunks, streams)
+}
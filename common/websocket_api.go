@@ -0,0 +1,282 @@
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// WsAPIRateLimit define a rate limit reported by a WebSocket API response
+type WsAPIRateLimit struct {
+	RateLimitType string `json:"rateLimitType"`
+	Interval      string `json:"interval"`
+	IntervalNum   int    `json:"intervalNum"`
+	Limit         int    `json:"limit"`
+	Count         int    `json:"count"`
+}
+
+// WsAPIResponse define a WebSocket API response
+type WsAPIResponse struct {
+	ID         *int64           `json:"id"`
+	Status     int              `json:"status"`
+	Result     json.RawMessage  `json:"result"`
+	Error      *APIError        `json:"error"`
+	RateLimits []WsAPIRateLimit `json:"rateLimits"`
+}
+
+type wsAPIRequest struct {
+	ID     int64          `json:"id"`
+	Method string         `json:"method"`
+	Params map[string]any `json:"params,omitempty"`
+}
+
+// WsAPIConn is a request/response connection to a Binance WebSocket API
+// endpoint. Responses are matched to requests by id, so calls may be issued
+// concurrently. The connection is dialed on first use and dialed again by the
+// next call after it drops; there is no automatic reconnect in between.
+type WsAPIConn struct {
+	cfg          WsConfig
+	onDisconnect func(err error)
+
+	mu      sync.Mutex
+	dialMu  sync.Mutex
+	conn    *websocket.Conn
+	pending map[int64]chan *WsAPIResponse
+	closed  bool
+
+	writeMu sync.Mutex
+	nextID  atomic.Int64
+}
+
+// NewWsAPIConn init a WebSocket API connection. cfg.Endpoint is the full
+// endpoint URL; the reconnect and stream settings of cfg are not used.
+// onDisconnect, when set, is called each time an established connection drops.
+func NewWsAPIConn(cfg WsConfig, onDisconnect func(err error)) *WsAPIConn {
+	return &WsAPIConn{
+		cfg:          cfg.withDefaults(),
+		onDisconnect: onDisconnect,
+		pending:      map[int64]chan *WsAPIResponse{},
+	}
+}
+
+// Connect dial the endpoint unless already connected
+func (c *WsAPIConn) Connect(ctx context.Context) error {
+	_, err := c.connection(ctx)
+	return err
+}
+
+// Connected report whether the connection is established
+func (c *WsAPIConn) Connected() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.conn != nil
+}
+
+// Call send a request and wait for its response. When ctx has no deadline the
+// configured RequestTimeout applies. A response carrying an error is returned
+// together with that *APIError.
+func (c *WsAPIConn) Call(ctx context.Context, method string, params map[string]any) (*WsAPIResponse, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.cfg.RequestTimeout)
+		defer cancel()
+	}
+
+	conn, err := c.connection(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	id := c.nextID.Add(1)
+	ch := make(chan *WsAPIResponse, 1)
+	c.mu.Lock()
+	if c.conn != conn {
+		c.mu.Unlock()
+		return nil, ErrWsNotConnected
+	}
+	c.pending[id] = ch
+	c.mu.Unlock()
+	defer func() {
+		c.mu.Lock()
+		delete(c.pending, id)
+		c.mu.Unlock()
+	}()
+
+	c.logDebug("binance websocket api request", "binance.method", method, "binance.request_id", id)
+	if err := c.writeJSON(conn, wsAPIRequest{ID: id, Method: method, Params: params}); err != nil {
+		return nil, err
+	}
+
+	select {
+	case res := <-ch:
+		if res == nil {
+			return nil, ErrWsNotConnected
+		}
+		if res.Error != nil {
+			res.Error.StatusCode = res.Status
+			res.Error.Endpoint = method
+			return res, res.Error
+		}
+		if res.Status >= 400 {
+			return res, fmt.Errorf("websocket api %s: status %d", method, res.Status)
+		}
+		return res, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// Close close the connection, later calls fail with ErrWsClosed
+func (c *WsAPIConn) Close() error {
+	c.mu.Lock()
+	if c.closed {
+		c.mu.Unlock()
+		return nil
+	}
+	c.closed = true
+	conn := c.conn
+	c.mu.Unlock()
+
+	if conn == nil {
+		return nil
+	}
+	_ = conn.WriteControl(websocket.CloseMessage,
+		websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""),
+		time.Now().Add(c.cfg.WriteTimeout))
+	return conn.Close()
+}
+
+func (c *WsAPIConn) connection(ctx context.Context) (*websocket.Conn, error) {
+	c.mu.Lock()
+	conn, closed := c.conn, c.closed
+	c.mu.Unlock()
+	if closed {
+		return nil, ErrWsClosed
+	}
+	if conn != nil {
+		return conn, nil
+	}
+
+	c.dialMu.Lock()
+	defer c.dialMu.Unlock()
+
+	c.mu.Lock()
+	conn, closed = c.conn, c.closed
+	c.mu.Unlock()
+	if closed {
+		return nil, ErrWsClosed
+	}
+	if conn != nil {
+		return conn, nil
+	}
+
+	conn, _, err := c.cfg.Dialer.DialContext(ctx, c.cfg.Endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	if c.closed {
+		c.mu.Unlock()
+		_ = conn.Close()
+		return nil, ErrWsClosed
+	}
+	c.conn = conn
+	c.mu.Unlock()
+	c.logDebug("binance websocket api connected")
+
+	go c.run(conn)
+	return conn, nil
+}
+
+func (c *WsAPIConn) run(conn *websocket.Conn) {
+	stopPing := startPinger(conn, c.cfg.PingInterval, c.cfg.WriteTimeout)
+	err := c.readLoop(conn)
+	stopPing()
+
+	c.mu.Lock()
+	if c.conn == conn {
+		c.conn = nil
+	}
+	pending := c.pending
+	c.pending = map[int64]chan *WsAPIResponse{}
+	closed := c.closed
+	c.mu.Unlock()
+
+	_ = conn.Close()
+	for _, ch := range pending {
+		ch <- nil
+	}
+	c.logDebug("binance websocket api disconnected", "error", err)
+	if !closed && c.onDisconnect != nil {
+		c.onDisconnect(err)
+	}
+}
+
+func (c *WsAPIConn) readLoop(conn *websocket.Conn) error {
+	extend := func() {
+		_ = conn.SetReadDeadline(time.Now().Add(c.cfg.ReadTimeout))
+	}
+	extend()
+	setDeadlineHandlers(conn, c.cfg.WriteTimeout, extend)
+
+	for {
+		_, message, err := conn.ReadMessage()
+		if err != nil {
+			return err
+		}
+		extend()
+
+		res := new(WsAPIResponse)
+		if err := json.Unmarshal(message, res); err != nil {
+			c.logError("binance websocket api decode", err)
+			continue
+		}
+		if res.ID == nil {
+			continue
+		}
+		c.mu.Lock()
+		ch, ok := c.pending[*res.ID]
+		delete(c.pending, *res.ID)
+		c.mu.Unlock()
+		if ok {
+			ch <- res
+		}
+	}
+}
+
+func (c *WsAPIConn) writeJSON(conn *websocket.Conn, v any) error {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	_ = conn.SetWriteDeadline(time.Now().Add(c.cfg.WriteTimeout))
+	return conn.WriteJSON(v)
+}
+
+func (c *WsAPIConn) logDebug(msg string, fields ...any) {
+	if c.cfg.Logger == nil {
+		return
+	}
+	fields = append([]any{
+		"binance.package", c.cfg.Package,
+		"url.full", c.cfg.Endpoint,
+	}, fields...)
+	c.cfg.Logger.Debugw(msg, fields...)
+}
+
+func (c *WsAPIConn) logError(msg string, err error) {
+	if c.cfg.Logger == nil {
+		return
+	}
+	c.cfg.Logger.Errorw(msg,
+		"binance.package", c.cfg.Package,
+		"url.full", c.cfg.Endpoint,
+		"error", err,
+	)
+}
@@ -0,0 +1,356 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// wsTestServer is a combined stream endpoint that acknowledges SUBSCRIBE and
+// UNSUBSCRIBE requests and hands them to the test
+type wsTestServer struct {
+	*httptest.Server
+	t *testing.T
+
+	mu    sync.Mutex
+	conns []*websocket.Conn
+	// reject fail the requests of this method with an error
+	reject string
+	// hold delay the acknowledgement of this method until release is closed
+	hold    string
+	release chan struct{}
+	reqCh   chan wsRequest
+}
+
+func newWsTestServer(t *testing.T) *wsTestServer {
+	s := &wsTestServer{t: t, reqCh: make(chan wsRequest, 64), release: make(chan struct{})}
+	upgrader := websocket.Upgrader{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		s.mu.Lock()
+		s.conns = append(s.conns, conn)
+		s.mu.Unlock()
+		for {
+			var req wsRequest
+			if err := conn.ReadJSON(&req); err != nil {
+				return
+			}
+			s.mu.Lock()
+			reject, hold := s.reject, s.hold
+			s.mu.Unlock()
+			s.reqCh <- req
+
+			if req.Method == hold {
+				<-s.release
+			}
+			resp := map[string]any{"result": nil, "id": req.ID}
+			if req.Method == reject {
+				resp = map[string]any{"error": map[string]any{"code": -1121, "msg": "Invalid symbol."}, "id": req.ID}
+			}
+			s.write(conn, resp)
+		}
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *wsTestServer) url() string {
+	return "ws" + strings.TrimPrefix(s.URL, "http") + "/stream"
+}
+
+func (s *wsTestServer) write(conn *websocket.Conn, v any) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := conn.WriteJSON(v); err != nil {
+		s.t.Logf("write: %v", err)
+	}
+}
+
+// send write a raw text frame
+func (s *wsTestServer) send(conn *websocket.Conn, message string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := conn.WriteMessage(websocket.TextMessage, []byte(message)); err != nil {
+		s.t.Logf("write: %v", err)
+	}
+}
+
+// conn return the last accepted connection
+func (s *wsTestServer) conn() *websocket.Conn {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.conns[len(s.conns)-1]
+}
+
+// next wait for the next request received by the server
+func (s *wsTestServer) next() wsRequest {
+	s.t.Helper()
+	select {
+	case req := <-s.reqCh:
+		return req
+	case <-time.After(5 * time.Second):
+		s.t.Fatal("no request received")
+		return wsRequest{}
+	}
+}
+
+func testWsConfig(endpoint string) WsConfig {
+	return WsConfig{
+		Endpoint:          endpoint,
+		ReconnectMinDelay: 10 * time.Millisecond,
+		ReconnectMaxDelay: 20 * time.Millisecond,
+		RequestTimeout:    2 * time.Second,
+	}
+}
+
+type wsTestMessage struct {
+	stream string
+	data   string
+}
+
+func TestWsStreamCombinedDispatch(t *testing.T) {
+	srv := newWsTestServer(t)
+	messages := make(chan wsTestMessage, 8)
+	ws := NewWsStream(testWsConfig(srv.url()), func(stream string, data []byte) {
+		messages <- wsTestMessage{stream, string(data)}
+	}, nil)
+	defer ws.Close()
+	if err := ws.Connect(context.Background()); err != nil {
+		t.Fatalf("connect: %v", err)
+	}
+
+	conn := srv.conn()
+	srv.send(conn, `{"stream":"btcusdt@trade","data":{"e":"trade","p":"1.5"}}`)
+	srv.send(conn, `{"e":"outboundAccountPosition","E":1}`)
+	srv.send(conn, `[{"s":"BTCUSDT"},{"s":"ETHUSDT"}]`)
+
+	want := []wsTestMessage{
+		{"btcusdt@trade", `{"e":"trade","p":"1.5"}`},
+		{"", `{"e":"outboundAccountPosition","E":1}`},
+		{"", `[{"s":"BTCUSDT"},{"s":"ETHUSDT"}]`},
+	}
+	for _, w := range want {
+		select {
+		case got := <-messages:
+			if got != w {
+				t.Fatalf("message = %+v, want %+v", got, w)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("message %+v not delivered", w)
+		}
+	}
+}
+
+func TestWsStreamSubscribeUnsubscribe(t *testing.T) {
+	srv := newWsTestServer(t)
+	ws := NewWsStream(testWsConfig(srv.url()), nil, nil)
+	defer ws.Close()
+	if err := ws.Subscribe(context.Background(), "btcusdt@trade"); err != nil {
+		t.Fatalf("subscribe before connect: %v", err)
+	}
+	if err := ws.Connect(context.Background()); err != nil {
+		t.Fatalf("connect: %v", err)
+	}
+	first := srv.next()
+	if first.Method != "SUBSCRIBE" || !reflect.DeepEqual(first.Params, []string{"btcusdt@trade"}) {
+		t.Fatalf("first request = %+v", first)
+	}
+
+	if err := ws.Subscribe(context.Background(), "ethusdt@trade", "btcusdt@trade"); err != nil {
+		t.Fatalf("subscribe: %v", err)
+	}
+	second := srv.next()
+	if second.Method != "SUBSCRIBE" || !reflect.DeepEqual(second.Params, []string{"ethusdt@trade"}) {
+		t.Fatalf("second request = %+v", second)
+	}
+	if second.ID <= first.ID {
+		t.Fatalf("request ids not increasing: %d then %d", first.ID, second.ID)
+	}
+
+	if err := ws.Unsubscribe(context.Background(), "btcusdt@trade"); err != nil {
+		t.Fatalf("unsubscribe: %v", err)
+	}
+	third := srv.next()
+	if third.Method != "UNSUBSCRIBE" || !reflect.DeepEqual(third.Params, []string{"btcusdt@trade"}) {
+		t.Fatalf("third request = %+v", third)
+	}
+	if third.ID <= second.ID {
+		t.Fatalf("request ids not increasing: %d then %d", second.ID, third.ID)
+	}
+	if got := ws.Streams(); !reflect.DeepEqual(got, []string{"ethusdt@trade"}) {
+		t.Fatalf("streams = %v", got)
+	}
+}
+
+func TestWsStreamSubscribeError(t *testing.T) {
+	srv := newWsTestServer(t)
+	srv.mu.Lock()
+	srv.reject = "SUBSCRIBE"
+	srv.mu.Unlock()
+	ws := NewWsStream(testWsConfig(srv.url()), nil, nil)
+	defer ws.Close()
+	if err := ws.Connect(context.Background()); err != nil {
+		t.Fatalf("connect: %v", err)
+	}
+
+	err := ws.Subscribe(context.Background(), "bad@trade")
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.Code != -1121 {
+		t.Fatalf("subscribe error = %v, want API error -1121", err)
+	}
+	if got := ws.Streams(); len(got) != 0 {
+		t.Fatalf("streams = %v, want none", got)
+	}
+}
+
+func TestWsStreamUnsubscribeKeepsStreamUntilAck(t *testing.T) {
+	srv := newWsTestServer(t)
+	srv.mu.Lock()
+	srv.hold = "UNSUBSCRIBE"
+	srv.mu.Unlock()
+	ws := NewWsStream(testWsConfig(srv.url()), nil, nil)
+	defer ws.Close()
+	if err := ws.Connect(context.Background()); err != nil {
+		t.Fatalf("connect: %v", err)
+	}
+	if err := ws.Subscribe(context.Background(), "btcusdt@trade"); err != nil {
+		t.Fatalf("subscribe: %v", err)
+	}
+	srv.next()
+
+	done := make(chan error, 1)
+	go func() { done <- ws.Unsubscribe(context.Background(), "btcusdt@trade") }()
+	if req := srv.next(); req.Method != "UNSUBSCRIBE" {
+		t.Fatalf("request = %+v", req)
+	}
+	if got := ws.Streams(); !reflect.DeepEqual(got, []string{"btcusdt@trade"}) {
+		t.Fatalf("streams before ack = %v", got)
+	}
+
+	close(srv.release)
+	if err := <-done; err != nil {
+		t.Fatalf("unsubscribe: %v", err)
+	}
+	if got := ws.Streams(); len(got) != 0 {
+		t.Fatalf("streams after ack = %v, want none", got)
+	}
+}
+
+func TestWsStreamUnsubscribeErrorKeepsStream(t *testing.T) {
+	srv := newWsTestServer(t)
+	ws := NewWsStream(testWsConfig(srv.url()), nil, nil)
+	defer ws.Close()
+	if err := ws.Connect(context.Background()); err != nil {
+		t.Fatalf("connect: %v", err)
+	}
+	if err := ws.Subscribe(context.Background(), "btcusdt@trade"); err != nil {
+		t.Fatalf("subscribe: %v", err)
+	}
+	srv.next()
+
+	srv.mu.Lock()
+	srv.reject = "UNSUBSCRIBE"
+	srv.mu.Unlock()
+	if err := ws.Unsubscribe(context.Background(), "btcusdt@trade"); err == nil {
+		t.Fatal("unsubscribe succeeded, want error")
+	}
+	if got := ws.Streams(); !reflect.DeepEqual(got, []string{"btcusdt@trade"}) {
+		t.Fatalf("streams = %v", got)
+	}
+}
+
+func TestWsStreamUnsubscribeErrorKeepsHandler(t *testing.T) {
+	srv := newWsTestServer(t)
+	messages := make(chan wsTestMessage, 8)
+	ws := NewWsStream(testWsConfig(srv.url()), func(stream string, data []byte) {
+		messages <- wsTestMessage{stream, string(data)}
+	}, nil)
+	defer ws.Close()
+	if err := ws.Connect(context.Background()); err != nil {
+		t.Fatalf("connect: %v", err)
+	}
+	if err := ws.Subscribe(context.Background(), "btcusdt@trade"); err != nil {
+		t.Fatalf("subscribe: %v", err)
+	}
+	srv.next()
+
+	srv.mu.Lock()
+	srv.reject = "UNSUBSCRIBE"
+	srv.mu.Unlock()
+	if err := ws.Unsubscribe(context.Background(), "btcusdt@trade"); err == nil {
+		t.Fatal("unsubscribe succeeded, want error")
+	}
+	srv.next()
+
+	srv.send(srv.conn(), `{"stream":"btcusdt@trade","data":{"e":"trade"}}`)
+	select {
+	case got := <-messages:
+		if got != (wsTestMessage{"btcusdt@trade", `{"e":"trade"}`}) {
+			t.Fatalf("message = %+v", got)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("message of a stream still subscribed not delivered")
+	}
+}
+
+func TestWsStreamReconnectResubscribe(t *testing.T) {
+	srv := newWsTestServer(t)
+	errs := make(chan error, 8)
+	ws := NewWsStream(testWsConfig(srv.url()), nil, func(err error) { errs <- err })
+	defer ws.Close()
+	if err := ws.Subscribe(context.Background(), "btcusdt@trade", "ethusdt@depth"); err != nil {
+		t.Fatalf("subscribe: %v", err)
+	}
+	if err := ws.Connect(context.Background()); err != nil {
+		t.Fatalf("connect: %v", err)
+	}
+	srv.next()
+
+	_ = srv.conn().Close()
+	select {
+	case <-errs:
+	case <-time.After(5 * time.Second):
+		t.Fatal("disconnect not reported")
+	}
+
+	req := srv.next()
+	if req.Method != "SUBSCRIBE" || !reflect.DeepEqual(req.Params, []string{"btcusdt@trade", "ethusdt@depth"}) {
+		t.Fatalf("resubscribe request = %+v", req)
+	}
+	srv.mu.Lock()
+	conns := len(srv.conns)
+	srv.mu.Unlock()
+	if conns != 2 {
+		t.Fatalf("connections = %d, want 2", conns)
+	}
+}
+
+func TestWsStreamClose(t *testing.T) {
+	srv := newWsTestServer(t)
+	ws := NewWsStream(testWsConfig(srv.url()), nil, nil)
+	if err := ws.Connect(context.Background()); err != nil {
+		t.Fatalf("connect: %v", err)
+	}
+	if err := ws.Close(); err != nil {
+		t.Fatalf("close: %v", err)
+	}
+	select {
+	case <-ws.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("done not closed")
+	}
+	if err := ws.Subscribe(context.Background(), "btcusdt@trade"); !errors.Is(err, ErrWsClosed) {
+		t.Fatalf("subscribe after close = %v, want ErrWsClosed", err)
+	}
+}
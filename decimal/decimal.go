@@ -28,3 +28,6 @@ func (d NullDecimalV2) MarshalText() ([]byte, error) {
 func (d NullDecimalV2) N() orig.NullDecimal {
 	return orig.NullDecimal(d)
 }
+
+// Decimal is the shopspring decimal, aliased so models can use this package only
+type Decimal = orig.Decimal
@@ -0,0 +1,65 @@
+package delivery
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// GetBalanceService get account balance
+type GetBalanceService struct {
+	c *Client
+}
+
+func (s *GetBalanceService) newRequest() *request {
+	r := &request{
+		Service:  "GetBalanceService",
+		Method:   http.MethodGet,
+		Endpoint: "/dapi/v1/balance",
+		SecType:  secTypeSigned,
+	}
+	return r
+}
+
+// Do send request
+func (s *GetBalanceService) Do(ctx context.Context, opts ...RequestOption) (res []*Balance, err error) {
+	data, _, err := s.c.CallAPI(ctx, s.newRequest(), opts...)
+	if err != nil {
+		return []*Balance{}, err
+	}
+	res = make([]*Balance, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*Balance{}, err
+	}
+	return res, nil
+}
+
+// GetAccountService get account info
+type GetAccountService struct {
+	c *Client
+}
+
+func (s *GetAccountService) newRequest() *request {
+	r := &request{
+		Service:  "GetAccountService",
+		Method:   http.MethodGet,
+		Endpoint: "/dapi/v1/account",
+		SecType:  secTypeSigned,
+	}
+	return r
+}
+
+// Do send request
+func (s *GetAccountService) Do(ctx context.Context, opts ...RequestOption) (res *Account, err error) {
+	data, _, err := s.c.CallAPI(ctx, s.newRequest(), opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(Account)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
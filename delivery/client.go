@@ -0,0 +1,351 @@
+package delivery
+
+import (
+	"net/http"
+
+	"github.com/ward-cap/go-binance/common"
+)
+
+// SideType define side type of order
+type SideType string
+
+// PositionSideType define position side type of order
+type PositionSideType string
+
+// OrderType define order type
+type OrderType string
+
+// TimeInForceType define time in force type of order
+type TimeInForceType string
+
+// NewOrderRespType define response JSON verbosity
+type NewOrderRespType string
+
+// OrderStatusType define order status type
+type OrderStatusType string
+
+// SymbolStatusType define the contract status of a symbol
+type SymbolStatusType string
+
+// SymbolFilterType define symbol filter type
+type SymbolFilterType string
+
+// WorkingType define working type
+type WorkingType string
+
+// MarginType define margin type
+type MarginType string
+
+// ContractType define contract type
+type ContractType string
+
+// PriceMatchType define the price match mode of an order
+type PriceMatchType string
+
+// IncomeType define income type
+type IncomeType string
+
+// Endpoints
+const (
+	baseApiMainUrl    = "https://dapi.binance.com"
+	baseWsMainUrl     = "wss://dstream.binance.com"
+	baseApiTestnetUrl = "https://testnet.binancefuture.com"
+	baseWsTestnetUrl  = "wss://dstream.binancefuture.com"
+)
+
+// UseTestnet switch all the API endpoints from production to the testnet
+var UseTestnet = false
+
+// Environment define the REST and websocket endpoints of a deployment. They
+// are always set together so a client never mixes hosts.
+type Environment struct {
+	BaseURL   string
+	BaseWsURL string
+}
+
+// MainnetEnvironment return the endpoints of the production deployment
+func MainnetEnvironment() Environment {
+	return Environment{
+		BaseURL:   baseApiMainUrl,
+		BaseWsURL: baseWsMainUrl,
+	}
+}
+
+// TestnetEnvironment return the endpoints of the testnet
+func TestnetEnvironment() Environment {
+	return Environment{
+		BaseURL:   baseApiTestnetUrl,
+		BaseWsURL: baseWsTestnetUrl,
+	}
+}
+
+// Global enums
+const (
+	SideTypeBuy  SideType = "BUY"
+	SideTypeSell SideType = "SELL"
+
+	PositionSideTypeBoth  PositionSideType = "BOTH"
+	PositionSideTypeLong  PositionSideType = "LONG"
+	PositionSideTypeShort PositionSideType = "SHORT"
+
+	OrderTypeLimit              OrderType = "LIMIT"
+	OrderTypeMarket             OrderType = "MARKET"
+	OrderTypeStop               OrderType = "STOP"
+	OrderTypeStopMarket         OrderType = "STOP_MARKET"
+	OrderTypeTakeProfit         OrderType = "TAKE_PROFIT"
+	OrderTypeTakeProfitMarket   OrderType = "TAKE_PROFIT_MARKET"
+	OrderTypeTrailingStopMarket OrderType = "TRAILING_STOP_MARKET"
+
+	TimeInForceTypeGTC TimeInForceType = "GTC" // Good Till Cancel
+	TimeInForceTypeIOC TimeInForceType = "IOC" // Immediate or Cancel
+	TimeInForceTypeFOK TimeInForceType = "FOK" // Fill or Kill
+	TimeInForceTypeGTX TimeInForceType = "GTX" // Good Till Crossing (Post Only)
+
+	NewOrderRespTypeACK    NewOrderRespType = "ACK"
+	NewOrderRespTypeRESULT NewOrderRespType = "RESULT"
+
+	OrderStatusTypeNew             OrderStatusType = "NEW"
+	OrderStatusTypePartiallyFilled OrderStatusType = "PARTIALLY_FILLED"
+	OrderStatusTypeFilled          OrderStatusType = "FILLED"
+	OrderStatusTypeCanceled        OrderStatusType = "CANCELED"
+	OrderStatusTypeRejected        OrderStatusType = "REJECTED"
+	OrderStatusTypeExpired         OrderStatusType = "EXPIRED"
+
+	SymbolStatusTypePendingTrading SymbolStatusType = "PENDING_TRADING"
+	SymbolStatusTypeTrading        SymbolStatusType = "TRADING"
+	SymbolStatusTypePreDelivering  SymbolStatusType = "PRE_DELIVERING"
+	SymbolStatusTypeDelivering     SymbolStatusType = "DELIVERING"
+	SymbolStatusTypeDelivered      SymbolStatusType = "DELIVERED"
+	SymbolStatusTypePreSettle      SymbolStatusType = "PRE_SETTLE"
+	SymbolStatusTypeSettling       SymbolStatusType = "SETTLING"
+	SymbolStatusTypeClose          SymbolStatusType = "CLOSE"
+
+	SymbolFilterTypeLotSize       SymbolFilterType = "LOT_SIZE"
+	SymbolFilterTypePrice         SymbolFilterType = "PRICE_FILTER"
+	SymbolFilterTypePercentPrice  SymbolFilterType = "PERCENT_PRICE"
+	SymbolFilterTypeMarketLotSize SymbolFilterType = "MARKET_LOT_SIZE"
+	SymbolFilterTypeMaxNumOrders  SymbolFilterType = "MAX_NUM_ORDERS"
+
+	WorkingTypeMarkPrice     WorkingType = "MARK_PRICE"
+	WorkingTypeContractPrice WorkingType = "CONTRACT_PRICE"
+
+	MarginTypeIsolated MarginType = "ISOLATED"
+	MarginTypeCrossed  MarginType = "CROSSED"
+
+	ContractTypePerpetual       ContractType = "PERPETUAL"
+	ContractTypeCurrentQuarter  ContractType = "CURRENT_QUARTER"
+	ContractTypeNextQuarter     ContractType = "NEXT_QUARTER"
+	ContractTypeCurrentQuarterD ContractType = "CURRENT_QUARTER DELIVERING"
+	ContractTypeNextQuarterD    ContractType = "NEXT_QUARTER DELIVERING"
+
+	PriceMatchTypeNone       PriceMatchType = "NONE"
+	PriceMatchTypeOpponent   PriceMatchType = "OPPONENT"
+	PriceMatchTypeOpponent5  PriceMatchType = "OPPONENT_5"
+	PriceMatchTypeOpponent10 PriceMatchType = "OPPONENT_10"
+	PriceMatchTypeOpponent20 PriceMatchType = "OPPONENT_20"
+	PriceMatchTypeQueue      PriceMatchType = "QUEUE"
+	PriceMatchTypeQueue5     PriceMatchType = "QUEUE_5"
+	PriceMatchTypeQueue10    PriceMatchType = "QUEUE_10"
+	PriceMatchTypeQueue20    PriceMatchType = "QUEUE_20"
+
+	IncomeTypeTransfer        IncomeType = "TRANSFER"
+	IncomeTypeWelcomeBonus    IncomeType = "WELCOME_BONUS"
+	IncomeTypeFundingFee      IncomeType = "FUNDING_FEE"
+	IncomeTypeRealizedPnl     IncomeType = "REALIZED_PNL"
+	IncomeTypeCommission      IncomeType = "COMMISSION"
+	IncomeTypeInsuranceClear  IncomeType = "INSURANCE_CLEAR"
+	IncomeTypeDeliveredSettle IncomeType = "DELIVERED_SETTELMENT"
+)
+
+// getEnvironment return the environment according the UseTestnet flag
+func getEnvironment() Environment {
+	if UseTestnet {
+		return TestnetEnvironment()
+	}
+	return MainnetEnvironment()
+}
+
+// getApiEndpoint return the base endpoint of the WS according the UseTestnet flag
+func getApiEndpoint() string {
+	return getEnvironment().BaseURL
+}
+
+// getWsEndpoint return the base endpoint of the market data websocket streams
+func getWsEndpoint() string {
+	return getEnvironment().BaseWsURL
+}
+
+// NewClient initialize an API client instance with API key and secret key.
+// You should always call this function before using this SDK.
+// Services will be created by the form client.NewXXXService().
+func NewClient(apiKey, secretKey string, client *http.Client) *Client {
+	return &Client{
+		RESTClient: common.NewRESTClient(restConfig, apiKey, secretKey, getApiEndpoint(), client),
+		BaseWsURL:  getWsEndpoint(),
+	}
+}
+
+// restConfig define the package settings of the REST client
+var restConfig = common.RESTConfig{
+	Package: "delivery",
+	Cost:    requestCost,
+	RetryOrders: map[string]common.OrderLookup{
+		"/dapi/v1/order": {ClientOrderIDParam: "newClientOrderId", LookupParam: "origClientOrderId"},
+	},
+}
+
+// Client define API client, requests are signed and sent by the embedded
+// common.RESTClient
+type Client struct {
+	common.RESTClient
+	BaseWsURL string
+}
+
+// SetApiEndpoint set api Endpoint. The endpoints of the environment whose
+// REST endpoint is url are all set; any other url only changes BaseURL.
+//
+// Deprecated: use SetEnvironment, which sets the websocket endpoints too.
+func (c *Client) SetApiEndpoint(url string) *Client {
+	for _, env := range []Environment{MainnetEnvironment(), TestnetEnvironment()} {
+		if env.BaseURL == url {
+			return c.SetEnvironment(env)
+		}
+	}
+	c.BaseURL = url
+	return c
+}
+
+// SetEnvironment set the REST and websocket endpoints of env
+func (c *Client) SetEnvironment(env Environment) *Client {
+	c.BaseURL = env.BaseURL
+	c.BaseWsURL = env.BaseWsURL
+	return c
+}
+
+// Environment return the endpoints the client uses
+func (c *Client) Environment() Environment {
+	return Environment{
+		BaseURL:   c.BaseURL,
+		BaseWsURL: c.BaseWsURL,
+	}
+}
+
+// NewPingService init ping service
+func (c *Client) NewPingService() *PingService {
+	return &PingService{c: c}
+}
+
+// NewServerTimeService init server time service
+func (c *Client) NewServerTimeService() *ServerTimeService {
+	return &ServerTimeService{c: c}
+}
+
+// NewSetServerTimeService init set server time service
+func (c *Client) NewSetServerTimeService() *SetServerTimeService {
+	return &SetServerTimeService{c: c}
+}
+
+// NewExchangeInfoService init exchange info service
+func (c *Client) NewExchangeInfoService() *ExchangeInfoService {
+	return &ExchangeInfoService{c: c}
+}
+
+// NewKlinesService init klines service
+func (c *Client) NewKlinesService() *KlinesService {
+	return &KlinesService{c: c}
+}
+
+// NewPremiumIndexService init premium index service
+func (c *Client) NewPremiumIndexService() *PremiumIndexService {
+	return &PremiumIndexService{c: c}
+}
+
+// NewCreateOrderService init creating order service
+func (c *Client) NewCreateOrderService() *CreateOrderService {
+	return &CreateOrderService{c: c}
+}
+
+// NewCreateBatchOrdersService init creating batch order service
+func (c *Client) NewCreateBatchOrdersService() *CreateBatchOrdersService {
+	return &CreateBatchOrdersService{c: c}
+}
+
+// NewGetOrderService init get order service
+func (c *Client) NewGetOrderService() *GetOrderService {
+	return &GetOrderService{c: c}
+}
+
+// NewCancelOrderService init cancel order service
+func (c *Client) NewCancelOrderService() *CancelOrderService {
+	return &CancelOrderService{c: c}
+}
+
+// NewCancelAllOpenOrdersService init cancel all open orders service
+func (c *Client) NewCancelAllOpenOrdersService() *CancelAllOpenOrdersService {
+	return &CancelAllOpenOrdersService{c: c}
+}
+
+// NewCancelMultipleOrdersService init cancel multiple orders service
+func (c *Client) NewCancelMultipleOrdersService() *CancelMultiplesOrdersService {
+	return &CancelMultiplesOrdersService{c: c}
+}
+
+// NewListOpenOrdersService init list open orders service
+func (c *Client) NewListOpenOrdersService() *ListOpenOrdersService {
+	return &ListOpenOrdersService{c: c}
+}
+
+// NewListOrdersService init listing orders service
+func (c *Client) NewListOrdersService() *ListOrdersService {
+	return &ListOrdersService{c: c}
+}
+
+// NewGetAccountService init getting account service
+func (c *Client) NewGetAccountService() *GetAccountService {
+	return &GetAccountService{c: c}
+}
+
+// NewGetBalanceService init getting balance service
+func (c *Client) NewGetBalanceService() *GetBalanceService {
+	return &GetBalanceService{c: c}
+}
+
+// NewGetPositionRiskService init getting position risk service
+func (c *Client) NewGetPositionRiskService() *GetPositionRiskService {
+	return &GetPositionRiskService{c: c}
+}
+
+// NewGetIncomeHistoryService init getting income history service
+func (c *Client) NewGetIncomeHistoryService() *GetIncomeHistoryService {
+	return &GetIncomeHistoryService{c: c}
+}
+
+// NewChangeLeverageService init change leverage service
+func (c *Client) NewChangeLeverageService() *ChangeLeverageService {
+	return &ChangeLeverageService{c: c}
+}
+
+// NewGetLeverageBracketService init get leverage bracket service
+func (c *Client) NewGetLeverageBracketService() *GetLeverageBracketService {
+	return &GetLeverageBracketService{c: c}
+}
+
+// NewChangeMarginTypeService init change margin type service
+func (c *Client) NewChangeMarginTypeService() *ChangeMarginTypeService {
+	return &ChangeMarginTypeService{c: c}
+}
+
+// NewUpdatePositionMarginService init update position margin
+func (c *Client) NewUpdatePositionMarginService() *UpdatePositionMarginService {
+	return &UpdatePositionMarginService{c: c}
+}
+
+// NewChangePositionModeService init change position mode service
+func (c *Client) NewChangePositionModeService() *ChangePositionModeService {
+	return &ChangePositionModeService{c: c}
+}
+
+// NewGetPositionModeService init get position mode service
+func (c *Client) NewGetPositionModeService() *GetPositionModeService {
+	return &GetPositionModeService{c: c}
+}
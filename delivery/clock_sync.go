@@ -0,0 +1,20 @@
+package delivery
+
+import (
+	"context"
+	"time"
+
+	"github.com/ward-cap/go-binance/common"
+)
+
+// EnableClockSync sync TimeOffset with the server time, then keep syncing it
+// every interval until ctx is done. A -1021 error (timestamp outside
+// recvWindow) triggers an immediate resync. Call it before the client is
+// shared between goroutines.
+func (c *Client) EnableClockSync(ctx context.Context, interval time.Duration) (*common.ClockSync, error) {
+	return c.StartClockSync(ctx, interval, c.serverTime)
+}
+
+func (c *Client) serverTime(ctx context.Context) (int64, error) {
+	return c.NewServerTimeService().Do(ctx)
+}
@@ -0,0 +1,41 @@
+package delivery
+
+import (
+	"github.com/shopspring/decimal"
+)
+
+// COIN-M contracts have a fixed value in the quote asset, e.g. 100 USD for
+// BTCUSD and 10 USD for the other pairs. Order quantities and positions are
+// counted in contracts while margin, notional and PnL are in the base asset.
+
+// ContractsToNotional return the notional in the base asset of contracts
+// of contractSize at price: contracts * contractSize / price. It returns zero
+// when price is zero.
+func ContractsToNotional(contracts decimal.Decimal, contractSize int64, price decimal.Decimal) decimal.Decimal {
+	if price.IsZero() {
+		return decimal.Zero
+	}
+	return contracts.Mul(decimal.NewFromInt(contractSize)).Div(price)
+}
+
+// NotionalToContracts return the whole number of contracts of contractSize
+// whose notional at price does not exceed notional in the base asset:
+// floor(notional * price / contractSize). It returns zero when contractSize is
+// not positive.
+func NotionalToContracts(notional decimal.Decimal, contractSize int64, price decimal.Decimal) decimal.Decimal {
+	if contractSize <= 0 {
+		return decimal.Zero
+	}
+	return notional.Mul(price).Div(decimal.NewFromInt(contractSize)).Floor()
+}
+
+// Notional return the notional in the base asset of contracts of s at price
+func (s *Symbol) Notional(contracts, price decimal.Decimal) decimal.Decimal {
+	return ContractsToNotional(contracts, s.ContractSize, price)
+}
+
+// Contracts return the whole number of contracts of s worth at most notional
+// in the base asset at price
+func (s *Symbol) Contracts(notional, price decimal.Decimal) decimal.Decimal {
+	return NotionalToContracts(notional, s.ContractSize, price)
+}
@@ -0,0 +1,131 @@
+package delivery
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/ward-cap/go-binance/common"
+)
+
+// ExchangeInfoService exchange info service
+type ExchangeInfoService struct {
+	c *Client
+}
+
+// Do send request
+func (s *ExchangeInfoService) Do(ctx context.Context, opts ...RequestOption) (res *ExchangeInfo, err error) {
+	r := &request{
+		Service:  "ExchangeInfoService",
+		Method:   http.MethodGet,
+		Endpoint: "/dapi/v1/exchangeInfo",
+		SecType:  secTypeNone,
+	}
+	data, _, err := s.c.CallAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(ExchangeInfo)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// LotSizeFilter return lot size filter of symbol
+func (s *Symbol) LotSizeFilter() *LotSizeFilter {
+	for _, filter := range s.Filters {
+		if filter["filterType"].(string) == string(SymbolFilterTypeLotSize) {
+			f := &LotSizeFilter{}
+			if i, ok := filter["maxQty"]; ok {
+				f.MaxQuantity = i.(string)
+			}
+			if i, ok := filter["minQty"]; ok {
+				f.MinQuantity = i.(string)
+			}
+			if i, ok := filter["stepSize"]; ok {
+				f.StepSize = i.(string)
+			}
+			return f
+		}
+	}
+	return nil
+}
+
+// PriceFilter return price filter of symbol
+func (s *Symbol) PriceFilter() *PriceFilter {
+	for _, filter := range s.Filters {
+		if filter["filterType"].(string) == string(SymbolFilterTypePrice) {
+			f := &PriceFilter{}
+			if i, ok := filter["maxPrice"]; ok {
+				f.MaxPrice = i.(string)
+			}
+			if i, ok := filter["minPrice"]; ok {
+				f.MinPrice = i.(string)
+			}
+			if i, ok := filter["tickSize"]; ok {
+				f.TickSize = i.(string)
+			}
+			return f
+		}
+	}
+	return nil
+}
+
+// PercentPriceFilter return percent price filter of symbol
+func (s *Symbol) PercentPriceFilter() *PercentPriceFilter {
+	for _, filter := range s.Filters {
+		if filter["filterType"].(string) == string(SymbolFilterTypePercentPrice) {
+			f := &PercentPriceFilter{}
+			if i, ok := filter["multiplierDecimal"]; ok {
+				f.MultiplierDecimal = i.(string)
+			}
+			if i, ok := filter["multiplierUp"]; ok {
+				f.MultiplierUp = i.(string)
+			}
+			if i, ok := filter["multiplierDown"]; ok {
+				f.MultiplierDown = i.(string)
+			}
+			return f
+		}
+	}
+	return nil
+}
+
+// MarketLotSizeFilter return market lot size filter of symbol
+func (s *Symbol) MarketLotSizeFilter() *MarketLotSizeFilter {
+	for _, filter := range s.Filters {
+		if filter["filterType"].(string) == string(SymbolFilterTypeMarketLotSize) {
+			f := &MarketLotSizeFilter{}
+			if i, ok := filter["maxQty"]; ok {
+				f.MaxQuantity = i.(string)
+			}
+			if i, ok := filter["minQty"]; ok {
+				f.MinQuantity = i.(string)
+			}
+			if i, ok := filter["stepSize"]; ok {
+				f.StepSize = i.(string)
+			}
+			return f
+		}
+	}
+	return nil
+}
+
+// MaxNumOrdersFilter return max num orders filter of symbol
+func (s *Symbol) MaxNumOrdersFilter() *MaxNumOrdersFilter {
+	for _, filter := range s.Filters {
+		if filter["filterType"].(string) == string(SymbolFilterTypeMaxNumOrders) {
+			f := &MaxNumOrdersFilter{}
+			if i, ok := filter["limit"]; ok {
+				if limit, okk := common.ToInt64(i); okk == nil {
+					f.Limit = limit
+				}
+			}
+			return f
+		}
+	}
+	return nil
+}
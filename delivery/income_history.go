@@ -0,0 +1,95 @@
+package delivery
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// GetIncomeHistoryService get position margin history service
+type GetIncomeHistoryService struct {
+	c          *Client
+	symbol     *string
+	incomeType *string
+	startTime  *int64
+	endTime    *int64
+	limit      *int64
+	page       *int64
+}
+
+// Symbol set symbol
+func (s *GetIncomeHistoryService) Symbol(symbol string) *GetIncomeHistoryService {
+	s.symbol = &symbol
+	return s
+}
+
+// IncomeType set income type
+func (s *GetIncomeHistoryService) IncomeType(incomeType string) *GetIncomeHistoryService {
+	s.incomeType = &incomeType
+	return s
+}
+
+// StartTime set startTime
+func (s *GetIncomeHistoryService) StartTime(startTime int64) *GetIncomeHistoryService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *GetIncomeHistoryService) EndTime(endTime int64) *GetIncomeHistoryService {
+	s.endTime = &endTime
+	return s
+}
+
+// Limit set limit
+func (s *GetIncomeHistoryService) Limit(limit int64) *GetIncomeHistoryService {
+	s.limit = &limit
+	return s
+}
+
+func (s *GetIncomeHistoryService) Page(page int64) *GetIncomeHistoryService {
+	s.page = &page
+	return s
+}
+
+func (s *GetIncomeHistoryService) newRequest() *request {
+	r := &request{
+		Service:  "GetIncomeHistoryService",
+		Method:   http.MethodGet,
+		Endpoint: "/dapi/v1/income",
+		SecType:  secTypeSigned,
+	}
+	if s.symbol != nil {
+		r.SetParam("symbol", *s.symbol)
+	}
+	if s.incomeType != nil {
+		r.SetParam("incomeType", *s.incomeType)
+	}
+	if s.startTime != nil {
+		r.SetParam("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.SetParam("endTime", *s.endTime)
+	}
+	if s.limit != nil {
+		r.SetParam("limit", *s.limit)
+	}
+	if s.page != nil {
+		r.SetParam("page", *s.page)
+	}
+	return r
+}
+
+// Do send request
+func (s *GetIncomeHistoryService) Do(ctx context.Context, opts ...RequestOption) (res []*IncomeHistory, err error) {
+	data, _, err := s.c.CallAPI(ctx, s.newRequest(), opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = make([]*IncomeHistory, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -0,0 +1,88 @@
+package delivery
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+type serverTimeResponse struct {
+	ServerTime int64 `json:"serverTime"`
+}
+
+type klineTuple []json.RawMessage
+
+func parseServerTime(data []byte) (int64, error) {
+	var res serverTimeResponse
+	if err := json.Unmarshal(data, &res); err != nil {
+		return 0, err
+	}
+	return res.ServerTime, nil
+}
+
+func parseKlines(data []byte) ([]*Kline, error) {
+	var rows []klineTuple
+	if err := json.Unmarshal(data, &rows); err != nil {
+		return nil, err
+	}
+	res := make([]*Kline, len(rows))
+	for i, row := range rows {
+		if len(row) < 11 {
+			return nil, fmt.Errorf("invalid kline response")
+		}
+		kline, err := parseKline(row)
+		if err != nil {
+			return nil, err
+		}
+		res[i] = kline
+	}
+	return res, nil
+}
+
+func parseKline(row klineTuple) (*Kline, error) {
+	openTime, err := rawInt64(row[0])
+	if err != nil {
+		return nil, err
+	}
+	closeTime, err := rawInt64(row[6])
+	if err != nil {
+		return nil, err
+	}
+	tradeNum, err := rawInt64(row[8])
+	if err != nil {
+		return nil, err
+	}
+	return &Kline{
+		OpenTime:                openTime,
+		Open:                    rawString(row[1]),
+		High:                    rawString(row[2]),
+		Low:                     rawString(row[3]),
+		Close:                   rawString(row[4]),
+		Volume:                  rawString(row[5]),
+		CloseTime:               closeTime,
+		BaseAssetVolume:         rawString(row[7]),
+		TradeNum:                tradeNum,
+		TakerBuyVolume:          rawString(row[9]),
+		TakerBuyBaseAssetVolume: rawString(row[10]),
+	}, nil
+}
+
+func rawString(data json.RawMessage) string {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		return s
+	}
+	return string(data)
+}
+
+func rawInt64(data json.RawMessage) (int64, error) {
+	var n int64
+	if err := json.Unmarshal(data, &n); err == nil {
+		return n, nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(s, 10, 64)
+}
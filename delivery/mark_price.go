@@ -0,0 +1,95 @@
+package delivery
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/ward-cap/go-binance/common"
+)
+
+// PremiumIndexService get premium index
+type PremiumIndexService struct {
+	c      *Client
+	symbol *string
+	pair   *string
+}
+
+// Symbol set symbol
+func (s *PremiumIndexService) Symbol(symbol string) *PremiumIndexService {
+	s.symbol = &symbol
+	return s
+}
+
+// Pair set pair, returning the premium index of all the contracts of pair
+func (s *PremiumIndexService) Pair(pair string) *PremiumIndexService {
+	s.pair = &pair
+	return s
+}
+
+func (s *PremiumIndexService) newRequest() *request {
+	r := &request{
+		Service:  "PremiumIndexService",
+		Method:   http.MethodGet,
+		Endpoint: "/dapi/v1/premiumIndex",
+		SecType:  secTypeNone,
+	}
+	if s.symbol != nil {
+		r.SetParam("symbol", *s.symbol)
+	}
+	if s.pair != nil {
+		r.SetParam("pair", *s.pair)
+	}
+	return r
+}
+
+// Do send request
+func (s *PremiumIndexService) Do(ctx context.Context, opts ...RequestOption) (res []*PremiumIndex, err error) {
+	data, _, err := s.c.CallAPI(ctx, s.newRequest(), opts...)
+	if err != nil {
+		return []*PremiumIndex{}, err
+	}
+	data = common.ToJSONList(data)
+	res = make([]*PremiumIndex, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*PremiumIndex{}, err
+	}
+	return res, nil
+}
+
+// GetLeverageBracketService get leverage brackets
+type GetLeverageBracketService struct {
+	c      *Client
+	symbol string
+}
+
+// Symbol set symbol
+func (s *GetLeverageBracketService) Symbol(symbol string) *GetLeverageBracketService {
+	s.symbol = symbol
+	return s
+}
+
+// Do send request
+func (s *GetLeverageBracketService) Do(ctx context.Context, opts ...RequestOption) (res []*LeverageBracket, err error) {
+	r := &request{
+		Service:  "GetLeverageBracketService",
+		Method:   http.MethodGet,
+		Endpoint: "/dapi/v2/leverageBracket",
+		SecType:  secTypeSigned,
+	}
+	if s.symbol != "" {
+		r.SetParam("symbol", s.symbol)
+	}
+	data, _, err := s.c.CallAPI(ctx, r, opts...)
+	if err != nil {
+		return []*LeverageBracket{}, err
+	}
+	data = common.ToJSONList(data)
+	res = make([]*LeverageBracket, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*LeverageBracket{}, err
+	}
+	return res, nil
+}
@@ -0,0 +1,383 @@
+package delivery
+
+import (
+	"github.com/shopspring/decimal"
+	"github.com/ward-cap/go-binance/common"
+)
+
+//go:generate easyjson -all models.go
+
+// Account define account info
+//
+//easyjson:json
+type Account struct {
+	Assets      []*AccountAsset    `json:"assets"`
+	Positions   []*AccountPosition `json:"positions"`
+	CanDeposit  bool               `json:"canDeposit"`
+	CanTrade    bool               `json:"canTrade"`
+	CanWithdraw bool               `json:"canWithdraw"`
+	FeeTier     int                `json:"feeTier"`
+	UpdateTime  int64              `json:"updateTime"`
+}
+
+// AccountAsset define account asset, all amounts are in the asset
+//
+//easyjson:json
+type AccountAsset struct {
+	Asset                  string `json:"asset"`
+	WalletBalance          string `json:"walletBalance"`
+	UnrealizedProfit       string `json:"unrealizedProfit"`
+	MarginBalance          string `json:"marginBalance"`
+	MaintMargin            string `json:"maintMargin"`
+	InitialMargin          string `json:"initialMargin"`
+	PositionInitialMargin  string `json:"positionInitialMargin"`
+	OpenOrderInitialMargin string `json:"openOrderInitialMargin"`
+	MaxWithdrawAmount      string `json:"maxWithdrawAmount"`
+	CrossWalletBalance     string `json:"crossWalletBalance"`
+	CrossUnPnl             string `json:"crossUnPnl"`
+	AvailableBalance       string `json:"availableBalance"`
+	UpdateTime             int64  `json:"updateTime"`
+}
+
+// AccountPosition define account position, PositionAmt is in contracts
+//
+//easyjson:json
+type AccountPosition struct {
+	Symbol                 string           `json:"symbol"`
+	PositionAmt            string           `json:"positionAmt"`
+	InitialMargin          string           `json:"initialMargin"`
+	MaintMargin            string           `json:"maintMargin"`
+	UnrealizedProfit       string           `json:"unrealizedProfit"`
+	PositionInitialMargin  string           `json:"positionInitialMargin"`
+	OpenOrderInitialMargin string           `json:"openOrderInitialMargin"`
+	Leverage               string           `json:"leverage"`
+	Isolated               bool             `json:"isolated"`
+	PositionSide           PositionSideType `json:"positionSide"`
+	EntryPrice             string           `json:"entryPrice"`
+	BreakEvenPrice         string           `json:"breakEvenPrice"`
+	MaxQuantity            string           `json:"maxQty"`
+	NotionalValue          string           `json:"notionalValue"`
+	IsolatedWallet         string           `json:"isolatedWallet"`
+	UpdateTime             int64            `json:"updateTime"`
+}
+
+// Balance define user balance of your account
+//
+//easyjson:json
+type Balance struct {
+	AccountAlias       string          `json:"accountAlias"`
+	Asset              string          `json:"asset"`
+	Balance            decimal.Decimal `json:"balance"`
+	WithdrawAvailable  decimal.Decimal `json:"withdrawAvailable"`
+	CrossWalletBalance decimal.Decimal `json:"crossWalletBalance"`
+	CrossUnPnl         decimal.Decimal `json:"crossUnPnl"`
+	AvailableBalance   decimal.Decimal `json:"availableBalance"`
+	UpdateTime         int64           `json:"updateTime"`
+}
+
+// Bracket define the bracket, caps and floors are in contracts
+//
+//easyjson:json
+type Bracket struct {
+	Bracket          int             `json:"bracket"`
+	InitialLeverage  int             `json:"initialLeverage"`
+	QtyCap           decimal.Decimal `json:"qtyCap"`
+	QtyFloor         decimal.Decimal `json:"qtyFloor"`
+	MaintMarginRatio decimal.Decimal `json:"maintMarginRatio"`
+	Cum              decimal.Decimal `json:"cum"`
+}
+
+// CancelOrderResponse define response of canceling order
+//
+//easyjson:json
+type CancelOrderResponse struct {
+	ClientOrderID    string           `json:"clientOrderId"`
+	CumQuantity      string           `json:"cumQty"`
+	CumBase          string           `json:"cumBase"`
+	ExecutedQuantity string           `json:"executedQty"`
+	OrderID          int64            `json:"orderId"`
+	OrigQuantity     string           `json:"origQty"`
+	Price            string           `json:"price"`
+	ReduceOnly       bool             `json:"reduceOnly"`
+	Side             SideType         `json:"side"`
+	Status           OrderStatusType  `json:"status"`
+	StopPrice        string           `json:"stopPrice"`
+	ClosePosition    bool             `json:"closePosition"`
+	Symbol           string           `json:"symbol"`
+	Pair             string           `json:"pair"`
+	TimeInForce      TimeInForceType  `json:"timeInForce"`
+	Type             OrderType        `json:"type"`
+	UpdateTime       int64            `json:"updateTime"`
+	WorkingType      WorkingType      `json:"workingType"`
+	ActivatePrice    string           `json:"activatePrice"`
+	PriceRate        string           `json:"priceRate"`
+	OrigType         string           `json:"origType"`
+	PositionSide     PositionSideType `json:"positionSide"`
+	PriceProtect     bool             `json:"priceProtect"`
+}
+
+// CreateBatchOrdersResponse define the orders placed by a batch, the orders
+// which could not be placed are in Errors. Both are indexed like the order
+// list, the other one is nil at each index.
+//
+//easyjson:json
+type CreateBatchOrdersResponse struct {
+	Orders []*Order
+	Errors []*common.APIError
+}
+
+// CreateOrderResponse define create order response. Quantities are in
+// contracts and CumBase is the filled notional in the base asset.
+//
+//easyjson:json
+type CreateOrderResponse struct {
+	Symbol            string           `json:"symbol"`
+	Pair              string           `json:"pair"`
+	OrderID           int64            `json:"orderId"`
+	ClientOrderID     string           `json:"clientOrderId"`
+	Price             string           `json:"price"`
+	OrigQuantity      string           `json:"origQty"`
+	ExecutedQuantity  string           `json:"executedQty"`
+	CumQuantity       string           `json:"cumQty"`
+	CumBase           string           `json:"cumBase"`
+	ReduceOnly        bool             `json:"reduceOnly"`
+	Status            OrderStatusType  `json:"status"`
+	StopPrice         string           `json:"stopPrice"`
+	TimeInForce       TimeInForceType  `json:"timeInForce"`
+	Type              OrderType        `json:"type"`
+	OrigType          string           `json:"origType"`
+	Side              SideType         `json:"side"`
+	UpdateTime        int64            `json:"updateTime"`
+	WorkingType       WorkingType      `json:"workingType"`
+	ActivatePrice     string           `json:"activatePrice"`
+	PriceRate         string           `json:"priceRate"`
+	AvgPrice          string           `json:"avgPrice"`
+	PositionSide      PositionSideType `json:"positionSide"`
+	ClosePosition     bool             `json:"closePosition"`
+	PriceProtect      bool             `json:"priceProtect"`
+	RateLimitOrder10s string           `json:"rateLimitOrder10s,omitempty"`
+	RateLimitOrder1m  string           `json:"rateLimitOrder1m,omitempty"`
+}
+
+// ExchangeInfo exchange info
+//
+//easyjson:json
+type ExchangeInfo struct {
+	Timezone        string        `json:"timezone"`
+	ServerTime      int64         `json:"serverTime"`
+	RateLimits      []RateLimit   `json:"rateLimits"`
+	ExchangeFilters []interface{} `json:"exchangeFilters"`
+	Symbols         []Symbol      `json:"symbols"`
+}
+
+// IncomeHistory define income history info, Income is in Asset
+//
+//easyjson:json
+type IncomeHistory struct {
+	Asset      string `json:"asset"`
+	Income     string `json:"income"`
+	IncomeType string `json:"incomeType"`
+	Info       string `json:"info"`
+	Symbol     string `json:"symbol"`
+	Time       int64  `json:"time"`
+	TranID     int64  `json:"tranId"`
+	TradeID    string `json:"tradeId"`
+}
+
+// Kline define kline info. Volume is in contracts and BaseAssetVolume in the
+// base asset.
+//
+//easyjson:json
+type Kline struct {
+	OpenTime                int64  `json:"openTime"`
+	Open                    string `json:"open"`
+	High                    string `json:"high"`
+	Low                     string `json:"low"`
+	Close                   string `json:"close"`
+	Volume                  string `json:"volume"`
+	CloseTime               int64  `json:"closeTime"`
+	BaseAssetVolume         string `json:"baseAssetVolume"`
+	TradeNum                int64  `json:"tradeNum"`
+	TakerBuyVolume          string `json:"takerBuyVolume"`
+	TakerBuyBaseAssetVolume string `json:"takerBuyBaseAssetVolume"`
+}
+
+// LeverageBracket define the leverage bracket
+//
+//easyjson:json
+type LeverageBracket struct {
+	Symbol       string          `json:"symbol"`
+	NotionalCoef decimal.Decimal `json:"notionalCoef"`
+	Brackets     []Bracket       `json:"brackets"`
+}
+
+// LotSizeFilter define lot size filter of symbol
+//
+//easyjson:json
+type LotSizeFilter struct {
+	MaxQuantity string `json:"maxQty"`
+	MinQuantity string `json:"minQty"`
+	StepSize    string `json:"stepSize"`
+}
+
+// MarketLotSizeFilter define market lot size filter of symbol
+//
+//easyjson:json
+type MarketLotSizeFilter struct {
+	MaxQuantity string `json:"maxQty"`
+	MinQuantity string `json:"minQty"`
+	StepSize    string `json:"stepSize"`
+}
+
+// MaxNumOrdersFilter define max num orders filter of symbol
+//
+//easyjson:json
+type MaxNumOrdersFilter struct {
+	Limit int64 `json:"limit"`
+}
+
+// Order define order info
+//
+//easyjson:json
+type Order struct {
+	Symbol           string              `json:"symbol"`
+	Pair             string              `json:"pair"`
+	OrderID          int64               `json:"orderId"`
+	ClientOrderID    string              `json:"clientOrderId"`
+	Price            decimal.Decimal     `json:"price"`
+	ReduceOnly       bool                `json:"reduceOnly"`
+	OrigQuantity     decimal.Decimal     `json:"origQty"`
+	ExecutedQuantity decimal.NullDecimal `json:"executedQty"`
+	CumQuantity      string              `json:"cumQty"`
+	CumBase          string              `json:"cumBase"`
+	Status           OrderStatusType     `json:"status"`
+	TimeInForce      TimeInForceType     `json:"timeInForce"`
+	Type             OrderType           `json:"type"`
+	Side             SideType            `json:"side"`
+	StopPrice        decimal.NullDecimal `json:"stopPrice"`
+	Time             int64               `json:"time"`
+	UpdateTime       int64               `json:"updateTime"`
+	WorkingType      WorkingType         `json:"workingType"`
+	ActivatePrice    string              `json:"activatePrice"`
+	PriceRate        string              `json:"priceRate"`
+	AvgPrice         string              `json:"avgPrice"`
+	OrigType         string              `json:"origType"`
+	PositionSide     PositionSideType    `json:"positionSide"`
+	PriceProtect     bool                `json:"priceProtect"`
+	ClosePosition    bool                `json:"closePosition"`
+	PriceMatch       PriceMatchType      `json:"priceMatch"`
+}
+
+// PercentPriceFilter define percent price filter of symbol
+//
+//easyjson:json
+type PercentPriceFilter struct {
+	MultiplierDecimal string `json:"multiplierDecimal"`
+	MultiplierUp      string `json:"multiplierUp"`
+	MultiplierDown    string `json:"multiplierDown"`
+}
+
+// Response of user's position mode
+//
+//easyjson:json
+type PositionMode struct {
+	DualSidePosition bool `json:"dualSidePosition"`
+}
+
+// PositionRisk define position risk info. PositionAmt and MaxQuantity are in
+// contracts, NotionalValue is in the margin asset.
+//
+//easyjson:json
+type PositionRisk struct {
+	Symbol           string          `json:"symbol"`
+	PositionAmt      decimal.Decimal `json:"positionAmt"`
+	EntryPrice       decimal.Decimal `json:"entryPrice"`
+	BreakEvenPrice   string          `json:"breakEvenPrice"`
+	MarkPrice        string          `json:"markPrice"`
+	UnRealizedProfit string          `json:"unRealizedProfit"`
+	LiquidationPrice string          `json:"liquidationPrice"`
+	Leverage         string          `json:"leverage"`
+	MaxQuantity      string          `json:"maxQty"`
+	MarginType       string          `json:"marginType"`
+	IsolatedMargin   string          `json:"isolatedMargin"`
+	IsAutoAddMargin  string          `json:"isAutoAddMargin"`
+	PositionSide     string          `json:"positionSide"`
+	NotionalValue    string          `json:"notionalValue"`
+	IsolatedWallet   string          `json:"isolatedWallet"`
+	UpdateTime       int64           `json:"updateTime"`
+}
+
+// PremiumIndex define premium index of mark price
+//
+//easyjson:json
+type PremiumIndex struct {
+	Symbol               string `json:"symbol"`
+	Pair                 string `json:"pair"`
+	MarkPrice            string `json:"markPrice"`
+	IndexPrice           string `json:"indexPrice"`
+	EstimatedSettlePrice string `json:"estimatedSettlePrice"`
+	LastFundingRate      string `json:"lastFundingRate"`
+	InterestRate         string `json:"interestRate"`
+	NextFundingTime      int64  `json:"nextFundingTime"`
+	Time                 int64  `json:"time"`
+}
+
+// PriceFilter define price filter of symbol
+//
+//easyjson:json
+type PriceFilter struct {
+	MaxPrice string `json:"maxPrice"`
+	MinPrice string `json:"minPrice"`
+	TickSize string `json:"tickSize"`
+}
+
+// RateLimit struct
+//
+//easyjson:json
+type RateLimit struct {
+	RateLimitType string `json:"rateLimitType"`
+	Interval      string `json:"interval"`
+	IntervalNum   int64  `json:"intervalNum"`
+	Limit         int64  `json:"limit"`
+}
+
+// Symbol market symbol. ContractSize is the value of one contract in the
+// quote asset.
+//
+//easyjson:json
+type Symbol struct {
+	Symbol                string                   `json:"symbol"`
+	Pair                  string                   `json:"pair"`
+	ContractType          ContractType             `json:"contractType"`
+	DeliveryDate          int64                    `json:"deliveryDate"`
+	OnboardDate           int64                    `json:"onboardDate"`
+	ContractStatus        SymbolStatusType         `json:"contractStatus"`
+	ContractSize          int64                    `json:"contractSize"`
+	MarginAsset           string                   `json:"marginAsset"`
+	MaintMarginPercent    string                   `json:"maintMarginPercent"`
+	RequiredMarginPercent string                   `json:"requiredMarginPercent"`
+	BaseAsset             string                   `json:"baseAsset"`
+	QuoteAsset            string                   `json:"quoteAsset"`
+	PricePrecision        int                      `json:"pricePrecision"`
+	QuantityPrecision     int                      `json:"quantityPrecision"`
+	BaseAssetPrecision    int                      `json:"baseAssetPrecision"`
+	QuotePrecision        int                      `json:"quotePrecision"`
+	EqualQtyPrecision     int                      `json:"equalQtyPrecision"`
+	TriggerProtect        string                   `json:"triggerProtect"`
+	UnderlyingType        string                   `json:"underlyingType"`
+	UnderlyingSubType     []string                 `json:"underlyingSubType"`
+	OrderTypes            []OrderType              `json:"orderTypes"`
+	TimeInForce           []TimeInForceType        `json:"timeInForce"`
+	Filters               []map[string]interface{} `json:"filters"`
+	LiquidationFee        string                   `json:"liquidationFee"`
+	MarketTakeBound       string                   `json:"marketTakeBound"`
+}
+
+// SymbolLeverage define leverage info of symbol, MaxQuantity is in contracts
+//
+//easyjson:json
+type SymbolLeverage struct {
+	Leverage    int    `json:"leverage"`
+	MaxQuantity string `json:"maxQty"`
+	Symbol      string `json:"symbol"`
+}
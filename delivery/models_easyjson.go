@@ -0,0 +1,4301 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package delivery
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+	common "github.com/ward-cap/go-binance/common"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceDelivery(in *jlexer.Lexer, out *SymbolLeverage) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "leverage":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Leverage = int(in.Int())
+			}
+		case "maxQty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.MaxQuantity = string(in.String())
+			}
+		case "symbol":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Symbol = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceDelivery(out *jwriter.Writer, in SymbolLeverage) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"leverage\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Leverage))
+	}
+	{
+		const prefix string = ",\"maxQty\":"
+		out.RawString(prefix)
+		out.String(string(in.MaxQuantity))
+	}
+	{
+		const prefix string = ",\"symbol\":"
+		out.RawString(prefix)
+		out.String(string(in.Symbol))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SymbolLeverage) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceDelivery(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SymbolLeverage) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceDelivery(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SymbolLeverage) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceDelivery(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SymbolLeverage) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceDelivery(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceDelivery1(in *jlexer.Lexer, out *Symbol) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "symbol":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Symbol = string(in.String())
+			}
+		case "pair":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Pair = string(in.String())
+			}
+		case "contractType":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ContractType = ContractType(in.String())
+			}
+		case "deliveryDate":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.DeliveryDate = int64(in.Int64())
+			}
+		case "onboardDate":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.OnboardDate = int64(in.Int64())
+			}
+		case "contractStatus":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ContractStatus = SymbolStatusType(in.String())
+			}
+		case "contractSize":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ContractSize = int64(in.Int64())
+			}
+		case "marginAsset":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.MarginAsset = string(in.String())
+			}
+		case "maintMarginPercent":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.MaintMarginPercent = string(in.String())
+			}
+		case "requiredMarginPercent":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.RequiredMarginPercent = string(in.String())
+			}
+		case "baseAsset":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.BaseAsset = string(in.String())
+			}
+		case "quoteAsset":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.QuoteAsset = string(in.String())
+			}
+		case "pricePrecision":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.PricePrecision = int(in.Int())
+			}
+		case "quantityPrecision":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.QuantityPrecision = int(in.Int())
+			}
+		case "baseAssetPrecision":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.BaseAssetPrecision = int(in.Int())
+			}
+		case "quotePrecision":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.QuotePrecision = int(in.Int())
+			}
+		case "equalQtyPrecision":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.EqualQtyPrecision = int(in.Int())
+			}
+		case "triggerProtect":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TriggerProtect = string(in.String())
+			}
+		case "underlyingType":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.UnderlyingType = string(in.String())
+			}
+		case "underlyingSubType":
+			if in.IsNull() {
+				in.Skip()
+				out.UnderlyingSubType = nil
+			} else {
+				in.Delim('[')
+				if out.UnderlyingSubType == nil {
+					if !in.IsDelim(']') {
+						out.UnderlyingSubType = make([]string, 0, 4)
+					} else {
+						out.UnderlyingSubType = []string{}
+					}
+				} else {
+					out.UnderlyingSubType = (out.UnderlyingSubType)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 string
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						v1 = string(in.String())
+					}
+					out.UnderlyingSubType = append(out.UnderlyingSubType, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "orderTypes":
+			if in.IsNull() {
+				in.Skip()
+				out.OrderTypes = nil
+			} else {
+				in.Delim('[')
+				if out.OrderTypes == nil {
+					if !in.IsDelim(']') {
+						out.OrderTypes = make([]OrderType, 0, 4)
+					} else {
+						out.OrderTypes = []OrderType{}
+					}
+				} else {
+					out.OrderTypes = (out.OrderTypes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v2 OrderType
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						v2 = OrderType(in.String())
+					}
+					out.OrderTypes = append(out.OrderTypes, v2)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "timeInForce":
+			if in.IsNull() {
+				in.Skip()
+				out.TimeInForce = nil
+			} else {
+				in.Delim('[')
+				if out.TimeInForce == nil {
+					if !in.IsDelim(']') {
+						out.TimeInForce = make([]TimeInForceType, 0, 4)
+					} else {
+						out.TimeInForce = []TimeInForceType{}
+					}
+				} else {
+					out.TimeInForce = (out.TimeInForce)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v3 TimeInForceType
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						v3 = TimeInForceType(in.String())
+					}
+					out.TimeInForce = append(out.TimeInForce, v3)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "filters":
+			if in.IsNull() {
+				in.Skip()
+				out.Filters = nil
+			} else {
+				in.Delim('[')
+				if out.Filters == nil {
+					if !in.IsDelim(']') {
+						out.Filters = make([]map[string]interface{}, 0, 8)
+					} else {
+						out.Filters = []map[string]interface{}{}
+					}
+				} else {
+					out.Filters = (out.Filters)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v4 map[string]interface{}
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						in.Delim('{')
+						v4 = make(map[string]interface{})
+						for !in.IsDelim('}') {
+							key := string(in.String())
+							in.WantColon()
+							var v5 interface{}
+							if m, ok := v5.(easyjson.Unmarshaler); ok {
+								m.UnmarshalEasyJSON(in)
+							} else if m, ok := v5.(json.Unmarshaler); ok {
+								_ = m.UnmarshalJSON(in.Raw())
+							} else {
+								v5 = in.Interface()
+							}
+							(v4)[key] = v5
+							in.WantComma()
+						}
+						in.Delim('}')
+					}
+					out.Filters = append(out.Filters, v4)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "liquidationFee":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.LiquidationFee = string(in.String())
+			}
+		case "marketTakeBound":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.MarketTakeBound = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceDelivery1(out *jwriter.Writer, in Symbol) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"symbol\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Symbol))
+	}
+	{
+		const prefix string = ",\"pair\":"
+		out.RawString(prefix)
+		out.String(string(in.Pair))
+	}
+	{
+		const prefix string = ",\"contractType\":"
+		out.RawString(prefix)
+		out.String(string(in.ContractType))
+	}
+	{
+		const prefix string = ",\"deliveryDate\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.DeliveryDate))
+	}
+	{
+		const prefix string = ",\"onboardDate\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.OnboardDate))
+	}
+	{
+		const prefix string = ",\"contractStatus\":"
+		out.RawString(prefix)
+		out.String(string(in.ContractStatus))
+	}
+	{
+		const prefix string = ",\"contractSize\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.ContractSize))
+	}
+	{
+		const prefix string = ",\"marginAsset\":"
+		out.RawString(prefix)
+		out.String(string(in.MarginAsset))
+	}
+	{
+		const prefix string = ",\"maintMarginPercent\":"
+		out.RawString(prefix)
+		out.String(string(in.MaintMarginPercent))
+	}
+	{
+		const prefix string = ",\"requiredMarginPercent\":"
+		out.RawString(prefix)
+		out.String(string(in.RequiredMarginPercent))
+	}
+	{
+		const prefix string = ",\"baseAsset\":"
+		out.RawString(prefix)
+		out.String(string(in.BaseAsset))
+	}
+	{
+		const prefix string = ",\"quoteAsset\":"
+		out.RawString(prefix)
+		out.String(string(in.QuoteAsset))
+	}
+	{
+		const prefix string = ",\"pricePrecision\":"
+		out.RawString(prefix)
+		out.Int(int(in.PricePrecision))
+	}
+	{
+		const prefix string = ",\"quantityPrecision\":"
+		out.RawString(prefix)
+		out.Int(int(in.QuantityPrecision))
+	}
+	{
+		const prefix string = ",\"baseAssetPrecision\":"
+		out.RawString(prefix)
+		out.Int(int(in.BaseAssetPrecision))
+	}
+	{
+		const prefix string = ",\"quotePrecision\":"
+		out.RawString(prefix)
+		out.Int(int(in.QuotePrecision))
+	}
+	{
+		const prefix string = ",\"equalQtyPrecision\":"
+		out.RawString(prefix)
+		out.Int(int(in.EqualQtyPrecision))
+	}
+	{
+		const prefix string = ",\"triggerProtect\":"
+		out.RawString(prefix)
+		out.String(string(in.TriggerProtect))
+	}
+	{
+		const prefix string = ",\"underlyingType\":"
+		out.RawString(prefix)
+		out.String(string(in.UnderlyingType))
+	}
+	{
+		const prefix string = ",\"underlyingSubType\":"
+		out.RawString(prefix)
+		if in.UnderlyingSubType == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v6, v7 := range in.UnderlyingSubType {
+				if v6 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v7))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"orderTypes\":"
+		out.RawString(prefix)
+		if in.OrderTypes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v8, v9 := range in.OrderTypes {
+				if v8 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v9))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"timeInForce\":"
+		out.RawString(prefix)
+		if in.TimeInForce == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v10, v11 := range in.TimeInForce {
+				if v10 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v11))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"filters\":"
+		out.RawString(prefix)
+		if in.Filters == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v12, v13 := range in.Filters {
+				if v12 > 0 {
+					out.RawByte(',')
+				}
+				if v13 == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+					out.RawString(`null`)
+				} else {
+					out.RawByte('{')
+					v14First := true
+					for v14Name, v14Value := range v13 {
+						if v14First {
+							v14First = false
+						} else {
+							out.RawByte(',')
+						}
+						out.String(string(v14Name))
+						out.RawByte(':')
+						if m, ok := v14Value.(easyjson.Marshaler); ok {
+							m.MarshalEasyJSON(out)
+						} else if m, ok := v14Value.(json.Marshaler); ok {
+							out.Raw(m.MarshalJSON())
+						} else {
+							out.Raw(json.Marshal(v14Value))
+						}
+					}
+					out.RawByte('}')
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"liquidationFee\":"
+		out.RawString(prefix)
+		out.String(string(in.LiquidationFee))
+	}
+	{
+		const prefix string = ",\"marketTakeBound\":"
+		out.RawString(prefix)
+		out.String(string(in.MarketTakeBound))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Symbol) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceDelivery1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Symbol) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceDelivery1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Symbol) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceDelivery1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Symbol) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceDelivery1(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceDelivery2(in *jlexer.Lexer, out *RateLimit) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "rateLimitType":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.RateLimitType = string(in.String())
+			}
+		case "interval":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Interval = string(in.String())
+			}
+		case "intervalNum":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.IntervalNum = int64(in.Int64())
+			}
+		case "limit":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Limit = int64(in.Int64())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceDelivery2(out *jwriter.Writer, in RateLimit) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"rateLimitType\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.RateLimitType))
+	}
+	{
+		const prefix string = ",\"interval\":"
+		out.RawString(prefix)
+		out.String(string(in.Interval))
+	}
+	{
+		const prefix string = ",\"intervalNum\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.IntervalNum))
+	}
+	{
+		const prefix string = ",\"limit\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Limit))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RateLimit) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceDelivery2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RateLimit) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceDelivery2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RateLimit) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceDelivery2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RateLimit) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceDelivery2(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceDelivery3(in *jlexer.Lexer, out *PriceFilter) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "maxPrice":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.MaxPrice = string(in.String())
+			}
+		case "minPrice":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.MinPrice = string(in.String())
+			}
+		case "tickSize":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TickSize = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceDelivery3(out *jwriter.Writer, in PriceFilter) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"maxPrice\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.MaxPrice))
+	}
+	{
+		const prefix string = ",\"minPrice\":"
+		out.RawString(prefix)
+		out.String(string(in.MinPrice))
+	}
+	{
+		const prefix string = ",\"tickSize\":"
+		out.RawString(prefix)
+		out.String(string(in.TickSize))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PriceFilter) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceDelivery3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PriceFilter) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceDelivery3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PriceFilter) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceDelivery3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PriceFilter) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceDelivery3(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceDelivery4(in *jlexer.Lexer, out *PremiumIndex) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "symbol":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Symbol = string(in.String())
+			}
+		case "pair":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Pair = string(in.String())
+			}
+		case "markPrice":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.MarkPrice = string(in.String())
+			}
+		case "indexPrice":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.IndexPrice = string(in.String())
+			}
+		case "estimatedSettlePrice":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.EstimatedSettlePrice = string(in.String())
+			}
+		case "lastFundingRate":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.LastFundingRate = string(in.String())
+			}
+		case "interestRate":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.InterestRate = string(in.String())
+			}
+		case "nextFundingTime":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.NextFundingTime = int64(in.Int64())
+			}
+		case "time":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Time = int64(in.Int64())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceDelivery4(out *jwriter.Writer, in PremiumIndex) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"symbol\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Symbol))
+	}
+	{
+		const prefix string = ",\"pair\":"
+		out.RawString(prefix)
+		out.String(string(in.Pair))
+	}
+	{
+		const prefix string = ",\"markPrice\":"
+		out.RawString(prefix)
+		out.String(string(in.MarkPrice))
+	}
+	{
+		const prefix string = ",\"indexPrice\":"
+		out.RawString(prefix)
+		out.String(string(in.IndexPrice))
+	}
+	{
+		const prefix string = ",\"estimatedSettlePrice\":"
+		out.RawString(prefix)
+		out.String(string(in.EstimatedSettlePrice))
+	}
+	{
+		const prefix string = ",\"lastFundingRate\":"
+		out.RawString(prefix)
+		out.String(string(in.LastFundingRate))
+	}
+	{
+		const prefix string = ",\"interestRate\":"
+		out.RawString(prefix)
+		out.String(string(in.InterestRate))
+	}
+	{
+		const prefix string = ",\"nextFundingTime\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.NextFundingTime))
+	}
+	{
+		const prefix string = ",\"time\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Time))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PremiumIndex) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceDelivery4(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PremiumIndex) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceDelivery4(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PremiumIndex) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceDelivery4(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PremiumIndex) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceDelivery4(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceDelivery5(in *jlexer.Lexer, out *PositionRisk) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "symbol":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Symbol = string(in.String())
+			}
+		case "positionAmt":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.PositionAmt).UnmarshalJSON(data))
+				}
+			}
+		case "entryPrice":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.EntryPrice).UnmarshalJSON(data))
+				}
+			}
+		case "breakEvenPrice":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.BreakEvenPrice = string(in.String())
+			}
+		case "markPrice":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.MarkPrice = string(in.String())
+			}
+		case "unRealizedProfit":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.UnRealizedProfit = string(in.String())
+			}
+		case "liquidationPrice":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.LiquidationPrice = string(in.String())
+			}
+		case "leverage":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Leverage = string(in.String())
+			}
+		case "maxQty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.MaxQuantity = string(in.String())
+			}
+		case "marginType":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.MarginType = string(in.String())
+			}
+		case "isolatedMargin":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.IsolatedMargin = string(in.String())
+			}
+		case "isAutoAddMargin":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.IsAutoAddMargin = string(in.String())
+			}
+		case "positionSide":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.PositionSide = string(in.String())
+			}
+		case "notionalValue":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.NotionalValue = string(in.String())
+			}
+		case "isolatedWallet":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.IsolatedWallet = string(in.String())
+			}
+		case "updateTime":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.UpdateTime = int64(in.Int64())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceDelivery5(out *jwriter.Writer, in PositionRisk) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"symbol\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Symbol))
+	}
+	{
+		const prefix string = ",\"positionAmt\":"
+		out.RawString(prefix)
+		out.Raw((in.PositionAmt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"entryPrice\":"
+		out.RawString(prefix)
+		out.Raw((in.EntryPrice).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"breakEvenPrice\":"
+		out.RawString(prefix)
+		out.String(string(in.BreakEvenPrice))
+	}
+	{
+		const prefix string = ",\"markPrice\":"
+		out.RawString(prefix)
+		out.String(string(in.MarkPrice))
+	}
+	{
+		const prefix string = ",\"unRealizedProfit\":"
+		out.RawString(prefix)
+		out.String(string(in.UnRealizedProfit))
+	}
+	{
+		const prefix string = ",\"liquidationPrice\":"
+		out.RawString(prefix)
+		out.String(string(in.LiquidationPrice))
+	}
+	{
+		const prefix string = ",\"leverage\":"
+		out.RawString(prefix)
+		out.String(string(in.Leverage))
+	}
+	{
+		const prefix string = ",\"maxQty\":"
+		out.RawString(prefix)
+		out.String(string(in.MaxQuantity))
+	}
+	{
+		const prefix string = ",\"marginType\":"
+		out.RawString(prefix)
+		out.String(string(in.MarginType))
+	}
+	{
+		const prefix string = ",\"isolatedMargin\":"
+		out.RawString(prefix)
+		out.String(string(in.IsolatedMargin))
+	}
+	{
+		const prefix string = ",\"isAutoAddMargin\":"
+		out.RawString(prefix)
+		out.String(string(in.IsAutoAddMargin))
+	}
+	{
+		const prefix string = ",\"positionSide\":"
+		out.RawString(prefix)
+		out.String(string(in.PositionSide))
+	}
+	{
+		const prefix string = ",\"notionalValue\":"
+		out.RawString(prefix)
+		out.String(string(in.NotionalValue))
+	}
+	{
+		const prefix string = ",\"isolatedWallet\":"
+		out.RawString(prefix)
+		out.String(string(in.IsolatedWallet))
+	}
+	{
+		const prefix string = ",\"updateTime\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.UpdateTime))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PositionRisk) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceDelivery5(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PositionRisk) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceDelivery5(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PositionRisk) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceDelivery5(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PositionRisk) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceDelivery5(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceDelivery6(in *jlexer.Lexer, out *PositionMode) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "dualSidePosition":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.DualSidePosition = bool(in.Bool())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceDelivery6(out *jwriter.Writer, in PositionMode) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"dualSidePosition\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.DualSidePosition))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PositionMode) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceDelivery6(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PositionMode) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceDelivery6(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PositionMode) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceDelivery6(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PositionMode) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceDelivery6(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceDelivery7(in *jlexer.Lexer, out *PercentPriceFilter) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "multiplierDecimal":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.MultiplierDecimal = string(in.String())
+			}
+		case "multiplierUp":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.MultiplierUp = string(in.String())
+			}
+		case "multiplierDown":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.MultiplierDown = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceDelivery7(out *jwriter.Writer, in PercentPriceFilter) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"multiplierDecimal\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.MultiplierDecimal))
+	}
+	{
+		const prefix string = ",\"multiplierUp\":"
+		out.RawString(prefix)
+		out.String(string(in.MultiplierUp))
+	}
+	{
+		const prefix string = ",\"multiplierDown\":"
+		out.RawString(prefix)
+		out.String(string(in.MultiplierDown))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PercentPriceFilter) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceDelivery7(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PercentPriceFilter) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceDelivery7(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PercentPriceFilter) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceDelivery7(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PercentPriceFilter) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceDelivery7(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceDelivery8(in *jlexer.Lexer, out *Order) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "symbol":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Symbol = string(in.String())
+			}
+		case "pair":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Pair = string(in.String())
+			}
+		case "orderId":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.OrderID = int64(in.Int64())
+			}
+		case "clientOrderId":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ClientOrderID = string(in.String())
+			}
+		case "price":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.Price).UnmarshalJSON(data))
+				}
+			}
+		case "reduceOnly":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ReduceOnly = bool(in.Bool())
+			}
+		case "origQty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.OrigQuantity).UnmarshalJSON(data))
+				}
+			}
+		case "executedQty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.ExecutedQuantity).UnmarshalJSON(data))
+				}
+			}
+		case "cumQty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.CumQuantity = string(in.String())
+			}
+		case "cumBase":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.CumBase = string(in.String())
+			}
+		case "status":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Status = OrderStatusType(in.String())
+			}
+		case "timeInForce":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TimeInForce = TimeInForceType(in.String())
+			}
+		case "type":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Type = OrderType(in.String())
+			}
+		case "side":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Side = SideType(in.String())
+			}
+		case "stopPrice":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.StopPrice).UnmarshalJSON(data))
+				}
+			}
+		case "time":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Time = int64(in.Int64())
+			}
+		case "updateTime":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.UpdateTime = int64(in.Int64())
+			}
+		case "workingType":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.WorkingType = WorkingType(in.String())
+			}
+		case "activatePrice":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ActivatePrice = string(in.String())
+			}
+		case "priceRate":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.PriceRate = string(in.String())
+			}
+		case "avgPrice":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.AvgPrice = string(in.String())
+			}
+		case "origType":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.OrigType = string(in.String())
+			}
+		case "positionSide":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.PositionSide = PositionSideType(in.String())
+			}
+		case "priceProtect":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.PriceProtect = bool(in.Bool())
+			}
+		case "closePosition":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ClosePosition = bool(in.Bool())
+			}
+		case "priceMatch":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.PriceMatch = PriceMatchType(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceDelivery8(out *jwriter.Writer, in Order) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"symbol\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Symbol))
+	}
+	{
+		const prefix string = ",\"pair\":"
+		out.RawString(prefix)
+		out.String(string(in.Pair))
+	}
+	{
+		const prefix string = ",\"orderId\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.OrderID))
+	}
+	{
+		const prefix string = ",\"clientOrderId\":"
+		out.RawString(prefix)
+		out.String(string(in.ClientOrderID))
+	}
+	{
+		const prefix string = ",\"price\":"
+		out.RawString(prefix)
+		out.Raw((in.Price).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"reduceOnly\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.ReduceOnly))
+	}
+	{
+		const prefix string = ",\"origQty\":"
+		out.RawString(prefix)
+		out.Raw((in.OrigQuantity).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"executedQty\":"
+		out.RawString(prefix)
+		out.Raw((in.ExecutedQuantity).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"cumQty\":"
+		out.RawString(prefix)
+		out.String(string(in.CumQuantity))
+	}
+	{
+		const prefix string = ",\"cumBase\":"
+		out.RawString(prefix)
+		out.String(string(in.CumBase))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	{
+		const prefix string = ",\"timeInForce\":"
+		out.RawString(prefix)
+		out.String(string(in.TimeInForce))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"side\":"
+		out.RawString(prefix)
+		out.String(string(in.Side))
+	}
+	{
+		const prefix string = ",\"stopPrice\":"
+		out.RawString(prefix)
+		out.Raw((in.StopPrice).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"time\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Time))
+	}
+	{
+		const prefix string = ",\"updateTime\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.UpdateTime))
+	}
+	{
+		const prefix string = ",\"workingType\":"
+		out.RawString(prefix)
+		out.String(string(in.WorkingType))
+	}
+	{
+		const prefix string = ",\"activatePrice\":"
+		out.RawString(prefix)
+		out.String(string(in.ActivatePrice))
+	}
+	{
+		const prefix string = ",\"priceRate\":"
+		out.RawString(prefix)
+		out.String(string(in.PriceRate))
+	}
+	{
+		const prefix string = ",\"avgPrice\":"
+		out.RawString(prefix)
+		out.String(string(in.AvgPrice))
+	}
+	{
+		const prefix string = ",\"origType\":"
+		out.RawString(prefix)
+		out.String(string(in.OrigType))
+	}
+	{
+		const prefix string = ",\"positionSide\":"
+		out.RawString(prefix)
+		out.String(string(in.PositionSide))
+	}
+	{
+		const prefix string = ",\"priceProtect\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.PriceProtect))
+	}
+	{
+		const prefix string = ",\"closePosition\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.ClosePosition))
+	}
+	{
+		const prefix string = ",\"priceMatch\":"
+		out.RawString(prefix)
+		out.String(string(in.PriceMatch))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Order) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceDelivery8(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Order) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceDelivery8(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Order) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceDelivery8(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Order) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceDelivery8(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceDelivery9(in *jlexer.Lexer, out *MaxNumOrdersFilter) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "limit":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Limit = int64(in.Int64())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceDelivery9(out *jwriter.Writer, in MaxNumOrdersFilter) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"limit\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.Limit))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MaxNumOrdersFilter) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceDelivery9(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MaxNumOrdersFilter) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceDelivery9(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MaxNumOrdersFilter) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceDelivery9(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MaxNumOrdersFilter) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceDelivery9(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceDelivery10(in *jlexer.Lexer, out *MarketLotSizeFilter) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "maxQty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.MaxQuantity = string(in.String())
+			}
+		case "minQty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.MinQuantity = string(in.String())
+			}
+		case "stepSize":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.StepSize = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceDelivery10(out *jwriter.Writer, in MarketLotSizeFilter) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"maxQty\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.MaxQuantity))
+	}
+	{
+		const prefix string = ",\"minQty\":"
+		out.RawString(prefix)
+		out.String(string(in.MinQuantity))
+	}
+	{
+		const prefix string = ",\"stepSize\":"
+		out.RawString(prefix)
+		out.String(string(in.StepSize))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MarketLotSizeFilter) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceDelivery10(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MarketLotSizeFilter) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceDelivery10(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MarketLotSizeFilter) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceDelivery10(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MarketLotSizeFilter) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceDelivery10(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceDelivery11(in *jlexer.Lexer, out *LotSizeFilter) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "maxQty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.MaxQuantity = string(in.String())
+			}
+		case "minQty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.MinQuantity = string(in.String())
+			}
+		case "stepSize":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.StepSize = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceDelivery11(out *jwriter.Writer, in LotSizeFilter) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"maxQty\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.MaxQuantity))
+	}
+	{
+		const prefix string = ",\"minQty\":"
+		out.RawString(prefix)
+		out.String(string(in.MinQuantity))
+	}
+	{
+		const prefix string = ",\"stepSize\":"
+		out.RawString(prefix)
+		out.String(string(in.StepSize))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v LotSizeFilter) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceDelivery11(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v LotSizeFilter) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceDelivery11(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *LotSizeFilter) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceDelivery11(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *LotSizeFilter) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceDelivery11(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceDelivery12(in *jlexer.Lexer, out *LeverageBracket) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "symbol":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Symbol = string(in.String())
+			}
+		case "notionalCoef":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.NotionalCoef).UnmarshalJSON(data))
+				}
+			}
+		case "brackets":
+			if in.IsNull() {
+				in.Skip()
+				out.Brackets = nil
+			} else {
+				in.Delim('[')
+				if out.Brackets == nil {
+					if !in.IsDelim(']') {
+						out.Brackets = make([]Bracket, 0, 0)
+					} else {
+						out.Brackets = []Bracket{}
+					}
+				} else {
+					out.Brackets = (out.Brackets)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v15 Bracket
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v15).UnmarshalEasyJSON(in)
+					}
+					out.Brackets = append(out.Brackets, v15)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceDelivery12(out *jwriter.Writer, in LeverageBracket) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"symbol\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Symbol))
+	}
+	{
+		const prefix string = ",\"notionalCoef\":"
+		out.RawString(prefix)
+		out.Raw((in.NotionalCoef).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"brackets\":"
+		out.RawString(prefix)
+		if in.Brackets == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v16, v17 := range in.Brackets {
+				if v16 > 0 {
+					out.RawByte(',')
+				}
+				(v17).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v LeverageBracket) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceDelivery12(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v LeverageBracket) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceDelivery12(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *LeverageBracket) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceDelivery12(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *LeverageBracket) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceDelivery12(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceDelivery13(in *jlexer.Lexer, out *Kline) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "openTime":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.OpenTime = int64(in.Int64())
+			}
+		case "open":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Open = string(in.String())
+			}
+		case "high":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.High = string(in.String())
+			}
+		case "low":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Low = string(in.String())
+			}
+		case "close":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Close = string(in.String())
+			}
+		case "volume":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Volume = string(in.String())
+			}
+		case "closeTime":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.CloseTime = int64(in.Int64())
+			}
+		case "baseAssetVolume":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.BaseAssetVolume = string(in.String())
+			}
+		case "tradeNum":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TradeNum = int64(in.Int64())
+			}
+		case "takerBuyVolume":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TakerBuyVolume = string(in.String())
+			}
+		case "takerBuyBaseAssetVolume":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TakerBuyBaseAssetVolume = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceDelivery13(out *jwriter.Writer, in Kline) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"openTime\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.OpenTime))
+	}
+	{
+		const prefix string = ",\"open\":"
+		out.RawString(prefix)
+		out.String(string(in.Open))
+	}
+	{
+		const prefix string = ",\"high\":"
+		out.RawString(prefix)
+		out.String(string(in.High))
+	}
+	{
+		const prefix string = ",\"low\":"
+		out.RawString(prefix)
+		out.String(string(in.Low))
+	}
+	{
+		const prefix string = ",\"close\":"
+		out.RawString(prefix)
+		out.String(string(in.Close))
+	}
+	{
+		const prefix string = ",\"volume\":"
+		out.RawString(prefix)
+		out.String(string(in.Volume))
+	}
+	{
+		const prefix string = ",\"closeTime\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.CloseTime))
+	}
+	{
+		const prefix string = ",\"baseAssetVolume\":"
+		out.RawString(prefix)
+		out.String(string(in.BaseAssetVolume))
+	}
+	{
+		const prefix string = ",\"tradeNum\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.TradeNum))
+	}
+	{
+		const prefix string = ",\"takerBuyVolume\":"
+		out.RawString(prefix)
+		out.String(string(in.TakerBuyVolume))
+	}
+	{
+		const prefix string = ",\"takerBuyBaseAssetVolume\":"
+		out.RawString(prefix)
+		out.String(string(in.TakerBuyBaseAssetVolume))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Kline) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceDelivery13(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Kline) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceDelivery13(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Kline) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceDelivery13(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Kline) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceDelivery13(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceDelivery14(in *jlexer.Lexer, out *IncomeHistory) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "asset":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Asset = string(in.String())
+			}
+		case "income":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Income = string(in.String())
+			}
+		case "incomeType":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.IncomeType = string(in.String())
+			}
+		case "info":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Info = string(in.String())
+			}
+		case "symbol":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Symbol = string(in.String())
+			}
+		case "time":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Time = int64(in.Int64())
+			}
+		case "tranId":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TranID = int64(in.Int64())
+			}
+		case "tradeId":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TradeID = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceDelivery14(out *jwriter.Writer, in IncomeHistory) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"asset\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Asset))
+	}
+	{
+		const prefix string = ",\"income\":"
+		out.RawString(prefix)
+		out.String(string(in.Income))
+	}
+	{
+		const prefix string = ",\"incomeType\":"
+		out.RawString(prefix)
+		out.String(string(in.IncomeType))
+	}
+	{
+		const prefix string = ",\"info\":"
+		out.RawString(prefix)
+		out.String(string(in.Info))
+	}
+	{
+		const prefix string = ",\"symbol\":"
+		out.RawString(prefix)
+		out.String(string(in.Symbol))
+	}
+	{
+		const prefix string = ",\"time\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Time))
+	}
+	{
+		const prefix string = ",\"tranId\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.TranID))
+	}
+	{
+		const prefix string = ",\"tradeId\":"
+		out.RawString(prefix)
+		out.String(string(in.TradeID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v IncomeHistory) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceDelivery14(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v IncomeHistory) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceDelivery14(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *IncomeHistory) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceDelivery14(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *IncomeHistory) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceDelivery14(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceDelivery15(in *jlexer.Lexer, out *ExchangeInfo) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "timezone":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Timezone = string(in.String())
+			}
+		case "serverTime":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ServerTime = int64(in.Int64())
+			}
+		case "rateLimits":
+			if in.IsNull() {
+				in.Skip()
+				out.RateLimits = nil
+			} else {
+				in.Delim('[')
+				if out.RateLimits == nil {
+					if !in.IsDelim(']') {
+						out.RateLimits = make([]RateLimit, 0, 1)
+					} else {
+						out.RateLimits = []RateLimit{}
+					}
+				} else {
+					out.RateLimits = (out.RateLimits)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v18 RateLimit
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v18).UnmarshalEasyJSON(in)
+					}
+					out.RateLimits = append(out.RateLimits, v18)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "exchangeFilters":
+			if in.IsNull() {
+				in.Skip()
+				out.ExchangeFilters = nil
+			} else {
+				in.Delim('[')
+				if out.ExchangeFilters == nil {
+					if !in.IsDelim(']') {
+						out.ExchangeFilters = make([]interface{}, 0, 4)
+					} else {
+						out.ExchangeFilters = []interface{}{}
+					}
+				} else {
+					out.ExchangeFilters = (out.ExchangeFilters)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v19 interface{}
+					if m, ok := v19.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v19.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
+					} else {
+						v19 = in.Interface()
+					}
+					out.ExchangeFilters = append(out.ExchangeFilters, v19)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "symbols":
+			if in.IsNull() {
+				in.Skip()
+				out.Symbols = nil
+			} else {
+				in.Delim('[')
+				if out.Symbols == nil {
+					if !in.IsDelim(']') {
+						out.Symbols = make([]Symbol, 0, 0)
+					} else {
+						out.Symbols = []Symbol{}
+					}
+				} else {
+					out.Symbols = (out.Symbols)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v20 Symbol
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v20).UnmarshalEasyJSON(in)
+					}
+					out.Symbols = append(out.Symbols, v20)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceDelivery15(out *jwriter.Writer, in ExchangeInfo) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"timezone\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Timezone))
+	}
+	{
+		const prefix string = ",\"serverTime\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.ServerTime))
+	}
+	{
+		const prefix string = ",\"rateLimits\":"
+		out.RawString(prefix)
+		if in.RateLimits == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v21, v22 := range in.RateLimits {
+				if v21 > 0 {
+					out.RawByte(',')
+				}
+				(v22).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"exchangeFilters\":"
+		out.RawString(prefix)
+		if in.ExchangeFilters == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v23, v24 := range in.ExchangeFilters {
+				if v23 > 0 {
+					out.RawByte(',')
+				}
+				if m, ok := v24.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v24.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(v24))
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"symbols\":"
+		out.RawString(prefix)
+		if in.Symbols == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v25, v26 := range in.Symbols {
+				if v25 > 0 {
+					out.RawByte(',')
+				}
+				(v26).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ExchangeInfo) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceDelivery15(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ExchangeInfo) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceDelivery15(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ExchangeInfo) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceDelivery15(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ExchangeInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceDelivery15(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceDelivery16(in *jlexer.Lexer, out *CreateOrderResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "symbol":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Symbol = string(in.String())
+			}
+		case "pair":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Pair = string(in.String())
+			}
+		case "orderId":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.OrderID = int64(in.Int64())
+			}
+		case "clientOrderId":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ClientOrderID = string(in.String())
+			}
+		case "price":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Price = string(in.String())
+			}
+		case "origQty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.OrigQuantity = string(in.String())
+			}
+		case "executedQty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ExecutedQuantity = string(in.String())
+			}
+		case "cumQty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.CumQuantity = string(in.String())
+			}
+		case "cumBase":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.CumBase = string(in.String())
+			}
+		case "reduceOnly":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ReduceOnly = bool(in.Bool())
+			}
+		case "status":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Status = OrderStatusType(in.String())
+			}
+		case "stopPrice":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.StopPrice = string(in.String())
+			}
+		case "timeInForce":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TimeInForce = TimeInForceType(in.String())
+			}
+		case "type":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Type = OrderType(in.String())
+			}
+		case "origType":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.OrigType = string(in.String())
+			}
+		case "side":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Side = SideType(in.String())
+			}
+		case "updateTime":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.UpdateTime = int64(in.Int64())
+			}
+		case "workingType":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.WorkingType = WorkingType(in.String())
+			}
+		case "activatePrice":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ActivatePrice = string(in.String())
+			}
+		case "priceRate":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.PriceRate = string(in.String())
+			}
+		case "avgPrice":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.AvgPrice = string(in.String())
+			}
+		case "positionSide":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.PositionSide = PositionSideType(in.String())
+			}
+		case "closePosition":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ClosePosition = bool(in.Bool())
+			}
+		case "priceProtect":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.PriceProtect = bool(in.Bool())
+			}
+		case "rateLimitOrder10s":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.RateLimitOrder10s = string(in.String())
+			}
+		case "rateLimitOrder1m":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.RateLimitOrder1m = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceDelivery16(out *jwriter.Writer, in CreateOrderResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"symbol\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Symbol))
+	}
+	{
+		const prefix string = ",\"pair\":"
+		out.RawString(prefix)
+		out.String(string(in.Pair))
+	}
+	{
+		const prefix string = ",\"orderId\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.OrderID))
+	}
+	{
+		const prefix string = ",\"clientOrderId\":"
+		out.RawString(prefix)
+		out.String(string(in.ClientOrderID))
+	}
+	{
+		const prefix string = ",\"price\":"
+		out.RawString(prefix)
+		out.String(string(in.Price))
+	}
+	{
+		const prefix string = ",\"origQty\":"
+		out.RawString(prefix)
+		out.String(string(in.OrigQuantity))
+	}
+	{
+		const prefix string = ",\"executedQty\":"
+		out.RawString(prefix)
+		out.String(string(in.ExecutedQuantity))
+	}
+	{
+		const prefix string = ",\"cumQty\":"
+		out.RawString(prefix)
+		out.String(string(in.CumQuantity))
+	}
+	{
+		const prefix string = ",\"cumBase\":"
+		out.RawString(prefix)
+		out.String(string(in.CumBase))
+	}
+	{
+		const prefix string = ",\"reduceOnly\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.ReduceOnly))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	{
+		const prefix string = ",\"stopPrice\":"
+		out.RawString(prefix)
+		out.String(string(in.StopPrice))
+	}
+	{
+		const prefix string = ",\"timeInForce\":"
+		out.RawString(prefix)
+		out.String(string(in.TimeInForce))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"origType\":"
+		out.RawString(prefix)
+		out.String(string(in.OrigType))
+	}
+	{
+		const prefix string = ",\"side\":"
+		out.RawString(prefix)
+		out.String(string(in.Side))
+	}
+	{
+		const prefix string = ",\"updateTime\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.UpdateTime))
+	}
+	{
+		const prefix string = ",\"workingType\":"
+		out.RawString(prefix)
+		out.String(string(in.WorkingType))
+	}
+	{
+		const prefix string = ",\"activatePrice\":"
+		out.RawString(prefix)
+		out.String(string(in.ActivatePrice))
+	}
+	{
+		const prefix string = ",\"priceRate\":"
+		out.RawString(prefix)
+		out.String(string(in.PriceRate))
+	}
+	{
+		const prefix string = ",\"avgPrice\":"
+		out.RawString(prefix)
+		out.String(string(in.AvgPrice))
+	}
+	{
+		const prefix string = ",\"positionSide\":"
+		out.RawString(prefix)
+		out.String(string(in.PositionSide))
+	}
+	{
+		const prefix string = ",\"closePosition\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.ClosePosition))
+	}
+	{
+		const prefix string = ",\"priceProtect\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.PriceProtect))
+	}
+	if in.RateLimitOrder10s != "" {
+		const prefix string = ",\"rateLimitOrder10s\":"
+		out.RawString(prefix)
+		out.String(string(in.RateLimitOrder10s))
+	}
+	if in.RateLimitOrder1m != "" {
+		const prefix string = ",\"rateLimitOrder1m\":"
+		out.RawString(prefix)
+		out.String(string(in.RateLimitOrder1m))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CreateOrderResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceDelivery16(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CreateOrderResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceDelivery16(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CreateOrderResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceDelivery16(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CreateOrderResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceDelivery16(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceDelivery17(in *jlexer.Lexer, out *CreateBatchOrdersResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "Orders":
+			if in.IsNull() {
+				in.Skip()
+				out.Orders = nil
+			} else {
+				in.Delim('[')
+				if out.Orders == nil {
+					if !in.IsDelim(']') {
+						out.Orders = make([]*Order, 0, 8)
+					} else {
+						out.Orders = []*Order{}
+					}
+				} else {
+					out.Orders = (out.Orders)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v27 *Order
+					if in.IsNull() {
+						in.Skip()
+						v27 = nil
+					} else {
+						if v27 == nil {
+							v27 = new(Order)
+						}
+						if in.IsNull() {
+							in.Skip()
+						} else {
+							(*v27).UnmarshalEasyJSON(in)
+						}
+					}
+					out.Orders = append(out.Orders, v27)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "Errors":
+			if in.IsNull() {
+				in.Skip()
+				out.Errors = nil
+			} else {
+				in.Delim('[')
+				if out.Errors == nil {
+					if !in.IsDelim(']') {
+						out.Errors = make([]*common.APIError, 0, 8)
+					} else {
+						out.Errors = []*common.APIError{}
+					}
+				} else {
+					out.Errors = (out.Errors)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v28 *common.APIError
+					if in.IsNull() {
+						in.Skip()
+						v28 = nil
+					} else {
+						if v28 == nil {
+							v28 = new(common.APIError)
+						}
+						if in.IsNull() {
+							in.Skip()
+						} else {
+							(*v28).UnmarshalEasyJSON(in)
+						}
+					}
+					out.Errors = append(out.Errors, v28)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceDelivery17(out *jwriter.Writer, in CreateBatchOrdersResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Orders\":"
+		out.RawString(prefix[1:])
+		if in.Orders == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v29, v30 := range in.Orders {
+				if v29 > 0 {
+					out.RawByte(',')
+				}
+				if v30 == nil {
+					out.RawString("null")
+				} else {
+					(*v30).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"Errors\":"
+		out.RawString(prefix)
+		if in.Errors == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v31, v32 := range in.Errors {
+				if v31 > 0 {
+					out.RawByte(',')
+				}
+				if v32 == nil {
+					out.RawString("null")
+				} else {
+					(*v32).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CreateBatchOrdersResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceDelivery17(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CreateBatchOrdersResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceDelivery17(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CreateBatchOrdersResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceDelivery17(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CreateBatchOrdersResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceDelivery17(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceDelivery18(in *jlexer.Lexer, out *CancelOrderResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "clientOrderId":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ClientOrderID = string(in.String())
+			}
+		case "cumQty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.CumQuantity = string(in.String())
+			}
+		case "cumBase":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.CumBase = string(in.String())
+			}
+		case "executedQty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ExecutedQuantity = string(in.String())
+			}
+		case "orderId":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.OrderID = int64(in.Int64())
+			}
+		case "origQty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.OrigQuantity = string(in.String())
+			}
+		case "price":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Price = string(in.String())
+			}
+		case "reduceOnly":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ReduceOnly = bool(in.Bool())
+			}
+		case "side":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Side = SideType(in.String())
+			}
+		case "status":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Status = OrderStatusType(in.String())
+			}
+		case "stopPrice":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.StopPrice = string(in.String())
+			}
+		case "closePosition":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ClosePosition = bool(in.Bool())
+			}
+		case "symbol":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Symbol = string(in.String())
+			}
+		case "pair":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Pair = string(in.String())
+			}
+		case "timeInForce":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TimeInForce = TimeInForceType(in.String())
+			}
+		case "type":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Type = OrderType(in.String())
+			}
+		case "updateTime":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.UpdateTime = int64(in.Int64())
+			}
+		case "workingType":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.WorkingType = WorkingType(in.String())
+			}
+		case "activatePrice":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ActivatePrice = string(in.String())
+			}
+		case "priceRate":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.PriceRate = string(in.String())
+			}
+		case "origType":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.OrigType = string(in.String())
+			}
+		case "positionSide":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.PositionSide = PositionSideType(in.String())
+			}
+		case "priceProtect":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.PriceProtect = bool(in.Bool())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceDelivery18(out *jwriter.Writer, in CancelOrderResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"clientOrderId\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ClientOrderID))
+	}
+	{
+		const prefix string = ",\"cumQty\":"
+		out.RawString(prefix)
+		out.String(string(in.CumQuantity))
+	}
+	{
+		const prefix string = ",\"cumBase\":"
+		out.RawString(prefix)
+		out.String(string(in.CumBase))
+	}
+	{
+		const prefix string = ",\"executedQty\":"
+		out.RawString(prefix)
+		out.String(string(in.ExecutedQuantity))
+	}
+	{
+		const prefix string = ",\"orderId\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.OrderID))
+	}
+	{
+		const prefix string = ",\"origQty\":"
+		out.RawString(prefix)
+		out.String(string(in.OrigQuantity))
+	}
+	{
+		const prefix string = ",\"price\":"
+		out.RawString(prefix)
+		out.String(string(in.Price))
+	}
+	{
+		const prefix string = ",\"reduceOnly\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.ReduceOnly))
+	}
+	{
+		const prefix string = ",\"side\":"
+		out.RawString(prefix)
+		out.String(string(in.Side))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	{
+		const prefix string = ",\"stopPrice\":"
+		out.RawString(prefix)
+		out.String(string(in.StopPrice))
+	}
+	{
+		const prefix string = ",\"closePosition\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.ClosePosition))
+	}
+	{
+		const prefix string = ",\"symbol\":"
+		out.RawString(prefix)
+		out.String(string(in.Symbol))
+	}
+	{
+		const prefix string = ",\"pair\":"
+		out.RawString(prefix)
+		out.String(string(in.Pair))
+	}
+	{
+		const prefix string = ",\"timeInForce\":"
+		out.RawString(prefix)
+		out.String(string(in.TimeInForce))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"updateTime\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.UpdateTime))
+	}
+	{
+		const prefix string = ",\"workingType\":"
+		out.RawString(prefix)
+		out.String(string(in.WorkingType))
+	}
+	{
+		const prefix string = ",\"activatePrice\":"
+		out.RawString(prefix)
+		out.String(string(in.ActivatePrice))
+	}
+	{
+		const prefix string = ",\"priceRate\":"
+		out.RawString(prefix)
+		out.String(string(in.PriceRate))
+	}
+	{
+		const prefix string = ",\"origType\":"
+		out.RawString(prefix)
+		out.String(string(in.OrigType))
+	}
+	{
+		const prefix string = ",\"positionSide\":"
+		out.RawString(prefix)
+		out.String(string(in.PositionSide))
+	}
+	{
+		const prefix string = ",\"priceProtect\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.PriceProtect))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CancelOrderResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceDelivery18(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CancelOrderResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceDelivery18(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CancelOrderResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceDelivery18(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CancelOrderResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceDelivery18(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceDelivery19(in *jlexer.Lexer, out *Bracket) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "bracket":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Bracket = int(in.Int())
+			}
+		case "initialLeverage":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.InitialLeverage = int(in.Int())
+			}
+		case "qtyCap":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.QtyCap).UnmarshalJSON(data))
+				}
+			}
+		case "qtyFloor":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.QtyFloor).UnmarshalJSON(data))
+				}
+			}
+		case "maintMarginRatio":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.MaintMarginRatio).UnmarshalJSON(data))
+				}
+			}
+		case "cum":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.Cum).UnmarshalJSON(data))
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceDelivery19(out *jwriter.Writer, in Bracket) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"bracket\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Bracket))
+	}
+	{
+		const prefix string = ",\"initialLeverage\":"
+		out.RawString(prefix)
+		out.Int(int(in.InitialLeverage))
+	}
+	{
+		const prefix string = ",\"qtyCap\":"
+		out.RawString(prefix)
+		out.Raw((in.QtyCap).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"qtyFloor\":"
+		out.RawString(prefix)
+		out.Raw((in.QtyFloor).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"maintMarginRatio\":"
+		out.RawString(prefix)
+		out.Raw((in.MaintMarginRatio).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"cum\":"
+		out.RawString(prefix)
+		out.Raw((in.Cum).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Bracket) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceDelivery19(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Bracket) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceDelivery19(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Bracket) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceDelivery19(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Bracket) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceDelivery19(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceDelivery20(in *jlexer.Lexer, out *Balance) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "accountAlias":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.AccountAlias = string(in.String())
+			}
+		case "asset":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Asset = string(in.String())
+			}
+		case "balance":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.Balance).UnmarshalJSON(data))
+				}
+			}
+		case "withdrawAvailable":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.WithdrawAvailable).UnmarshalJSON(data))
+				}
+			}
+		case "crossWalletBalance":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.CrossWalletBalance).UnmarshalJSON(data))
+				}
+			}
+		case "crossUnPnl":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.CrossUnPnl).UnmarshalJSON(data))
+				}
+			}
+		case "availableBalance":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.AvailableBalance).UnmarshalJSON(data))
+				}
+			}
+		case "updateTime":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.UpdateTime = int64(in.Int64())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceDelivery20(out *jwriter.Writer, in Balance) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"accountAlias\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.AccountAlias))
+	}
+	{
+		const prefix string = ",\"asset\":"
+		out.RawString(prefix)
+		out.String(string(in.Asset))
+	}
+	{
+		const prefix string = ",\"balance\":"
+		out.RawString(prefix)
+		out.Raw((in.Balance).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"withdrawAvailable\":"
+		out.RawString(prefix)
+		out.Raw((in.WithdrawAvailable).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"crossWalletBalance\":"
+		out.RawString(prefix)
+		out.Raw((in.CrossWalletBalance).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"crossUnPnl\":"
+		out.RawString(prefix)
+		out.Raw((in.CrossUnPnl).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"availableBalance\":"
+		out.RawString(prefix)
+		out.Raw((in.AvailableBalance).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"updateTime\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.UpdateTime))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Balance) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceDelivery20(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Balance) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceDelivery20(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Balance) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceDelivery20(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Balance) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceDelivery20(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceDelivery21(in *jlexer.Lexer, out *AccountPosition) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "symbol":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Symbol = string(in.String())
+			}
+		case "positionAmt":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.PositionAmt = string(in.String())
+			}
+		case "initialMargin":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.InitialMargin = string(in.String())
+			}
+		case "maintMargin":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.MaintMargin = string(in.String())
+			}
+		case "unrealizedProfit":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.UnrealizedProfit = string(in.String())
+			}
+		case "positionInitialMargin":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.PositionInitialMargin = string(in.String())
+			}
+		case "openOrderInitialMargin":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.OpenOrderInitialMargin = string(in.String())
+			}
+		case "leverage":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Leverage = string(in.String())
+			}
+		case "isolated":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Isolated = bool(in.Bool())
+			}
+		case "positionSide":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.PositionSide = PositionSideType(in.String())
+			}
+		case "entryPrice":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.EntryPrice = string(in.String())
+			}
+		case "breakEvenPrice":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.BreakEvenPrice = string(in.String())
+			}
+		case "maxQty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.MaxQuantity = string(in.String())
+			}
+		case "notionalValue":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.NotionalValue = string(in.String())
+			}
+		case "isolatedWallet":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.IsolatedWallet = string(in.String())
+			}
+		case "updateTime":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.UpdateTime = int64(in.Int64())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceDelivery21(out *jwriter.Writer, in AccountPosition) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"symbol\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Symbol))
+	}
+	{
+		const prefix string = ",\"positionAmt\":"
+		out.RawString(prefix)
+		out.String(string(in.PositionAmt))
+	}
+	{
+		const prefix string = ",\"initialMargin\":"
+		out.RawString(prefix)
+		out.String(string(in.InitialMargin))
+	}
+	{
+		const prefix string = ",\"maintMargin\":"
+		out.RawString(prefix)
+		out.String(string(in.MaintMargin))
+	}
+	{
+		const prefix string = ",\"unrealizedProfit\":"
+		out.RawString(prefix)
+		out.String(string(in.UnrealizedProfit))
+	}
+	{
+		const prefix string = ",\"positionInitialMargin\":"
+		out.RawString(prefix)
+		out.String(string(in.PositionInitialMargin))
+	}
+	{
+		const prefix string = ",\"openOrderInitialMargin\":"
+		out.RawString(prefix)
+		out.String(string(in.OpenOrderInitialMargin))
+	}
+	{
+		const prefix string = ",\"leverage\":"
+		out.RawString(prefix)
+		out.String(string(in.Leverage))
+	}
+	{
+		const prefix string = ",\"isolated\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Isolated))
+	}
+	{
+		const prefix string = ",\"positionSide\":"
+		out.RawString(prefix)
+		out.String(string(in.PositionSide))
+	}
+	{
+		const prefix string = ",\"entryPrice\":"
+		out.RawString(prefix)
+		out.String(string(in.EntryPrice))
+	}
+	{
+		const prefix string = ",\"breakEvenPrice\":"
+		out.RawString(prefix)
+		out.String(string(in.BreakEvenPrice))
+	}
+	{
+		const prefix string = ",\"maxQty\":"
+		out.RawString(prefix)
+		out.String(string(in.MaxQuantity))
+	}
+	{
+		const prefix string = ",\"notionalValue\":"
+		out.RawString(prefix)
+		out.String(string(in.NotionalValue))
+	}
+	{
+		const prefix string = ",\"isolatedWallet\":"
+		out.RawString(prefix)
+		out.String(string(in.IsolatedWallet))
+	}
+	{
+		const prefix string = ",\"updateTime\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.UpdateTime))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AccountPosition) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceDelivery21(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v AccountPosition) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceDelivery21(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AccountPosition) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceDelivery21(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *AccountPosition) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceDelivery21(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceDelivery22(in *jlexer.Lexer, out *AccountAsset) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "asset":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Asset = string(in.String())
+			}
+		case "walletBalance":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.WalletBalance = string(in.String())
+			}
+		case "unrealizedProfit":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.UnrealizedProfit = string(in.String())
+			}
+		case "marginBalance":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.MarginBalance = string(in.String())
+			}
+		case "maintMargin":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.MaintMargin = string(in.String())
+			}
+		case "initialMargin":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.InitialMargin = string(in.String())
+			}
+		case "positionInitialMargin":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.PositionInitialMargin = string(in.String())
+			}
+		case "openOrderInitialMargin":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.OpenOrderInitialMargin = string(in.String())
+			}
+		case "maxWithdrawAmount":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.MaxWithdrawAmount = string(in.String())
+			}
+		case "crossWalletBalance":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.CrossWalletBalance = string(in.String())
+			}
+		case "crossUnPnl":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.CrossUnPnl = string(in.String())
+			}
+		case "availableBalance":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.AvailableBalance = string(in.String())
+			}
+		case "updateTime":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.UpdateTime = int64(in.Int64())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceDelivery22(out *jwriter.Writer, in AccountAsset) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"asset\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Asset))
+	}
+	{
+		const prefix string = ",\"walletBalance\":"
+		out.RawString(prefix)
+		out.String(string(in.WalletBalance))
+	}
+	{
+		const prefix string = ",\"unrealizedProfit\":"
+		out.RawString(prefix)
+		out.String(string(in.UnrealizedProfit))
+	}
+	{
+		const prefix string = ",\"marginBalance\":"
+		out.RawString(prefix)
+		out.String(string(in.MarginBalance))
+	}
+	{
+		const prefix string = ",\"maintMargin\":"
+		out.RawString(prefix)
+		out.String(string(in.MaintMargin))
+	}
+	{
+		const prefix string = ",\"initialMargin\":"
+		out.RawString(prefix)
+		out.String(string(in.InitialMargin))
+	}
+	{
+		const prefix string = ",\"positionInitialMargin\":"
+		out.RawString(prefix)
+		out.String(string(in.PositionInitialMargin))
+	}
+	{
+		const prefix string = ",\"openOrderInitialMargin\":"
+		out.RawString(prefix)
+		out.String(string(in.OpenOrderInitialMargin))
+	}
+	{
+		const prefix string = ",\"maxWithdrawAmount\":"
+		out.RawString(prefix)
+		out.String(string(in.MaxWithdrawAmount))
+	}
+	{
+		const prefix string = ",\"crossWalletBalance\":"
+		out.RawString(prefix)
+		out.String(string(in.CrossWalletBalance))
+	}
+	{
+		const prefix string = ",\"crossUnPnl\":"
+		out.RawString(prefix)
+		out.String(string(in.CrossUnPnl))
+	}
+	{
+		const prefix string = ",\"availableBalance\":"
+		out.RawString(prefix)
+		out.String(string(in.AvailableBalance))
+	}
+	{
+		const prefix string = ",\"updateTime\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.UpdateTime))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AccountAsset) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceDelivery22(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v AccountAsset) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceDelivery22(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AccountAsset) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceDelivery22(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *AccountAsset) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceDelivery22(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceDelivery23(in *jlexer.Lexer, out *Account) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "assets":
+			if in.IsNull() {
+				in.Skip()
+				out.Assets = nil
+			} else {
+				in.Delim('[')
+				if out.Assets == nil {
+					if !in.IsDelim(']') {
+						out.Assets = make([]*AccountAsset, 0, 8)
+					} else {
+						out.Assets = []*AccountAsset{}
+					}
+				} else {
+					out.Assets = (out.Assets)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v33 *AccountAsset
+					if in.IsNull() {
+						in.Skip()
+						v33 = nil
+					} else {
+						if v33 == nil {
+							v33 = new(AccountAsset)
+						}
+						if in.IsNull() {
+							in.Skip()
+						} else {
+							(*v33).UnmarshalEasyJSON(in)
+						}
+					}
+					out.Assets = append(out.Assets, v33)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "positions":
+			if in.IsNull() {
+				in.Skip()
+				out.Positions = nil
+			} else {
+				in.Delim('[')
+				if out.Positions == nil {
+					if !in.IsDelim(']') {
+						out.Positions = make([]*AccountPosition, 0, 8)
+					} else {
+						out.Positions = []*AccountPosition{}
+					}
+				} else {
+					out.Positions = (out.Positions)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v34 *AccountPosition
+					if in.IsNull() {
+						in.Skip()
+						v34 = nil
+					} else {
+						if v34 == nil {
+							v34 = new(AccountPosition)
+						}
+						if in.IsNull() {
+							in.Skip()
+						} else {
+							(*v34).UnmarshalEasyJSON(in)
+						}
+					}
+					out.Positions = append(out.Positions, v34)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "canDeposit":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.CanDeposit = bool(in.Bool())
+			}
+		case "canTrade":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.CanTrade = bool(in.Bool())
+			}
+		case "canWithdraw":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.CanWithdraw = bool(in.Bool())
+			}
+		case "feeTier":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.FeeTier = int(in.Int())
+			}
+		case "updateTime":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.UpdateTime = int64(in.Int64())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceDelivery23(out *jwriter.Writer, in Account) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"assets\":"
+		out.RawString(prefix[1:])
+		if in.Assets == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v35, v36 := range in.Assets {
+				if v35 > 0 {
+					out.RawByte(',')
+				}
+				if v36 == nil {
+					out.RawString("null")
+				} else {
+					(*v36).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"positions\":"
+		out.RawString(prefix)
+		if in.Positions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v37, v38 := range in.Positions {
+				if v37 > 0 {
+					out.RawByte(',')
+				}
+				if v38 == nil {
+					out.RawString("null")
+				} else {
+					(*v38).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"canDeposit\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CanDeposit))
+	}
+	{
+		const prefix string = ",\"canTrade\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CanTrade))
+	}
+	{
+		const prefix string = ",\"canWithdraw\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CanWithdraw))
+	}
+	{
+		const prefix string = ",\"feeTier\":"
+		out.RawString(prefix)
+		out.Int(int(in.FeeTier))
+	}
+	{
+		const prefix string = ",\"updateTime\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.UpdateTime))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Account) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceDelivery23(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Account) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceDelivery23(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Account) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceDelivery23(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Account) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceDelivery23(l, v)
+}
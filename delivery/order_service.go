@@ -0,0 +1,684 @@
+package delivery
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/shopspring/decimal"
+	"github.com/ward-cap/go-binance/common"
+)
+
+// CreateOrderService create order
+type CreateOrderService struct {
+	c                *Client
+	symbol           string
+	side             SideType
+	positionSide     *PositionSideType
+	orderType        OrderType
+	timeInForce      *TimeInForceType
+	quantity         string
+	reduceOnly       *bool
+	price            *string
+	newClientOrderID *string
+	stopPrice        *string
+	workingType      *WorkingType
+	activationPrice  *string
+	callbackRate     *string
+	priceProtect     *bool
+	newOrderRespType NewOrderRespType
+	closePosition    *bool
+}
+
+// Symbol set symbol
+func (s *CreateOrderService) Symbol(symbol string) *CreateOrderService {
+	s.symbol = symbol
+	return s
+}
+
+// Side set side
+func (s *CreateOrderService) Side(side SideType) *CreateOrderService {
+	s.side = side
+	return s
+}
+
+// PositionSide set side
+func (s *CreateOrderService) PositionSide(positionSide PositionSideType) *CreateOrderService {
+	s.positionSide = &positionSide
+	return s
+}
+
+// Type set type
+func (s *CreateOrderService) Type(orderType OrderType) *CreateOrderService {
+	s.orderType = orderType
+	return s
+}
+
+// TimeInForce set timeInForce
+func (s *CreateOrderService) TimeInForce(timeInForce TimeInForceType) *CreateOrderService {
+	s.timeInForce = &timeInForce
+	return s
+}
+
+// Quantity set quantity in contracts, see Symbol.Contracts
+func (s *CreateOrderService) Quantity(quantity string) *CreateOrderService {
+	s.quantity = quantity
+	return s
+}
+
+// QuantityDecimal set quantity from a decimal
+func (s *CreateOrderService) QuantityDecimal(quantity decimal.Decimal) *CreateOrderService {
+	return s.Quantity(quantity.String())
+}
+
+// ReduceOnly set reduceOnly
+func (s *CreateOrderService) ReduceOnly(reduceOnly bool) *CreateOrderService {
+	s.reduceOnly = &reduceOnly
+	return s
+}
+
+// Price set price
+func (s *CreateOrderService) Price(price string) *CreateOrderService {
+	s.price = &price
+	return s
+}
+
+// PriceDecimal set price from a decimal
+func (s *CreateOrderService) PriceDecimal(price decimal.Decimal) *CreateOrderService {
+	return s.Price(price.String())
+}
+
+// NewClientOrderID set newClientOrderID
+func (s *CreateOrderService) NewClientOrderID(newClientOrderID string) *CreateOrderService {
+	s.newClientOrderID = &newClientOrderID
+	return s
+}
+
+// StopPrice set stopPrice
+func (s *CreateOrderService) StopPrice(stopPrice string) *CreateOrderService {
+	s.stopPrice = &stopPrice
+	return s
+}
+
+// StopPriceDecimal set stopPrice from a decimal
+func (s *CreateOrderService) StopPriceDecimal(stopPrice decimal.Decimal) *CreateOrderService {
+	return s.StopPrice(stopPrice.String())
+}
+
+// WorkingType set workingType
+func (s *CreateOrderService) WorkingType(workingType WorkingType) *CreateOrderService {
+	s.workingType = &workingType
+	return s
+}
+
+// ActivationPrice set activationPrice
+func (s *CreateOrderService) ActivationPrice(activationPrice string) *CreateOrderService {
+	s.activationPrice = &activationPrice
+	return s
+}
+
+// ActivationPriceDecimal set activationPrice from a decimal
+func (s *CreateOrderService) ActivationPriceDecimal(activationPrice decimal.Decimal) *CreateOrderService {
+	return s.ActivationPrice(activationPrice.String())
+}
+
+// CallbackRate set callbackRate
+func (s *CreateOrderService) CallbackRate(callbackRate string) *CreateOrderService {
+	s.callbackRate = &callbackRate
+	return s
+}
+
+// CallbackRateDecimal set callbackRate from a decimal
+func (s *CreateOrderService) CallbackRateDecimal(callbackRate decimal.Decimal) *CreateOrderService {
+	return s.CallbackRate(callbackRate.String())
+}
+
+// PriceProtect set priceProtect
+func (s *CreateOrderService) PriceProtect(priceProtect bool) *CreateOrderService {
+	s.priceProtect = &priceProtect
+	return s
+}
+
+// NewOrderResponseType set newOrderResponseType
+func (s *CreateOrderService) NewOrderResponseType(newOrderResponseType NewOrderRespType) *CreateOrderService {
+	s.newOrderRespType = newOrderResponseType
+	return s
+}
+
+// ClosePosition set closePosition
+func (s *CreateOrderService) ClosePosition(closePosition bool) *CreateOrderService {
+	s.closePosition = &closePosition
+	return s
+}
+
+func (s *CreateOrderService) newRequest(endpoint string) *request {
+	r := &request{
+		Service:  "CreateOrderService",
+		Method:   http.MethodPost,
+		Endpoint: endpoint,
+		SecType:  secTypeSigned,
+	}
+	m := params{
+		"symbol":           s.symbol,
+		"side":             s.side,
+		"type":             s.orderType,
+		"newOrderRespType": s.newOrderRespType,
+	}
+	if s.quantity != "" {
+		m["quantity"] = s.quantity
+	}
+	if s.positionSide != nil {
+		m["positionSide"] = *s.positionSide
+	}
+	if s.timeInForce != nil {
+		m["timeInForce"] = *s.timeInForce
+	}
+	if s.reduceOnly != nil {
+		m["reduceOnly"] = *s.reduceOnly
+	}
+	if s.price != nil {
+		m["price"] = *s.price
+	}
+	if s.newClientOrderID != nil {
+		m["newClientOrderId"] = *s.newClientOrderID
+	}
+	if s.stopPrice != nil {
+		m["stopPrice"] = *s.stopPrice
+	}
+	if s.workingType != nil {
+		m["workingType"] = *s.workingType
+	}
+	if s.priceProtect != nil {
+		m["priceProtect"] = *s.priceProtect
+	}
+	if s.activationPrice != nil {
+		m["activationPrice"] = *s.activationPrice
+	}
+	if s.callbackRate != nil {
+		m["callbackRate"] = *s.callbackRate
+	}
+	if s.closePosition != nil {
+		m["closePosition"] = *s.closePosition
+	}
+	r.SetFormParams(m)
+	return r
+}
+
+func (s *CreateOrderService) createOrder(ctx context.Context, endpoint string, opts ...RequestOption) (data []byte, header *http.Header, err error) {
+	data, header, err = s.c.CallAPI(ctx, s.newRequest(endpoint), opts...)
+	if err != nil {
+		return []byte{}, &http.Header{}, err
+	}
+	return data, header, nil
+}
+
+// Do send request
+func (s *CreateOrderService) Do(ctx context.Context, opts ...RequestOption) (res *CreateOrderResponse, err error) {
+	data, header, err := s.createOrder(ctx, "/dapi/v1/order", opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(CreateOrderResponse)
+	err = json.Unmarshal(data, res)
+	res.RateLimitOrder10s = header.Get("X-Mbx-Order-Count-10s")
+	res.RateLimitOrder1m = header.Get("X-Mbx-Order-Count-1m")
+
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ListOpenOrdersService list opened orders
+type ListOpenOrdersService struct {
+	c      *Client
+	symbol string
+	pair   string
+}
+
+// Symbol set symbol
+func (s *ListOpenOrdersService) Symbol(symbol string) *ListOpenOrdersService {
+	s.symbol = symbol
+	return s
+}
+
+// Pair set pair, listing the open orders of all the contracts of pair
+func (s *ListOpenOrdersService) Pair(pair string) *ListOpenOrdersService {
+	s.pair = pair
+	return s
+}
+
+func (s *ListOpenOrdersService) newRequest() *request {
+	r := &request{
+		Service:  "ListOpenOrdersService",
+		Method:   http.MethodGet,
+		Endpoint: "/dapi/v1/openOrders",
+		SecType:  secTypeSigned,
+	}
+	if s.symbol != "" {
+		r.SetParam("symbol", s.symbol)
+	}
+	if s.pair != "" {
+		r.SetParam("pair", s.pair)
+	}
+	return r
+}
+
+// Do send request
+func (s *ListOpenOrdersService) Do(ctx context.Context, opts ...RequestOption) (res []*Order, err error) {
+	data, _, err := s.c.CallAPI(ctx, s.newRequest(), opts...)
+	if err != nil {
+		return []*Order{}, err
+	}
+	res = make([]*Order, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*Order{}, err
+	}
+	return res, nil
+}
+
+// GetOrderService get an order
+type GetOrderService struct {
+	c                 *Client
+	symbol            string
+	orderID           *int64
+	origClientOrderID *string
+}
+
+// Symbol set symbol
+func (s *GetOrderService) Symbol(symbol string) *GetOrderService {
+	s.symbol = symbol
+	return s
+}
+
+// OrderID set orderID
+func (s *GetOrderService) OrderID(orderID int64) *GetOrderService {
+	s.orderID = &orderID
+	return s
+}
+
+// OrigClientOrderID set origClientOrderID
+func (s *GetOrderService) OrigClientOrderID(origClientOrderID string) *GetOrderService {
+	s.origClientOrderID = &origClientOrderID
+	return s
+}
+
+func (s *GetOrderService) newRequest() *request {
+	r := &request{
+		Service:  "GetOrderService",
+		Method:   http.MethodGet,
+		Endpoint: "/dapi/v1/order",
+		SecType:  secTypeSigned,
+	}
+	r.SetParam("symbol", s.symbol)
+	if s.orderID != nil {
+		r.SetParam("orderId", *s.orderID)
+	}
+	if s.origClientOrderID != nil {
+		r.SetParam("origClientOrderId", *s.origClientOrderID)
+	}
+	return r
+}
+
+// Do send request
+func (s *GetOrderService) Do(ctx context.Context, opts ...RequestOption) (res *Order, err error) {
+	data, _, err := s.c.CallAPI(ctx, s.newRequest(), opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(Order)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ListOrdersService all account orders; active, canceled, or filled
+type ListOrdersService struct {
+	c         *Client
+	symbol    string
+	pair      string
+	orderID   *int64
+	startTime *int64
+	endTime   *int64
+	limit     *int
+}
+
+// Symbol set symbol
+func (s *ListOrdersService) Symbol(symbol string) *ListOrdersService {
+	s.symbol = symbol
+	return s
+}
+
+// Pair set pair, listing the orders of all the contracts of pair. Either
+// symbol or pair must be sent.
+func (s *ListOrdersService) Pair(pair string) *ListOrdersService {
+	s.pair = pair
+	return s
+}
+
+// OrderID set orderID
+func (s *ListOrdersService) OrderID(orderID int64) *ListOrdersService {
+	s.orderID = &orderID
+	return s
+}
+
+// StartTime set starttime
+func (s *ListOrdersService) StartTime(startTime int64) *ListOrdersService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endtime
+func (s *ListOrdersService) EndTime(endTime int64) *ListOrdersService {
+	s.endTime = &endTime
+	return s
+}
+
+// Limit set limit
+func (s *ListOrdersService) Limit(limit int) *ListOrdersService {
+	s.limit = &limit
+	return s
+}
+
+func (s *ListOrdersService) newRequest() *request {
+	r := &request{
+		Service:  "ListOrdersService",
+		Method:   http.MethodGet,
+		Endpoint: "/dapi/v1/allOrders",
+		SecType:  secTypeSigned,
+	}
+	if s.symbol != "" {
+		r.SetParam("symbol", s.symbol)
+	}
+	if s.pair != "" {
+		r.SetParam("pair", s.pair)
+	}
+	if s.orderID != nil {
+		r.SetParam("orderId", *s.orderID)
+	}
+	if s.startTime != nil {
+		r.SetParam("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.SetParam("endTime", *s.endTime)
+	}
+	if s.limit != nil {
+		r.SetParam("limit", *s.limit)
+	}
+	return r
+}
+
+// Do send request
+func (s *ListOrdersService) Do(ctx context.Context, opts ...RequestOption) (res []*Order, err error) {
+	data, _, err := s.c.CallAPI(ctx, s.newRequest(), opts...)
+	if err != nil {
+		return []*Order{}, err
+	}
+	res = make([]*Order, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*Order{}, err
+	}
+	return res, nil
+}
+
+// CancelOrderService cancel an order
+type CancelOrderService struct {
+	c                 *Client
+	symbol            string
+	orderID           *int64
+	origClientOrderID *string
+}
+
+// Symbol set symbol
+func (s *CancelOrderService) Symbol(symbol string) *CancelOrderService {
+	s.symbol = symbol
+	return s
+}
+
+// OrderID set orderID
+func (s *CancelOrderService) OrderID(orderID int64) *CancelOrderService {
+	s.orderID = &orderID
+	return s
+}
+
+// OrigClientOrderID set origClientOrderID
+func (s *CancelOrderService) OrigClientOrderID(origClientOrderID string) *CancelOrderService {
+	s.origClientOrderID = &origClientOrderID
+	return s
+}
+
+func (s *CancelOrderService) newRequest() *request {
+	r := &request{
+		Service:  "CancelOrderService",
+		Method:   http.MethodDelete,
+		Endpoint: "/dapi/v1/order",
+		SecType:  secTypeSigned,
+	}
+	r.SetFormParam("symbol", s.symbol)
+	if s.orderID != nil {
+		r.SetFormParam("orderId", *s.orderID)
+	}
+	if s.origClientOrderID != nil {
+		r.SetFormParam("origClientOrderId", *s.origClientOrderID)
+	}
+	return r
+}
+
+// Do send request
+func (s *CancelOrderService) Do(ctx context.Context, opts ...RequestOption) (res *CancelOrderResponse, err error) {
+	data, _, err := s.c.CallAPI(ctx, s.newRequest(), opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(CancelOrderResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CancelAllOpenOrdersService cancel all open orders
+type CancelAllOpenOrdersService struct {
+	c      *Client
+	symbol string
+}
+
+// Symbol set symbol
+func (s *CancelAllOpenOrdersService) Symbol(symbol string) *CancelAllOpenOrdersService {
+	s.symbol = symbol
+	return s
+}
+
+// Do send request
+func (s *CancelAllOpenOrdersService) Do(ctx context.Context, opts ...RequestOption) (err error) {
+	r := &request{
+		Service:  "CancelAllOpenOrdersService",
+		Method:   http.MethodDelete,
+		Endpoint: "/dapi/v1/allOpenOrders",
+		SecType:  secTypeSigned,
+	}
+	r.SetFormParam("symbol", s.symbol)
+	_, _, err = s.c.CallAPI(ctx, r, opts...)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// CancelMultiplesOrdersService cancel a list of orders
+type CancelMultiplesOrdersService struct {
+	c                     *Client
+	symbol                string
+	orderIDList           []int64
+	origClientOrderIDList []string
+}
+
+// Symbol set symbol
+func (s *CancelMultiplesOrdersService) Symbol(symbol string) *CancelMultiplesOrdersService {
+	s.symbol = symbol
+	return s
+}
+
+// OrderID set orderID
+func (s *CancelMultiplesOrdersService) OrderIDList(orderIDList []int64) *CancelMultiplesOrdersService {
+	s.orderIDList = orderIDList
+	return s
+}
+
+// OrigClientOrderID set origClientOrderID
+func (s *CancelMultiplesOrdersService) OrigClientOrderIDList(origClientOrderIDList []string) *CancelMultiplesOrdersService {
+	s.origClientOrderIDList = origClientOrderIDList
+	return s
+}
+
+// Do send request
+func (s *CancelMultiplesOrdersService) Do(ctx context.Context, opts ...RequestOption) (res []*CancelOrderResponse, err error) {
+	r := &request{
+		Service:  "CancelMultiplesOrdersService",
+		Method:   http.MethodDelete,
+		Endpoint: "/dapi/v1/batchOrders",
+		SecType:  secTypeSigned,
+	}
+	r.SetFormParam("symbol", s.symbol)
+	if s.orderIDList != nil {
+		// convert a slice of integers to a string e.g. [1 2 3] => "[1,2,3]"
+		orderIDListString := strings.Join(strings.Fields(fmt.Sprint(s.orderIDList)), ",")
+		r.SetFormParam("orderIdList", orderIDListString)
+	}
+	if s.origClientOrderIDList != nil {
+		r.SetFormParam("origClientOrderIdList", s.origClientOrderIDList)
+	}
+	data, _, err := s.c.CallAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = make([]*CancelOrderResponse, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*CancelOrderResponse{}, err
+	}
+	return res, nil
+}
+
+type CreateBatchOrdersService struct {
+	c      *Client
+	orders []*CreateOrderService
+}
+
+func (s *CreateBatchOrdersService) OrderList(orders []*CreateOrderService) *CreateBatchOrdersService {
+	s.orders = orders
+	return s
+}
+
+// Do send request. Orders which failed are returned in Errors instead of
+// failing the whole batch. Orders and Errors are aligned with the order list:
+// for each index exactly one of Orders[i] and Errors[i] is set.
+func (s *CreateBatchOrdersService) Do(ctx context.Context, opts ...RequestOption) (res *CreateBatchOrdersResponse, err error) {
+	r := &request{
+		Service:  "CreateBatchOrdersService",
+		Method:   http.MethodPost,
+		Endpoint: "/dapi/v1/batchOrders",
+		SecType:  secTypeSigned,
+	}
+
+	orders := []params{}
+	for _, order := range s.orders {
+		m := params{
+			"symbol":           order.symbol,
+			"side":             order.side,
+			"type":             order.orderType,
+			"quantity":         order.quantity,
+			"newOrderRespType": order.newOrderRespType,
+		}
+
+		if order.positionSide != nil {
+			m["positionSide"] = *order.positionSide
+		}
+		if order.timeInForce != nil {
+			m["timeInForce"] = *order.timeInForce
+		}
+		if order.reduceOnly != nil {
+			m["reduceOnly"] = *order.reduceOnly
+		}
+		if order.price != nil {
+			m["price"] = *order.price
+		}
+		if order.newClientOrderID != nil {
+			m["newClientOrderId"] = *order.newClientOrderID
+		}
+		if order.stopPrice != nil {
+			m["stopPrice"] = *order.stopPrice
+		}
+		if order.workingType != nil {
+			m["workingType"] = *order.workingType
+		}
+		if order.priceProtect != nil {
+			m["priceProtect"] = *order.priceProtect
+		}
+		if order.activationPrice != nil {
+			m["activationPrice"] = *order.activationPrice
+		}
+		if order.callbackRate != nil {
+			m["callbackRate"] = *order.callbackRate
+		}
+		if order.closePosition != nil {
+			m["closePosition"] = *order.closePosition
+		}
+		orders = append(orders, m)
+	}
+	b, err := json.Marshal(orders)
+	if err != nil {
+		return &CreateBatchOrdersResponse{}, err
+	}
+	m := params{
+		"batchOrders": string(b),
+	}
+
+	r.SetFormParams(m)
+
+	data, _, err := s.c.CallAPI(ctx, r, opts...)
+
+	if err != nil {
+		return &CreateBatchOrdersResponse{}, err
+	}
+
+	rawMessages := make([]*json.RawMessage, 0)
+
+	err = json.Unmarshal(data, &rawMessages)
+
+	if err != nil {
+		return &CreateBatchOrdersResponse{}, err
+	}
+
+	if len(rawMessages) != len(s.orders) {
+		return &CreateBatchOrdersResponse{}, fmt.Errorf("got %d results for %d orders", len(rawMessages), len(s.orders))
+	}
+	batchCreateOrdersResponse := &CreateBatchOrdersResponse{
+		Orders: make([]*Order, len(rawMessages)),
+		Errors: make([]*common.APIError, len(rawMessages)),
+	}
+
+	for i, j := range rawMessages {
+		apiErr := new(common.APIError)
+		if err := json.Unmarshal(*j, apiErr); err == nil && apiErr.Code != 0 {
+			apiErr.Endpoint = r.Endpoint
+			apiErr.Service = r.Service
+			batchCreateOrdersResponse.Errors[i] = apiErr
+			continue
+		}
+		o := new(Order)
+		if err := json.Unmarshal(*j, o); err != nil {
+			return &CreateBatchOrdersResponse{}, err
+		}
+		batchCreateOrdersResponse.Orders[i] = o
+	}
+
+	return batchCreateOrdersResponse, nil
+}
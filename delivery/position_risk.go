@@ -0,0 +1,56 @@
+package delivery
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// GetPositionRiskService get position risk
+type GetPositionRiskService struct {
+	c           *Client
+	marginAsset string
+	pair        string
+}
+
+// MarginAsset set marginAsset
+func (s *GetPositionRiskService) MarginAsset(marginAsset string) *GetPositionRiskService {
+	s.marginAsset = marginAsset
+	return s
+}
+
+// Pair set pair
+func (s *GetPositionRiskService) Pair(pair string) *GetPositionRiskService {
+	s.pair = pair
+	return s
+}
+
+func (s *GetPositionRiskService) newRequest() *request {
+	r := &request{
+		Service:  "GetPositionRiskService",
+		Method:   http.MethodGet,
+		Endpoint: "/dapi/v1/positionRisk",
+		SecType:  secTypeSigned,
+	}
+	if s.marginAsset != "" {
+		r.SetParam("marginAsset", s.marginAsset)
+	}
+	if s.pair != "" {
+		r.SetParam("pair", s.pair)
+	}
+	return r
+}
+
+// Do send request
+func (s *GetPositionRiskService) Do(ctx context.Context, opts ...RequestOption) (res []*PositionRisk, err error) {
+	data, _, err := s.c.CallAPI(ctx, s.newRequest(), opts...)
+	if err != nil {
+		return []*PositionRisk{}, err
+	}
+	res = make([]*PositionRisk, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*PositionRisk{}, err
+	}
+	return res, nil
+}
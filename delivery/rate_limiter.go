@@ -0,0 +1,119 @@
+package delivery
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/ward-cap/go-binance/common"
+)
+
+// endpointWeights lists the request weight of /dapi endpoints, keyed by
+// method and endpoint. Endpoints missing here weigh 1.
+var endpointWeights = map[string]int64{
+	"GET /dapi/v1/exchangeInfo":    1,
+	"GET /dapi/v1/allOrders":       20,
+	"GET /dapi/v1/income":          20,
+	"GET /dapi/v1/account":         5,
+	"GET /dapi/v1/balance":         1,
+	"GET /dapi/v1/positionRisk":    1,
+	"GET /dapi/v2/leverageBracket": 1,
+	"POST /dapi/v1/order":          0,
+	"POST /dapi/v1/batchOrders":    5,
+	"DELETE /dapi/v1/batchOrders":  1,
+}
+
+// endpointOrders lists the endpoints counting against the order rate limits
+var endpointOrders = map[string]int64{
+	"POST /dapi/v1/order":       1,
+	"POST /dapi/v1/batchOrders": 5,
+}
+
+// requestCost return the weight and order count of r
+func requestCost(r *request) common.RequestCost {
+	if !strings.HasPrefix(r.Endpoint, "/dapi/") {
+		return common.RequestCost{}
+	}
+	key := r.Method + " " + r.Endpoint
+	cost := common.RequestCost{Requests: 1, Weight: 1, Orders: endpointOrders[key]}
+	if weight, ok := endpointWeights[key]; ok {
+		cost.Weight = weight
+	}
+
+	hasSymbol := r.Query.Get("symbol") != ""
+	switch key {
+	case "GET /dapi/v1/depth":
+		cost.Weight = depthWeight(r.Query.Get("limit"))
+	case "GET /dapi/v1/klines", "GET /dapi/v1/continuousKlines", "GET /dapi/v1/indexPriceKlines", "GET /dapi/v1/markPriceKlines":
+		cost.Weight = klinesWeight(r.Query.Get("limit"))
+	case "GET /dapi/v1/premiumIndex":
+		if !hasSymbol {
+			cost.Weight = 10
+		}
+	case "GET /dapi/v1/openOrders":
+		if !hasSymbol {
+			cost.Weight = 40
+		}
+	case "GET /dapi/v1/allOrders":
+		if !hasSymbol {
+			cost.Weight = 40
+		}
+	}
+	return cost
+}
+
+func depthWeight(limit string) int64 {
+	n, err := strconv.Atoi(limit)
+	if err != nil {
+		n = 500
+	}
+	switch {
+	case n <= 50:
+		return 2
+	case n <= 100:
+		return 5
+	case n <= 500:
+		return 10
+	default:
+		return 20
+	}
+}
+
+func klinesWeight(limit string) int64 {
+	n, err := strconv.Atoi(limit)
+	if err != nil {
+		n = 500
+	}
+	switch {
+	case n < 100:
+		return 1
+	case n < 500:
+		return 2
+	case n <= 1000:
+		return 5
+	default:
+		return 10
+	}
+}
+
+// EnableRateLimiter fetch the rate limits from exchangeInfo and throttle
+// every later request with them. In fail fast mode requests that would exceed
+// a limit return a *common.RateLimitError instead of waiting. Call it before
+// the client is shared between goroutines.
+func (c *Client) EnableRateLimiter(ctx context.Context, failFast bool) error {
+	info, err := c.NewExchangeInfoService().Do(ctx)
+	if err != nil {
+		return err
+	}
+	limits := make([]common.RateLimit, len(info.RateLimits))
+	for i, limit := range info.RateLimits {
+		limits[i] = common.RateLimit{
+			RateLimitType: limit.RateLimitType,
+			Interval:      limit.Interval,
+			IntervalNum:   limit.IntervalNum,
+			Limit:         limit.Limit,
+		}
+	}
+	c.RateLimiter = common.NewRateLimiter(limits, failFast)
+	return nil
+}
@@ -11,15 +11,19 @@ type GetBalanceService struct {
 	c *Client
 }
 
-// Do send request
-func (s *GetBalanceService) Do(ctx context.Context, opts ...RequestOption) (res []*Balance, err error) {
+func (s *GetBalanceService) newRequest() *request {
 	r := &request{
-		service:  "GetBalanceService",
-		method:   http.MethodGet,
-		endpoint: "/fapi/v2/balance",
-		secType:  secTypeSigned,
+		Service:  "GetBalanceService",
+		Method:   http.MethodGet,
+		Endpoint: "/fapi/v2/balance",
+		SecType:  secTypeSigned,
 	}
-	data, _, err := s.c.callAPI(ctx, r, opts...)
+	return r
+}
+
+// Do send request
+func (s *GetBalanceService) Do(ctx context.Context, opts ...RequestOption) (res []*Balance, err error) {
+	data, _, err := s.c.CallAPI(ctx, s.newRequest(), opts...)
 	if err != nil {
 		return []*Balance{}, err
 	}
@@ -31,20 +35,38 @@ func (s *GetBalanceService) Do(ctx context.Context, opts ...RequestOption) (res
 	return res, nil
 }
 
+// DoV2 send request and return the decimal typed BalanceV2
+func (s *GetBalanceService) DoV2(ctx context.Context, opts ...RequestOption) (res []*BalanceV2, err error) {
+	data, _, err := s.c.CallAPI(ctx, s.newRequest(), opts...)
+	if err != nil {
+		return []*BalanceV2{}, err
+	}
+	res = make([]*BalanceV2, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*BalanceV2{}, err
+	}
+	return res, nil
+}
+
 // GetAccountService get account info
 type GetAccountService struct {
 	c *Client
 }
 
-// Do send request
-func (s *GetAccountService) Do(ctx context.Context, opts ...RequestOption) (res *Account, err error) {
+func (s *GetAccountService) newRequest() *request {
 	r := &request{
-		service:  "GetAccountService",
-		method:   http.MethodGet,
-		endpoint: "/fapi/v2/account",
-		secType:  secTypeSigned,
+		Service:  "GetAccountService",
+		Method:   http.MethodGet,
+		Endpoint: "/fapi/v2/account",
+		SecType:  secTypeSigned,
 	}
-	data, _, err := s.c.callAPI(ctx, r, opts...)
+	return r
+}
+
+// Do send request
+func (s *GetAccountService) Do(ctx context.Context, opts ...RequestOption) (res *Account, err error) {
+	data, _, err := s.c.CallAPI(ctx, s.newRequest(), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -55,3 +77,17 @@ func (s *GetAccountService) Do(ctx context.Context, opts ...RequestOption) (res
 	}
 	return res, nil
 }
+
+// DoV2 send request and return the decimal typed AccountV2
+func (s *GetAccountService) DoV2(ctx context.Context, opts ...RequestOption) (res *AccountV2, err error) {
+	data, _, err := s.c.CallAPI(ctx, s.newRequest(), opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(AccountV2)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
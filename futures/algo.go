@@ -14,12 +14,12 @@ type OpenAlgoOrdersService struct {
 // Do send request
 func (s *OpenAlgoOrdersService) Do(ctx context.Context, opts ...RequestOption) (res []*AlgoOrders, err error) {
 	r := &request{
-		service:  "OpenAlgoOrdersService",
-		method:   http.MethodGet,
-		endpoint: "/fapi/v1/openAlgoOrders",
-		secType:  secTypeSigned,
+		Service:  "OpenAlgoOrdersService",
+		Method:   http.MethodGet,
+		Endpoint: "/fapi/v1/openAlgoOrders",
+		SecType:  secTypeSigned,
 	}
-	data, _, err := s.c.callAPI(ctx, r, opts...)
+	data, _, err := s.c.CallAPI(ctx, r, opts...)
 	if err != nil {
 		return
 	}
@@ -47,24 +47,24 @@ func (s *CloseAlgoOrdersService) SetSymbol(symbol string) *CloseAlgoOrdersServic
 
 func (s *CloseAlgoOrdersService) Do(ctx context.Context, opts ...RequestOption) (res CloseAlgoOrderResponse, err error) {
 	r := &request{
-		service: "CloseAlgoOrdersService",
-		method:  http.MethodDelete,
-		secType: secTypeSigned,
+		Service: "CloseAlgoOrdersService",
+		Method:  http.MethodDelete,
+		SecType: secTypeSigned,
 	}
 	if (s.algoID == 0) == (s.symbol == "") {
 		return res, fmt.Errorf("either algoID or symbol must be set, but not both")
 	}
 
 	if s.algoID != 0 {
-		r.setFormParam("algoId", s.algoID)
-		r.endpoint = "/fapi/v1/algoOrder"
+		r.SetFormParam("algoId", s.algoID)
+		r.Endpoint = "/fapi/v1/algoOrder"
 	}
 	if s.symbol != "" {
-		r.setFormParam("symbol", s.symbol)
-		r.endpoint = "fapi/v1/algoOpenOrders"
+		r.SetFormParam("symbol", s.symbol)
+		r.Endpoint = "fapi/v1/algoOpenOrders"
 	}
 
-	data, _, err := s.c.callAPI(ctx, r, opts...)
+	data, _, err := s.c.CallAPI(ctx, r, opts...)
 	if err != nil {
 		return
 	}
@@ -1,20 +1,10 @@
 package futures
 
 import (
-	"bytes"
-	"context"
-	"crypto/hmac"
-	"crypto/sha256"
-	"encoding/hex"
-	"encoding/json"
-	"fmt"
-	"io"
 	"net/http"
-	"net/url"
 	"time"
 
 	"github.com/ward-cap/go-binance/common"
-	"go.uber.org/zap"
 )
 
 // SideType define side type of order
@@ -53,6 +43,9 @@ type SideEffectType string
 // WorkingType define working type
 type WorkingType string
 
+// PriceMatchType define the price match mode of an order
+type PriceMatchType string
+
 // MarginType define margin type
 type MarginType string
 
@@ -70,9 +63,43 @@ type ForceOrderCloseType string
 
 // Endpoints
 const (
-	baseApiMainUrl = "https://fapi.binance.com"
+	baseApiMainUrl      = "https://fapi.binance.com"
+	baseWsMainUrl       = "wss://fstream.binance.com"
+	baseWsAPIMainUrl    = "wss://ws-fapi.binance.com/ws-fapi/v1"
+	baseApiTestnetUrl   = "https://testnet.binancefuture.com"
+	baseWsTestnetUrl    = "wss://fstream.binancefuture.com"
+	baseWsAPITestnetUrl = "wss://testnet.binancefuture.com/ws-fapi/v1"
 )
 
+// UseTestnet switch all the API endpoints from production to the testnet
+var UseTestnet = false
+
+// Environment define the REST, websocket and WebSocket API endpoints of a
+// deployment. They are always set together so a client never mixes hosts.
+type Environment struct {
+	BaseURL      string
+	BaseWsURL    string
+	BaseWsAPIURL string
+}
+
+// MainnetEnvironment return the endpoints of the production deployment
+func MainnetEnvironment() Environment {
+	return Environment{
+		BaseURL:      baseApiMainUrl,
+		BaseWsURL:    baseWsMainUrl,
+		BaseWsAPIURL: baseWsAPIMainUrl,
+	}
+}
+
+// TestnetEnvironment return the endpoints of the testnet
+func TestnetEnvironment() Environment {
+	return Environment{
+		BaseURL:      baseApiTestnetUrl,
+		BaseWsURL:    baseWsTestnetUrl,
+		BaseWsAPIURL: baseWsAPITestnetUrl,
+	}
+}
+
 // Global enums
 const (
 	SideTypeBuy  SideType = "BUY"
@@ -121,6 +148,16 @@ const (
 	WorkingTypeMarkPrice     WorkingType = "MARK_PRICE"
 	WorkingTypeContractPrice WorkingType = "CONTRACT_PRICE"
 
+	PriceMatchTypeNone       PriceMatchType = "NONE"
+	PriceMatchTypeOpponent   PriceMatchType = "OPPONENT"
+	PriceMatchTypeOpponent5  PriceMatchType = "OPPONENT_5"
+	PriceMatchTypeOpponent10 PriceMatchType = "OPPONENT_10"
+	PriceMatchTypeOpponent20 PriceMatchType = "OPPONENT_20"
+	PriceMatchTypeQueue      PriceMatchType = "QUEUE"
+	PriceMatchTypeQueue5     PriceMatchType = "QUEUE_5"
+	PriceMatchTypeQueue10    PriceMatchType = "QUEUE_10"
+	PriceMatchTypeQueue20    PriceMatchType = "QUEUE_20"
+
 	SymbolStatusTypePreTrading   SymbolStatusType = "PRE_TRADING"
 	SymbolStatusTypeTrading      SymbolStatusType = "TRADING"
 	SymbolStatusTypePostTrading  SymbolStatusType = "POST_TRADING"
@@ -180,36 +217,47 @@ func currentTimestamp() int64 {
 	return int64(time.Nanosecond) * time.Now().UnixNano() / int64(time.Millisecond)
 }
 
+// getEnvironment return the environment according the UseTestnet flag
+func getEnvironment() Environment {
+	if UseTestnet {
+		return TestnetEnvironment()
+	}
+	return MainnetEnvironment()
+}
+
 // getApiEndpoint return the base endpoint of the WS according the UseTestnet flag
 func getApiEndpoint() string {
-	return baseApiMainUrl
+	return getEnvironment().BaseURL
+}
+
+// getWsEndpoint return the base endpoint of the market data websocket streams
+func getWsEndpoint() string {
+	return getEnvironment().BaseWsURL
+}
+
+// getWsAPIEndpoint return the endpoint of the WebSocket API
+func getWsAPIEndpoint() string {
+	return getEnvironment().BaseWsAPIURL
 }
 
 // NewClient initialize an API client instance with API key and secret key.
 // You should always call this function before using this SDK.
 // Services will be created by the form client.NewXXXService().
 func NewClient(apiKey, secretKey string, client *http.Client) *Client {
-	if client == nil {
-		client = http.DefaultClient
-	}
 	return &Client{
-		APIKey:     apiKey,
-		SecretKey:  secretKey,
-		BaseURL:    getApiEndpoint(),
-		UserAgent:  "Binance/golang",
-		HTTPClient: client,
+		RESTClient:   common.NewRESTClient(restConfig, apiKey, secretKey, getApiEndpoint(), client),
+		BaseWsURL:    getWsEndpoint(),
+		BaseWsAPIURL: getWsAPIEndpoint(),
 	}
 }
 
-// Sign returns an HMAC SHA256 signature for the provided payload.
-func (c *Client) Sign(payload string) string {
-	h := hmac.New(sha256.New, []byte(c.SecretKey))
-	_, err := io.WriteString(h, payload)
-	if err != nil {
-		panic(err)
-	}
-
-	return hex.EncodeToString(h.Sum(nil))
+// restConfig define the package settings of the REST client
+var restConfig = common.RESTConfig{
+	Package: "futures",
+	Cost:    requestCost,
+	RetryOrders: map[string]common.OrderLookup{
+		"/fapi/v1/order": {ClientOrderIDParam: "newClientOrderId", LookupParam: "origClientOrderId"},
+	},
 }
 
 //func NewProxiedClient(apiKey, secretKey, proxyUrl string) *Client {
@@ -235,198 +283,44 @@ func (c *Client) Sign(payload string) string {
 
 //type doFunc func(req *http.Request) (*http.Response, error)
 
-// Client define API client
+// Client define API client, requests are signed and sent by the embedded
+// common.RESTClient
 type Client struct {
-	APIKey     string
-	SecretKey  string
-	BaseURL    string
-	UserAgent  string
-	HTTPClient *http.Client
-	Debug      bool
-	Logger     *zap.SugaredLogger
-	TimeOffset int64
-}
-
-func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
-	// set request options from user
-	for _, opt := range opts {
-		opt(r)
-	}
-	err = r.validate()
-	if err != nil {
-		return err
-	}
-
-	fullURL := fmt.Sprintf("%s%s", c.BaseURL, r.endpoint)
-	if r.recvWindow > 0 {
-		r.setParam(recvWindowKey, r.recvWindow.Milliseconds())
-	} else {
-		r.setParam(recvWindowKey, time.Second*15)
-	}
-
-	if r.secType == secTypeSigned {
-		r.setParam(timestampKey, currentTimestamp()-c.TimeOffset)
-	}
-	queryString := r.query.Encode()
-	body := &bytes.Buffer{}
-	bodyString := r.form.Encode()
-	header := http.Header{}
-	if r.header != nil {
-		header = r.header.Clone()
-	}
-	if bodyString != "" {
-		header.Set("Content-Type", "application/x-www-form-urlencoded")
-		body = bytes.NewBufferString(bodyString)
-	}
-	if r.secType == secTypeAPIKey || r.secType == secTypeSigned {
-		header.Set("X-MBX-APIKEY", c.APIKey)
-	}
-
-	if r.secType == secTypeSigned {
-		raw := fmt.Sprintf("%s%s", queryString, bodyString)
-		signature := c.Sign(raw)
-		v := url.Values{}
-		v.Set(signatureKey, signature)
-		if queryString == "" {
-			queryString = v.Encode()
-		} else {
-			queryString = fmt.Sprintf("%s&%s", queryString, v.Encode())
-		}
-	}
-	if queryString != "" {
-		fullURL = fmt.Sprintf("%s?%s", fullURL, queryString)
-	}
-	//c.debug("full url: %s, body: %s", fullURL, bodyString)
-
-	r.fullURL = fullURL
-	r.header = header
-	r.body = body
-	return nil
+	common.RESTClient
+	BaseWsURL    string
+	BaseWsAPIURL string
 }
 
-func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption) (data []byte, header *http.Header, err error) {
-	startedAt := time.Now()
-	service := r.service
-	ctx, span := common.StartRequestSpan(ctx, "go-binance/futures", service)
-	defer span.End()
-
-	err = c.parseRequest(r, opts...)
-	if err != nil {
-		c.logAPIError(ctx, service, r, nil, startedAt, err)
-		return []byte{}, &http.Header{}, err
-	}
-
-	req, err := http.NewRequestWithContext(ctx, r.method, r.fullURL, r.body)
-	if err != nil {
-		c.logAPIError(ctx, service, r, nil, startedAt, err)
-		return []byte{}, &http.Header{}, err
-	}
-	req = req.WithContext(common.WithHTTPConnTrace(req.Context()))
-	req.Header = r.header
-
-	c.logAPIRequest(ctx, service, r, req)
-
-	res, err := c.HTTPClient.Do(req)
-	if err != nil {
-		c.logAPIError(ctx, service, r, req, startedAt, err)
-		return []byte{}, &http.Header{}, err
-	}
-	defer func() {
-		cerr := res.Body.Close()
-		if err == nil && cerr != nil {
-			err = cerr
+// SetApiEndpoint set api Endpoint. The endpoints of the environment whose
+// REST endpoint is url are all set; any other url only changes BaseURL.
+//
+// Deprecated: use SetEnvironment, which sets the websocket endpoints too.
+func (c *Client) SetApiEndpoint(url string) *Client {
+	for _, env := range []Environment{MainnetEnvironment(), TestnetEnvironment()} {
+		if env.BaseURL == url {
+			return c.SetEnvironment(env)
 		}
-	}()
-
-	data, err = io.ReadAll(res.Body)
-	if err != nil {
-		c.logAPIError(ctx, service, r, req, startedAt, err)
-		return []byte{}, &http.Header{}, err
-	}
-	c.logAPIResponse(ctx, service, r, req, res, data, startedAt)
-
-	if res.StatusCode >= http.StatusBadRequest {
-		apiErr := new(common.APIError)
-		_ = json.Unmarshal(data, apiErr)
-		c.logAPIError(ctx, service, r, req, startedAt, apiErr)
-		return nil, &http.Header{}, apiErr
 	}
-	return data, &res.Header, nil
-}
-
-func (c *Client) logAPIRequest(ctx context.Context, service string, r *request, req *http.Request) {
-	if c == nil || c.Logger == nil || req == nil {
-		return
-	}
-
-	fields := []any{
-		"binance.package", "futures",
-		"binance.service", service,
-		"http.method", r.method,
-		"url.full", common.SanitizeURL(req.URL.String(), signatureKey),
-		"server.address", req.URL.Host,
-		"binance.api_key", common.MaskAPIKey(c.APIKey),
-		"http.request.header", common.SanitizeHeaders(req.Header),
-		"http.request.body", common.ReadBodyForLog(r.body),
-	}
-	fields = common.AppendContextField(ctx, fields)
-
-	c.Logger.Debugw("binance api request", fields...)
+	c.BaseURL = url
+	return c
 }
 
-func (c *Client) logAPIResponse(ctx context.Context, service string, r *request, req *http.Request, res *http.Response, data []byte, startedAt time.Time) {
-	if c == nil || c.Logger == nil || req == nil || res == nil {
-		return
-	}
-
-	fields := []any{
-		"binance.package", "futures",
-		"binance.service", service,
-		"http.method", r.method,
-		"url.full", common.SanitizeURL(req.URL.String(), signatureKey),
-		"server.address", req.URL.Host,
-		"http.response.status_code", res.StatusCode,
-		"http.response.header", common.SanitizeHeaders(res.Header),
-		"http.response.body", string(data),
-		"event.duration", time.Since(startedAt),
-	}
-	if reused, ok := common.HTTPConnReused(req.Context()); ok {
-		fields = append(fields, "http.connection.reused", reused)
-	}
-	fields = common.AppendContextField(ctx, fields)
-
-	c.Logger.Debugw("binance api response", fields...)
+// SetEnvironment set the REST, websocket and WebSocket API endpoints of env.
+// Streams and WebSocket API clients created before keep their endpoints.
+func (c *Client) SetEnvironment(env Environment) *Client {
+	c.BaseURL = env.BaseURL
+	c.BaseWsURL = env.BaseWsURL
+	c.BaseWsAPIURL = env.BaseWsAPIURL
+	return c
 }
 
-func (c *Client) logAPIError(ctx context.Context, service string, r *request, req *http.Request, startedAt time.Time, err error) {
-	if c == nil || c.Logger == nil || err == nil {
-		return
-	}
-
-	serverAddress := ""
-	if req != nil && req.URL != nil {
-		serverAddress = req.URL.Host
+// Environment return the endpoints the client uses
+func (c *Client) Environment() Environment {
+	return Environment{
+		BaseURL:      c.BaseURL,
+		BaseWsURL:    c.BaseWsURL,
+		BaseWsAPIURL: c.BaseWsAPIURL,
 	}
-
-	fields := []any{
-		"binance.package", "futures",
-		"binance.service", service,
-		"http.method", r.method,
-		"url.full", common.SanitizeURL(r.fullURL, signatureKey),
-		"server.address", serverAddress,
-		"binance.api_key", common.MaskAPIKey(c.APIKey),
-		"event.duration", time.Since(startedAt),
-		"error", err,
-	}
-	fields = common.AppendContextField(ctx, fields)
-
-	c.Logger.Errorw("binance api error", fields...)
-}
-
-// SetApiEndpoint set api Endpoint
-func (c *Client) SetApiEndpoint(url string) *Client {
-	c.BaseURL = url
-	return c
 }
 
 // NewPingService init ping service
@@ -526,6 +420,21 @@ func (c *Client) NewCancelOrderService() *CancelOrderService {
 	return &CancelOrderService{c: c}
 }
 
+// NewModifyOrderService init modify order service
+func (c *Client) NewModifyOrderService() *ModifyOrderService {
+	return &ModifyOrderService{c: c}
+}
+
+// NewModifyBatchOrdersService init modify batch orders service
+func (c *Client) NewModifyBatchOrdersService() *ModifyBatchOrdersService {
+	return &ModifyBatchOrdersService{c: c}
+}
+
+// NewGetOrderAmendmentHistoryService init order amendment history service
+func (c *Client) NewGetOrderAmendmentHistoryService() *GetOrderAmendmentHistoryService {
+	return &GetOrderAmendmentHistoryService{c: c}
+}
+
 // NewCancelAllOpenOrdersService init cancel all open orders service
 func (c *Client) NewCancelAllOpenOrdersService() *CancelAllOpenOrdersService {
 	return &CancelAllOpenOrdersService{c: c}
@@ -17,18 +17,22 @@ func (service *CommissionRateService) Symbol(symbol string) *CommissionRateServi
 	return service
 }
 
-// Do send request
-func (s *CommissionRateService) Do(ctx context.Context, opts ...RequestOption) (res *CommissionRate, err error) {
+func (s *CommissionRateService) newRequest() *request {
 	r := &request{
-		service:  "CommissionRateService",
-		method:   http.MethodGet,
-		endpoint: "/fapi/v1/commissionRate",
-		secType:  secTypeSigned,
+		Service:  "CommissionRateService",
+		Method:   http.MethodGet,
+		Endpoint: "/fapi/v1/commissionRate",
+		SecType:  secTypeSigned,
 	}
 	if s.symbol != "" {
-		r.setParam("symbol", s.symbol)
+		r.SetParam("symbol", s.symbol)
 	}
-	data, _, err := s.c.callAPI(ctx, r, opts...)
+	return r
+}
+
+// Do send request
+func (s *CommissionRateService) Do(ctx context.Context, opts ...RequestOption) (res *CommissionRate, err error) {
+	data, _, err := s.c.CallAPI(ctx, s.newRequest(), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -39,3 +43,17 @@ func (s *CommissionRateService) Do(ctx context.Context, opts ...RequestOption) (
 	}
 	return res, nil
 }
+
+// DoV2 send request and return the decimal typed CommissionRateV2
+func (s *CommissionRateService) DoV2(ctx context.Context, opts ...RequestOption) (res *CommissionRateV2, err error) {
+	data, _, err := s.c.CallAPI(ctx, s.newRequest(), opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(CommissionRateV2)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -27,15 +27,15 @@ func (s *DepthService) Limit(limit int) *DepthService {
 // Do send request
 func (s *DepthService) Do(ctx context.Context, opts ...RequestOption) (res *DepthResponse, err error) {
 	r := &request{
-		service:  "DepthService",
-		method:   http.MethodGet,
-		endpoint: "/fapi/v1/depth",
+		Service:  "DepthService",
+		Method:   http.MethodGet,
+		Endpoint: "/fapi/v1/depth",
 	}
-	r.setParam("symbol", s.symbol)
+	r.SetParam("symbol", s.symbol)
 	if s.limit != nil {
-		r.setParam("limit", *s.limit)
+		r.SetParam("limit", *s.limit)
 	}
-	data, _, err := s.c.callAPI(ctx, r, opts...)
+	data, _, err := s.c.CallAPI(ctx, r, opts...)
 	if err != nil {
 		return nil, err
 	}
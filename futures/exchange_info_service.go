@@ -15,12 +15,12 @@ type ExchangeInfoService struct {
 // Do send request
 func (s *ExchangeInfoService) Do(ctx context.Context, opts ...RequestOption) (res *ExchangeInfo, err error) {
 	r := &request{
-		service:  "ExchangeInfoService",
-		method:   http.MethodGet,
-		endpoint: "/fapi/v1/exchangeInfo",
-		secType:  secTypeNone,
+		Service:  "ExchangeInfoService",
+		Method:   http.MethodGet,
+		Endpoint: "/fapi/v1/exchangeInfo",
+		SecType:  secTypeNone,
 	}
-	data, _, err := s.c.callAPI(ctx, r, opts...)
+	data, _, err := s.c.CallAPI(ctx, r, opts...)
 	if err != nil {
 		return nil, err
 	}
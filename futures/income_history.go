@@ -52,34 +52,37 @@ func (s *GetIncomeHistoryService) Page(page int64) *GetIncomeHistoryService {
 	return s
 }
 
-// Do send request
-func (s *GetIncomeHistoryService) Do(ctx context.Context, opts ...RequestOption) (res []*IncomeHistory, err error) {
+func (s *GetIncomeHistoryService) newRequest() *request {
 	r := &request{
-		service:  "GetIncomeHistoryService",
-		method:   http.MethodGet,
-		endpoint: "/fapi/v1/income",
-		secType:  secTypeSigned,
+		Service:  "GetIncomeHistoryService",
+		Method:   http.MethodGet,
+		Endpoint: "/fapi/v1/income",
+		SecType:  secTypeSigned,
 	}
 	if s.symbol != nil {
-		r.setParam("symbol", *s.symbol)
+		r.SetParam("symbol", *s.symbol)
 	}
 	if s.incomeType != nil {
-		r.setParam("incomeType", *s.incomeType)
+		r.SetParam("incomeType", *s.incomeType)
 	}
 	if s.startTime != nil {
-		r.setParam("startTime", *s.startTime)
+		r.SetParam("startTime", *s.startTime)
 	}
 	if s.endTime != nil {
-		r.setParam("endTime", *s.endTime)
+		r.SetParam("endTime", *s.endTime)
 	}
 	if s.limit != nil {
-		r.setParam("limit", *s.limit)
+		r.SetParam("limit", *s.limit)
 	}
 	if s.page != nil {
-		r.setParam("page", *s.page)
+		r.SetParam("page", *s.page)
 	}
+	return r
+}
 
-	data, _, err := s.c.callAPI(ctx, r, opts...)
+// Do send request
+func (s *GetIncomeHistoryService) Do(ctx context.Context, opts ...RequestOption) (res []*IncomeHistory, err error) {
+	data, _, err := s.c.CallAPI(ctx, s.newRequest(), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -90,3 +93,17 @@ func (s *GetIncomeHistoryService) Do(ctx context.Context, opts ...RequestOption)
 	}
 	return res, nil
 }
+
+// DoV2 send request and return the decimal typed IncomeHistoryV2
+func (s *GetIncomeHistoryService) DoV2(ctx context.Context, opts ...RequestOption) (res []*IncomeHistoryV2, err error) {
+	data, _, err := s.c.CallAPI(ctx, s.newRequest(), opts...)
+	if err != nil {
+		return []*IncomeHistoryV2{}, err
+	}
+	res = make([]*IncomeHistoryV2, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*IncomeHistoryV2{}, err
+	}
+	return res, nil
+}
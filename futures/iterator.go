@@ -0,0 +1,109 @@
+package futures
+
+import (
+	"context"
+	"iter"
+	"sync/atomic"
+	"time"
+
+	"github.com/ward-cap/go-binance/common"
+)
+
+// timeRange resolve the range an iterator walks, endTime default to the
+// server time and startTime to one window before endTime, or to 0 when the
+// endpoint has no window
+func (c *Client) timeRange(startTime, endTime *int64, window time.Duration) (int64, int64) {
+	end := currentTimestamp() - atomic.LoadInt64(&c.TimeOffset)
+	if endTime != nil {
+		end = *endTime
+	}
+	var start int64
+	if startTime != nil {
+		start = *startTime
+	} else if window > 0 {
+		start = end - window.Milliseconds() + 1
+	}
+	return start, end
+}
+
+// incomeKey identify an income, tranId is only unique within an income type
+type incomeKey struct {
+	incomeType string
+	tranID     int64
+}
+
+// fundingRateKey identify a funding rate, fundingTime is shared by every
+// symbol when the symbol is not set
+type fundingRateKey struct {
+	symbol      string
+	fundingTime int64
+}
+
+// All iterate over the income history between startTime and endTime in
+// 7 day windows, endTime default to now and startTime to 7 days before
+// endTime. limit and page are not used.
+func (s *GetIncomeHistoryService) All(ctx context.Context, opts ...RequestOption) iter.Seq2[*IncomeHistory, error] {
+	const window = 7 * 24 * time.Hour
+	base := *s
+	start, end := s.c.timeRange(s.startTime, s.endTime, window)
+	p := &common.Paginator[*IncomeHistory, incomeKey]{
+		StartTime: start,
+		EndTime:   end,
+		Window:    window,
+		Limit:     1000,
+		Fetch: func(ctx context.Context, page common.Page) ([]*IncomeHistory, error) {
+			svc, limit := base, int64(1000)
+			svc.limit, svc.page = &limit, nil
+			svc.startTime, svc.endTime = &page.StartTime, &page.EndTime
+			return svc.Do(ctx, opts...)
+		},
+		Key:  func(h *IncomeHistory) incomeKey { return incomeKey{h.IncomeType, h.TranID} },
+		Time: func(h *IncomeHistory) int64 { return h.Time },
+	}
+	return p.All(ctx)
+}
+
+// All iterate over the trades between startTime and endTime in 7 day
+// windows, endTime default to now and startTime to 7 days before endTime.
+// orderId, fromID and limit are not used.
+func (s *ListAccountTradeService) All(ctx context.Context, opts ...RequestOption) iter.Seq2[*AccountTrade, error] {
+	const window = 7 * 24 * time.Hour
+	base := *s
+	start, end := s.c.timeRange(s.startTime, s.endTime, window)
+	p := &common.Paginator[*AccountTrade, int64]{
+		StartTime: start,
+		EndTime:   end,
+		Window:    window,
+		Limit:     1000,
+		Fetch: func(ctx context.Context, page common.Page) ([]*AccountTrade, error) {
+			svc, limit := base, 1000
+			svc.orderId, svc.fromID, svc.limit = nil, nil, &limit
+			svc.startTime, svc.endTime = &page.StartTime, &page.EndTime
+			return svc.Do(ctx, opts...)
+		},
+		Key:  func(t *AccountTrade) int64 { return t.ID },
+		Time: func(t *AccountTrade) int64 { return t.Time },
+	}
+	return p.All(ctx)
+}
+
+// All iterate over the funding rates between startTime and endTime, endTime
+// default to now and startTime to the first funding rate. limit is not used.
+func (s *FundingRateService) All(ctx context.Context, opts ...RequestOption) iter.Seq2[*FundingRate, error] {
+	base := *s
+	start, end := s.c.timeRange(s.startTime, s.endTime, 0)
+	p := &common.Paginator[*FundingRate, fundingRateKey]{
+		StartTime: start,
+		EndTime:   end,
+		Limit:     1000,
+		Fetch: func(ctx context.Context, page common.Page) ([]*FundingRate, error) {
+			svc, limit := base, 1000
+			svc.limit = &limit
+			svc.startTime, svc.endTime = &page.StartTime, &page.EndTime
+			return svc.Do(ctx, opts...)
+		},
+		Key:  func(r *FundingRate) fundingRateKey { return fundingRateKey{r.Symbol, r.FundingTime} },
+		Time: func(r *FundingRate) int64 { return r.FundingTime },
+	}
+	return p.All(ctx)
+}
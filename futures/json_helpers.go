@@ -22,6 +22,24 @@ type depthPayload struct {
 	Asks         []priceLevelTuple `json:"asks"`
 }
 
+type wsDepthPayload struct {
+	Event            string            `json:"e"`
+	Time             int64             `json:"E"`
+	TransactionTime  int64             `json:"T"`
+	Symbol           string            `json:"s"`
+	FirstUpdateID    int64             `json:"U"`
+	LastUpdateID     int64             `json:"u"`
+	PrevLastUpdateID int64             `json:"pu"`
+	Bids             []priceLevelTuple `json:"b"`
+	Asks             []priceLevelTuple `json:"a"`
+}
+
+type wsUserDataEventHeader struct {
+	Event     UserDataEventType `json:"e"`
+	Time      json.RawMessage   `json:"E"`
+	ListenKey string            `json:"listenKey"`
+}
+
 type priceLevelTuple [2]string
 
 type klineTuple []json.RawMessage
@@ -63,6 +81,76 @@ func parseDepth(data []byte) (*DepthResponse, error) {
 	return res, nil
 }
 
+func parseWsDepthEvent(data []byte) (*WsDepthEvent, error) {
+	var payload wsDepthPayload
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return nil, err
+	}
+	res := &WsDepthEvent{
+		Event:            payload.Event,
+		Time:             payload.Time,
+		TransactionTime:  payload.TransactionTime,
+		Symbol:           payload.Symbol,
+		FirstUpdateID:    payload.FirstUpdateID,
+		LastUpdateID:     payload.LastUpdateID,
+		PrevLastUpdateID: payload.PrevLastUpdateID,
+	}
+	res.Bids = make([]Bid, len(payload.Bids))
+	for i, bid := range payload.Bids {
+		res.Bids[i] = Bid{Price: bid[0], Quantity: bid[1]}
+	}
+	res.Asks = make([]Ask, len(payload.Asks))
+	for i, ask := range payload.Asks {
+		res.Asks[i] = Ask{Price: ask[0], Quantity: ask[1]}
+	}
+	return res, nil
+}
+
+func parseWsUserDataEvent(data []byte) (*WsUserDataEvent, error) {
+	var header wsUserDataEventHeader
+	if err := json.Unmarshal(data, &header); err != nil {
+		return nil, err
+	}
+	res := &WsUserDataEvent{Event: header.Event}
+	if len(header.Time) > 0 {
+		t, err := rawInt64(header.Time)
+		if err != nil {
+			return nil, err
+		}
+		res.Time = t
+	}
+
+	var err error
+	switch header.Event {
+	case UserDataEventTypeAccountUpdate:
+		res.AccountUpdate = new(WsAccountUpdateEvent)
+		err = res.AccountUpdate.UnmarshalJSON(data)
+	case UserDataEventTypeOrderTradeUpdate:
+		res.OrderTradeUpdate = new(WsOrderTradeUpdateEvent)
+		err = res.OrderTradeUpdate.UnmarshalJSON(data)
+	case UserDataEventTypeAccountConfigUpdate:
+		res.AccountConfigUpdate = new(WsAccountConfigUpdateEvent)
+		err = res.AccountConfigUpdate.UnmarshalJSON(data)
+	case UserDataEventTypeAlgoUpdate:
+		res.AlgoUpdate = new(WsAlgoUpdateEvent)
+		err = res.AlgoUpdate.UnmarshalJSON(data)
+	case UserDataEventTypeMarginCall:
+		res.MarginCall = new(WsMarginCallEvent)
+		err = res.MarginCall.UnmarshalJSON(data)
+	case UserDataEventTypeListenKeyExpired:
+		// the event time is sent as a string here, so build it from the header
+		res.ListenKeyExpired = &WsListenKeyExpiredEvent{
+			Event:     header.Event,
+			Time:      res.Time,
+			ListenKey: header.ListenKey,
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
 func parseKlines(data []byte) ([]*Kline, error) {
 	var rows []klineTuple
 	if err := json.Unmarshal(data, &rows); err != nil {
@@ -61,22 +61,22 @@ func (s *KlinesService) Do(ctx context.Context, opts ...RequestOption) (res []*K
 	}()
 
 	r := &request{
-		service:  "KlinesService",
-		method:   http.MethodGet,
-		endpoint: "/fapi/v1/klines",
+		Service:  "KlinesService",
+		Method:   http.MethodGet,
+		Endpoint: "/fapi/v1/klines",
 	}
-	r.setParam("symbol", s.symbol)
-	r.setParam("interval", s.interval)
+	r.SetParam("symbol", s.symbol)
+	r.SetParam("interval", s.interval)
 	if s.limit != nil {
-		r.setParam("limit", *s.limit)
+		r.SetParam("limit", *s.limit)
 	}
 	if s.startTime != nil {
-		r.setParam("startTime", *s.startTime)
+		r.SetParam("startTime", *s.startTime)
 	}
 	if s.endTime != nil {
-		r.setParam("endTime", *s.endTime)
+		r.SetParam("endTime", *s.endTime)
 	}
-	data, _, err := s.c.callAPI(ctx, r, opts...)
+	data, _, err := s.c.CallAPI(ctx, r, opts...)
 	if err != nil {
 		return []*Kline{}, err
 	}
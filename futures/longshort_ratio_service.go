@@ -49,25 +49,25 @@ func (s *LongShortRatioService) EndTime(endTime int64) *LongShortRatioService {
 // Do send request
 func (s *LongShortRatioService) Do(ctx context.Context, opts ...RequestOption) (res []*LongShortRatio, err error) {
 	r := &request{
-		service:  "LongShortRatioService",
-		method:   http.MethodGet,
-		endpoint: "/futures/data/globalLongShortAccountRatio",
+		Service:  "LongShortRatioService",
+		Method:   http.MethodGet,
+		Endpoint: "/futures/data/globalLongShortAccountRatio",
 	}
 
-	r.setParam("symbol", s.symbol)
-	r.setParam("period", s.period)
+	r.SetParam("symbol", s.symbol)
+	r.SetParam("period", s.period)
 
 	if s.limit != nil {
-		r.setParam("limit", *s.limit)
+		r.SetParam("limit", *s.limit)
 	}
 	if s.startTime != nil {
-		r.setParam("startTime", *s.startTime)
+		r.SetParam("startTime", *s.startTime)
 	}
 	if s.endTime != nil {
-		r.setParam("endTime", *s.endTime)
+		r.SetParam("endTime", *s.endTime)
 	}
 
-	data, _, err := s.c.callAPI(ctx, r, opts...)
+	data, _, err := s.c.CallAPI(ctx, r, opts...)
 	if err != nil {
 		return []*LongShortRatio{}, err
 	}
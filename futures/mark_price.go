@@ -19,18 +19,22 @@ func (s *PremiumIndexService) Symbol(symbol string) *PremiumIndexService {
 	return s
 }
 
-// Do send request
-func (s *PremiumIndexService) Do(ctx context.Context, opts ...RequestOption) (res []*PremiumIndex, err error) {
+func (s *PremiumIndexService) newRequest() *request {
 	r := &request{
-		service:  "PremiumIndexService",
-		method:   http.MethodGet,
-		endpoint: "/fapi/v1/premiumIndex",
-		secType:  secTypeNone,
+		Service:  "PremiumIndexService",
+		Method:   http.MethodGet,
+		Endpoint: "/fapi/v1/premiumIndex",
+		SecType:  secTypeNone,
 	}
 	if s.symbol != nil {
-		r.setParam("symbol", *s.symbol)
+		r.SetParam("symbol", *s.symbol)
 	}
-	data, _, err := s.c.callAPI(ctx, r, opts...)
+	return r
+}
+
+// Do send request
+func (s *PremiumIndexService) Do(ctx context.Context, opts ...RequestOption) (res []*PremiumIndex, err error) {
+	data, _, err := s.c.CallAPI(ctx, s.newRequest(), opts...)
 	data = common.ToJSONList(data)
 	if err != nil {
 		return []*PremiumIndex{}, err
@@ -43,6 +47,21 @@ func (s *PremiumIndexService) Do(ctx context.Context, opts ...RequestOption) (re
 	return res, nil
 }
 
+// DoV2 send request and return the decimal typed PremiumIndexV2
+func (s *PremiumIndexService) DoV2(ctx context.Context, opts ...RequestOption) (res []*PremiumIndexV2, err error) {
+	data, _, err := s.c.CallAPI(ctx, s.newRequest(), opts...)
+	if err != nil {
+		return []*PremiumIndexV2{}, err
+	}
+	data = common.ToJSONList(data)
+	res = make([]*PremiumIndexV2, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*PremiumIndexV2{}, err
+	}
+	return res, nil
+}
+
 // FundingRateService get funding rate
 type FundingRateService struct {
 	c         *Client
@@ -76,25 +95,29 @@ func (s *FundingRateService) Limit(limit int) *FundingRateService {
 	return s
 }
 
-// Do send request
-func (s *FundingRateService) Do(ctx context.Context, opts ...RequestOption) (res []*FundingRate, err error) {
+func (s *FundingRateService) newRequest() *request {
 	r := &request{
-		service:  "FundingRateService",
-		method:   http.MethodGet,
-		endpoint: "/fapi/v1/fundingRate",
-		secType:  secTypeNone,
+		Service:  "FundingRateService",
+		Method:   http.MethodGet,
+		Endpoint: "/fapi/v1/fundingRate",
+		SecType:  secTypeNone,
 	}
-	r.setParam("symbol", s.symbol)
+	r.SetParam("symbol", s.symbol)
 	if s.startTime != nil {
-		r.setParam("startTime", *s.startTime)
+		r.SetParam("startTime", *s.startTime)
 	}
 	if s.endTime != nil {
-		r.setParam("endTime", *s.endTime)
+		r.SetParam("endTime", *s.endTime)
 	}
 	if s.limit != nil {
-		r.setParam("limit", *s.limit)
+		r.SetParam("limit", *s.limit)
 	}
-	data, _, err := s.c.callAPI(ctx, r, opts...)
+	return r
+}
+
+// Do send request
+func (s *FundingRateService) Do(ctx context.Context, opts ...RequestOption) (res []*FundingRate, err error) {
+	data, _, err := s.c.CallAPI(ctx, s.newRequest(), opts...)
 	if err != nil {
 		return []*FundingRate{}, err
 	}
@@ -106,6 +129,20 @@ func (s *FundingRateService) Do(ctx context.Context, opts ...RequestOption) (res
 	return res, nil
 }
 
+// DoV2 send request and return the decimal typed FundingRateV2
+func (s *FundingRateService) DoV2(ctx context.Context, opts ...RequestOption) (res []*FundingRateV2, err error) {
+	data, _, err := s.c.CallAPI(ctx, s.newRequest(), opts...)
+	if err != nil {
+		return []*FundingRateV2{}, err
+	}
+	res = make([]*FundingRateV2, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*FundingRateV2{}, err
+	}
+	return res, nil
+}
+
 // GetLeverageBracketService get funding rate
 type GetLeverageBracketService struct {
 	c      *Client
@@ -121,16 +158,16 @@ func (s *GetLeverageBracketService) Symbol(symbol string) *GetLeverageBracketSer
 // Do send request
 func (s *GetLeverageBracketService) Do(ctx context.Context, opts ...RequestOption) (res []*LeverageBracket, err error) {
 	r := &request{
-		service:  "GetLeverageBracketService",
-		method:   http.MethodGet,
-		endpoint: "/fapi/v1/leverageBracket",
-		secType:  secTypeSigned,
+		Service:  "GetLeverageBracketService",
+		Method:   http.MethodGet,
+		Endpoint: "/fapi/v1/leverageBracket",
+		SecType:  secTypeSigned,
 	}
-	r.setParam("symbol", s.symbol)
+	r.SetParam("symbol", s.symbol)
 	if s.symbol != "" {
-		r.setParam("symbol", s.symbol)
+		r.SetParam("symbol", s.symbol)
 	}
-	data, _, err := s.c.callAPI(ctx, r, opts...)
+	data, _, err := s.c.CallAPI(ctx, r, opts...)
 	if err != nil {
 		return []*LeverageBracket{}, err
 	}
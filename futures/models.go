@@ -277,6 +277,11 @@ type CreateOrderResponse struct {
 	PriceProtect      bool             `json:"priceProtect"`
 	RateLimitOrder10s string           `json:"rateLimitOrder10s,omitempty"`
 	RateLimitOrder1m  string           `json:"rateLimitOrder1m,omitempty"`
+
+	// Recovered is set when a retried placement found the order already
+	// placed; the response is then built from the queried order and the
+	// rate limit counts are empty.
+	Recovered bool `json:"-"`
 }
 
 // DepthResponse define depth info with bids and asks
@@ -414,6 +419,16 @@ type MinNotionalFilter struct {
 	Notional string `json:"notional"`
 }
 
+// ModifyBatchOrdersResponse define the orders modified by a batch, the
+// orders which could not be modified are in Errors. Both are indexed like the
+// order list, the other one is nil at each index.
+//
+//easyjson:json
+type ModifyBatchOrdersResponse struct {
+	Orders []*Order
+	Errors []*common.APIError
+}
+
 // Response of user's multi-asset mode
 //
 //easyjson:json
@@ -464,6 +479,38 @@ type Order struct {
 	PositionSide     PositionSideType    `json:"positionSide"`
 	PriceProtect     bool                `json:"priceProtect"`
 	ClosePosition    bool                `json:"closePosition"`
+	PriceMatch       PriceMatchType      `json:"priceMatch"`
+}
+
+// OrderAmendment define a modification of an order
+//
+//easyjson:json
+type OrderAmendment struct {
+	AmendmentID   int64                `json:"amendmentId"`
+	Symbol        string               `json:"symbol"`
+	Pair          string               `json:"pair"`
+	OrderID       int64                `json:"orderId"`
+	ClientOrderID string               `json:"clientOrderId"`
+	Time          int64                `json:"time"`
+	Amendment     OrderAmendmentDetail `json:"amendment"`
+}
+
+// OrderAmendmentDetail define the price and quantity of an order before and
+// after a modification, Count is the number of modifications of the order
+//
+//easyjson:json
+type OrderAmendmentDetail struct {
+	Price        OrderAmendmentValue `json:"price"`
+	OrigQuantity OrderAmendmentValue `json:"origQty"`
+	Count        int                 `json:"count"`
+}
+
+// OrderAmendmentValue define a value before and after a modification
+//
+//easyjson:json
+type OrderAmendmentValue struct {
+	Before string `json:"before"`
+	After  string `json:"after"`
 }
 
 // PercentPriceFilter define percent price filter of symbol
@@ -700,3 +747,402 @@ type UserLiquidationOrder struct {
 	Time             int64            `json:"time"`
 	UpdateTime       int64            `json:"updateTime"`
 }
+
+// WsAccountConfigLeverage define leverage change of an account config update event
+//
+//easyjson:json
+type WsAccountConfigLeverage struct {
+	Symbol   string `json:"s"`
+	Leverage int    `json:"l"`
+}
+
+// WsAccountConfigMultiAssets define multi-assets mode change of an account config update event
+//
+//easyjson:json
+type WsAccountConfigMultiAssets struct {
+	MultiAssets bool `json:"j"`
+}
+
+// WsAccountConfigUpdateEvent define websocket account config update event
+//
+//easyjson:json
+type WsAccountConfigUpdateEvent struct {
+	Event           UserDataEventType           `json:"e"`
+	Time            int64                       `json:"E"`
+	TransactionTime int64                       `json:"T"`
+	Leverage        *WsAccountConfigLeverage    `json:"ac,omitempty"`
+	MultiAssets     *WsAccountConfigMultiAssets `json:"ai,omitempty"`
+}
+
+// WsAccountUpdate define account update of an account update event
+//
+//easyjson:json
+type WsAccountUpdate struct {
+	Reason    UserDataEventReasonType `json:"m"`
+	Balances  []WsBalance             `json:"B"`
+	Positions []WsPosition            `json:"P"`
+}
+
+// WsAccountUpdateEvent define websocket account update event
+//
+//easyjson:json
+type WsAccountUpdateEvent struct {
+	Event           UserDataEventType `json:"e"`
+	Time            int64             `json:"E"`
+	TransactionTime int64             `json:"T"`
+	AccountUpdate   WsAccountUpdate   `json:"a"`
+}
+
+// WsAggTradeEvent define websocket aggregate trade event
+//
+//easyjson:json
+type WsAggTradeEvent struct {
+	Event  string `json:"e"`
+	Time   int64  `json:"E"`
+	Symbol string `json:"s"`
+	AggTrade
+}
+
+// WsAlgoUpdate define algo order of an algo update event
+//
+//easyjson:json
+type WsAlgoUpdate struct {
+	ClientAlgoID            string           `json:"caid"`
+	AlgoID                  int64            `json:"aid"`
+	AlgoType                string           `json:"at"`
+	Type                    OrderType        `json:"o"`
+	Symbol                  string           `json:"s"`
+	Side                    SideType         `json:"S"`
+	PositionSide            PositionSideType `json:"ps"`
+	TimeInForce             TimeInForceType  `json:"f"`
+	Quantity                string           `json:"q"`
+	Status                  string           `json:"X"`
+	ActualOrderID           string           `json:"ai"`
+	ActualPrice             string           `json:"ap"`
+	ActualQuantity          string           `json:"aq"`
+	ActualOrderType         string           `json:"act"`
+	TriggerPrice            string           `json:"tp"`
+	Price                   string           `json:"p"`
+	SelfTradePreventionMode string           `json:"V"`
+	WorkingType             WorkingType      `json:"wt"`
+	PriceMatch              string           `json:"pm"`
+	ClosePosition           bool             `json:"cp"`
+	PriceProtect            bool             `json:"pP"`
+	ReduceOnly              bool             `json:"R"`
+	TriggerTime             int64            `json:"tt"`
+	GoodTillDate            int64            `json:"gtd"`
+	RejectReason            string           `json:"rm"`
+}
+
+// WsAlgoUpdateEvent define websocket algo update event
+//
+//easyjson:json
+type WsAlgoUpdateEvent struct {
+	Event           UserDataEventType `json:"e"`
+	TransactionTime int64             `json:"T"`
+	Time            int64             `json:"E"`
+	AlgoUpdate      WsAlgoUpdate      `json:"o"`
+}
+
+// WsAllLiquidationOrderEvent define array of websocket liquidation order events
+//
+//easyjson:json
+type WsAllLiquidationOrderEvent []*WsLiquidationOrderEvent
+
+// WsAllMarkPriceEvent define array of websocket mark price events
+//
+//easyjson:json
+type WsAllMarkPriceEvent []*WsMarkPriceEvent
+
+// WsBalance define balance of an account update event
+//
+//easyjson:json
+type WsBalance struct {
+	Asset              string `json:"a"`
+	Balance            string `json:"wb"`
+	CrossWalletBalance string `json:"cw"`
+	ChangeBalance      string `json:"bc"`
+}
+
+// WsBookTickerEvent define websocket best bid/ask event
+//
+//easyjson:json
+type WsBookTickerEvent struct {
+	Event           string `json:"e"`
+	UpdateID        int64  `json:"u"`
+	Time            int64  `json:"E"`
+	TransactionTime int64  `json:"T"`
+	Symbol          string `json:"s"`
+	BestBidPrice    string `json:"b"`
+	BestBidQty      string `json:"B"`
+	BestAskPrice    string `json:"a"`
+	BestAskQty      string `json:"A"`
+}
+
+// WsCompositeIndexEvent define websocket composite index event
+//
+//easyjson:json
+type WsCompositeIndexEvent struct {
+	Event       string                `json:"e"`
+	Time        int64                 `json:"E"`
+	Symbol      string                `json:"s"`
+	Price       string                `json:"p"`
+	BaseAsset   string                `json:"C"`
+	Composition []WsCompositionWeight `json:"c"`
+}
+
+// WsCompositionWeight define a component of a composite index
+//
+//easyjson:json
+type WsCompositionWeight struct {
+	BaseAsset          string `json:"b"`
+	QuoteAsset         string `json:"q"`
+	WeightInQuantity   string `json:"w"`
+	WeightInPercentage string `json:"W"`
+	IndexPrice         string `json:"i"`
+}
+
+// WsContinuousKline define websocket continuous kline
+//
+//easyjson:json
+type WsContinuousKline struct {
+	StartTime            int64  `json:"t"`
+	EndTime              int64  `json:"T"`
+	Interval             string `json:"i"`
+	FirstTradeID         int64  `json:"f"`
+	LastTradeID          int64  `json:"L"`
+	Open                 string `json:"o"`
+	Close                string `json:"c"`
+	High                 string `json:"h"`
+	Low                  string `json:"l"`
+	Volume               string `json:"v"`
+	TradeNum             int64  `json:"n"`
+	IsFinal              bool   `json:"x"`
+	QuoteVolume          string `json:"q"`
+	ActiveBuyVolume      string `json:"V"`
+	ActiveBuyQuoteVolume string `json:"Q"`
+}
+
+// WsContinuousKlineEvent define websocket continuous kline event
+//
+//easyjson:json
+type WsContinuousKlineEvent struct {
+	Event        string            `json:"e"`
+	Time         int64             `json:"E"`
+	PairSymbol   string            `json:"ps"`
+	ContractType ContractType      `json:"ct"`
+	Kline        WsContinuousKline `json:"k"`
+}
+
+// WsContractInfoBracket define a notional bracket of a contract info event
+//
+//easyjson:json
+type WsContractInfoBracket struct {
+	Bracket          int64   `json:"bs"`
+	BracketNotionalF float64 `json:"bnf"`
+	BracketNotionalC float64 `json:"bnc"`
+	MaintMarginRatio float64 `json:"mmr"`
+	Cum              float64 `json:"cf"`
+	MinLeverage      int64   `json:"mi"`
+	MaxLeverage      int64   `json:"ma"`
+}
+
+// WsContractInfoEvent define websocket contract info event
+//
+//easyjson:json
+type WsContractInfoEvent struct {
+	Event        string                  `json:"e"`
+	Time         int64                   `json:"E"`
+	Symbol       string                  `json:"s"`
+	Pair         string                  `json:"ps"`
+	ContractType ContractType            `json:"ct"`
+	DeliveryDate int64                   `json:"dt"`
+	OnboardDate  int64                   `json:"ot"`
+	Status       string                  `json:"cs"`
+	Brackets     []WsContractInfoBracket `json:"bks"`
+}
+
+// WsDepthEvent define websocket diff depth event
+//
+//easyjson:json
+type WsDepthEvent struct {
+	Event            string `json:"e"`
+	Time             int64  `json:"E"`
+	TransactionTime  int64  `json:"T"`
+	Symbol           string `json:"s"`
+	FirstUpdateID    int64  `json:"U"`
+	LastUpdateID     int64  `json:"u"`
+	PrevLastUpdateID int64  `json:"pu"`
+	Bids             []Bid  `json:"b"`
+	Asks             []Ask  `json:"a"`
+}
+
+// WsKline define websocket kline
+//
+//easyjson:json
+type WsKline struct {
+	StartTime            int64  `json:"t"`
+	EndTime              int64  `json:"T"`
+	Symbol               string `json:"s"`
+	Interval             string `json:"i"`
+	FirstTradeID         int64  `json:"f"`
+	LastTradeID          int64  `json:"L"`
+	Open                 string `json:"o"`
+	Close                string `json:"c"`
+	High                 string `json:"h"`
+	Low                  string `json:"l"`
+	Volume               string `json:"v"`
+	TradeNum             int64  `json:"n"`
+	IsFinal              bool   `json:"x"`
+	QuoteVolume          string `json:"q"`
+	ActiveBuyVolume      string `json:"V"`
+	ActiveBuyQuoteVolume string `json:"Q"`
+}
+
+// WsKlineEvent define websocket kline event
+//
+//easyjson:json
+type WsKlineEvent struct {
+	Event  string  `json:"e"`
+	Time   int64   `json:"E"`
+	Symbol string  `json:"s"`
+	Kline  WsKline `json:"k"`
+}
+
+// WsLiquidationOrder define websocket liquidation order
+//
+//easyjson:json
+type WsLiquidationOrder struct {
+	Symbol               string          `json:"s"`
+	Side                 SideType        `json:"S"`
+	OrderType            OrderType       `json:"o"`
+	TimeInForce          TimeInForceType `json:"f"`
+	OrigQuantity         string          `json:"q"`
+	Price                string          `json:"p"`
+	AvgPrice             string          `json:"ap"`
+	OrderStatus          OrderStatusType `json:"X"`
+	LastFilledQty        string          `json:"l"`
+	AccumulatedFilledQty string          `json:"z"`
+	TradeTime            int64           `json:"T"`
+}
+
+// WsLiquidationOrderEvent define websocket liquidation order event
+//
+//easyjson:json
+type WsLiquidationOrderEvent struct {
+	Event            string             `json:"e"`
+	Time             int64              `json:"E"`
+	LiquidationOrder WsLiquidationOrder `json:"o"`
+}
+
+// WsListenKeyExpiredEvent define websocket listen key expired event
+//
+//easyjson:json
+type WsListenKeyExpiredEvent struct {
+	Event     UserDataEventType `json:"e"`
+	Time      int64             `json:"E"`
+	ListenKey string            `json:"listenKey"`
+}
+
+// WsMarginCallEvent define websocket margin call event
+//
+//easyjson:json
+type WsMarginCallEvent struct {
+	Event              UserDataEventType      `json:"e"`
+	Time               int64                  `json:"E"`
+	CrossWalletBalance string                 `json:"cw"`
+	Positions          []WsMarginCallPosition `json:"p"`
+}
+
+// WsMarginCallPosition define position of a margin call event
+//
+//easyjson:json
+type WsMarginCallPosition struct {
+	Symbol                    string           `json:"s"`
+	Side                      PositionSideType `json:"ps"`
+	Amount                    string           `json:"pa"`
+	MarginType                MarginType       `json:"mt"`
+	IsolatedWallet            string           `json:"iw"`
+	MarkPrice                 string           `json:"mp"`
+	UnrealizedPnL             string           `json:"up"`
+	MaintenanceMarginRequired string           `json:"mm"`
+}
+
+// WsMarkPriceEvent define websocket mark price event
+//
+//easyjson:json
+type WsMarkPriceEvent struct {
+	Event                string `json:"e"`
+	Time                 int64  `json:"E"`
+	Symbol               string `json:"s"`
+	MarkPrice            string `json:"p"`
+	IndexPrice           string `json:"i"`
+	EstimatedSettlePrice string `json:"P"`
+	FundingRate          string `json:"r"`
+	NextFundingTime      int64  `json:"T"`
+}
+
+// WsOrderTradeUpdate define order of an order trade update event
+//
+//easyjson:json
+type WsOrderTradeUpdate struct {
+	Symbol                  string             `json:"s"`
+	ClientOrderID           string             `json:"c"`
+	Side                    SideType           `json:"S"`
+	Type                    OrderType          `json:"o"`
+	TimeInForce             TimeInForceType    `json:"f"`
+	OriginalQty             string             `json:"q"`
+	OriginalPrice           string             `json:"p"`
+	AveragePrice            string             `json:"ap"`
+	StopPrice               string             `json:"sp"`
+	ExecutionType           OrderExecutionType `json:"x"`
+	Status                  OrderStatusType    `json:"X"`
+	ID                      int64              `json:"i"`
+	LastFilledQty           string             `json:"l"`
+	AccumulatedFilledQty    string             `json:"z"`
+	LastFilledPrice         string             `json:"L"`
+	CommissionAsset         string             `json:"N"`
+	Commission              string             `json:"n"`
+	TradeTime               int64              `json:"T"`
+	TradeID                 int64              `json:"t"`
+	BidsNotional            string             `json:"b"`
+	AsksNotional            string             `json:"a"`
+	IsMaker                 bool               `json:"m"`
+	IsReduceOnly            bool               `json:"R"`
+	WorkingType             WorkingType        `json:"wt"`
+	OriginalType            OrderType          `json:"ot"`
+	PositionSide            PositionSideType   `json:"ps"`
+	IsClosingPosition       bool               `json:"cp"`
+	ActivationPrice         string             `json:"AP"`
+	CallbackRate            string             `json:"cr"`
+	PriceProtect            bool               `json:"pP"`
+	RealizedPnL             string             `json:"rp"`
+	SelfTradePreventionMode string             `json:"V"`
+	PriceMatch              string             `json:"pm"`
+	GoodTillDate            int64              `json:"gtd"`
+}
+
+// WsOrderTradeUpdateEvent define websocket order trade update event
+//
+//easyjson:json
+type WsOrderTradeUpdateEvent struct {
+	Event            UserDataEventType  `json:"e"`
+	Time             int64              `json:"E"`
+	TransactionTime  int64              `json:"T"`
+	OrderTradeUpdate WsOrderTradeUpdate `json:"o"`
+}
+
+// WsPosition define position of an account update event
+//
+//easyjson:json
+type WsPosition struct {
+	Symbol              string           `json:"s"`
+	Amount              string           `json:"pa"`
+	EntryPrice          string           `json:"ep"`
+	BreakEvenPrice      string           `json:"bep"`
+	AccumulatedRealized string           `json:"cr"`
+	UnrealizedPnL       string           `json:"up"`
+	MarginType          MarginType       `json:"mt"`
+	IsolatedWallet      string           `json:"iw"`
+	Side                PositionSideType `json:"ps"`
+}
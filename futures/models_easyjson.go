@@ -18,7 +18,7 @@ var (
 	_ easyjson.Marshaler
 )
 
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures(in *jlexer.Lexer, out *UserLiquidationOrder) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures(in *jlexer.Lexer, out *WsPosition) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -32,125 +32,59 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures(in *jlexer.Lexer, ou
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "orderId":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.OrderId = int64(in.Int64())
-			}
-		case "symbol":
+		case "s":
 			if in.IsNull() {
 				in.Skip()
 			} else {
 				out.Symbol = string(in.String())
 			}
-		case "status":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.Status = OrderStatusType(in.String())
-			}
-		case "clientOrderId":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.ClientOrderId = string(in.String())
-			}
-		case "price":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.Price = string(in.String())
-			}
-		case "avgPrice":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.AveragePrice = string(in.String())
-			}
-		case "origQty":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.OrigQuantity = string(in.String())
-			}
-		case "executedQty":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.ExecutedQuantity = string(in.String())
-			}
-		case "cumQuote":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.CumQuote = string(in.String())
-			}
-		case "timeInForce":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.TimeInForce = TimeInForceType(in.String())
-			}
-		case "type":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.Type = OrderType(in.String())
-			}
-		case "reduceOnly":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.ReduceOnly = bool(in.Bool())
-			}
-		case "closePosition":
+		case "pa":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.ClosePosition = bool(in.Bool())
+				out.Amount = string(in.String())
 			}
-		case "side":
+		case "ep":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Side = SideType(in.String())
+				out.EntryPrice = string(in.String())
 			}
-		case "positionSide":
+		case "bep":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.PositionSide = PositionSideType(in.String())
+				out.BreakEvenPrice = string(in.String())
 			}
-		case "stopPrice":
+		case "cr":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.StopPrice = string(in.String())
+				out.AccumulatedRealized = string(in.String())
 			}
-		case "workingType":
+		case "up":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.WorkingType = WorkingType(in.String())
+				out.UnrealizedPnL = string(in.String())
 			}
-		case "origType":
+		case "mt":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.OrigType = string(in.String())
+				out.MarginType = MarginType(in.String())
 			}
-		case "time":
+		case "iw":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Time = int64(in.Int64())
+				out.IsolatedWallet = string(in.String())
 			}
-		case "updateTime":
+		case "ps":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.UpdateTime = int64(in.Int64())
+				out.Side = PositionSideType(in.String())
 			}
 		default:
 			in.SkipRecursive()
@@ -162,137 +96,82 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures(out *jwriter.Writer, in UserLiquidationOrder) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures(out *jwriter.Writer, in WsPosition) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"orderId\":"
+		const prefix string = ",\"s\":"
 		out.RawString(prefix[1:])
-		out.Int64(int64(in.OrderId))
-	}
-	{
-		const prefix string = ",\"symbol\":"
-		out.RawString(prefix)
 		out.String(string(in.Symbol))
 	}
 	{
-		const prefix string = ",\"status\":"
-		out.RawString(prefix)
-		out.String(string(in.Status))
-	}
-	{
-		const prefix string = ",\"clientOrderId\":"
-		out.RawString(prefix)
-		out.String(string(in.ClientOrderId))
-	}
-	{
-		const prefix string = ",\"price\":"
-		out.RawString(prefix)
-		out.String(string(in.Price))
-	}
-	{
-		const prefix string = ",\"avgPrice\":"
-		out.RawString(prefix)
-		out.String(string(in.AveragePrice))
-	}
-	{
-		const prefix string = ",\"origQty\":"
+		const prefix string = ",\"pa\":"
 		out.RawString(prefix)
-		out.String(string(in.OrigQuantity))
+		out.String(string(in.Amount))
 	}
 	{
-		const prefix string = ",\"executedQty\":"
+		const prefix string = ",\"ep\":"
 		out.RawString(prefix)
-		out.String(string(in.ExecutedQuantity))
+		out.String(string(in.EntryPrice))
 	}
 	{
-		const prefix string = ",\"cumQuote\":"
+		const prefix string = ",\"bep\":"
 		out.RawString(prefix)
-		out.String(string(in.CumQuote))
+		out.String(string(in.BreakEvenPrice))
 	}
 	{
-		const prefix string = ",\"timeInForce\":"
+		const prefix string = ",\"cr\":"
 		out.RawString(prefix)
-		out.String(string(in.TimeInForce))
+		out.String(string(in.AccumulatedRealized))
 	}
 	{
-		const prefix string = ",\"type\":"
+		const prefix string = ",\"up\":"
 		out.RawString(prefix)
-		out.String(string(in.Type))
+		out.String(string(in.UnrealizedPnL))
 	}
 	{
-		const prefix string = ",\"reduceOnly\":"
+		const prefix string = ",\"mt\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.ReduceOnly))
+		out.String(string(in.MarginType))
 	}
 	{
-		const prefix string = ",\"closePosition\":"
+		const prefix string = ",\"iw\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.ClosePosition))
+		out.String(string(in.IsolatedWallet))
 	}
 	{
-		const prefix string = ",\"side\":"
+		const prefix string = ",\"ps\":"
 		out.RawString(prefix)
 		out.String(string(in.Side))
 	}
-	{
-		const prefix string = ",\"positionSide\":"
-		out.RawString(prefix)
-		out.String(string(in.PositionSide))
-	}
-	{
-		const prefix string = ",\"stopPrice\":"
-		out.RawString(prefix)
-		out.String(string(in.StopPrice))
-	}
-	{
-		const prefix string = ",\"workingType\":"
-		out.RawString(prefix)
-		out.String(string(in.WorkingType))
-	}
-	{
-		const prefix string = ",\"origType\":"
-		out.RawString(prefix)
-		out.String(string(in.OrigType))
-	}
-	{
-		const prefix string = ",\"time\":"
-		out.RawString(prefix)
-		out.Int64(int64(in.Time))
-	}
-	{
-		const prefix string = ",\"updateTime\":"
-		out.RawString(prefix)
-		out.Int64(int64(in.UpdateTime))
-	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v UserLiquidationOrder) MarshalJSON() ([]byte, error) {
+func (v WsPosition) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v UserLiquidationOrder) MarshalEasyJSON(w *jwriter.Writer) {
+func (v WsPosition) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *UserLiquidationOrder) UnmarshalJSON(data []byte) error {
+func (v *WsPosition) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *UserLiquidationOrder) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *WsPosition) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures1(in *jlexer.Lexer, out *TraderSummaryResponse) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures1(in *jlexer.Lexer, out *WsOrderTradeUpdateEvent) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -306,35 +185,29 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures1(in *jlexer.Lexer, o
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "customerId":
+		case "e":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.CustomerId = string(in.String())
-			}
-		case "unit":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.Unit = string(in.String())
+				out.Event = UserDataEventType(in.String())
 			}
-		case "tradeVol":
+		case "E":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.TradeVol = string(in.String())
+				out.Time = int64(in.Int64())
 			}
-		case "rebateVol":
+		case "T":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.RebateVol = string(in.String())
+				out.TransactionTime = int64(in.Int64())
 			}
-		case "time":
+		case "o":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Time = int64(in.Int64())
+				(out.OrderTradeUpdate).UnmarshalEasyJSON(in)
 			}
 		default:
 			in.SkipRecursive()
@@ -346,62 +219,57 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures1(in *jlexer.Lexer, o
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures1(out *jwriter.Writer, in TraderSummaryResponse) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures1(out *jwriter.Writer, in WsOrderTradeUpdateEvent) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"customerId\":"
+		const prefix string = ",\"e\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.CustomerId))
-	}
-	{
-		const prefix string = ",\"unit\":"
-		out.RawString(prefix)
-		out.String(string(in.Unit))
+		out.String(string(in.Event))
 	}
 	{
-		const prefix string = ",\"tradeVol\":"
+		const prefix string = ",\"E\":"
 		out.RawString(prefix)
-		out.String(string(in.TradeVol))
+		out.Int64(int64(in.Time))
 	}
 	{
-		const prefix string = ",\"rebateVol\":"
+		const prefix string = ",\"T\":"
 		out.RawString(prefix)
-		out.String(string(in.RebateVol))
+		out.Int64(int64(in.TransactionTime))
 	}
 	{
-		const prefix string = ",\"time\":"
+		const prefix string = ",\"o\":"
 		out.RawString(prefix)
-		out.Int64(int64(in.Time))
+		(in.OrderTradeUpdate).MarshalEasyJSON(out)
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v TraderSummaryResponse) MarshalJSON() ([]byte, error) {
+func (v WsOrderTradeUpdateEvent) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures1(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v TraderSummaryResponse) MarshalEasyJSON(w *jwriter.Writer) {
+func (v WsOrderTradeUpdateEvent) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures1(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *TraderSummaryResponse) UnmarshalJSON(data []byte) error {
+func (v *WsOrderTradeUpdateEvent) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures1(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *TraderSummaryResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *WsOrderTradeUpdateEvent) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures1(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures2(in *jlexer.Lexer, out *TradeV3) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures2(in *jlexer.Lexer, out *WsOrderTradeUpdate) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -415,95 +283,4640 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures2(in *jlexer.Lexer, o
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "id":
+		case "s":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.ID = int64(in.Int64())
+				out.Symbol = string(in.String())
 			}
-		case "symbol":
+		case "c":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Symbol = string(in.String())
+				out.ClientOrderID = string(in.String())
 			}
-		case "orderId":
+		case "S":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.OrderID = int64(in.Int64())
+				out.Side = SideType(in.String())
 			}
-		case "price":
+		case "o":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Price = string(in.String())
+				out.Type = OrderType(in.String())
 			}
-		case "qty":
+		case "f":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Quantity = string(in.String())
+				out.TimeInForce = TimeInForceType(in.String())
 			}
-		case "quoteQty":
+		case "q":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.QuoteQuantity = string(in.String())
+				out.OriginalQty = string(in.String())
 			}
-		case "commission":
+		case "p":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Commission = string(in.String())
+				out.OriginalPrice = string(in.String())
 			}
-		case "commissionAsset":
+		case "ap":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.CommissionAsset = string(in.String())
+				out.AveragePrice = string(in.String())
 			}
-		case "time":
+		case "sp":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Time = int64(in.Int64())
+				out.StopPrice = string(in.String())
 			}
-		case "isBuyer":
+		case "x":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.IsBuyer = bool(in.Bool())
+				out.ExecutionType = OrderExecutionType(in.String())
 			}
-		case "isMaker":
+		case "X":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.IsMaker = bool(in.Bool())
+				out.Status = OrderStatusType(in.String())
 			}
-		case "isBestMatch":
+		case "i":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.IsBestMatch = bool(in.Bool())
+				out.ID = int64(in.Int64())
 			}
-		default:
-			in.SkipRecursive()
-		}
-		in.WantComma()
-	}
-	in.Delim('}')
-	if isTopLevel {
-		in.Consumed()
-	}
-}
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures2(out *jwriter.Writer, in TradeV3) {
-	out.RawByte('{')
-	first := true
-	_ = first
-	{
-		const prefix string = ",\"id\":"
-		out.RawString(prefix[1:])
+		case "l":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.LastFilledQty = string(in.String())
+			}
+		case "z":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.AccumulatedFilledQty = string(in.String())
+			}
+		case "L":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.LastFilledPrice = string(in.String())
+			}
+		case "N":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.CommissionAsset = string(in.String())
+			}
+		case "n":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Commission = string(in.String())
+			}
+		case "T":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TradeTime = int64(in.Int64())
+			}
+		case "t":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TradeID = int64(in.Int64())
+			}
+		case "b":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.BidsNotional = string(in.String())
+			}
+		case "a":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.AsksNotional = string(in.String())
+			}
+		case "m":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.IsMaker = bool(in.Bool())
+			}
+		case "R":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.IsReduceOnly = bool(in.Bool())
+			}
+		case "wt":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.WorkingType = WorkingType(in.String())
+			}
+		case "ot":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.OriginalType = OrderType(in.String())
+			}
+		case "ps":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.PositionSide = PositionSideType(in.String())
+			}
+		case "cp":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.IsClosingPosition = bool(in.Bool())
+			}
+		case "AP":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ActivationPrice = string(in.String())
+			}
+		case "cr":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.CallbackRate = string(in.String())
+			}
+		case "pP":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.PriceProtect = bool(in.Bool())
+			}
+		case "rp":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.RealizedPnL = string(in.String())
+			}
+		case "V":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.SelfTradePreventionMode = string(in.String())
+			}
+		case "pm":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.PriceMatch = string(in.String())
+			}
+		case "gtd":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.GoodTillDate = int64(in.Int64())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures2(out *jwriter.Writer, in WsOrderTradeUpdate) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"s\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Symbol))
+	}
+	{
+		const prefix string = ",\"c\":"
+		out.RawString(prefix)
+		out.String(string(in.ClientOrderID))
+	}
+	{
+		const prefix string = ",\"S\":"
+		out.RawString(prefix)
+		out.String(string(in.Side))
+	}
+	{
+		const prefix string = ",\"o\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"f\":"
+		out.RawString(prefix)
+		out.String(string(in.TimeInForce))
+	}
+	{
+		const prefix string = ",\"q\":"
+		out.RawString(prefix)
+		out.String(string(in.OriginalQty))
+	}
+	{
+		const prefix string = ",\"p\":"
+		out.RawString(prefix)
+		out.String(string(in.OriginalPrice))
+	}
+	{
+		const prefix string = ",\"ap\":"
+		out.RawString(prefix)
+		out.String(string(in.AveragePrice))
+	}
+	{
+		const prefix string = ",\"sp\":"
+		out.RawString(prefix)
+		out.String(string(in.StopPrice))
+	}
+	{
+		const prefix string = ",\"x\":"
+		out.RawString(prefix)
+		out.String(string(in.ExecutionType))
+	}
+	{
+		const prefix string = ",\"X\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	{
+		const prefix string = ",\"i\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.ID))
+	}
+	{
+		const prefix string = ",\"l\":"
+		out.RawString(prefix)
+		out.String(string(in.LastFilledQty))
+	}
+	{
+		const prefix string = ",\"z\":"
+		out.RawString(prefix)
+		out.String(string(in.AccumulatedFilledQty))
+	}
+	{
+		const prefix string = ",\"L\":"
+		out.RawString(prefix)
+		out.String(string(in.LastFilledPrice))
+	}
+	{
+		const prefix string = ",\"N\":"
+		out.RawString(prefix)
+		out.String(string(in.CommissionAsset))
+	}
+	{
+		const prefix string = ",\"n\":"
+		out.RawString(prefix)
+		out.String(string(in.Commission))
+	}
+	{
+		const prefix string = ",\"T\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.TradeTime))
+	}
+	{
+		const prefix string = ",\"t\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.TradeID))
+	}
+	{
+		const prefix string = ",\"b\":"
+		out.RawString(prefix)
+		out.String(string(in.BidsNotional))
+	}
+	{
+		const prefix string = ",\"a\":"
+		out.RawString(prefix)
+		out.String(string(in.AsksNotional))
+	}
+	{
+		const prefix string = ",\"m\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsMaker))
+	}
+	{
+		const prefix string = ",\"R\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsReduceOnly))
+	}
+	{
+		const prefix string = ",\"wt\":"
+		out.RawString(prefix)
+		out.String(string(in.WorkingType))
+	}
+	{
+		const prefix string = ",\"ot\":"
+		out.RawString(prefix)
+		out.String(string(in.OriginalType))
+	}
+	{
+		const prefix string = ",\"ps\":"
+		out.RawString(prefix)
+		out.String(string(in.PositionSide))
+	}
+	{
+		const prefix string = ",\"cp\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsClosingPosition))
+	}
+	{
+		const prefix string = ",\"AP\":"
+		out.RawString(prefix)
+		out.String(string(in.ActivationPrice))
+	}
+	{
+		const prefix string = ",\"cr\":"
+		out.RawString(prefix)
+		out.String(string(in.CallbackRate))
+	}
+	{
+		const prefix string = ",\"pP\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.PriceProtect))
+	}
+	{
+		const prefix string = ",\"rp\":"
+		out.RawString(prefix)
+		out.String(string(in.RealizedPnL))
+	}
+	{
+		const prefix string = ",\"V\":"
+		out.RawString(prefix)
+		out.String(string(in.SelfTradePreventionMode))
+	}
+	{
+		const prefix string = ",\"pm\":"
+		out.RawString(prefix)
+		out.String(string(in.PriceMatch))
+	}
+	{
+		const prefix string = ",\"gtd\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.GoodTillDate))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v WsOrderTradeUpdate) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v WsOrderTradeUpdate) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *WsOrderTradeUpdate) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *WsOrderTradeUpdate) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures2(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures3(in *jlexer.Lexer, out *WsMarkPriceEvent) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "e":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Event = string(in.String())
+			}
+		case "E":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Time = int64(in.Int64())
+			}
+		case "s":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Symbol = string(in.String())
+			}
+		case "p":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.MarkPrice = string(in.String())
+			}
+		case "i":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.IndexPrice = string(in.String())
+			}
+		case "P":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.EstimatedSettlePrice = string(in.String())
+			}
+		case "r":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.FundingRate = string(in.String())
+			}
+		case "T":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.NextFundingTime = int64(in.Int64())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures3(out *jwriter.Writer, in WsMarkPriceEvent) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"e\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Event))
+	}
+	{
+		const prefix string = ",\"E\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Time))
+	}
+	{
+		const prefix string = ",\"s\":"
+		out.RawString(prefix)
+		out.String(string(in.Symbol))
+	}
+	{
+		const prefix string = ",\"p\":"
+		out.RawString(prefix)
+		out.String(string(in.MarkPrice))
+	}
+	{
+		const prefix string = ",\"i\":"
+		out.RawString(prefix)
+		out.String(string(in.IndexPrice))
+	}
+	{
+		const prefix string = ",\"P\":"
+		out.RawString(prefix)
+		out.String(string(in.EstimatedSettlePrice))
+	}
+	{
+		const prefix string = ",\"r\":"
+		out.RawString(prefix)
+		out.String(string(in.FundingRate))
+	}
+	{
+		const prefix string = ",\"T\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.NextFundingTime))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v WsMarkPriceEvent) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v WsMarkPriceEvent) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *WsMarkPriceEvent) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *WsMarkPriceEvent) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures3(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures4(in *jlexer.Lexer, out *WsMarginCallPosition) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "s":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Symbol = string(in.String())
+			}
+		case "ps":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Side = PositionSideType(in.String())
+			}
+		case "pa":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Amount = string(in.String())
+			}
+		case "mt":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.MarginType = MarginType(in.String())
+			}
+		case "iw":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.IsolatedWallet = string(in.String())
+			}
+		case "mp":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.MarkPrice = string(in.String())
+			}
+		case "up":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.UnrealizedPnL = string(in.String())
+			}
+		case "mm":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.MaintenanceMarginRequired = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures4(out *jwriter.Writer, in WsMarginCallPosition) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"s\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Symbol))
+	}
+	{
+		const prefix string = ",\"ps\":"
+		out.RawString(prefix)
+		out.String(string(in.Side))
+	}
+	{
+		const prefix string = ",\"pa\":"
+		out.RawString(prefix)
+		out.String(string(in.Amount))
+	}
+	{
+		const prefix string = ",\"mt\":"
+		out.RawString(prefix)
+		out.String(string(in.MarginType))
+	}
+	{
+		const prefix string = ",\"iw\":"
+		out.RawString(prefix)
+		out.String(string(in.IsolatedWallet))
+	}
+	{
+		const prefix string = ",\"mp\":"
+		out.RawString(prefix)
+		out.String(string(in.MarkPrice))
+	}
+	{
+		const prefix string = ",\"up\":"
+		out.RawString(prefix)
+		out.String(string(in.UnrealizedPnL))
+	}
+	{
+		const prefix string = ",\"mm\":"
+		out.RawString(prefix)
+		out.String(string(in.MaintenanceMarginRequired))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v WsMarginCallPosition) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures4(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v WsMarginCallPosition) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures4(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *WsMarginCallPosition) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures4(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *WsMarginCallPosition) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures4(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures5(in *jlexer.Lexer, out *WsMarginCallEvent) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "e":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Event = UserDataEventType(in.String())
+			}
+		case "E":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Time = int64(in.Int64())
+			}
+		case "cw":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.CrossWalletBalance = string(in.String())
+			}
+		case "p":
+			if in.IsNull() {
+				in.Skip()
+				out.Positions = nil
+			} else {
+				in.Delim('[')
+				if out.Positions == nil {
+					if !in.IsDelim(']') {
+						out.Positions = make([]WsMarginCallPosition, 0, 0)
+					} else {
+						out.Positions = []WsMarginCallPosition{}
+					}
+				} else {
+					out.Positions = (out.Positions)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 WsMarginCallPosition
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v1).UnmarshalEasyJSON(in)
+					}
+					out.Positions = append(out.Positions, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures5(out *jwriter.Writer, in WsMarginCallEvent) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"e\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Event))
+	}
+	{
+		const prefix string = ",\"E\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Time))
+	}
+	{
+		const prefix string = ",\"cw\":"
+		out.RawString(prefix)
+		out.String(string(in.CrossWalletBalance))
+	}
+	{
+		const prefix string = ",\"p\":"
+		out.RawString(prefix)
+		if in.Positions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v2, v3 := range in.Positions {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				(v3).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v WsMarginCallEvent) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures5(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v WsMarginCallEvent) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures5(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *WsMarginCallEvent) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures5(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *WsMarginCallEvent) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures5(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures6(in *jlexer.Lexer, out *WsListenKeyExpiredEvent) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "e":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Event = UserDataEventType(in.String())
+			}
+		case "E":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Time = int64(in.Int64())
+			}
+		case "listenKey":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ListenKey = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures6(out *jwriter.Writer, in WsListenKeyExpiredEvent) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"e\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Event))
+	}
+	{
+		const prefix string = ",\"E\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Time))
+	}
+	{
+		const prefix string = ",\"listenKey\":"
+		out.RawString(prefix)
+		out.String(string(in.ListenKey))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v WsListenKeyExpiredEvent) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures6(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v WsListenKeyExpiredEvent) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures6(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *WsListenKeyExpiredEvent) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures6(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *WsListenKeyExpiredEvent) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures6(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures7(in *jlexer.Lexer, out *WsLiquidationOrderEvent) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "e":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Event = string(in.String())
+			}
+		case "E":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Time = int64(in.Int64())
+			}
+		case "o":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.LiquidationOrder).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures7(out *jwriter.Writer, in WsLiquidationOrderEvent) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"e\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Event))
+	}
+	{
+		const prefix string = ",\"E\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Time))
+	}
+	{
+		const prefix string = ",\"o\":"
+		out.RawString(prefix)
+		(in.LiquidationOrder).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v WsLiquidationOrderEvent) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures7(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v WsLiquidationOrderEvent) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures7(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *WsLiquidationOrderEvent) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures7(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *WsLiquidationOrderEvent) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures7(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures8(in *jlexer.Lexer, out *WsLiquidationOrder) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "s":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Symbol = string(in.String())
+			}
+		case "S":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Side = SideType(in.String())
+			}
+		case "o":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.OrderType = OrderType(in.String())
+			}
+		case "f":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TimeInForce = TimeInForceType(in.String())
+			}
+		case "q":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.OrigQuantity = string(in.String())
+			}
+		case "p":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Price = string(in.String())
+			}
+		case "ap":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.AvgPrice = string(in.String())
+			}
+		case "X":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.OrderStatus = OrderStatusType(in.String())
+			}
+		case "l":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.LastFilledQty = string(in.String())
+			}
+		case "z":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.AccumulatedFilledQty = string(in.String())
+			}
+		case "T":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TradeTime = int64(in.Int64())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures8(out *jwriter.Writer, in WsLiquidationOrder) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"s\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Symbol))
+	}
+	{
+		const prefix string = ",\"S\":"
+		out.RawString(prefix)
+		out.String(string(in.Side))
+	}
+	{
+		const prefix string = ",\"o\":"
+		out.RawString(prefix)
+		out.String(string(in.OrderType))
+	}
+	{
+		const prefix string = ",\"f\":"
+		out.RawString(prefix)
+		out.String(string(in.TimeInForce))
+	}
+	{
+		const prefix string = ",\"q\":"
+		out.RawString(prefix)
+		out.String(string(in.OrigQuantity))
+	}
+	{
+		const prefix string = ",\"p\":"
+		out.RawString(prefix)
+		out.String(string(in.Price))
+	}
+	{
+		const prefix string = ",\"ap\":"
+		out.RawString(prefix)
+		out.String(string(in.AvgPrice))
+	}
+	{
+		const prefix string = ",\"X\":"
+		out.RawString(prefix)
+		out.String(string(in.OrderStatus))
+	}
+	{
+		const prefix string = ",\"l\":"
+		out.RawString(prefix)
+		out.String(string(in.LastFilledQty))
+	}
+	{
+		const prefix string = ",\"z\":"
+		out.RawString(prefix)
+		out.String(string(in.AccumulatedFilledQty))
+	}
+	{
+		const prefix string = ",\"T\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.TradeTime))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v WsLiquidationOrder) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures8(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v WsLiquidationOrder) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures8(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *WsLiquidationOrder) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures8(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *WsLiquidationOrder) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures8(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures9(in *jlexer.Lexer, out *WsKlineEvent) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "e":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Event = string(in.String())
+			}
+		case "E":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Time = int64(in.Int64())
+			}
+		case "s":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Symbol = string(in.String())
+			}
+		case "k":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Kline).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures9(out *jwriter.Writer, in WsKlineEvent) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"e\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Event))
+	}
+	{
+		const prefix string = ",\"E\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Time))
+	}
+	{
+		const prefix string = ",\"s\":"
+		out.RawString(prefix)
+		out.String(string(in.Symbol))
+	}
+	{
+		const prefix string = ",\"k\":"
+		out.RawString(prefix)
+		(in.Kline).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v WsKlineEvent) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures9(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v WsKlineEvent) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures9(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *WsKlineEvent) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures9(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *WsKlineEvent) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures9(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures10(in *jlexer.Lexer, out *WsKline) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "t":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.StartTime = int64(in.Int64())
+			}
+		case "T":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.EndTime = int64(in.Int64())
+			}
+		case "s":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Symbol = string(in.String())
+			}
+		case "i":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Interval = string(in.String())
+			}
+		case "f":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.FirstTradeID = int64(in.Int64())
+			}
+		case "L":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.LastTradeID = int64(in.Int64())
+			}
+		case "o":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Open = string(in.String())
+			}
+		case "c":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Close = string(in.String())
+			}
+		case "h":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.High = string(in.String())
+			}
+		case "l":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Low = string(in.String())
+			}
+		case "v":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Volume = string(in.String())
+			}
+		case "n":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TradeNum = int64(in.Int64())
+			}
+		case "x":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.IsFinal = bool(in.Bool())
+			}
+		case "q":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.QuoteVolume = string(in.String())
+			}
+		case "V":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ActiveBuyVolume = string(in.String())
+			}
+		case "Q":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ActiveBuyQuoteVolume = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures10(out *jwriter.Writer, in WsKline) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"t\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.StartTime))
+	}
+	{
+		const prefix string = ",\"T\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.EndTime))
+	}
+	{
+		const prefix string = ",\"s\":"
+		out.RawString(prefix)
+		out.String(string(in.Symbol))
+	}
+	{
+		const prefix string = ",\"i\":"
+		out.RawString(prefix)
+		out.String(string(in.Interval))
+	}
+	{
+		const prefix string = ",\"f\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.FirstTradeID))
+	}
+	{
+		const prefix string = ",\"L\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.LastTradeID))
+	}
+	{
+		const prefix string = ",\"o\":"
+		out.RawString(prefix)
+		out.String(string(in.Open))
+	}
+	{
+		const prefix string = ",\"c\":"
+		out.RawString(prefix)
+		out.String(string(in.Close))
+	}
+	{
+		const prefix string = ",\"h\":"
+		out.RawString(prefix)
+		out.String(string(in.High))
+	}
+	{
+		const prefix string = ",\"l\":"
+		out.RawString(prefix)
+		out.String(string(in.Low))
+	}
+	{
+		const prefix string = ",\"v\":"
+		out.RawString(prefix)
+		out.String(string(in.Volume))
+	}
+	{
+		const prefix string = ",\"n\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.TradeNum))
+	}
+	{
+		const prefix string = ",\"x\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsFinal))
+	}
+	{
+		const prefix string = ",\"q\":"
+		out.RawString(prefix)
+		out.String(string(in.QuoteVolume))
+	}
+	{
+		const prefix string = ",\"V\":"
+		out.RawString(prefix)
+		out.String(string(in.ActiveBuyVolume))
+	}
+	{
+		const prefix string = ",\"Q\":"
+		out.RawString(prefix)
+		out.String(string(in.ActiveBuyQuoteVolume))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v WsKline) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures10(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v WsKline) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures10(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *WsKline) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures10(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *WsKline) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures10(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures11(in *jlexer.Lexer, out *WsDepthEvent) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "e":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Event = string(in.String())
+			}
+		case "E":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Time = int64(in.Int64())
+			}
+		case "T":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TransactionTime = int64(in.Int64())
+			}
+		case "s":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Symbol = string(in.String())
+			}
+		case "U":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.FirstUpdateID = int64(in.Int64())
+			}
+		case "u":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.LastUpdateID = int64(in.Int64())
+			}
+		case "pu":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.PrevLastUpdateID = int64(in.Int64())
+			}
+		case "b":
+			if in.IsNull() {
+				in.Skip()
+				out.Bids = nil
+			} else {
+				in.Delim('[')
+				if out.Bids == nil {
+					if !in.IsDelim(']') {
+						out.Bids = make([]common.PriceLevel, 0, 2)
+					} else {
+						out.Bids = []common.PriceLevel{}
+					}
+				} else {
+					out.Bids = (out.Bids)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v4 common.PriceLevel
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v4).UnmarshalEasyJSON(in)
+					}
+					out.Bids = append(out.Bids, v4)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "a":
+			if in.IsNull() {
+				in.Skip()
+				out.Asks = nil
+			} else {
+				in.Delim('[')
+				if out.Asks == nil {
+					if !in.IsDelim(']') {
+						out.Asks = make([]common.PriceLevel, 0, 2)
+					} else {
+						out.Asks = []common.PriceLevel{}
+					}
+				} else {
+					out.Asks = (out.Asks)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v5 common.PriceLevel
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v5).UnmarshalEasyJSON(in)
+					}
+					out.Asks = append(out.Asks, v5)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures11(out *jwriter.Writer, in WsDepthEvent) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"e\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Event))
+	}
+	{
+		const prefix string = ",\"E\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Time))
+	}
+	{
+		const prefix string = ",\"T\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.TransactionTime))
+	}
+	{
+		const prefix string = ",\"s\":"
+		out.RawString(prefix)
+		out.String(string(in.Symbol))
+	}
+	{
+		const prefix string = ",\"U\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.FirstUpdateID))
+	}
+	{
+		const prefix string = ",\"u\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.LastUpdateID))
+	}
+	{
+		const prefix string = ",\"pu\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.PrevLastUpdateID))
+	}
+	{
+		const prefix string = ",\"b\":"
+		out.RawString(prefix)
+		if in.Bids == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v6, v7 := range in.Bids {
+				if v6 > 0 {
+					out.RawByte(',')
+				}
+				(v7).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"a\":"
+		out.RawString(prefix)
+		if in.Asks == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v8, v9 := range in.Asks {
+				if v8 > 0 {
+					out.RawByte(',')
+				}
+				(v9).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v WsDepthEvent) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures11(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v WsDepthEvent) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures11(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *WsDepthEvent) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures11(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *WsDepthEvent) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures11(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures12(in *jlexer.Lexer, out *WsContractInfoEvent) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "e":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Event = string(in.String())
+			}
+		case "E":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Time = int64(in.Int64())
+			}
+		case "s":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Symbol = string(in.String())
+			}
+		case "ps":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Pair = string(in.String())
+			}
+		case "ct":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ContractType = ContractType(in.String())
+			}
+		case "dt":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.DeliveryDate = int64(in.Int64())
+			}
+		case "ot":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.OnboardDate = int64(in.Int64())
+			}
+		case "cs":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Status = string(in.String())
+			}
+		case "bks":
+			if in.IsNull() {
+				in.Skip()
+				out.Brackets = nil
+			} else {
+				in.Delim('[')
+				if out.Brackets == nil {
+					if !in.IsDelim(']') {
+						out.Brackets = make([]WsContractInfoBracket, 0, 1)
+					} else {
+						out.Brackets = []WsContractInfoBracket{}
+					}
+				} else {
+					out.Brackets = (out.Brackets)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v10 WsContractInfoBracket
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v10).UnmarshalEasyJSON(in)
+					}
+					out.Brackets = append(out.Brackets, v10)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures12(out *jwriter.Writer, in WsContractInfoEvent) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"e\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Event))
+	}
+	{
+		const prefix string = ",\"E\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Time))
+	}
+	{
+		const prefix string = ",\"s\":"
+		out.RawString(prefix)
+		out.String(string(in.Symbol))
+	}
+	{
+		const prefix string = ",\"ps\":"
+		out.RawString(prefix)
+		out.String(string(in.Pair))
+	}
+	{
+		const prefix string = ",\"ct\":"
+		out.RawString(prefix)
+		out.String(string(in.ContractType))
+	}
+	{
+		const prefix string = ",\"dt\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.DeliveryDate))
+	}
+	{
+		const prefix string = ",\"ot\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.OnboardDate))
+	}
+	{
+		const prefix string = ",\"cs\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	{
+		const prefix string = ",\"bks\":"
+		out.RawString(prefix)
+		if in.Brackets == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v11, v12 := range in.Brackets {
+				if v11 > 0 {
+					out.RawByte(',')
+				}
+				(v12).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v WsContractInfoEvent) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures12(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v WsContractInfoEvent) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures12(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *WsContractInfoEvent) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures12(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *WsContractInfoEvent) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures12(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures13(in *jlexer.Lexer, out *WsContractInfoBracket) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "bs":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Bracket = int64(in.Int64())
+			}
+		case "bnf":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.BracketNotionalF = float64(in.Float64())
+			}
+		case "bnc":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.BracketNotionalC = float64(in.Float64())
+			}
+		case "mmr":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.MaintMarginRatio = float64(in.Float64())
+			}
+		case "cf":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Cum = float64(in.Float64())
+			}
+		case "mi":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.MinLeverage = int64(in.Int64())
+			}
+		case "ma":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.MaxLeverage = int64(in.Int64())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures13(out *jwriter.Writer, in WsContractInfoBracket) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"bs\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.Bracket))
+	}
+	{
+		const prefix string = ",\"bnf\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.BracketNotionalF))
+	}
+	{
+		const prefix string = ",\"bnc\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.BracketNotionalC))
+	}
+	{
+		const prefix string = ",\"mmr\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.MaintMarginRatio))
+	}
+	{
+		const prefix string = ",\"cf\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Cum))
+	}
+	{
+		const prefix string = ",\"mi\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.MinLeverage))
+	}
+	{
+		const prefix string = ",\"ma\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.MaxLeverage))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v WsContractInfoBracket) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures13(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v WsContractInfoBracket) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures13(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *WsContractInfoBracket) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures13(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *WsContractInfoBracket) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures13(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures14(in *jlexer.Lexer, out *WsContinuousKlineEvent) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "e":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Event = string(in.String())
+			}
+		case "E":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Time = int64(in.Int64())
+			}
+		case "ps":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.PairSymbol = string(in.String())
+			}
+		case "ct":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ContractType = ContractType(in.String())
+			}
+		case "k":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Kline).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures14(out *jwriter.Writer, in WsContinuousKlineEvent) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"e\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Event))
+	}
+	{
+		const prefix string = ",\"E\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Time))
+	}
+	{
+		const prefix string = ",\"ps\":"
+		out.RawString(prefix)
+		out.String(string(in.PairSymbol))
+	}
+	{
+		const prefix string = ",\"ct\":"
+		out.RawString(prefix)
+		out.String(string(in.ContractType))
+	}
+	{
+		const prefix string = ",\"k\":"
+		out.RawString(prefix)
+		(in.Kline).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v WsContinuousKlineEvent) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures14(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v WsContinuousKlineEvent) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures14(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *WsContinuousKlineEvent) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures14(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *WsContinuousKlineEvent) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures14(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures15(in *jlexer.Lexer, out *WsContinuousKline) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "t":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.StartTime = int64(in.Int64())
+			}
+		case "T":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.EndTime = int64(in.Int64())
+			}
+		case "i":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Interval = string(in.String())
+			}
+		case "f":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.FirstTradeID = int64(in.Int64())
+			}
+		case "L":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.LastTradeID = int64(in.Int64())
+			}
+		case "o":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Open = string(in.String())
+			}
+		case "c":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Close = string(in.String())
+			}
+		case "h":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.High = string(in.String())
+			}
+		case "l":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Low = string(in.String())
+			}
+		case "v":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Volume = string(in.String())
+			}
+		case "n":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TradeNum = int64(in.Int64())
+			}
+		case "x":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.IsFinal = bool(in.Bool())
+			}
+		case "q":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.QuoteVolume = string(in.String())
+			}
+		case "V":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ActiveBuyVolume = string(in.String())
+			}
+		case "Q":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ActiveBuyQuoteVolume = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures15(out *jwriter.Writer, in WsContinuousKline) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"t\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.StartTime))
+	}
+	{
+		const prefix string = ",\"T\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.EndTime))
+	}
+	{
+		const prefix string = ",\"i\":"
+		out.RawString(prefix)
+		out.String(string(in.Interval))
+	}
+	{
+		const prefix string = ",\"f\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.FirstTradeID))
+	}
+	{
+		const prefix string = ",\"L\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.LastTradeID))
+	}
+	{
+		const prefix string = ",\"o\":"
+		out.RawString(prefix)
+		out.String(string(in.Open))
+	}
+	{
+		const prefix string = ",\"c\":"
+		out.RawString(prefix)
+		out.String(string(in.Close))
+	}
+	{
+		const prefix string = ",\"h\":"
+		out.RawString(prefix)
+		out.String(string(in.High))
+	}
+	{
+		const prefix string = ",\"l\":"
+		out.RawString(prefix)
+		out.String(string(in.Low))
+	}
+	{
+		const prefix string = ",\"v\":"
+		out.RawString(prefix)
+		out.String(string(in.Volume))
+	}
+	{
+		const prefix string = ",\"n\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.TradeNum))
+	}
+	{
+		const prefix string = ",\"x\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsFinal))
+	}
+	{
+		const prefix string = ",\"q\":"
+		out.RawString(prefix)
+		out.String(string(in.QuoteVolume))
+	}
+	{
+		const prefix string = ",\"V\":"
+		out.RawString(prefix)
+		out.String(string(in.ActiveBuyVolume))
+	}
+	{
+		const prefix string = ",\"Q\":"
+		out.RawString(prefix)
+		out.String(string(in.ActiveBuyQuoteVolume))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v WsContinuousKline) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures15(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v WsContinuousKline) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures15(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *WsContinuousKline) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures15(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *WsContinuousKline) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures15(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures16(in *jlexer.Lexer, out *WsCompositionWeight) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "b":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.BaseAsset = string(in.String())
+			}
+		case "q":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.QuoteAsset = string(in.String())
+			}
+		case "w":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.WeightInQuantity = string(in.String())
+			}
+		case "W":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.WeightInPercentage = string(in.String())
+			}
+		case "i":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.IndexPrice = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures16(out *jwriter.Writer, in WsCompositionWeight) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"b\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.BaseAsset))
+	}
+	{
+		const prefix string = ",\"q\":"
+		out.RawString(prefix)
+		out.String(string(in.QuoteAsset))
+	}
+	{
+		const prefix string = ",\"w\":"
+		out.RawString(prefix)
+		out.String(string(in.WeightInQuantity))
+	}
+	{
+		const prefix string = ",\"W\":"
+		out.RawString(prefix)
+		out.String(string(in.WeightInPercentage))
+	}
+	{
+		const prefix string = ",\"i\":"
+		out.RawString(prefix)
+		out.String(string(in.IndexPrice))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v WsCompositionWeight) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures16(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v WsCompositionWeight) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures16(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *WsCompositionWeight) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures16(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *WsCompositionWeight) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures16(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures17(in *jlexer.Lexer, out *WsCompositeIndexEvent) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "e":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Event = string(in.String())
+			}
+		case "E":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Time = int64(in.Int64())
+			}
+		case "s":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Symbol = string(in.String())
+			}
+		case "p":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Price = string(in.String())
+			}
+		case "C":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.BaseAsset = string(in.String())
+			}
+		case "c":
+			if in.IsNull() {
+				in.Skip()
+				out.Composition = nil
+			} else {
+				in.Delim('[')
+				if out.Composition == nil {
+					if !in.IsDelim(']') {
+						out.Composition = make([]WsCompositionWeight, 0, 0)
+					} else {
+						out.Composition = []WsCompositionWeight{}
+					}
+				} else {
+					out.Composition = (out.Composition)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v13 WsCompositionWeight
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v13).UnmarshalEasyJSON(in)
+					}
+					out.Composition = append(out.Composition, v13)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures17(out *jwriter.Writer, in WsCompositeIndexEvent) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"e\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Event))
+	}
+	{
+		const prefix string = ",\"E\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Time))
+	}
+	{
+		const prefix string = ",\"s\":"
+		out.RawString(prefix)
+		out.String(string(in.Symbol))
+	}
+	{
+		const prefix string = ",\"p\":"
+		out.RawString(prefix)
+		out.String(string(in.Price))
+	}
+	{
+		const prefix string = ",\"C\":"
+		out.RawString(prefix)
+		out.String(string(in.BaseAsset))
+	}
+	{
+		const prefix string = ",\"c\":"
+		out.RawString(prefix)
+		if in.Composition == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v14, v15 := range in.Composition {
+				if v14 > 0 {
+					out.RawByte(',')
+				}
+				(v15).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v WsCompositeIndexEvent) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures17(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v WsCompositeIndexEvent) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures17(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *WsCompositeIndexEvent) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures17(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *WsCompositeIndexEvent) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures17(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures18(in *jlexer.Lexer, out *WsBookTickerEvent) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "e":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Event = string(in.String())
+			}
+		case "u":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.UpdateID = int64(in.Int64())
+			}
+		case "E":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Time = int64(in.Int64())
+			}
+		case "T":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TransactionTime = int64(in.Int64())
+			}
+		case "s":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Symbol = string(in.String())
+			}
+		case "b":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.BestBidPrice = string(in.String())
+			}
+		case "B":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.BestBidQty = string(in.String())
+			}
+		case "a":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.BestAskPrice = string(in.String())
+			}
+		case "A":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.BestAskQty = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures18(out *jwriter.Writer, in WsBookTickerEvent) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"e\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Event))
+	}
+	{
+		const prefix string = ",\"u\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.UpdateID))
+	}
+	{
+		const prefix string = ",\"E\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Time))
+	}
+	{
+		const prefix string = ",\"T\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.TransactionTime))
+	}
+	{
+		const prefix string = ",\"s\":"
+		out.RawString(prefix)
+		out.String(string(in.Symbol))
+	}
+	{
+		const prefix string = ",\"b\":"
+		out.RawString(prefix)
+		out.String(string(in.BestBidPrice))
+	}
+	{
+		const prefix string = ",\"B\":"
+		out.RawString(prefix)
+		out.String(string(in.BestBidQty))
+	}
+	{
+		const prefix string = ",\"a\":"
+		out.RawString(prefix)
+		out.String(string(in.BestAskPrice))
+	}
+	{
+		const prefix string = ",\"A\":"
+		out.RawString(prefix)
+		out.String(string(in.BestAskQty))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v WsBookTickerEvent) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures18(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v WsBookTickerEvent) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures18(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *WsBookTickerEvent) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures18(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *WsBookTickerEvent) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures18(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures19(in *jlexer.Lexer, out *WsBalance) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "a":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Asset = string(in.String())
+			}
+		case "wb":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Balance = string(in.String())
+			}
+		case "cw":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.CrossWalletBalance = string(in.String())
+			}
+		case "bc":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ChangeBalance = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures19(out *jwriter.Writer, in WsBalance) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"a\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Asset))
+	}
+	{
+		const prefix string = ",\"wb\":"
+		out.RawString(prefix)
+		out.String(string(in.Balance))
+	}
+	{
+		const prefix string = ",\"cw\":"
+		out.RawString(prefix)
+		out.String(string(in.CrossWalletBalance))
+	}
+	{
+		const prefix string = ",\"bc\":"
+		out.RawString(prefix)
+		out.String(string(in.ChangeBalance))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v WsBalance) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures19(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v WsBalance) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures19(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *WsBalance) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures19(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *WsBalance) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures19(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures20(in *jlexer.Lexer, out *WsAllMarkPriceEvent) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+		*out = nil
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(WsAllMarkPriceEvent, 0, 8)
+			} else {
+				*out = WsAllMarkPriceEvent{}
+			}
+		} else {
+			*out = (*out)[:0]
+		}
+		for !in.IsDelim(']') {
+			var v16 *WsMarkPriceEvent
+			if in.IsNull() {
+				in.Skip()
+				v16 = nil
+			} else {
+				if v16 == nil {
+					v16 = new(WsMarkPriceEvent)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*v16).UnmarshalEasyJSON(in)
+				}
+			}
+			*out = append(*out, v16)
+			in.WantComma()
+		}
+		in.Delim(']')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures20(out *jwriter.Writer, in WsAllMarkPriceEvent) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v17, v18 := range in {
+			if v17 > 0 {
+				out.RawByte(',')
+			}
+			if v18 == nil {
+				out.RawString("null")
+			} else {
+				(*v18).MarshalEasyJSON(out)
+			}
+		}
+		out.RawByte(']')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v WsAllMarkPriceEvent) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures20(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v WsAllMarkPriceEvent) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures20(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *WsAllMarkPriceEvent) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures20(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *WsAllMarkPriceEvent) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures20(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures21(in *jlexer.Lexer, out *WsAllLiquidationOrderEvent) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+		*out = nil
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(WsAllLiquidationOrderEvent, 0, 8)
+			} else {
+				*out = WsAllLiquidationOrderEvent{}
+			}
+		} else {
+			*out = (*out)[:0]
+		}
+		for !in.IsDelim(']') {
+			var v19 *WsLiquidationOrderEvent
+			if in.IsNull() {
+				in.Skip()
+				v19 = nil
+			} else {
+				if v19 == nil {
+					v19 = new(WsLiquidationOrderEvent)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*v19).UnmarshalEasyJSON(in)
+				}
+			}
+			*out = append(*out, v19)
+			in.WantComma()
+		}
+		in.Delim(']')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures21(out *jwriter.Writer, in WsAllLiquidationOrderEvent) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v20, v21 := range in {
+			if v20 > 0 {
+				out.RawByte(',')
+			}
+			if v21 == nil {
+				out.RawString("null")
+			} else {
+				(*v21).MarshalEasyJSON(out)
+			}
+		}
+		out.RawByte(']')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v WsAllLiquidationOrderEvent) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures21(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v WsAllLiquidationOrderEvent) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures21(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *WsAllLiquidationOrderEvent) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures21(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *WsAllLiquidationOrderEvent) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures21(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures22(in *jlexer.Lexer, out *WsAlgoUpdateEvent) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "e":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Event = UserDataEventType(in.String())
+			}
+		case "T":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TransactionTime = int64(in.Int64())
+			}
+		case "E":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Time = int64(in.Int64())
+			}
+		case "o":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.AlgoUpdate).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures22(out *jwriter.Writer, in WsAlgoUpdateEvent) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"e\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Event))
+	}
+	{
+		const prefix string = ",\"T\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.TransactionTime))
+	}
+	{
+		const prefix string = ",\"E\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Time))
+	}
+	{
+		const prefix string = ",\"o\":"
+		out.RawString(prefix)
+		(in.AlgoUpdate).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v WsAlgoUpdateEvent) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures22(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v WsAlgoUpdateEvent) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures22(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *WsAlgoUpdateEvent) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures22(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *WsAlgoUpdateEvent) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures22(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures23(in *jlexer.Lexer, out *WsAlgoUpdate) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "caid":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ClientAlgoID = string(in.String())
+			}
+		case "aid":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.AlgoID = int64(in.Int64())
+			}
+		case "at":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.AlgoType = string(in.String())
+			}
+		case "o":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Type = OrderType(in.String())
+			}
+		case "s":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Symbol = string(in.String())
+			}
+		case "S":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Side = SideType(in.String())
+			}
+		case "ps":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.PositionSide = PositionSideType(in.String())
+			}
+		case "f":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TimeInForce = TimeInForceType(in.String())
+			}
+		case "q":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Quantity = string(in.String())
+			}
+		case "X":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Status = string(in.String())
+			}
+		case "ai":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ActualOrderID = string(in.String())
+			}
+		case "ap":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ActualPrice = string(in.String())
+			}
+		case "aq":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ActualQuantity = string(in.String())
+			}
+		case "act":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ActualOrderType = string(in.String())
+			}
+		case "tp":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TriggerPrice = string(in.String())
+			}
+		case "p":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Price = string(in.String())
+			}
+		case "V":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.SelfTradePreventionMode = string(in.String())
+			}
+		case "wt":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.WorkingType = WorkingType(in.String())
+			}
+		case "pm":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.PriceMatch = string(in.String())
+			}
+		case "cp":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ClosePosition = bool(in.Bool())
+			}
+		case "pP":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.PriceProtect = bool(in.Bool())
+			}
+		case "R":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ReduceOnly = bool(in.Bool())
+			}
+		case "tt":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TriggerTime = int64(in.Int64())
+			}
+		case "gtd":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.GoodTillDate = int64(in.Int64())
+			}
+		case "rm":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.RejectReason = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures23(out *jwriter.Writer, in WsAlgoUpdate) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"caid\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ClientAlgoID))
+	}
+	{
+		const prefix string = ",\"aid\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.AlgoID))
+	}
+	{
+		const prefix string = ",\"at\":"
+		out.RawString(prefix)
+		out.String(string(in.AlgoType))
+	}
+	{
+		const prefix string = ",\"o\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"s\":"
+		out.RawString(prefix)
+		out.String(string(in.Symbol))
+	}
+	{
+		const prefix string = ",\"S\":"
+		out.RawString(prefix)
+		out.String(string(in.Side))
+	}
+	{
+		const prefix string = ",\"ps\":"
+		out.RawString(prefix)
+		out.String(string(in.PositionSide))
+	}
+	{
+		const prefix string = ",\"f\":"
+		out.RawString(prefix)
+		out.String(string(in.TimeInForce))
+	}
+	{
+		const prefix string = ",\"q\":"
+		out.RawString(prefix)
+		out.String(string(in.Quantity))
+	}
+	{
+		const prefix string = ",\"X\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	{
+		const prefix string = ",\"ai\":"
+		out.RawString(prefix)
+		out.String(string(in.ActualOrderID))
+	}
+	{
+		const prefix string = ",\"ap\":"
+		out.RawString(prefix)
+		out.String(string(in.ActualPrice))
+	}
+	{
+		const prefix string = ",\"aq\":"
+		out.RawString(prefix)
+		out.String(string(in.ActualQuantity))
+	}
+	{
+		const prefix string = ",\"act\":"
+		out.RawString(prefix)
+		out.String(string(in.ActualOrderType))
+	}
+	{
+		const prefix string = ",\"tp\":"
+		out.RawString(prefix)
+		out.String(string(in.TriggerPrice))
+	}
+	{
+		const prefix string = ",\"p\":"
+		out.RawString(prefix)
+		out.String(string(in.Price))
+	}
+	{
+		const prefix string = ",\"V\":"
+		out.RawString(prefix)
+		out.String(string(in.SelfTradePreventionMode))
+	}
+	{
+		const prefix string = ",\"wt\":"
+		out.RawString(prefix)
+		out.String(string(in.WorkingType))
+	}
+	{
+		const prefix string = ",\"pm\":"
+		out.RawString(prefix)
+		out.String(string(in.PriceMatch))
+	}
+	{
+		const prefix string = ",\"cp\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.ClosePosition))
+	}
+	{
+		const prefix string = ",\"pP\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.PriceProtect))
+	}
+	{
+		const prefix string = ",\"R\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.ReduceOnly))
+	}
+	{
+		const prefix string = ",\"tt\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.TriggerTime))
+	}
+	{
+		const prefix string = ",\"gtd\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.GoodTillDate))
+	}
+	{
+		const prefix string = ",\"rm\":"
+		out.RawString(prefix)
+		out.String(string(in.RejectReason))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v WsAlgoUpdate) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures23(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v WsAlgoUpdate) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures23(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *WsAlgoUpdate) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures23(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *WsAlgoUpdate) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures23(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures24(in *jlexer.Lexer, out *WsAggTradeEvent) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "e":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Event = string(in.String())
+			}
+		case "E":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Time = int64(in.Int64())
+			}
+		case "s":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Symbol = string(in.String())
+			}
+		case "a":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.AggTradeID = int64(in.Int64())
+			}
+		case "p":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Price = string(in.String())
+			}
+		case "q":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Quantity = string(in.String())
+			}
+		case "f":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.FirstTradeID = int64(in.Int64())
+			}
+		case "l":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.LastTradeID = int64(in.Int64())
+			}
+		case "T":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Timestamp = int64(in.Int64())
+			}
+		case "m":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.IsBuyerMaker = bool(in.Bool())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures24(out *jwriter.Writer, in WsAggTradeEvent) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"e\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Event))
+	}
+	{
+		const prefix string = ",\"E\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Time))
+	}
+	{
+		const prefix string = ",\"s\":"
+		out.RawString(prefix)
+		out.String(string(in.Symbol))
+	}
+	{
+		const prefix string = ",\"a\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.AggTradeID))
+	}
+	{
+		const prefix string = ",\"p\":"
+		out.RawString(prefix)
+		out.String(string(in.Price))
+	}
+	{
+		const prefix string = ",\"q\":"
+		out.RawString(prefix)
+		out.String(string(in.Quantity))
+	}
+	{
+		const prefix string = ",\"f\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.FirstTradeID))
+	}
+	{
+		const prefix string = ",\"l\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.LastTradeID))
+	}
+	{
+		const prefix string = ",\"T\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Timestamp))
+	}
+	{
+		const prefix string = ",\"m\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsBuyerMaker))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v WsAggTradeEvent) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures24(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v WsAggTradeEvent) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures24(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *WsAggTradeEvent) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures24(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *WsAggTradeEvent) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures24(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures25(in *jlexer.Lexer, out *WsAccountUpdateEvent) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "e":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Event = UserDataEventType(in.String())
+			}
+		case "E":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Time = int64(in.Int64())
+			}
+		case "T":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TransactionTime = int64(in.Int64())
+			}
+		case "a":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.AccountUpdate).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures25(out *jwriter.Writer, in WsAccountUpdateEvent) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"e\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Event))
+	}
+	{
+		const prefix string = ",\"E\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Time))
+	}
+	{
+		const prefix string = ",\"T\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.TransactionTime))
+	}
+	{
+		const prefix string = ",\"a\":"
+		out.RawString(prefix)
+		(in.AccountUpdate).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v WsAccountUpdateEvent) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures25(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v WsAccountUpdateEvent) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures25(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *WsAccountUpdateEvent) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures25(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *WsAccountUpdateEvent) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures25(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures26(in *jlexer.Lexer, out *WsAccountUpdate) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "m":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Reason = UserDataEventReasonType(in.String())
+			}
+		case "B":
+			if in.IsNull() {
+				in.Skip()
+				out.Balances = nil
+			} else {
+				in.Delim('[')
+				if out.Balances == nil {
+					if !in.IsDelim(']') {
+						out.Balances = make([]WsBalance, 0, 1)
+					} else {
+						out.Balances = []WsBalance{}
+					}
+				} else {
+					out.Balances = (out.Balances)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v22 WsBalance
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v22).UnmarshalEasyJSON(in)
+					}
+					out.Balances = append(out.Balances, v22)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "P":
+			if in.IsNull() {
+				in.Skip()
+				out.Positions = nil
+			} else {
+				in.Delim('[')
+				if out.Positions == nil {
+					if !in.IsDelim(']') {
+						out.Positions = make([]WsPosition, 0, 0)
+					} else {
+						out.Positions = []WsPosition{}
+					}
+				} else {
+					out.Positions = (out.Positions)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v23 WsPosition
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v23).UnmarshalEasyJSON(in)
+					}
+					out.Positions = append(out.Positions, v23)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures26(out *jwriter.Writer, in WsAccountUpdate) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"m\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Reason))
+	}
+	{
+		const prefix string = ",\"B\":"
+		out.RawString(prefix)
+		if in.Balances == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v24, v25 := range in.Balances {
+				if v24 > 0 {
+					out.RawByte(',')
+				}
+				(v25).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"P\":"
+		out.RawString(prefix)
+		if in.Positions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v26, v27 := range in.Positions {
+				if v26 > 0 {
+					out.RawByte(',')
+				}
+				(v27).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v WsAccountUpdate) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures26(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v WsAccountUpdate) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures26(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *WsAccountUpdate) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures26(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *WsAccountUpdate) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures26(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures27(in *jlexer.Lexer, out *WsAccountConfigUpdateEvent) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "e":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Event = UserDataEventType(in.String())
+			}
+		case "E":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Time = int64(in.Int64())
+			}
+		case "T":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TransactionTime = int64(in.Int64())
+			}
+		case "ac":
+			if in.IsNull() {
+				in.Skip()
+				out.Leverage = nil
+			} else {
+				if out.Leverage == nil {
+					out.Leverage = new(WsAccountConfigLeverage)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.Leverage).UnmarshalEasyJSON(in)
+				}
+			}
+		case "ai":
+			if in.IsNull() {
+				in.Skip()
+				out.MultiAssets = nil
+			} else {
+				if out.MultiAssets == nil {
+					out.MultiAssets = new(WsAccountConfigMultiAssets)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.MultiAssets).UnmarshalEasyJSON(in)
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures27(out *jwriter.Writer, in WsAccountConfigUpdateEvent) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"e\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Event))
+	}
+	{
+		const prefix string = ",\"E\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Time))
+	}
+	{
+		const prefix string = ",\"T\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.TransactionTime))
+	}
+	if in.Leverage != nil {
+		const prefix string = ",\"ac\":"
+		out.RawString(prefix)
+		(*in.Leverage).MarshalEasyJSON(out)
+	}
+	if in.MultiAssets != nil {
+		const prefix string = ",\"ai\":"
+		out.RawString(prefix)
+		(*in.MultiAssets).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v WsAccountConfigUpdateEvent) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures27(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v WsAccountConfigUpdateEvent) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures27(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *WsAccountConfigUpdateEvent) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures27(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *WsAccountConfigUpdateEvent) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures27(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures28(in *jlexer.Lexer, out *WsAccountConfigMultiAssets) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "j":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.MultiAssets = bool(in.Bool())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures28(out *jwriter.Writer, in WsAccountConfigMultiAssets) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"j\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.MultiAssets))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v WsAccountConfigMultiAssets) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures28(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v WsAccountConfigMultiAssets) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures28(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *WsAccountConfigMultiAssets) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures28(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *WsAccountConfigMultiAssets) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures28(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures29(in *jlexer.Lexer, out *WsAccountConfigLeverage) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "s":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Symbol = string(in.String())
+			}
+		case "l":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Leverage = int(in.Int())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures29(out *jwriter.Writer, in WsAccountConfigLeverage) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"s\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Symbol))
+	}
+	{
+		const prefix string = ",\"l\":"
+		out.RawString(prefix)
+		out.Int(int(in.Leverage))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v WsAccountConfigLeverage) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures29(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v WsAccountConfigLeverage) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures29(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *WsAccountConfigLeverage) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures29(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *WsAccountConfigLeverage) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures29(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures30(in *jlexer.Lexer, out *UserLiquidationOrder) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "orderId":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.OrderId = int64(in.Int64())
+			}
+		case "symbol":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Symbol = string(in.String())
+			}
+		case "status":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Status = OrderStatusType(in.String())
+			}
+		case "clientOrderId":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ClientOrderId = string(in.String())
+			}
+		case "price":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Price = string(in.String())
+			}
+		case "avgPrice":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.AveragePrice = string(in.String())
+			}
+		case "origQty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.OrigQuantity = string(in.String())
+			}
+		case "executedQty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ExecutedQuantity = string(in.String())
+			}
+		case "cumQuote":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.CumQuote = string(in.String())
+			}
+		case "timeInForce":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TimeInForce = TimeInForceType(in.String())
+			}
+		case "type":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Type = OrderType(in.String())
+			}
+		case "reduceOnly":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ReduceOnly = bool(in.Bool())
+			}
+		case "closePosition":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ClosePosition = bool(in.Bool())
+			}
+		case "side":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Side = SideType(in.String())
+			}
+		case "positionSide":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.PositionSide = PositionSideType(in.String())
+			}
+		case "stopPrice":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.StopPrice = string(in.String())
+			}
+		case "workingType":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.WorkingType = WorkingType(in.String())
+			}
+		case "origType":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.OrigType = string(in.String())
+			}
+		case "time":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Time = int64(in.Int64())
+			}
+		case "updateTime":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.UpdateTime = int64(in.Int64())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures30(out *jwriter.Writer, in UserLiquidationOrder) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"orderId\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.OrderId))
+	}
+	{
+		const prefix string = ",\"symbol\":"
+		out.RawString(prefix)
+		out.String(string(in.Symbol))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	{
+		const prefix string = ",\"clientOrderId\":"
+		out.RawString(prefix)
+		out.String(string(in.ClientOrderId))
+	}
+	{
+		const prefix string = ",\"price\":"
+		out.RawString(prefix)
+		out.String(string(in.Price))
+	}
+	{
+		const prefix string = ",\"avgPrice\":"
+		out.RawString(prefix)
+		out.String(string(in.AveragePrice))
+	}
+	{
+		const prefix string = ",\"origQty\":"
+		out.RawString(prefix)
+		out.String(string(in.OrigQuantity))
+	}
+	{
+		const prefix string = ",\"executedQty\":"
+		out.RawString(prefix)
+		out.String(string(in.ExecutedQuantity))
+	}
+	{
+		const prefix string = ",\"cumQuote\":"
+		out.RawString(prefix)
+		out.String(string(in.CumQuote))
+	}
+	{
+		const prefix string = ",\"timeInForce\":"
+		out.RawString(prefix)
+		out.String(string(in.TimeInForce))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"reduceOnly\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.ReduceOnly))
+	}
+	{
+		const prefix string = ",\"closePosition\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.ClosePosition))
+	}
+	{
+		const prefix string = ",\"side\":"
+		out.RawString(prefix)
+		out.String(string(in.Side))
+	}
+	{
+		const prefix string = ",\"positionSide\":"
+		out.RawString(prefix)
+		out.String(string(in.PositionSide))
+	}
+	{
+		const prefix string = ",\"stopPrice\":"
+		out.RawString(prefix)
+		out.String(string(in.StopPrice))
+	}
+	{
+		const prefix string = ",\"workingType\":"
+		out.RawString(prefix)
+		out.String(string(in.WorkingType))
+	}
+	{
+		const prefix string = ",\"origType\":"
+		out.RawString(prefix)
+		out.String(string(in.OrigType))
+	}
+	{
+		const prefix string = ",\"time\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Time))
+	}
+	{
+		const prefix string = ",\"updateTime\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.UpdateTime))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v UserLiquidationOrder) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures30(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v UserLiquidationOrder) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures30(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *UserLiquidationOrder) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures30(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *UserLiquidationOrder) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures30(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures31(in *jlexer.Lexer, out *TraderSummaryResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "customerId":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.CustomerId = string(in.String())
+			}
+		case "unit":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Unit = string(in.String())
+			}
+		case "tradeVol":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TradeVol = string(in.String())
+			}
+		case "rebateVol":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.RebateVol = string(in.String())
+			}
+		case "time":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Time = int64(in.Int64())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures31(out *jwriter.Writer, in TraderSummaryResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"customerId\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.CustomerId))
+	}
+	{
+		const prefix string = ",\"unit\":"
+		out.RawString(prefix)
+		out.String(string(in.Unit))
+	}
+	{
+		const prefix string = ",\"tradeVol\":"
+		out.RawString(prefix)
+		out.String(string(in.TradeVol))
+	}
+	{
+		const prefix string = ",\"rebateVol\":"
+		out.RawString(prefix)
+		out.String(string(in.RebateVol))
+	}
+	{
+		const prefix string = ",\"time\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Time))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TraderSummaryResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures31(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TraderSummaryResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures31(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TraderSummaryResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures31(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TraderSummaryResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures31(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures32(in *jlexer.Lexer, out *TradeV3) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "id":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ID = int64(in.Int64())
+			}
+		case "symbol":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Symbol = string(in.String())
+			}
+		case "orderId":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.OrderID = int64(in.Int64())
+			}
+		case "price":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Price = string(in.String())
+			}
+		case "qty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Quantity = string(in.String())
+			}
+		case "quoteQty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.QuoteQuantity = string(in.String())
+			}
+		case "commission":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Commission = string(in.String())
+			}
+		case "commissionAsset":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.CommissionAsset = string(in.String())
+			}
+		case "time":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Time = int64(in.Int64())
+			}
+		case "isBuyer":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.IsBuyer = bool(in.Bool())
+			}
+		case "isMaker":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.IsMaker = bool(in.Bool())
+			}
+		case "isBestMatch":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.IsBestMatch = bool(in.Bool())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures32(out *jwriter.Writer, in TradeV3) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
 		out.Int64(int64(in.ID))
 	}
 	{
@@ -567,27 +4980,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures2(out *jwriter.Writer
 // MarshalJSON supports json.Marshaler interface
 func (v TradeV3) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures2(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures32(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v TradeV3) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures2(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures32(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *TradeV3) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures2(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures32(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *TradeV3) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures2(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures32(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures3(in *jlexer.Lexer, out *Trade) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures33(in *jlexer.Lexer, out *Trade) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -647,7 +5060,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures3(in *jlexer.Lexer, o
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures3(out *jwriter.Writer, in Trade) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures33(out *jwriter.Writer, in Trade) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -687,27 +5100,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures3(out *jwriter.Writer
 // MarshalJSON supports json.Marshaler interface
 func (v Trade) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures3(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures33(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Trade) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures3(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures33(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Trade) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures3(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures33(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Trade) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures3(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures33(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures4(in *jlexer.Lexer, out *SymbolPrice) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures34(in *jlexer.Lexer, out *SymbolPrice) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -743,7 +5156,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures4(in *jlexer.Lexer, o
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures4(out *jwriter.Writer, in SymbolPrice) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures34(out *jwriter.Writer, in SymbolPrice) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -763,27 +5176,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures4(out *jwriter.Writer
 // MarshalJSON supports json.Marshaler interface
 func (v SymbolPrice) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures4(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures34(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v SymbolPrice) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures4(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures34(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *SymbolPrice) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures4(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures34(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *SymbolPrice) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures4(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures34(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures5(in *jlexer.Lexer, out *SymbolLeverage) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures35(in *jlexer.Lexer, out *SymbolLeverage) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -825,7 +5238,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures5(in *jlexer.Lexer, o
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures5(out *jwriter.Writer, in SymbolLeverage) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures35(out *jwriter.Writer, in SymbolLeverage) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -850,27 +5263,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures5(out *jwriter.Writer
 // MarshalJSON supports json.Marshaler interface
 func (v SymbolLeverage) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures5(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures35(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v SymbolLeverage) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures5(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures35(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *SymbolLeverage) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures5(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures35(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *SymbolLeverage) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures5(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures35(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures6(in *jlexer.Lexer, out *Symbol) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures36(in *jlexer.Lexer, out *Symbol) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -978,13 +5391,13 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures6(in *jlexer.Lexer, o
 					out.UnderlyingSubType = (out.UnderlyingSubType)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v1 string
+					var v28 string
 					if in.IsNull() {
 						in.Skip()
 					} else {
-						v1 = string(in.String())
+						v28 = string(in.String())
 					}
-					out.UnderlyingSubType = append(out.UnderlyingSubType, v1)
+					out.UnderlyingSubType = append(out.UnderlyingSubType, v28)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -1017,13 +5430,13 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures6(in *jlexer.Lexer, o
 					out.OrderType = (out.OrderType)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v2 OrderType
+					var v29 OrderType
 					if in.IsNull() {
 						in.Skip()
 					} else {
-						v2 = OrderType(in.String())
+						v29 = OrderType(in.String())
 					}
-					out.OrderType = append(out.OrderType, v2)
+					out.OrderType = append(out.OrderType, v29)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -1044,13 +5457,13 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures6(in *jlexer.Lexer, o
 					out.TimeInForce = (out.TimeInForce)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v3 TimeInForceType
+					var v30 TimeInForceType
 					if in.IsNull() {
 						in.Skip()
 					} else {
-						v3 = TimeInForceType(in.String())
+						v30 = TimeInForceType(in.String())
 					}
-					out.TimeInForce = append(out.TimeInForce, v3)
+					out.TimeInForce = append(out.TimeInForce, v30)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -1071,29 +5484,29 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures6(in *jlexer.Lexer, o
 					out.Filters = (out.Filters)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v4 map[string]interface{}
+					var v31 map[string]interface{}
 					if in.IsNull() {
 						in.Skip()
 					} else {
 						in.Delim('{')
-						v4 = make(map[string]interface{})
+						v31 = make(map[string]interface{})
 						for !in.IsDelim('}') {
 							key := string(in.String())
 							in.WantColon()
-							var v5 interface{}
-							if m, ok := v5.(easyjson.Unmarshaler); ok {
+							var v32 interface{}
+							if m, ok := v32.(easyjson.Unmarshaler); ok {
 								m.UnmarshalEasyJSON(in)
-							} else if m, ok := v5.(json.Unmarshaler); ok {
+							} else if m, ok := v32.(json.Unmarshaler); ok {
 								_ = m.UnmarshalJSON(in.Raw())
 							} else {
-								v5 = in.Interface()
+								v32 = in.Interface()
 							}
-							(v4)[key] = v5
+							(v31)[key] = v32
 							in.WantComma()
 						}
 						in.Delim('}')
 					}
-					out.Filters = append(out.Filters, v4)
+					out.Filters = append(out.Filters, v31)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -1138,7 +5551,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures6(in *jlexer.Lexer, o
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures6(out *jwriter.Writer, in Symbol) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures36(out *jwriter.Writer, in Symbol) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1214,11 +5627,11 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures6(out *jwriter.Writer
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v6, v7 := range in.UnderlyingSubType {
-				if v6 > 0 {
+			for v33, v34 := range in.UnderlyingSubType {
+				if v33 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v7))
+				out.String(string(v34))
 			}
 			out.RawByte(']')
 		}
@@ -1240,11 +5653,11 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures6(out *jwriter.Writer
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v8, v9 := range in.OrderType {
-				if v8 > 0 {
+			for v35, v36 := range in.OrderType {
+				if v35 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v9))
+				out.String(string(v36))
 			}
 			out.RawByte(']')
 		}
@@ -1256,11 +5669,11 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures6(out *jwriter.Writer
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v10, v11 := range in.TimeInForce {
-				if v10 > 0 {
+			for v37, v38 := range in.TimeInForce {
+				if v37 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v11))
+				out.String(string(v38))
 			}
 			out.RawByte(']')
 		}
@@ -1272,29 +5685,29 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures6(out *jwriter.Writer
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v12, v13 := range in.Filters {
-				if v12 > 0 {
+			for v39, v40 := range in.Filters {
+				if v39 > 0 {
 					out.RawByte(',')
 				}
-				if v13 == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+				if v40 == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
 					out.RawString(`null`)
 				} else {
 					out.RawByte('{')
-					v14First := true
-					for v14Name, v14Value := range v13 {
-						if v14First {
-							v14First = false
+					v41First := true
+					for v41Name, v41Value := range v40 {
+						if v41First {
+							v41First = false
 						} else {
 							out.RawByte(',')
 						}
-						out.String(string(v14Name))
+						out.String(string(v41Name))
 						out.RawByte(':')
-						if m, ok := v14Value.(easyjson.Marshaler); ok {
+						if m, ok := v41Value.(easyjson.Marshaler); ok {
 							m.MarshalEasyJSON(out)
-						} else if m, ok := v14Value.(json.Marshaler); ok {
+						} else if m, ok := v41Value.(json.Marshaler); ok {
 							out.Raw(m.MarshalJSON())
 						} else {
-							out.Raw(json.Marshal(v14Value))
+							out.Raw(json.Marshal(v41Value))
 						}
 					}
 					out.RawByte('}')
@@ -1334,27 +5747,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures6(out *jwriter.Writer
 // MarshalJSON supports json.Marshaler interface
 func (v Symbol) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures6(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures36(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Symbol) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures6(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures36(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Symbol) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures6(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures36(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Symbol) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures6(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures36(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures7(in *jlexer.Lexer, out *ReferralOverviewResponse) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures37(in *jlexer.Lexer, out *ReferralOverviewResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1396,25 +5809,230 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures7(in *jlexer.Lexer, o
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Unit = string(in.String())
+				out.Unit = string(in.String())
+			}
+		case "totalTradeVol":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TotalTradeVol = string(in.String())
+			}
+		case "totalRebateVol":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TotalRebateVol = string(in.String())
+			}
+		case "time":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Time = int64(in.Int64())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures37(out *jwriter.Writer, in ReferralOverviewResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"brokerId\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.BrokerId))
+	}
+	{
+		const prefix string = ",\"newTraderRebateCommission\":"
+		out.RawString(prefix)
+		out.String(string(in.NewTraderRebateCommission))
+	}
+	{
+		const prefix string = ",\"oldTraderRebateCommission\":"
+		out.RawString(prefix)
+		out.String(string(in.OldTraderRebateCommission))
+	}
+	{
+		const prefix string = ",\"totalTradeUser\":"
+		out.RawString(prefix)
+		out.Int(int(in.TotalTradeUser))
+	}
+	{
+		const prefix string = ",\"unit\":"
+		out.RawString(prefix)
+		out.String(string(in.Unit))
+	}
+	{
+		const prefix string = ",\"totalTradeVol\":"
+		out.RawString(prefix)
+		out.String(string(in.TotalTradeVol))
+	}
+	{
+		const prefix string = ",\"totalRebateVol\":"
+		out.RawString(prefix)
+		out.String(string(in.TotalRebateVol))
+	}
+	{
+		const prefix string = ",\"time\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Time))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReferralOverviewResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures37(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReferralOverviewResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures37(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReferralOverviewResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures37(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReferralOverviewResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures37(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures38(in *jlexer.Lexer, out *RebateNewUser) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "brokerId":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.BrokerId = string(in.String())
+			}
+		case "rebateWorking":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.RebateWorking = bool(in.Bool())
+			}
+		case "ifNewUser":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.IfNewUser = bool(in.Bool())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures38(out *jwriter.Writer, in RebateNewUser) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"brokerId\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.BrokerId))
+	}
+	{
+		const prefix string = ",\"rebateWorking\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.RebateWorking))
+	}
+	{
+		const prefix string = ",\"ifNewUser\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IfNewUser))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RebateNewUser) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures38(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RebateNewUser) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures38(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RebateNewUser) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures38(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RebateNewUser) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures38(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures39(in *jlexer.Lexer, out *RateLimit) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "rateLimitType":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.RateLimitType = string(in.String())
 			}
-		case "totalTradeVol":
+		case "interval":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.TotalTradeVol = string(in.String())
+				out.Interval = string(in.String())
 			}
-		case "totalRebateVol":
+		case "intervalNum":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.TotalRebateVol = string(in.String())
+				out.IntervalNum = int64(in.Int64())
 			}
-		case "time":
+		case "limit":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Time = int64(in.Int64())
+				out.Limit = int64(in.Int64())
 			}
 		default:
 			in.SkipRecursive()
@@ -1426,77 +6044,57 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures7(in *jlexer.Lexer, o
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures7(out *jwriter.Writer, in ReferralOverviewResponse) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures39(out *jwriter.Writer, in RateLimit) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"brokerId\":"
+		const prefix string = ",\"rateLimitType\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.BrokerId))
-	}
-	{
-		const prefix string = ",\"newTraderRebateCommission\":"
-		out.RawString(prefix)
-		out.String(string(in.NewTraderRebateCommission))
-	}
-	{
-		const prefix string = ",\"oldTraderRebateCommission\":"
-		out.RawString(prefix)
-		out.String(string(in.OldTraderRebateCommission))
-	}
-	{
-		const prefix string = ",\"totalTradeUser\":"
-		out.RawString(prefix)
-		out.Int(int(in.TotalTradeUser))
-	}
-	{
-		const prefix string = ",\"unit\":"
-		out.RawString(prefix)
-		out.String(string(in.Unit))
+		out.String(string(in.RateLimitType))
 	}
 	{
-		const prefix string = ",\"totalTradeVol\":"
+		const prefix string = ",\"interval\":"
 		out.RawString(prefix)
-		out.String(string(in.TotalTradeVol))
+		out.String(string(in.Interval))
 	}
 	{
-		const prefix string = ",\"totalRebateVol\":"
+		const prefix string = ",\"intervalNum\":"
 		out.RawString(prefix)
-		out.String(string(in.TotalRebateVol))
+		out.Int64(int64(in.IntervalNum))
 	}
 	{
-		const prefix string = ",\"time\":"
+		const prefix string = ",\"limit\":"
 		out.RawString(prefix)
-		out.Int64(int64(in.Time))
+		out.Int64(int64(in.Limit))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ReferralOverviewResponse) MarshalJSON() ([]byte, error) {
+func (v RateLimit) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures7(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures39(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ReferralOverviewResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures7(w, v)
+func (v RateLimit) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures39(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ReferralOverviewResponse) UnmarshalJSON(data []byte) error {
+func (v *RateLimit) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures7(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures39(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ReferralOverviewResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures7(l, v)
+func (v *RateLimit) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures39(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures8(in *jlexer.Lexer, out *RebateNewUser) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures40(in *jlexer.Lexer, out *PriceFilter) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1510,23 +6108,23 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures8(in *jlexer.Lexer, o
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "brokerId":
+		case "maxPrice":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.BrokerId = string(in.String())
+				out.MaxPrice = string(in.String())
 			}
-		case "rebateWorking":
+		case "minPrice":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.RebateWorking = bool(in.Bool())
+				out.MinPrice = string(in.String())
 			}
-		case "ifNewUser":
+		case "tickSize":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.IfNewUser = bool(in.Bool())
+				out.TickSize = string(in.String())
 			}
 		default:
 			in.SkipRecursive()
@@ -1538,52 +6136,52 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures8(in *jlexer.Lexer, o
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures8(out *jwriter.Writer, in RebateNewUser) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures40(out *jwriter.Writer, in PriceFilter) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"brokerId\":"
+		const prefix string = ",\"maxPrice\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.BrokerId))
+		out.String(string(in.MaxPrice))
 	}
 	{
-		const prefix string = ",\"rebateWorking\":"
+		const prefix string = ",\"minPrice\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.RebateWorking))
+		out.String(string(in.MinPrice))
 	}
 	{
-		const prefix string = ",\"ifNewUser\":"
+		const prefix string = ",\"tickSize\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.IfNewUser))
+		out.String(string(in.TickSize))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v RebateNewUser) MarshalJSON() ([]byte, error) {
+func (v PriceFilter) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures8(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures40(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v RebateNewUser) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures8(w, v)
+func (v PriceFilter) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures40(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *RebateNewUser) UnmarshalJSON(data []byte) error {
+func (v *PriceFilter) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures8(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures40(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *RebateNewUser) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures8(l, v)
+func (v *PriceFilter) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures40(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures9(in *jlexer.Lexer, out *RateLimit) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures41(in *jlexer.Lexer, out *PriceChangeStats) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1597,91 +6195,236 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures9(in *jlexer.Lexer, o
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "rateLimitType":
+		case "symbol":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.RateLimitType = string(in.String())
+				out.Symbol = string(in.String())
 			}
-		case "interval":
+		case "priceChange":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Interval = string(in.String())
+				out.PriceChange = string(in.String())
 			}
-		case "intervalNum":
+		case "priceChangePercent":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.IntervalNum = int64(in.Int64())
+				out.PriceChangePercent = string(in.String())
 			}
-		case "limit":
+		case "weightedAvgPrice":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Limit = int64(in.Int64())
+				out.WeightedAvgPrice = string(in.String())
+			}
+		case "prevClosePrice":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.PrevClosePrice = string(in.String())
+			}
+		case "lastPrice":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.LastPrice = string(in.String())
+			}
+		case "lastQty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.LastQuantity = string(in.String())
+			}
+		case "openPrice":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.OpenPrice).UnmarshalJSON(data))
+				}
+			}
+		case "highPrice":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.HighPrice = string(in.String())
+			}
+		case "lowPrice":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.LowPrice = string(in.String())
+			}
+		case "volume":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Volume = string(in.String())
+			}
+		case "quoteVolume":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.QuoteVolume = string(in.String())
+			}
+		case "openTime":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.OpenTime = int64(in.Int64())
+			}
+		case "closeTime":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.CloseTime = int64(in.Int64())
+			}
+		case "firstId":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.FirstID = int64(in.Int64())
+			}
+		case "lastId":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.LastID = int64(in.Int64())
+			}
+		case "count":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Count = int64(in.Int64())
 			}
 		default:
 			in.SkipRecursive()
 		}
 		in.WantComma()
 	}
-	in.Delim('}')
-	if isTopLevel {
-		in.Consumed()
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures41(out *jwriter.Writer, in PriceChangeStats) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"symbol\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Symbol))
+	}
+	{
+		const prefix string = ",\"priceChange\":"
+		out.RawString(prefix)
+		out.String(string(in.PriceChange))
+	}
+	{
+		const prefix string = ",\"priceChangePercent\":"
+		out.RawString(prefix)
+		out.String(string(in.PriceChangePercent))
+	}
+	{
+		const prefix string = ",\"weightedAvgPrice\":"
+		out.RawString(prefix)
+		out.String(string(in.WeightedAvgPrice))
+	}
+	{
+		const prefix string = ",\"prevClosePrice\":"
+		out.RawString(prefix)
+		out.String(string(in.PrevClosePrice))
+	}
+	{
+		const prefix string = ",\"lastPrice\":"
+		out.RawString(prefix)
+		out.String(string(in.LastPrice))
+	}
+	{
+		const prefix string = ",\"lastQty\":"
+		out.RawString(prefix)
+		out.String(string(in.LastQuantity))
 	}
-}
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures9(out *jwriter.Writer, in RateLimit) {
-	out.RawByte('{')
-	first := true
-	_ = first
 	{
-		const prefix string = ",\"rateLimitType\":"
-		out.RawString(prefix[1:])
-		out.String(string(in.RateLimitType))
+		const prefix string = ",\"openPrice\":"
+		out.RawString(prefix)
+		out.Raw((in.OpenPrice).MarshalJSON())
 	}
 	{
-		const prefix string = ",\"interval\":"
+		const prefix string = ",\"highPrice\":"
 		out.RawString(prefix)
-		out.String(string(in.Interval))
+		out.String(string(in.HighPrice))
 	}
 	{
-		const prefix string = ",\"intervalNum\":"
+		const prefix string = ",\"lowPrice\":"
 		out.RawString(prefix)
-		out.Int64(int64(in.IntervalNum))
+		out.String(string(in.LowPrice))
 	}
 	{
-		const prefix string = ",\"limit\":"
+		const prefix string = ",\"volume\":"
 		out.RawString(prefix)
-		out.Int64(int64(in.Limit))
+		out.String(string(in.Volume))
+	}
+	{
+		const prefix string = ",\"quoteVolume\":"
+		out.RawString(prefix)
+		out.String(string(in.QuoteVolume))
+	}
+	{
+		const prefix string = ",\"openTime\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.OpenTime))
+	}
+	{
+		const prefix string = ",\"closeTime\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.CloseTime))
+	}
+	{
+		const prefix string = ",\"firstId\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.FirstID))
+	}
+	{
+		const prefix string = ",\"lastId\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.LastID))
+	}
+	{
+		const prefix string = ",\"count\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Count))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v RateLimit) MarshalJSON() ([]byte, error) {
+func (v PriceChangeStats) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures9(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures41(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v RateLimit) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures9(w, v)
+func (v PriceChangeStats) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures41(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *RateLimit) UnmarshalJSON(data []byte) error {
+func (v *PriceChangeStats) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures9(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures41(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *RateLimit) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures9(l, v)
+func (v *PriceChangeStats) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures41(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures10(in *jlexer.Lexer, out *PriceFilter) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures42(in *jlexer.Lexer, out *PremiumIndex) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1695,23 +6438,35 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures10(in *jlexer.Lexer,
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "maxPrice":
+		case "symbol":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.MaxPrice = string(in.String())
+				out.Symbol = string(in.String())
 			}
-		case "minPrice":
+		case "markPrice":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.MinPrice = string(in.String())
+				out.MarkPrice = string(in.String())
 			}
-		case "tickSize":
+		case "lastFundingRate":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.TickSize = string(in.String())
+				out.LastFundingRate = string(in.String())
+			}
+		case "nextFundingTime":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.NextFundingTime = int64(in.Int64())
+			}
+		case "time":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Time = int64(in.Int64())
 			}
 		default:
 			in.SkipRecursive()
@@ -1723,52 +6478,62 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures10(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures10(out *jwriter.Writer, in PriceFilter) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures42(out *jwriter.Writer, in PremiumIndex) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"maxPrice\":"
+		const prefix string = ",\"symbol\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.MaxPrice))
+		out.String(string(in.Symbol))
 	}
 	{
-		const prefix string = ",\"minPrice\":"
+		const prefix string = ",\"markPrice\":"
 		out.RawString(prefix)
-		out.String(string(in.MinPrice))
+		out.String(string(in.MarkPrice))
 	}
 	{
-		const prefix string = ",\"tickSize\":"
+		const prefix string = ",\"lastFundingRate\":"
 		out.RawString(prefix)
-		out.String(string(in.TickSize))
+		out.String(string(in.LastFundingRate))
+	}
+	{
+		const prefix string = ",\"nextFundingTime\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.NextFundingTime))
+	}
+	{
+		const prefix string = ",\"time\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Time))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v PriceFilter) MarshalJSON() ([]byte, error) {
+func (v PremiumIndex) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures10(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures42(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v PriceFilter) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures10(w, v)
+func (v PremiumIndex) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures42(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *PriceFilter) UnmarshalJSON(data []byte) error {
+func (v *PremiumIndex) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures10(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures42(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *PriceFilter) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures10(l, v)
+func (v *PremiumIndex) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures42(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures11(in *jlexer.Lexer, out *PriceChangeStats) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures43(in *jlexer.Lexer, out *PositionRisk) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1782,109 +6547,99 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures11(in *jlexer.Lexer,
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "symbol":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.Symbol = string(in.String())
-			}
-		case "priceChange":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.PriceChange = string(in.String())
-			}
-		case "priceChangePercent":
+		case "entryPrice":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.PriceChangePercent = string(in.String())
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.EntryPrice).UnmarshalJSON(data))
+				}
 			}
-		case "weightedAvgPrice":
+		case "breakEvenPrice":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.WeightedAvgPrice = string(in.String())
+				out.BreakEvenPrice = string(in.String())
 			}
-		case "prevClosePrice":
+		case "marginType":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.PrevClosePrice = string(in.String())
+				out.MarginType = string(in.String())
 			}
-		case "lastPrice":
+		case "isAutoAddMargin":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.LastPrice = string(in.String())
+				out.IsAutoAddMargin = string(in.String())
 			}
-		case "lastQty":
+		case "isolatedMargin":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.LastQuantity = string(in.String())
+				out.IsolatedMargin = string(in.String())
 			}
-		case "openPrice":
+		case "leverage":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				if data := in.Raw(); in.Ok() {
-					in.AddError((out.OpenPrice).UnmarshalJSON(data))
-				}
+				out.Leverage = string(in.String())
 			}
-		case "highPrice":
+		case "liquidationPrice":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.HighPrice = string(in.String())
+				out.LiquidationPrice = string(in.String())
 			}
-		case "lowPrice":
+		case "markPrice":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.LowPrice = string(in.String())
+				out.MarkPrice = string(in.String())
 			}
-		case "volume":
+		case "maxNotionalValue":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Volume = string(in.String())
+				out.MaxNotionalValue = string(in.String())
 			}
-		case "quoteVolume":
+		case "positionAmt":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.QuoteVolume = string(in.String())
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.PositionAmt).UnmarshalJSON(data))
+				}
 			}
-		case "openTime":
+		case "symbol":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.OpenTime = int64(in.Int64())
+				out.Symbol = string(in.String())
 			}
-		case "closeTime":
+		case "unRealizedProfit":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.CloseTime = int64(in.Int64())
+				out.UnRealizedProfit = string(in.String())
 			}
-		case "firstId":
+		case "positionSide":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.FirstID = int64(in.Int64())
+				out.PositionSide = string(in.String())
 			}
-		case "lastId":
+		case "notional":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.LastID = int64(in.Int64())
+				out.Notional = string(in.String())
 			}
-		case "count":
+		case "isolatedWallet":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Count = int64(in.Int64())
+				out.IsolatedWallet = string(in.String())
 			}
 		default:
 			in.SkipRecursive()
@@ -1896,122 +6651,112 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures11(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures11(out *jwriter.Writer, in PriceChangeStats) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures43(out *jwriter.Writer, in PositionRisk) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"symbol\":"
+		const prefix string = ",\"entryPrice\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Symbol))
-	}
-	{
-		const prefix string = ",\"priceChange\":"
-		out.RawString(prefix)
-		out.String(string(in.PriceChange))
-	}
-	{
-		const prefix string = ",\"priceChangePercent\":"
-		out.RawString(prefix)
-		out.String(string(in.PriceChangePercent))
+		out.Raw((in.EntryPrice).MarshalJSON())
 	}
 	{
-		const prefix string = ",\"weightedAvgPrice\":"
+		const prefix string = ",\"breakEvenPrice\":"
 		out.RawString(prefix)
-		out.String(string(in.WeightedAvgPrice))
+		out.String(string(in.BreakEvenPrice))
 	}
 	{
-		const prefix string = ",\"prevClosePrice\":"
+		const prefix string = ",\"marginType\":"
 		out.RawString(prefix)
-		out.String(string(in.PrevClosePrice))
+		out.String(string(in.MarginType))
 	}
 	{
-		const prefix string = ",\"lastPrice\":"
+		const prefix string = ",\"isAutoAddMargin\":"
 		out.RawString(prefix)
-		out.String(string(in.LastPrice))
+		out.String(string(in.IsAutoAddMargin))
 	}
 	{
-		const prefix string = ",\"lastQty\":"
+		const prefix string = ",\"isolatedMargin\":"
 		out.RawString(prefix)
-		out.String(string(in.LastQuantity))
+		out.String(string(in.IsolatedMargin))
 	}
 	{
-		const prefix string = ",\"openPrice\":"
+		const prefix string = ",\"leverage\":"
 		out.RawString(prefix)
-		out.Raw((in.OpenPrice).MarshalJSON())
+		out.String(string(in.Leverage))
 	}
 	{
-		const prefix string = ",\"highPrice\":"
+		const prefix string = ",\"liquidationPrice\":"
 		out.RawString(prefix)
-		out.String(string(in.HighPrice))
+		out.String(string(in.LiquidationPrice))
 	}
 	{
-		const prefix string = ",\"lowPrice\":"
+		const prefix string = ",\"markPrice\":"
 		out.RawString(prefix)
-		out.String(string(in.LowPrice))
+		out.String(string(in.MarkPrice))
 	}
 	{
-		const prefix string = ",\"volume\":"
+		const prefix string = ",\"maxNotionalValue\":"
 		out.RawString(prefix)
-		out.String(string(in.Volume))
+		out.String(string(in.MaxNotionalValue))
 	}
 	{
-		const prefix string = ",\"quoteVolume\":"
+		const prefix string = ",\"positionAmt\":"
 		out.RawString(prefix)
-		out.String(string(in.QuoteVolume))
+		out.Raw((in.PositionAmt).MarshalJSON())
 	}
 	{
-		const prefix string = ",\"openTime\":"
+		const prefix string = ",\"symbol\":"
 		out.RawString(prefix)
-		out.Int64(int64(in.OpenTime))
+		out.String(string(in.Symbol))
 	}
 	{
-		const prefix string = ",\"closeTime\":"
+		const prefix string = ",\"unRealizedProfit\":"
 		out.RawString(prefix)
-		out.Int64(int64(in.CloseTime))
+		out.String(string(in.UnRealizedProfit))
 	}
 	{
-		const prefix string = ",\"firstId\":"
+		const prefix string = ",\"positionSide\":"
 		out.RawString(prefix)
-		out.Int64(int64(in.FirstID))
+		out.String(string(in.PositionSide))
 	}
 	{
-		const prefix string = ",\"lastId\":"
+		const prefix string = ",\"notional\":"
 		out.RawString(prefix)
-		out.Int64(int64(in.LastID))
+		out.String(string(in.Notional))
 	}
 	{
-		const prefix string = ",\"count\":"
+		const prefix string = ",\"isolatedWallet\":"
 		out.RawString(prefix)
-		out.Int64(int64(in.Count))
+		out.String(string(in.IsolatedWallet))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v PriceChangeStats) MarshalJSON() ([]byte, error) {
+func (v PositionRisk) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures11(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures43(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v PriceChangeStats) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures11(w, v)
+func (v PositionRisk) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures43(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *PriceChangeStats) UnmarshalJSON(data []byte) error {
+func (v *PositionRisk) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures11(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures43(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *PriceChangeStats) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures11(l, v)
+func (v *PositionRisk) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures43(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures12(in *jlexer.Lexer, out *PremiumIndex) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures44(in *jlexer.Lexer, out *PositionMode) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2025,29 +6770,88 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures12(in *jlexer.Lexer,
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "symbol":
+		case "dualSidePosition":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Symbol = string(in.String())
+				out.DualSidePosition = bool(in.Bool())
 			}
-		case "markPrice":
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures44(out *jwriter.Writer, in PositionMode) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"dualSidePosition\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.DualSidePosition))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PositionMode) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures44(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PositionMode) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures44(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PositionMode) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures44(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PositionMode) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures44(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures45(in *jlexer.Lexer, out *PositionMarginHistory) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "amount":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.MarkPrice = string(in.String())
+				out.Amount = string(in.String())
 			}
-		case "lastFundingRate":
+		case "asset":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.LastFundingRate = string(in.String())
+				out.Asset = string(in.String())
 			}
-		case "nextFundingTime":
+		case "symbol":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.NextFundingTime = int64(in.Int64())
+				out.Symbol = string(in.String())
 			}
 		case "time":
 			if in.IsNull() {
@@ -2055,6 +6859,18 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures12(in *jlexer.Lexer,
 			} else {
 				out.Time = int64(in.Int64())
 			}
+		case "type":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Type = int(in.Int())
+			}
+		case "positionSide":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.PositionSide = string(in.String())
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -2065,62 +6881,67 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures12(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures12(out *jwriter.Writer, in PremiumIndex) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures45(out *jwriter.Writer, in PositionMarginHistory) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"symbol\":"
+		const prefix string = ",\"amount\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Symbol))
+		out.String(string(in.Amount))
 	}
 	{
-		const prefix string = ",\"markPrice\":"
+		const prefix string = ",\"asset\":"
 		out.RawString(prefix)
-		out.String(string(in.MarkPrice))
+		out.String(string(in.Asset))
 	}
 	{
-		const prefix string = ",\"lastFundingRate\":"
+		const prefix string = ",\"symbol\":"
 		out.RawString(prefix)
-		out.String(string(in.LastFundingRate))
+		out.String(string(in.Symbol))
 	}
 	{
-		const prefix string = ",\"nextFundingTime\":"
+		const prefix string = ",\"time\":"
 		out.RawString(prefix)
-		out.Int64(int64(in.NextFundingTime))
+		out.Int64(int64(in.Time))
 	}
 	{
-		const prefix string = ",\"time\":"
+		const prefix string = ",\"type\":"
 		out.RawString(prefix)
-		out.Int64(int64(in.Time))
+		out.Int(int(in.Type))
+	}
+	{
+		const prefix string = ",\"positionSide\":"
+		out.RawString(prefix)
+		out.String(string(in.PositionSide))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v PremiumIndex) MarshalJSON() ([]byte, error) {
+func (v PositionMarginHistory) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures12(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures45(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v PremiumIndex) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures12(w, v)
+func (v PositionMarginHistory) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures45(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *PremiumIndex) UnmarshalJSON(data []byte) error {
+func (v *PositionMarginHistory) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures12(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures45(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *PremiumIndex) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures12(l, v)
+func (v *PositionMarginHistory) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures45(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures13(in *jlexer.Lexer, out *PositionRisk) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures46(in *jlexer.Lexer, out *PercentPriceFilter) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2134,99 +6955,23 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures13(in *jlexer.Lexer,
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "entryPrice":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				if data := in.Raw(); in.Ok() {
-					in.AddError((out.EntryPrice).UnmarshalJSON(data))
-				}
-			}
-		case "breakEvenPrice":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.BreakEvenPrice = string(in.String())
-			}
-		case "marginType":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.MarginType = string(in.String())
-			}
-		case "isAutoAddMargin":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.IsAutoAddMargin = string(in.String())
-			}
-		case "isolatedMargin":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.IsolatedMargin = string(in.String())
-			}
-		case "leverage":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.Leverage = string(in.String())
-			}
-		case "liquidationPrice":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.LiquidationPrice = string(in.String())
-			}
-		case "markPrice":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.MarkPrice = string(in.String())
-			}
-		case "maxNotionalValue":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.MaxNotionalValue = string(in.String())
-			}
-		case "positionAmt":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				if data := in.Raw(); in.Ok() {
-					in.AddError((out.PositionAmt).UnmarshalJSON(data))
-				}
-			}
-		case "symbol":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.Symbol = string(in.String())
-			}
-		case "unRealizedProfit":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.UnRealizedProfit = string(in.String())
-			}
-		case "positionSide":
+		case "multiplierDecimal":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.PositionSide = string(in.String())
+				out.MultiplierDecimal = string(in.String())
 			}
-		case "notional":
+		case "multiplierUp":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Notional = string(in.String())
+				out.MultiplierUp = string(in.String())
 			}
-		case "isolatedWallet":
+		case "multiplierDown":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.IsolatedWallet = string(in.String())
+				out.MultiplierDown = string(in.String())
 			}
 		default:
 			in.SkipRecursive()
@@ -2238,112 +6983,52 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures13(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures13(out *jwriter.Writer, in PositionRisk) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures46(out *jwriter.Writer, in PercentPriceFilter) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"entryPrice\":"
+		const prefix string = ",\"multiplierDecimal\":"
 		out.RawString(prefix[1:])
-		out.Raw((in.EntryPrice).MarshalJSON())
-	}
-	{
-		const prefix string = ",\"breakEvenPrice\":"
-		out.RawString(prefix)
-		out.String(string(in.BreakEvenPrice))
-	}
-	{
-		const prefix string = ",\"marginType\":"
-		out.RawString(prefix)
-		out.String(string(in.MarginType))
-	}
-	{
-		const prefix string = ",\"isAutoAddMargin\":"
-		out.RawString(prefix)
-		out.String(string(in.IsAutoAddMargin))
-	}
-	{
-		const prefix string = ",\"isolatedMargin\":"
-		out.RawString(prefix)
-		out.String(string(in.IsolatedMargin))
-	}
-	{
-		const prefix string = ",\"leverage\":"
-		out.RawString(prefix)
-		out.String(string(in.Leverage))
-	}
-	{
-		const prefix string = ",\"liquidationPrice\":"
-		out.RawString(prefix)
-		out.String(string(in.LiquidationPrice))
-	}
-	{
-		const prefix string = ",\"markPrice\":"
-		out.RawString(prefix)
-		out.String(string(in.MarkPrice))
-	}
-	{
-		const prefix string = ",\"maxNotionalValue\":"
-		out.RawString(prefix)
-		out.String(string(in.MaxNotionalValue))
-	}
-	{
-		const prefix string = ",\"positionAmt\":"
-		out.RawString(prefix)
-		out.Raw((in.PositionAmt).MarshalJSON())
-	}
-	{
-		const prefix string = ",\"symbol\":"
-		out.RawString(prefix)
-		out.String(string(in.Symbol))
-	}
-	{
-		const prefix string = ",\"unRealizedProfit\":"
-		out.RawString(prefix)
-		out.String(string(in.UnRealizedProfit))
-	}
-	{
-		const prefix string = ",\"positionSide\":"
-		out.RawString(prefix)
-		out.String(string(in.PositionSide))
+		out.String(string(in.MultiplierDecimal))
 	}
 	{
-		const prefix string = ",\"notional\":"
+		const prefix string = ",\"multiplierUp\":"
 		out.RawString(prefix)
-		out.String(string(in.Notional))
+		out.String(string(in.MultiplierUp))
 	}
 	{
-		const prefix string = ",\"isolatedWallet\":"
+		const prefix string = ",\"multiplierDown\":"
 		out.RawString(prefix)
-		out.String(string(in.IsolatedWallet))
+		out.String(string(in.MultiplierDown))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v PositionRisk) MarshalJSON() ([]byte, error) {
+func (v PercentPriceFilter) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures13(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures46(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v PositionRisk) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures13(w, v)
+func (v PercentPriceFilter) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures46(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *PositionRisk) UnmarshalJSON(data []byte) error {
+func (v *PercentPriceFilter) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures13(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures46(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *PositionRisk) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures13(l, v)
+func (v *PercentPriceFilter) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures46(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures14(in *jlexer.Lexer, out *PositionMode) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures47(in *jlexer.Lexer, out *OrderAmendmentValue) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2357,11 +7042,17 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures14(in *jlexer.Lexer,
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "dualSidePosition":
+		case "before":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.DualSidePosition = bool(in.Bool())
+				out.Before = string(in.String())
+			}
+		case "after":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.After = string(in.String())
 			}
 		default:
 			in.SkipRecursive()
@@ -2373,42 +7064,47 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures14(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures14(out *jwriter.Writer, in PositionMode) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures47(out *jwriter.Writer, in OrderAmendmentValue) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"dualSidePosition\":"
+		const prefix string = ",\"before\":"
 		out.RawString(prefix[1:])
-		out.Bool(bool(in.DualSidePosition))
+		out.String(string(in.Before))
+	}
+	{
+		const prefix string = ",\"after\":"
+		out.RawString(prefix)
+		out.String(string(in.After))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v PositionMode) MarshalJSON() ([]byte, error) {
+func (v OrderAmendmentValue) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures14(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures47(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v PositionMode) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures14(w, v)
+func (v OrderAmendmentValue) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures47(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *PositionMode) UnmarshalJSON(data []byte) error {
+func (v *OrderAmendmentValue) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures14(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures47(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *PositionMode) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures14(l, v)
+func (v *OrderAmendmentValue) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures47(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures15(in *jlexer.Lexer, out *PositionMarginHistory) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures48(in *jlexer.Lexer, out *OrderAmendmentDetail) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2422,41 +7118,23 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures15(in *jlexer.Lexer,
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "amount":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.Amount = string(in.String())
-			}
-		case "asset":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.Asset = string(in.String())
-			}
-		case "symbol":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.Symbol = string(in.String())
-			}
-		case "time":
+		case "price":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Time = int64(in.Int64())
+				(out.Price).UnmarshalEasyJSON(in)
 			}
-		case "type":
+		case "origQty":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Type = int(in.Int())
+				(out.OrigQuantity).UnmarshalEasyJSON(in)
 			}
-		case "positionSide":
+		case "count":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.PositionSide = string(in.String())
+				out.Count = int(in.Int())
 			}
 		default:
 			in.SkipRecursive()
@@ -2468,67 +7146,52 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures15(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures15(out *jwriter.Writer, in PositionMarginHistory) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures48(out *jwriter.Writer, in OrderAmendmentDetail) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"amount\":"
+		const prefix string = ",\"price\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Amount))
-	}
-	{
-		const prefix string = ",\"asset\":"
-		out.RawString(prefix)
-		out.String(string(in.Asset))
-	}
-	{
-		const prefix string = ",\"symbol\":"
-		out.RawString(prefix)
-		out.String(string(in.Symbol))
-	}
-	{
-		const prefix string = ",\"time\":"
-		out.RawString(prefix)
-		out.Int64(int64(in.Time))
+		(in.Price).MarshalEasyJSON(out)
 	}
 	{
-		const prefix string = ",\"type\":"
+		const prefix string = ",\"origQty\":"
 		out.RawString(prefix)
-		out.Int(int(in.Type))
+		(in.OrigQuantity).MarshalEasyJSON(out)
 	}
 	{
-		const prefix string = ",\"positionSide\":"
+		const prefix string = ",\"count\":"
 		out.RawString(prefix)
-		out.String(string(in.PositionSide))
+		out.Int(int(in.Count))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v PositionMarginHistory) MarshalJSON() ([]byte, error) {
+func (v OrderAmendmentDetail) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures15(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures48(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v PositionMarginHistory) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures15(w, v)
+func (v OrderAmendmentDetail) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures48(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *PositionMarginHistory) UnmarshalJSON(data []byte) error {
+func (v *OrderAmendmentDetail) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures15(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures48(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *PositionMarginHistory) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures15(l, v)
+func (v *OrderAmendmentDetail) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures48(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures16(in *jlexer.Lexer, out *PercentPriceFilter) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures49(in *jlexer.Lexer, out *OrderAmendment) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2542,23 +7205,47 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures16(in *jlexer.Lexer,
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "multiplierDecimal":
+		case "amendmentId":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.MultiplierDecimal = string(in.String())
+				out.AmendmentID = int64(in.Int64())
 			}
-		case "multiplierUp":
+		case "symbol":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.MultiplierUp = string(in.String())
+				out.Symbol = string(in.String())
 			}
-		case "multiplierDown":
+		case "pair":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.MultiplierDown = string(in.String())
+				out.Pair = string(in.String())
+			}
+		case "orderId":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.OrderID = int64(in.Int64())
+			}
+		case "clientOrderId":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ClientOrderID = string(in.String())
+			}
+		case "time":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Time = int64(in.Int64())
+			}
+		case "amendment":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Amendment).UnmarshalEasyJSON(in)
 			}
 		default:
 			in.SkipRecursive()
@@ -2570,52 +7257,72 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures16(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures16(out *jwriter.Writer, in PercentPriceFilter) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures49(out *jwriter.Writer, in OrderAmendment) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"multiplierDecimal\":"
+		const prefix string = ",\"amendmentId\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.MultiplierDecimal))
+		out.Int64(int64(in.AmendmentID))
 	}
 	{
-		const prefix string = ",\"multiplierUp\":"
+		const prefix string = ",\"symbol\":"
 		out.RawString(prefix)
-		out.String(string(in.MultiplierUp))
+		out.String(string(in.Symbol))
 	}
 	{
-		const prefix string = ",\"multiplierDown\":"
+		const prefix string = ",\"pair\":"
 		out.RawString(prefix)
-		out.String(string(in.MultiplierDown))
+		out.String(string(in.Pair))
+	}
+	{
+		const prefix string = ",\"orderId\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.OrderID))
+	}
+	{
+		const prefix string = ",\"clientOrderId\":"
+		out.RawString(prefix)
+		out.String(string(in.ClientOrderID))
+	}
+	{
+		const prefix string = ",\"time\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Time))
+	}
+	{
+		const prefix string = ",\"amendment\":"
+		out.RawString(prefix)
+		(in.Amendment).MarshalEasyJSON(out)
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v PercentPriceFilter) MarshalJSON() ([]byte, error) {
+func (v OrderAmendment) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures16(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures49(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v PercentPriceFilter) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures16(w, v)
+func (v OrderAmendment) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures49(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *PercentPriceFilter) UnmarshalJSON(data []byte) error {
+func (v *OrderAmendment) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures16(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures49(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *PercentPriceFilter) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures16(l, v)
+func (v *OrderAmendment) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures49(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures17(in *jlexer.Lexer, out *Order) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures50(in *jlexer.Lexer, out *Order) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2781,6 +7488,12 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures17(in *jlexer.Lexer,
 			} else {
 				out.ClosePosition = bool(in.Bool())
 			}
+		case "priceMatch":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.PriceMatch = PriceMatchType(in.String())
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -2791,7 +7504,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures17(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures17(out *jwriter.Writer, in Order) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures50(out *jwriter.Writer, in Order) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -2913,7 +7626,12 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures17(out *jwriter.Write
 	{
 		const prefix string = ",\"closePosition\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.ClosePosition))
+		out.Bool(bool(in.ClosePosition))
+	}
+	{
+		const prefix string = ",\"priceMatch\":"
+		out.RawString(prefix)
+		out.String(string(in.PriceMatch))
 	}
 	out.RawByte('}')
 }
@@ -2921,27 +7639,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures17(out *jwriter.Write
 // MarshalJSON supports json.Marshaler interface
 func (v Order) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures17(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures50(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Order) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures17(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures50(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Order) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures17(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures50(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Order) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures17(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures50(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures18(in *jlexer.Lexer, out *OpenInterestStatistic) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures51(in *jlexer.Lexer, out *OpenInterestStatistic) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2989,7 +7707,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures18(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures18(out *jwriter.Writer, in OpenInterestStatistic) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures51(out *jwriter.Writer, in OpenInterestStatistic) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -3019,27 +7737,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures18(out *jwriter.Write
 // MarshalJSON supports json.Marshaler interface
 func (v OpenInterestStatistic) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures18(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures51(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v OpenInterestStatistic) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures18(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures51(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *OpenInterestStatistic) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures18(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures51(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *OpenInterestStatistic) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures18(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures51(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures19(in *jlexer.Lexer, out *OpenInterest) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures52(in *jlexer.Lexer, out *OpenInterest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3081,7 +7799,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures19(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures19(out *jwriter.Writer, in OpenInterest) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures52(out *jwriter.Writer, in OpenInterest) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -3106,27 +7824,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures19(out *jwriter.Write
 // MarshalJSON supports json.Marshaler interface
 func (v OpenInterest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures19(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures52(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v OpenInterest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures19(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures52(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *OpenInterest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures19(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures52(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *OpenInterest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures19(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures52(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures20(in *jlexer.Lexer, out *MultiAssetMode) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures53(in *jlexer.Lexer, out *MultiAssetMode) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3156,7 +7874,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures20(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures20(out *jwriter.Writer, in MultiAssetMode) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures53(out *jwriter.Writer, in MultiAssetMode) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -3171,27 +7889,191 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures20(out *jwriter.Write
 // MarshalJSON supports json.Marshaler interface
 func (v MultiAssetMode) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures20(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures53(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v MultiAssetMode) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures20(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures53(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *MultiAssetMode) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures20(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures53(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *MultiAssetMode) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures20(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures53(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures54(in *jlexer.Lexer, out *ModifyBatchOrdersResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "Orders":
+			if in.IsNull() {
+				in.Skip()
+				out.Orders = nil
+			} else {
+				in.Delim('[')
+				if out.Orders == nil {
+					if !in.IsDelim(']') {
+						out.Orders = make([]*Order, 0, 8)
+					} else {
+						out.Orders = []*Order{}
+					}
+				} else {
+					out.Orders = (out.Orders)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v42 *Order
+					if in.IsNull() {
+						in.Skip()
+						v42 = nil
+					} else {
+						if v42 == nil {
+							v42 = new(Order)
+						}
+						if in.IsNull() {
+							in.Skip()
+						} else {
+							(*v42).UnmarshalEasyJSON(in)
+						}
+					}
+					out.Orders = append(out.Orders, v42)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "Errors":
+			if in.IsNull() {
+				in.Skip()
+				out.Errors = nil
+			} else {
+				in.Delim('[')
+				if out.Errors == nil {
+					if !in.IsDelim(']') {
+						out.Errors = make([]*common.APIError, 0, 8)
+					} else {
+						out.Errors = []*common.APIError{}
+					}
+				} else {
+					out.Errors = (out.Errors)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v43 *common.APIError
+					if in.IsNull() {
+						in.Skip()
+						v43 = nil
+					} else {
+						if v43 == nil {
+							v43 = new(common.APIError)
+						}
+						if in.IsNull() {
+							in.Skip()
+						} else {
+							(*v43).UnmarshalEasyJSON(in)
+						}
+					}
+					out.Errors = append(out.Errors, v43)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures54(out *jwriter.Writer, in ModifyBatchOrdersResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Orders\":"
+		out.RawString(prefix[1:])
+		if in.Orders == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v44, v45 := range in.Orders {
+				if v44 > 0 {
+					out.RawByte(',')
+				}
+				if v45 == nil {
+					out.RawString("null")
+				} else {
+					(*v45).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"Errors\":"
+		out.RawString(prefix)
+		if in.Errors == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v46, v47 := range in.Errors {
+				if v46 > 0 {
+					out.RawByte(',')
+				}
+				if v47 == nil {
+					out.RawString("null")
+				} else {
+					(*v47).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures21(in *jlexer.Lexer, out *MinNotionalFilter) {
+
+// MarshalJSON supports json.Marshaler interface
+func (v ModifyBatchOrdersResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures54(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ModifyBatchOrdersResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures54(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ModifyBatchOrdersResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures54(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ModifyBatchOrdersResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures54(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures55(in *jlexer.Lexer, out *MinNotionalFilter) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3221,7 +8103,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures21(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures21(out *jwriter.Writer, in MinNotionalFilter) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures55(out *jwriter.Writer, in MinNotionalFilter) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -3236,27 +8118,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures21(out *jwriter.Write
 // MarshalJSON supports json.Marshaler interface
 func (v MinNotionalFilter) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures21(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures55(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v MinNotionalFilter) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures21(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures55(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *MinNotionalFilter) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures21(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures55(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *MinNotionalFilter) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures21(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures55(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures22(in *jlexer.Lexer, out *MaxNumOrdersFilter) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures56(in *jlexer.Lexer, out *MaxNumOrdersFilter) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3286,7 +8168,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures22(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures22(out *jwriter.Writer, in MaxNumOrdersFilter) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures56(out *jwriter.Writer, in MaxNumOrdersFilter) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -3301,27 +8183,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures22(out *jwriter.Write
 // MarshalJSON supports json.Marshaler interface
 func (v MaxNumOrdersFilter) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures22(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures56(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v MaxNumOrdersFilter) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures22(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures56(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *MaxNumOrdersFilter) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures22(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures56(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *MaxNumOrdersFilter) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures22(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures56(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures23(in *jlexer.Lexer, out *MaxNumAlgoOrdersFilter) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures57(in *jlexer.Lexer, out *MaxNumAlgoOrdersFilter) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3351,7 +8233,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures23(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures23(out *jwriter.Writer, in MaxNumAlgoOrdersFilter) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures57(out *jwriter.Writer, in MaxNumAlgoOrdersFilter) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -3366,27 +8248,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures23(out *jwriter.Write
 // MarshalJSON supports json.Marshaler interface
 func (v MaxNumAlgoOrdersFilter) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures23(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures57(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v MaxNumAlgoOrdersFilter) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures23(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures57(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *MaxNumAlgoOrdersFilter) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures23(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures57(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *MaxNumAlgoOrdersFilter) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures23(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures57(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures24(in *jlexer.Lexer, out *MarketLotSizeFilter) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures58(in *jlexer.Lexer, out *MarketLotSizeFilter) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3428,7 +8310,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures24(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures24(out *jwriter.Writer, in MarketLotSizeFilter) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures58(out *jwriter.Writer, in MarketLotSizeFilter) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -3453,27 +8335,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures24(out *jwriter.Write
 // MarshalJSON supports json.Marshaler interface
 func (v MarketLotSizeFilter) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures24(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures58(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v MarketLotSizeFilter) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures24(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures58(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *MarketLotSizeFilter) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures24(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures58(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *MarketLotSizeFilter) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures24(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures58(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures25(in *jlexer.Lexer, out *LotSizeFilter) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures59(in *jlexer.Lexer, out *LotSizeFilter) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3515,7 +8397,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures25(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures25(out *jwriter.Writer, in LotSizeFilter) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures59(out *jwriter.Writer, in LotSizeFilter) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -3540,27 +8422,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures25(out *jwriter.Write
 // MarshalJSON supports json.Marshaler interface
 func (v LotSizeFilter) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures25(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures59(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v LotSizeFilter) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures25(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures59(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *LotSizeFilter) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures25(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures59(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *LotSizeFilter) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures25(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures59(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures26(in *jlexer.Lexer, out *LongShortRatio) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures60(in *jlexer.Lexer, out *LongShortRatio) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3614,7 +8496,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures26(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures26(out *jwriter.Writer, in LongShortRatio) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures60(out *jwriter.Writer, in LongShortRatio) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -3649,27 +8531,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures26(out *jwriter.Write
 // MarshalJSON supports json.Marshaler interface
 func (v LongShortRatio) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures26(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures60(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v LongShortRatio) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures26(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures60(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *LongShortRatio) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures26(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures60(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *LongShortRatio) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures26(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures60(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures27(in *jlexer.Lexer, out *LiquidationOrder) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures61(in *jlexer.Lexer, out *LiquidationOrder) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3753,7 +8635,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures27(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures27(out *jwriter.Writer, in LiquidationOrder) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures61(out *jwriter.Writer, in LiquidationOrder) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -3813,27 +8695,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures27(out *jwriter.Write
 // MarshalJSON supports json.Marshaler interface
 func (v LiquidationOrder) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures27(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures61(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v LiquidationOrder) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures27(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures61(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *LiquidationOrder) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures27(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures61(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *LiquidationOrder) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures27(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures61(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures28(in *jlexer.Lexer, out *LeverageBracket) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures62(in *jlexer.Lexer, out *LeverageBracket) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3869,13 +8751,13 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures28(in *jlexer.Lexer,
 					out.Brackets = (out.Brackets)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v15 Bracket
+					var v48 Bracket
 					if in.IsNull() {
 						in.Skip()
 					} else {
-						(v15).UnmarshalEasyJSON(in)
+						(v48).UnmarshalEasyJSON(in)
 					}
-					out.Brackets = append(out.Brackets, v15)
+					out.Brackets = append(out.Brackets, v48)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -3890,7 +8772,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures28(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures28(out *jwriter.Writer, in LeverageBracket) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures62(out *jwriter.Writer, in LeverageBracket) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -3906,11 +8788,11 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures28(out *jwriter.Write
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v16, v17 := range in.Brackets {
-				if v16 > 0 {
+			for v49, v50 := range in.Brackets {
+				if v49 > 0 {
 					out.RawByte(',')
 				}
-				(v17).MarshalEasyJSON(out)
+				(v50).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -3921,27 +8803,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures28(out *jwriter.Write
 // MarshalJSON supports json.Marshaler interface
 func (v LeverageBracket) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures28(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures62(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v LeverageBracket) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures28(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures62(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *LeverageBracket) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures28(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures62(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *LeverageBracket) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures28(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures62(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures29(in *jlexer.Lexer, out *Kline) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures63(in *jlexer.Lexer, out *Kline) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4031,7 +8913,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures29(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures29(out *jwriter.Writer, in Kline) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures63(out *jwriter.Writer, in Kline) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -4096,27 +8978,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures29(out *jwriter.Write
 // MarshalJSON supports json.Marshaler interface
 func (v Kline) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures29(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures63(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Kline) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures29(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures63(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Kline) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures29(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures63(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Kline) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures29(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures63(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures30(in *jlexer.Lexer, out *IncomeHistory) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures64(in *jlexer.Lexer, out *IncomeHistory) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4188,7 +9070,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures30(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures30(out *jwriter.Writer, in IncomeHistory) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures64(out *jwriter.Writer, in IncomeHistory) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -4238,27 +9120,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures30(out *jwriter.Write
 // MarshalJSON supports json.Marshaler interface
 func (v IncomeHistory) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures30(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures64(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v IncomeHistory) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures30(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures64(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *IncomeHistory) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures30(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures64(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *IncomeHistory) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures30(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures64(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures31(in *jlexer.Lexer, out *FundingRate) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures65(in *jlexer.Lexer, out *FundingRate) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4306,7 +9188,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures31(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures31(out *jwriter.Writer, in FundingRate) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures65(out *jwriter.Writer, in FundingRate) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -4336,27 +9218,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures31(out *jwriter.Write
 // MarshalJSON supports json.Marshaler interface
 func (v FundingRate) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures31(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures65(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v FundingRate) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures31(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures65(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *FundingRate) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures31(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures65(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *FundingRate) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures31(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures65(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures32(in *jlexer.Lexer, out *ExchangeInfo) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures66(in *jlexer.Lexer, out *ExchangeInfo) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4398,13 +9280,13 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures32(in *jlexer.Lexer,
 					out.RateLimits = (out.RateLimits)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v18 RateLimit
+					var v51 RateLimit
 					if in.IsNull() {
 						in.Skip()
 					} else {
-						(v18).UnmarshalEasyJSON(in)
+						(v51).UnmarshalEasyJSON(in)
 					}
-					out.RateLimits = append(out.RateLimits, v18)
+					out.RateLimits = append(out.RateLimits, v51)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -4425,15 +9307,15 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures32(in *jlexer.Lexer,
 					out.ExchangeFilters = (out.ExchangeFilters)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v19 interface{}
-					if m, ok := v19.(easyjson.Unmarshaler); ok {
+					var v52 interface{}
+					if m, ok := v52.(easyjson.Unmarshaler); ok {
 						m.UnmarshalEasyJSON(in)
-					} else if m, ok := v19.(json.Unmarshaler); ok {
+					} else if m, ok := v52.(json.Unmarshaler); ok {
 						_ = m.UnmarshalJSON(in.Raw())
 					} else {
-						v19 = in.Interface()
+						v52 = in.Interface()
 					}
-					out.ExchangeFilters = append(out.ExchangeFilters, v19)
+					out.ExchangeFilters = append(out.ExchangeFilters, v52)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -4454,13 +9336,13 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures32(in *jlexer.Lexer,
 					out.Symbols = (out.Symbols)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v20 Symbol
+					var v53 Symbol
 					if in.IsNull() {
 						in.Skip()
 					} else {
-						(v20).UnmarshalEasyJSON(in)
+						(v53).UnmarshalEasyJSON(in)
 					}
-					out.Symbols = append(out.Symbols, v20)
+					out.Symbols = append(out.Symbols, v53)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -4475,7 +9357,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures32(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures32(out *jwriter.Writer, in ExchangeInfo) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures66(out *jwriter.Writer, in ExchangeInfo) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -4496,11 +9378,11 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures32(out *jwriter.Write
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v21, v22 := range in.RateLimits {
-				if v21 > 0 {
+			for v54, v55 := range in.RateLimits {
+				if v54 > 0 {
 					out.RawByte(',')
 				}
-				(v22).MarshalEasyJSON(out)
+				(v55).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -4512,16 +9394,16 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures32(out *jwriter.Write
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v23, v24 := range in.ExchangeFilters {
-				if v23 > 0 {
+			for v56, v57 := range in.ExchangeFilters {
+				if v56 > 0 {
 					out.RawByte(',')
 				}
-				if m, ok := v24.(easyjson.Marshaler); ok {
+				if m, ok := v57.(easyjson.Marshaler); ok {
 					m.MarshalEasyJSON(out)
-				} else if m, ok := v24.(json.Marshaler); ok {
+				} else if m, ok := v57.(json.Marshaler); ok {
 					out.Raw(m.MarshalJSON())
 				} else {
-					out.Raw(json.Marshal(v24))
+					out.Raw(json.Marshal(v57))
 				}
 			}
 			out.RawByte(']')
@@ -4534,11 +9416,11 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures32(out *jwriter.Write
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v25, v26 := range in.Symbols {
-				if v25 > 0 {
+			for v58, v59 := range in.Symbols {
+				if v58 > 0 {
 					out.RawByte(',')
 				}
-				(v26).MarshalEasyJSON(out)
+				(v59).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -4549,27 +9431,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures32(out *jwriter.Write
 // MarshalJSON supports json.Marshaler interface
 func (v ExchangeInfo) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures32(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures66(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v ExchangeInfo) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures32(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures66(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *ExchangeInfo) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures32(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures66(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *ExchangeInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures32(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures66(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures33(in *jlexer.Lexer, out *DepthResponse) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures67(in *jlexer.Lexer, out *DepthResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4617,13 +9499,13 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures33(in *jlexer.Lexer,
 					out.Bids = (out.Bids)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v27 common.PriceLevel
+					var v60 common.PriceLevel
 					if in.IsNull() {
 						in.Skip()
 					} else {
-						(v27).UnmarshalEasyJSON(in)
+						(v60).UnmarshalEasyJSON(in)
 					}
-					out.Bids = append(out.Bids, v27)
+					out.Bids = append(out.Bids, v60)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -4644,13 +9526,13 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures33(in *jlexer.Lexer,
 					out.Asks = (out.Asks)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v28 common.PriceLevel
+					var v61 common.PriceLevel
 					if in.IsNull() {
 						in.Skip()
 					} else {
-						(v28).UnmarshalEasyJSON(in)
+						(v61).UnmarshalEasyJSON(in)
 					}
-					out.Asks = append(out.Asks, v28)
+					out.Asks = append(out.Asks, v61)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -4665,7 +9547,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures33(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures33(out *jwriter.Writer, in DepthResponse) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures67(out *jwriter.Writer, in DepthResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -4691,11 +9573,11 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures33(out *jwriter.Write
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v29, v30 := range in.Bids {
-				if v29 > 0 {
+			for v62, v63 := range in.Bids {
+				if v62 > 0 {
 					out.RawByte(',')
 				}
-				(v30).MarshalEasyJSON(out)
+				(v63).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -4707,11 +9589,11 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures33(out *jwriter.Write
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v31, v32 := range in.Asks {
-				if v31 > 0 {
+			for v64, v65 := range in.Asks {
+				if v64 > 0 {
 					out.RawByte(',')
 				}
-				(v32).MarshalEasyJSON(out)
+				(v65).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -4722,27 +9604,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures33(out *jwriter.Write
 // MarshalJSON supports json.Marshaler interface
 func (v DepthResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures33(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures67(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v DepthResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures33(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures67(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *DepthResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures33(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures67(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *DepthResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures33(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures67(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures34(in *jlexer.Lexer, out *CreateOrderResponse) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures68(in *jlexer.Lexer, out *CreateOrderResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4904,7 +9786,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures34(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures34(out *jwriter.Writer, in CreateOrderResponse) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures68(out *jwriter.Writer, in CreateOrderResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -5029,27 +9911,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures34(out *jwriter.Write
 // MarshalJSON supports json.Marshaler interface
 func (v CreateOrderResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures34(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures68(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v CreateOrderResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures34(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures68(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *CreateOrderResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures34(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures68(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *CreateOrderResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures34(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures68(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures35(in *jlexer.Lexer, out *CreateBatchOrdersResponse) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures69(in *jlexer.Lexer, out *CreateBatchOrdersResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5079,21 +9961,21 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures35(in *jlexer.Lexer,
 					out.Orders = (out.Orders)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v33 *Order
+					var v66 *Order
 					if in.IsNull() {
 						in.Skip()
-						v33 = nil
+						v66 = nil
 					} else {
-						if v33 == nil {
-							v33 = new(Order)
+						if v66 == nil {
+							v66 = new(Order)
 						}
 						if in.IsNull() {
 							in.Skip()
 						} else {
-							(*v33).UnmarshalEasyJSON(in)
+							(*v66).UnmarshalEasyJSON(in)
 						}
 					}
-					out.Orders = append(out.Orders, v33)
+					out.Orders = append(out.Orders, v66)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -5108,7 +9990,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures35(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures35(out *jwriter.Writer, in CreateBatchOrdersResponse) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures69(out *jwriter.Writer, in CreateBatchOrdersResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -5119,14 +10001,14 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures35(out *jwriter.Write
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v34, v35 := range in.Orders {
-				if v34 > 0 {
+			for v67, v68 := range in.Orders {
+				if v67 > 0 {
 					out.RawByte(',')
 				}
-				if v35 == nil {
+				if v68 == nil {
 					out.RawString("null")
 				} else {
-					(*v35).MarshalEasyJSON(out)
+					(*v68).MarshalEasyJSON(out)
 				}
 			}
 			out.RawByte(']')
@@ -5138,27 +10020,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures35(out *jwriter.Write
 // MarshalJSON supports json.Marshaler interface
 func (v CreateBatchOrdersResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures35(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures69(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v CreateBatchOrdersResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures35(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures69(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *CreateBatchOrdersResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures35(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures69(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *CreateBatchOrdersResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures35(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures69(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures36(in *jlexer.Lexer, out *ContinuousKline) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures70(in *jlexer.Lexer, out *ContinuousKline) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5248,7 +10130,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures36(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures36(out *jwriter.Writer, in ContinuousKline) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures70(out *jwriter.Writer, in ContinuousKline) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -5313,27 +10195,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures36(out *jwriter.Write
 // MarshalJSON supports json.Marshaler interface
 func (v ContinuousKline) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures36(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures70(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v ContinuousKline) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures36(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures70(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *ContinuousKline) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures36(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures70(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *ContinuousKline) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures36(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures70(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures37(in *jlexer.Lexer, out *CommissionRate) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures71(in *jlexer.Lexer, out *CommissionRate) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5375,7 +10257,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures37(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures37(out *jwriter.Writer, in CommissionRate) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures71(out *jwriter.Writer, in CommissionRate) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -5400,27 +10282,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures37(out *jwriter.Write
 // MarshalJSON supports json.Marshaler interface
 func (v CommissionRate) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures37(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures71(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v CommissionRate) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures37(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures71(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *CommissionRate) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures37(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures71(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *CommissionRate) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures37(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures71(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures38(in *jlexer.Lexer, out *CloseAlgoOrderResponse) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures72(in *jlexer.Lexer, out *CloseAlgoOrderResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5468,7 +10350,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures38(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures38(out *jwriter.Writer, in CloseAlgoOrderResponse) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures72(out *jwriter.Writer, in CloseAlgoOrderResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -5498,27 +10380,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures38(out *jwriter.Write
 // MarshalJSON supports json.Marshaler interface
 func (v CloseAlgoOrderResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures38(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures72(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v CloseAlgoOrderResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures38(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures72(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *CloseAlgoOrderResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures38(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures72(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *CloseAlgoOrderResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures38(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures72(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures39(in *jlexer.Lexer, out *CancelOrderResponse) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures73(in *jlexer.Lexer, out *CancelOrderResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5668,7 +10550,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures39(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures39(out *jwriter.Writer, in CancelOrderResponse) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures73(out *jwriter.Writer, in CancelOrderResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -5783,27 +10665,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures39(out *jwriter.Write
 // MarshalJSON supports json.Marshaler interface
 func (v CancelOrderResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures39(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures73(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v CancelOrderResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures39(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures73(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *CancelOrderResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures39(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures73(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *CancelOrderResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures39(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures73(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures40(in *jlexer.Lexer, out *Bracket) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures74(in *jlexer.Lexer, out *Bracket) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5843,7 +10725,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures40(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures40(out *jwriter.Writer, in Bracket) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures74(out *jwriter.Writer, in Bracket) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -5863,27 +10745,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures40(out *jwriter.Write
 // MarshalJSON supports json.Marshaler interface
 func (v Bracket) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures40(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures74(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Bracket) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures40(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures74(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Bracket) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures40(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures74(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Bracket) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures40(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures74(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures41(in *jlexer.Lexer, out *BookTicker) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures75(in *jlexer.Lexer, out *BookTicker) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5937,7 +10819,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures41(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures41(out *jwriter.Writer, in BookTicker) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures75(out *jwriter.Writer, in BookTicker) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -5972,27 +10854,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures41(out *jwriter.Write
 // MarshalJSON supports json.Marshaler interface
 func (v BookTicker) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures41(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures75(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v BookTicker) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures41(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures75(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *BookTicker) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures41(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures75(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *BookTicker) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures41(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures75(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures42(in *jlexer.Lexer, out *Balance) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures76(in *jlexer.Lexer, out *Balance) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -6068,7 +10950,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures42(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures42(out *jwriter.Writer, in Balance) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures76(out *jwriter.Writer, in Balance) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -6113,27 +10995,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures42(out *jwriter.Write
 // MarshalJSON supports json.Marshaler interface
 func (v Balance) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures42(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures76(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Balance) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures42(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures76(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Balance) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures42(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures76(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Balance) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures42(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures76(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures43(in *jlexer.Lexer, out *AlgoOrders) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures77(in *jlexer.Lexer, out *AlgoOrders) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -6339,7 +11221,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures43(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures43(out *jwriter.Writer, in AlgoOrders) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures77(out *jwriter.Writer, in AlgoOrders) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -6505,27 +11387,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures43(out *jwriter.Write
 // MarshalJSON supports json.Marshaler interface
 func (v AlgoOrders) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures43(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures77(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v AlgoOrders) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures43(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures77(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *AlgoOrders) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures43(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures77(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *AlgoOrders) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures43(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures77(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures44(in *jlexer.Lexer, out *AggTrade) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures78(in *jlexer.Lexer, out *AggTrade) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -6591,7 +11473,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures44(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures44(out *jwriter.Writer, in AggTrade) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures78(out *jwriter.Writer, in AggTrade) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -6636,27 +11518,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures44(out *jwriter.Write
 // MarshalJSON supports json.Marshaler interface
 func (v AggTrade) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures44(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures78(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v AggTrade) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures44(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures78(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *AggTrade) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures44(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures78(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *AggTrade) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures44(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures78(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures45(in *jlexer.Lexer, out *AccountTrade) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures79(in *jlexer.Lexer, out *AccountTrade) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -6770,7 +11652,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures45(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures45(out *jwriter.Writer, in AccountTrade) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures79(out *jwriter.Writer, in AccountTrade) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -6850,27 +11732,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures45(out *jwriter.Write
 // MarshalJSON supports json.Marshaler interface
 func (v AccountTrade) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures45(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures79(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v AccountTrade) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures45(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures79(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *AccountTrade) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures45(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures79(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *AccountTrade) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures45(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures79(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures46(in *jlexer.Lexer, out *AccountPosition) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures80(in *jlexer.Lexer, out *AccountPosition) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -6992,7 +11874,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures46(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures46(out *jwriter.Writer, in AccountPosition) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures80(out *jwriter.Writer, in AccountPosition) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -7082,27 +11964,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures46(out *jwriter.Write
 // MarshalJSON supports json.Marshaler interface
 func (v AccountPosition) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures46(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures80(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v AccountPosition) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures46(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures80(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *AccountPosition) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures46(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures80(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *AccountPosition) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures46(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures80(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures47(in *jlexer.Lexer, out *AccountAsset) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures81(in *jlexer.Lexer, out *AccountAsset) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -7210,7 +12092,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures47(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures47(out *jwriter.Writer, in AccountAsset) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures81(out *jwriter.Writer, in AccountAsset) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -7290,27 +12172,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures47(out *jwriter.Write
 // MarshalJSON supports json.Marshaler interface
 func (v AccountAsset) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures47(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures81(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v AccountAsset) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures47(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures81(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *AccountAsset) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures47(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures81(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *AccountAsset) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures47(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures81(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures48(in *jlexer.Lexer, out *Account) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures82(in *jlexer.Lexer, out *Account) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -7340,21 +12222,21 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures48(in *jlexer.Lexer,
 					out.Assets = (out.Assets)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v36 *AccountAsset
+					var v69 *AccountAsset
 					if in.IsNull() {
 						in.Skip()
-						v36 = nil
+						v69 = nil
 					} else {
-						if v36 == nil {
-							v36 = new(AccountAsset)
+						if v69 == nil {
+							v69 = new(AccountAsset)
 						}
 						if in.IsNull() {
 							in.Skip()
 						} else {
-							(*v36).UnmarshalEasyJSON(in)
+							(*v69).UnmarshalEasyJSON(in)
 						}
 					}
-					out.Assets = append(out.Assets, v36)
+					out.Assets = append(out.Assets, v69)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -7477,21 +12359,21 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures48(in *jlexer.Lexer,
 					out.Positions = (out.Positions)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v37 *AccountPosition
+					var v70 *AccountPosition
 					if in.IsNull() {
 						in.Skip()
-						v37 = nil
+						v70 = nil
 					} else {
-						if v37 == nil {
-							v37 = new(AccountPosition)
+						if v70 == nil {
+							v70 = new(AccountPosition)
 						}
 						if in.IsNull() {
 							in.Skip()
 						} else {
-							(*v37).UnmarshalEasyJSON(in)
+							(*v70).UnmarshalEasyJSON(in)
 						}
 					}
-					out.Positions = append(out.Positions, v37)
+					out.Positions = append(out.Positions, v70)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -7506,7 +12388,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures48(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures48(out *jwriter.Writer, in Account) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures82(out *jwriter.Writer, in Account) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -7517,14 +12399,14 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures48(out *jwriter.Write
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v38, v39 := range in.Assets {
-				if v38 > 0 {
+			for v71, v72 := range in.Assets {
+				if v71 > 0 {
 					out.RawByte(',')
 				}
-				if v39 == nil {
+				if v72 == nil {
 					out.RawString("null")
 				} else {
-					(*v39).MarshalEasyJSON(out)
+					(*v72).MarshalEasyJSON(out)
 				}
 			}
 			out.RawByte(']')
@@ -7622,14 +12504,14 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures48(out *jwriter.Write
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v40, v41 := range in.Positions {
-				if v40 > 0 {
+			for v73, v74 := range in.Positions {
+				if v73 > 0 {
 					out.RawByte(',')
 				}
-				if v41 == nil {
+				if v74 == nil {
 					out.RawString("null")
 				} else {
-					(*v41).MarshalEasyJSON(out)
+					(*v74).MarshalEasyJSON(out)
 				}
 			}
 			out.RawByte(']')
@@ -7641,23 +12523,23 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures48(out *jwriter.Write
 // MarshalJSON supports json.Marshaler interface
 func (v Account) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures48(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures82(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Account) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures48(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceFutures82(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Account) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures48(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures82(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Account) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures48(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceFutures82(l, v)
 }
@@ -0,0 +1,372 @@
+package futures
+
+import (
+	"github.com/ward-cap/go-binance/decimal"
+)
+
+//go:generate easyjson -all models_v2.go
+
+// The V2 models mirror the models of the same name with every price,
+// quantity, balance and rate decimal typed. Optional numbers that Binance may
+// send empty or null are decimal.NullDecimalV2.
+
+// AccountAssetV2 define account asset
+//
+//easyjson:json
+type AccountAssetV2 struct {
+	Asset                  string          `json:"asset"`
+	InitialMargin          decimal.Decimal `json:"initialMargin"`
+	MaintMargin            decimal.Decimal `json:"maintMargin"`
+	MarginBalance          decimal.Decimal `json:"marginBalance"`
+	MaxWithdrawAmount      decimal.Decimal `json:"maxWithdrawAmount"`
+	OpenOrderInitialMargin decimal.Decimal `json:"openOrderInitialMargin"`
+	PositionInitialMargin  decimal.Decimal `json:"positionInitialMargin"`
+	UnrealizedProfit       decimal.Decimal `json:"unrealizedProfit"`
+	WalletBalance          decimal.Decimal `json:"walletBalance"`
+	CrossWalletBalance     decimal.Decimal `json:"crossWalletBalance"`
+	CrossUnPnl             decimal.Decimal `json:"crossUnPnl"`
+	AvailableBalance       decimal.Decimal `json:"availableBalance"`
+	MarginAvailable        bool            `json:"marginAvailable"`
+	UpdateTime             int64           `json:"updateTime"`
+}
+
+// AccountPositionV2 define account position
+//
+//easyjson:json
+type AccountPositionV2 struct {
+	Isolated               bool             `json:"isolated"`
+	Leverage               decimal.Decimal  `json:"leverage"`
+	InitialMargin          decimal.Decimal  `json:"initialMargin"`
+	MaintMargin            decimal.Decimal  `json:"maintMargin"`
+	OpenOrderInitialMargin decimal.Decimal  `json:"openOrderInitialMargin"`
+	PositionInitialMargin  decimal.Decimal  `json:"positionInitialMargin"`
+	Symbol                 string           `json:"symbol"`
+	UnrealizedProfit       decimal.Decimal  `json:"unrealizedProfit"`
+	EntryPrice             decimal.Decimal  `json:"entryPrice"`
+	MaxNotional            decimal.Decimal  `json:"maxNotional"`
+	PositionSide           PositionSideType `json:"positionSide"`
+	PositionAmt            decimal.Decimal  `json:"positionAmt"`
+	Notional               decimal.Decimal  `json:"notional"`
+	BidNotional            decimal.Decimal  `json:"bidNotional"`
+	AskNotional            decimal.Decimal  `json:"askNotional"`
+	UpdateTime             int64            `json:"updateTime"`
+}
+
+// AccountTradeV2 define account trade
+//
+//easyjson:json
+type AccountTradeV2 struct {
+	Buyer           bool             `json:"buyer"`
+	Commission      decimal.Decimal  `json:"commission"`
+	CommissionAsset string           `json:"commissionAsset"`
+	ID              int64            `json:"id"`
+	Maker           bool             `json:"maker"`
+	OrderID         int64            `json:"orderId"`
+	Price           decimal.Decimal  `json:"price"`
+	Quantity        decimal.Decimal  `json:"qty"`
+	QuoteQuantity   decimal.Decimal  `json:"quoteQty"`
+	RealizedPnl     decimal.Decimal  `json:"realizedPnl"`
+	Side            SideType         `json:"side"`
+	PositionSide    PositionSideType `json:"positionSide"`
+	Symbol          string           `json:"symbol"`
+	Time            int64            `json:"time"`
+}
+
+// AccountV2 define account info
+//
+//easyjson:json
+type AccountV2 struct {
+	Assets                      []*AccountAssetV2    `json:"assets"`
+	FeeTier                     int                  `json:"feeTier"`
+	CanTrade                    bool                 `json:"canTrade"`
+	CanDeposit                  bool                 `json:"canDeposit"`
+	CanWithdraw                 bool                 `json:"canWithdraw"`
+	UpdateTime                  int64                `json:"updateTime"`
+	MultiAssetsMargin           bool                 `json:"multiAssetsMargin"`
+	TotalInitialMargin          decimal.Decimal      `json:"totalInitialMargin"`
+	TotalMaintMargin            decimal.Decimal      `json:"totalMaintMargin"`
+	TotalWalletBalance          decimal.Decimal      `json:"totalWalletBalance"`
+	TotalUnrealizedProfit       decimal.Decimal      `json:"totalUnrealizedProfit"`
+	TotalMarginBalance          decimal.Decimal      `json:"totalMarginBalance"`
+	TotalPositionInitialMargin  decimal.Decimal      `json:"totalPositionInitialMargin"`
+	TotalOpenOrderInitialMargin decimal.Decimal      `json:"totalOpenOrderInitialMargin"`
+	TotalCrossWalletBalance     decimal.Decimal      `json:"totalCrossWalletBalance"`
+	TotalCrossUnPnl             decimal.Decimal      `json:"totalCrossUnPnl"`
+	AvailableBalance            decimal.Decimal      `json:"availableBalance"`
+	MaxWithdrawAmount           decimal.Decimal      `json:"maxWithdrawAmount"`
+	Positions                   []*AccountPositionV2 `json:"positions"`
+}
+
+// AggTradeV2 define aggregate trade info
+//
+//easyjson:json
+type AggTradeV2 struct {
+	AggTradeID   int64           `json:"a"`
+	Price        decimal.Decimal `json:"p"`
+	Quantity     decimal.Decimal `json:"q"`
+	FirstTradeID int64           `json:"f"`
+	LastTradeID  int64           `json:"l"`
+	Timestamp    int64           `json:"T"`
+	IsBuyerMaker bool            `json:"m"`
+}
+
+// BalanceV2 define user balance of your account
+//
+//easyjson:json
+type BalanceV2 struct {
+	AccountAlias       string          `json:"accountAlias"`
+	Asset              string          `json:"asset"`
+	Balance            decimal.Decimal `json:"balance"`
+	CrossWalletBalance decimal.Decimal `json:"crossWalletBalance"`
+	CrossUnPnl         decimal.Decimal `json:"crossUnPnl"`
+	AvailableBalance   decimal.Decimal `json:"availableBalance"`
+	MaxWithdrawAmount  decimal.Decimal `json:"maxWithdrawAmount"`
+}
+
+// BookTickerV2 define book ticker info
+//
+//easyjson:json
+type BookTickerV2 struct {
+	Symbol      string          `json:"symbol"`
+	BidPrice    decimal.Decimal `json:"bidPrice"`
+	BidQuantity decimal.Decimal `json:"bidQty"`
+	AskPrice    decimal.Decimal `json:"askPrice"`
+	AskQuantity decimal.Decimal `json:"askQty"`
+}
+
+// CancelOrderResponseV2 define response of canceling order
+//
+//easyjson:json
+type CancelOrderResponseV2 struct {
+	ClientOrderID    string                `json:"clientOrderId"`
+	CumQuantity      decimal.Decimal       `json:"cumQty"`
+	CumQuote         decimal.Decimal       `json:"cumQuote"`
+	ExecutedQuantity decimal.Decimal       `json:"executedQty"`
+	OrderID          int64                 `json:"orderId"`
+	OrigQuantity     decimal.Decimal       `json:"origQty"`
+	Price            decimal.Decimal       `json:"price"`
+	ReduceOnly       bool                  `json:"reduceOnly"`
+	Side             SideType              `json:"side"`
+	Status           OrderStatusType       `json:"status"`
+	StopPrice        decimal.NullDecimalV2 `json:"stopPrice"`
+	Symbol           string                `json:"symbol"`
+	TimeInForce      TimeInForceType       `json:"timeInForce"`
+	Type             OrderType             `json:"type"`
+	UpdateTime       int64                 `json:"updateTime"`
+	WorkingType      WorkingType           `json:"workingType"`
+	ActivatePrice    decimal.NullDecimalV2 `json:"activatePrice"`
+	PriceRate        decimal.NullDecimalV2 `json:"priceRate"`
+	OrigType         string                `json:"origType"`
+	PositionSide     PositionSideType      `json:"positionSide"`
+	PriceProtect     bool                  `json:"priceProtect"`
+}
+
+// CommissionRateV2 define the commission rates of a symbol
+//
+//easyjson:json
+type CommissionRateV2 struct {
+	Symbol              string          `json:"symbol"`
+	MakerCommissionRate decimal.Decimal `json:"makerCommissionRate"`
+	TakerCommissionRate decimal.Decimal `json:"takerCommissionRate"`
+}
+
+// CreateOrderResponseV2 define create order response
+//
+//easyjson:json
+type CreateOrderResponseV2 struct {
+	Symbol            string                `json:"symbol"`
+	OrderID           int64                 `json:"orderId"`
+	ClientOrderID     string                `json:"clientOrderId"`
+	Price             decimal.Decimal       `json:"price"`
+	OrigQuantity      decimal.Decimal       `json:"origQty"`
+	ExecutedQuantity  decimal.Decimal       `json:"executedQty"`
+	CumQuote          decimal.Decimal       `json:"cumQuote"`
+	ReduceOnly        bool                  `json:"reduceOnly"`
+	Status            OrderStatusType       `json:"status"`
+	StopPrice         decimal.NullDecimalV2 `json:"stopPrice"`
+	TimeInForce       TimeInForceType       `json:"timeInForce"`
+	Type              OrderType             `json:"type"`
+	Side              SideType              `json:"side"`
+	UpdateTime        int64                 `json:"updateTime"`
+	WorkingType       WorkingType           `json:"workingType"`
+	ActivatePrice     decimal.NullDecimalV2 `json:"activatePrice"`
+	PriceRate         decimal.NullDecimalV2 `json:"priceRate"`
+	AvgPrice          decimal.Decimal       `json:"avgPrice"`
+	PositionSide      PositionSideType      `json:"positionSide"`
+	ClosePosition     bool                  `json:"closePosition"`
+	PriceProtect      bool                  `json:"priceProtect"`
+	RateLimitOrder10s string                `json:"rateLimitOrder10s,omitempty"`
+	RateLimitOrder1m  string                `json:"rateLimitOrder1m,omitempty"`
+
+	// Recovered is set when a retried placement found the order already
+	// placed; the response is then decoded from the queried order and the
+	// rate limit counts are empty.
+	Recovered bool `json:"-"`
+}
+
+// FundingRateV2 define funding rate of mark price
+//
+//easyjson:json
+type FundingRateV2 struct {
+	Symbol      string          `json:"symbol"`
+	FundingRate decimal.Decimal `json:"fundingRate"`
+	FundingTime int64           `json:"fundingTime"`
+	Time        int64           `json:"time"`
+}
+
+// IncomeHistoryV2 define position margin history info
+//
+//easyjson:json
+type IncomeHistoryV2 struct {
+	Asset      string          `json:"asset"`
+	Income     decimal.Decimal `json:"income"`
+	IncomeType string          `json:"incomeType"`
+	Info       string          `json:"info"`
+	Symbol     string          `json:"symbol"`
+	Time       int64           `json:"time"`
+	TranID     int64           `json:"tranId"`
+	TradeID    string          `json:"tradeId"`
+}
+
+// OrderV2 define order info
+//
+//easyjson:json
+type OrderV2 struct {
+	Symbol           string                `json:"symbol"`
+	OrderID          int64                 `json:"orderId"`
+	ClientOrderID    string                `json:"clientOrderId"`
+	Price            decimal.Decimal       `json:"price"`
+	ReduceOnly       bool                  `json:"reduceOnly"`
+	OrigQuantity     decimal.Decimal       `json:"origQty"`
+	ExecutedQuantity decimal.Decimal       `json:"executedQty"`
+	CumQuantity      decimal.Decimal       `json:"cumQty"`
+	CumQuote         decimal.Decimal       `json:"cumQuote"`
+	Status           OrderStatusType       `json:"status"`
+	TimeInForce      TimeInForceType       `json:"timeInForce"`
+	Type             OrderType             `json:"type"`
+	Side             SideType              `json:"side"`
+	StopPrice        decimal.NullDecimalV2 `json:"stopPrice"`
+	Time             int64                 `json:"time"`
+	UpdateTime       int64                 `json:"updateTime"`
+	WorkingType      WorkingType           `json:"workingType"`
+	ActivatePrice    decimal.NullDecimalV2 `json:"activatePrice"`
+	PriceRate        decimal.NullDecimalV2 `json:"priceRate"`
+	AvgPrice         decimal.Decimal       `json:"avgPrice"`
+	OrigType         string                `json:"origType"`
+	PositionSide     PositionSideType      `json:"positionSide"`
+	PriceProtect     bool                  `json:"priceProtect"`
+	ClosePosition    bool                  `json:"closePosition"`
+}
+
+// PositionMarginHistoryV2 define a position margin change
+//
+//easyjson:json
+type PositionMarginHistoryV2 struct {
+	Amount       decimal.Decimal `json:"amount"`
+	Asset        string          `json:"asset"`
+	Symbol       string          `json:"symbol"`
+	Time         int64           `json:"time"`
+	Type         int             `json:"type"`
+	PositionSide string          `json:"positionSide"`
+}
+
+// PositionRiskV2 define position risk info
+//
+//easyjson:json
+type PositionRiskV2 struct {
+	EntryPrice       decimal.Decimal       `json:"entryPrice"`
+	BreakEvenPrice   decimal.NullDecimalV2 `json:"breakEvenPrice"`
+	MarginType       string                `json:"marginType"`
+	IsAutoAddMargin  string                `json:"isAutoAddMargin"`
+	IsolatedMargin   decimal.Decimal       `json:"isolatedMargin"`
+	Leverage         decimal.Decimal       `json:"leverage"`
+	LiquidationPrice decimal.NullDecimalV2 `json:"liquidationPrice"`
+	MarkPrice        decimal.Decimal       `json:"markPrice"`
+	MaxNotionalValue decimal.Decimal       `json:"maxNotionalValue"`
+	PositionAmt      decimal.Decimal       `json:"positionAmt"`
+	Symbol           string                `json:"symbol"`
+	UnRealizedProfit decimal.Decimal       `json:"unRealizedProfit"`
+	PositionSide     string                `json:"positionSide"`
+	Notional         decimal.Decimal       `json:"notional"`
+	IsolatedWallet   decimal.Decimal       `json:"isolatedWallet"`
+}
+
+// PremiumIndexV2 define premium index of mark price
+//
+//easyjson:json
+type PremiumIndexV2 struct {
+	Symbol          string                `json:"symbol"`
+	MarkPrice       decimal.Decimal       `json:"markPrice"`
+	LastFundingRate decimal.NullDecimalV2 `json:"lastFundingRate"`
+	NextFundingTime int64                 `json:"nextFundingTime"`
+	Time            int64                 `json:"time"`
+}
+
+// PriceChangeStatsV2 define price change stats
+//
+//easyjson:json
+type PriceChangeStatsV2 struct {
+	Symbol             string                `json:"symbol"`
+	PriceChange        decimal.Decimal       `json:"priceChange"`
+	PriceChangePercent decimal.Decimal       `json:"priceChangePercent"`
+	WeightedAvgPrice   decimal.Decimal       `json:"weightedAvgPrice"`
+	PrevClosePrice     decimal.Decimal       `json:"prevClosePrice"`
+	LastPrice          decimal.Decimal       `json:"lastPrice"`
+	LastQuantity       decimal.Decimal       `json:"lastQty"`
+	OpenPrice          decimal.NullDecimalV2 `json:"openPrice"`
+	HighPrice          decimal.Decimal       `json:"highPrice"`
+	LowPrice           decimal.Decimal       `json:"lowPrice"`
+	Volume             decimal.Decimal       `json:"volume"`
+	QuoteVolume        decimal.Decimal       `json:"quoteVolume"`
+	OpenTime           int64                 `json:"openTime"`
+	CloseTime          int64                 `json:"closeTime"`
+	FirstID            int64                 `json:"firstId"`
+	LastID             int64                 `json:"lastId"`
+	Count              int64                 `json:"count"`
+}
+
+// SymbolPriceV2 define symbol and price pair
+//
+//easyjson:json
+type SymbolPriceV2 struct {
+	Symbol string          `json:"symbol"`
+	Price  decimal.Decimal `json:"price"`
+}
+
+// TradeV2 define trade info
+//
+//easyjson:json
+type TradeV2 struct {
+	ID            int64           `json:"id"`
+	Price         decimal.Decimal `json:"price"`
+	Quantity      decimal.Decimal `json:"qty"`
+	QuoteQuantity decimal.Decimal `json:"quoteQty"`
+	Time          int64           `json:"time"`
+	IsBuyerMaker  bool            `json:"isBuyerMaker"`
+}
+
+// UserLiquidationOrderV2 define a liquidation order of the user
+//
+//easyjson:json
+type UserLiquidationOrderV2 struct {
+	OrderId          int64                 `json:"orderId"`
+	Symbol           string                `json:"symbol"`
+	Status           OrderStatusType       `json:"status"`
+	ClientOrderId    string                `json:"clientOrderId"`
+	Price            decimal.Decimal       `json:"price"`
+	AveragePrice     decimal.Decimal       `json:"avgPrice"`
+	OrigQuantity     decimal.Decimal       `json:"origQty"`
+	ExecutedQuantity decimal.Decimal       `json:"executedQty"`
+	CumQuote         decimal.Decimal       `json:"cumQuote"`
+	TimeInForce      TimeInForceType       `json:"timeInForce"`
+	Type             OrderType             `json:"type"`
+	ReduceOnly       bool                  `json:"reduceOnly"`
+	ClosePosition    bool                  `json:"closePosition"`
+	Side             SideType              `json:"side"`
+	PositionSide     PositionSideType      `json:"positionSide"`
+	StopPrice        decimal.NullDecimalV2 `json:"stopPrice"`
+	WorkingType      WorkingType           `json:"workingType"`
+	OrigType         string                `json:"origType"`
+	Time             int64                 `json:"time"`
+	UpdateTime       int64                 `json:"updateTime"`
+}
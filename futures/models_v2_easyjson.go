@@ -0,0 +1,4011 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package futures
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjsonE769a587DecodeGithubComWardCapGoBinanceFutures(in *jlexer.Lexer, out *UserLiquidationOrderV2) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "orderId":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.OrderId = int64(in.Int64())
+			}
+		case "symbol":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Symbol = string(in.String())
+			}
+		case "status":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Status = OrderStatusType(in.String())
+			}
+		case "clientOrderId":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ClientOrderId = string(in.String())
+			}
+		case "price":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.Price).UnmarshalJSON(data))
+				}
+			}
+		case "avgPrice":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.AveragePrice).UnmarshalJSON(data))
+				}
+			}
+		case "origQty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.OrigQuantity).UnmarshalJSON(data))
+				}
+			}
+		case "executedQty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.ExecutedQuantity).UnmarshalJSON(data))
+				}
+			}
+		case "cumQuote":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.CumQuote).UnmarshalJSON(data))
+				}
+			}
+		case "timeInForce":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TimeInForce = TimeInForceType(in.String())
+			}
+		case "type":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Type = OrderType(in.String())
+			}
+		case "reduceOnly":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ReduceOnly = bool(in.Bool())
+			}
+		case "closePosition":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ClosePosition = bool(in.Bool())
+			}
+		case "side":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Side = SideType(in.String())
+			}
+		case "positionSide":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.PositionSide = PositionSideType(in.String())
+			}
+		case "stopPrice":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.StopPrice).UnmarshalJSON(data))
+				}
+			}
+		case "workingType":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.WorkingType = WorkingType(in.String())
+			}
+		case "origType":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.OrigType = string(in.String())
+			}
+		case "time":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Time = int64(in.Int64())
+			}
+		case "updateTime":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.UpdateTime = int64(in.Int64())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonE769a587EncodeGithubComWardCapGoBinanceFutures(out *jwriter.Writer, in UserLiquidationOrderV2) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"orderId\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.OrderId))
+	}
+	{
+		const prefix string = ",\"symbol\":"
+		out.RawString(prefix)
+		out.String(string(in.Symbol))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	{
+		const prefix string = ",\"clientOrderId\":"
+		out.RawString(prefix)
+		out.String(string(in.ClientOrderId))
+	}
+	{
+		const prefix string = ",\"price\":"
+		out.RawString(prefix)
+		out.Raw((in.Price).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"avgPrice\":"
+		out.RawString(prefix)
+		out.Raw((in.AveragePrice).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"origQty\":"
+		out.RawString(prefix)
+		out.Raw((in.OrigQuantity).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"executedQty\":"
+		out.RawString(prefix)
+		out.Raw((in.ExecutedQuantity).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"cumQuote\":"
+		out.RawString(prefix)
+		out.Raw((in.CumQuote).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"timeInForce\":"
+		out.RawString(prefix)
+		out.String(string(in.TimeInForce))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"reduceOnly\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.ReduceOnly))
+	}
+	{
+		const prefix string = ",\"closePosition\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.ClosePosition))
+	}
+	{
+		const prefix string = ",\"side\":"
+		out.RawString(prefix)
+		out.String(string(in.Side))
+	}
+	{
+		const prefix string = ",\"positionSide\":"
+		out.RawString(prefix)
+		out.String(string(in.PositionSide))
+	}
+	{
+		const prefix string = ",\"stopPrice\":"
+		out.RawString(prefix)
+		out.Raw((in.StopPrice).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"workingType\":"
+		out.RawString(prefix)
+		out.String(string(in.WorkingType))
+	}
+	{
+		const prefix string = ",\"origType\":"
+		out.RawString(prefix)
+		out.String(string(in.OrigType))
+	}
+	{
+		const prefix string = ",\"time\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Time))
+	}
+	{
+		const prefix string = ",\"updateTime\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.UpdateTime))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v UserLiquidationOrderV2) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonE769a587EncodeGithubComWardCapGoBinanceFutures(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v UserLiquidationOrderV2) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonE769a587EncodeGithubComWardCapGoBinanceFutures(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *UserLiquidationOrderV2) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonE769a587DecodeGithubComWardCapGoBinanceFutures(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *UserLiquidationOrderV2) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonE769a587DecodeGithubComWardCapGoBinanceFutures(l, v)
+}
+func easyjsonE769a587DecodeGithubComWardCapGoBinanceFutures1(in *jlexer.Lexer, out *TradeV2) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "id":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ID = int64(in.Int64())
+			}
+		case "price":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.Price).UnmarshalJSON(data))
+				}
+			}
+		case "qty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.Quantity).UnmarshalJSON(data))
+				}
+			}
+		case "quoteQty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.QuoteQuantity).UnmarshalJSON(data))
+				}
+			}
+		case "time":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Time = int64(in.Int64())
+			}
+		case "isBuyerMaker":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.IsBuyerMaker = bool(in.Bool())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonE769a587EncodeGithubComWardCapGoBinanceFutures1(out *jwriter.Writer, in TradeV2) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.ID))
+	}
+	{
+		const prefix string = ",\"price\":"
+		out.RawString(prefix)
+		out.Raw((in.Price).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"qty\":"
+		out.RawString(prefix)
+		out.Raw((in.Quantity).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"quoteQty\":"
+		out.RawString(prefix)
+		out.Raw((in.QuoteQuantity).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"time\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Time))
+	}
+	{
+		const prefix string = ",\"isBuyerMaker\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsBuyerMaker))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TradeV2) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonE769a587EncodeGithubComWardCapGoBinanceFutures1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TradeV2) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonE769a587EncodeGithubComWardCapGoBinanceFutures1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TradeV2) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonE769a587DecodeGithubComWardCapGoBinanceFutures1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TradeV2) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonE769a587DecodeGithubComWardCapGoBinanceFutures1(l, v)
+}
+func easyjsonE769a587DecodeGithubComWardCapGoBinanceFutures2(in *jlexer.Lexer, out *SymbolPriceV2) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "symbol":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Symbol = string(in.String())
+			}
+		case "price":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.Price).UnmarshalJSON(data))
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonE769a587EncodeGithubComWardCapGoBinanceFutures2(out *jwriter.Writer, in SymbolPriceV2) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"symbol\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Symbol))
+	}
+	{
+		const prefix string = ",\"price\":"
+		out.RawString(prefix)
+		out.Raw((in.Price).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SymbolPriceV2) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonE769a587EncodeGithubComWardCapGoBinanceFutures2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SymbolPriceV2) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonE769a587EncodeGithubComWardCapGoBinanceFutures2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SymbolPriceV2) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonE769a587DecodeGithubComWardCapGoBinanceFutures2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SymbolPriceV2) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonE769a587DecodeGithubComWardCapGoBinanceFutures2(l, v)
+}
+func easyjsonE769a587DecodeGithubComWardCapGoBinanceFutures3(in *jlexer.Lexer, out *PriceChangeStatsV2) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "symbol":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Symbol = string(in.String())
+			}
+		case "priceChange":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.PriceChange).UnmarshalJSON(data))
+				}
+			}
+		case "priceChangePercent":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.PriceChangePercent).UnmarshalJSON(data))
+				}
+			}
+		case "weightedAvgPrice":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.WeightedAvgPrice).UnmarshalJSON(data))
+				}
+			}
+		case "prevClosePrice":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.PrevClosePrice).UnmarshalJSON(data))
+				}
+			}
+		case "lastPrice":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.LastPrice).UnmarshalJSON(data))
+				}
+			}
+		case "lastQty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.LastQuantity).UnmarshalJSON(data))
+				}
+			}
+		case "openPrice":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.OpenPrice).UnmarshalJSON(data))
+				}
+			}
+		case "highPrice":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.HighPrice).UnmarshalJSON(data))
+				}
+			}
+		case "lowPrice":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.LowPrice).UnmarshalJSON(data))
+				}
+			}
+		case "volume":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.Volume).UnmarshalJSON(data))
+				}
+			}
+		case "quoteVolume":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.QuoteVolume).UnmarshalJSON(data))
+				}
+			}
+		case "openTime":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.OpenTime = int64(in.Int64())
+			}
+		case "closeTime":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.CloseTime = int64(in.Int64())
+			}
+		case "firstId":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.FirstID = int64(in.Int64())
+			}
+		case "lastId":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.LastID = int64(in.Int64())
+			}
+		case "count":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Count = int64(in.Int64())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonE769a587EncodeGithubComWardCapGoBinanceFutures3(out *jwriter.Writer, in PriceChangeStatsV2) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"symbol\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Symbol))
+	}
+	{
+		const prefix string = ",\"priceChange\":"
+		out.RawString(prefix)
+		out.Raw((in.PriceChange).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"priceChangePercent\":"
+		out.RawString(prefix)
+		out.Raw((in.PriceChangePercent).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"weightedAvgPrice\":"
+		out.RawString(prefix)
+		out.Raw((in.WeightedAvgPrice).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"prevClosePrice\":"
+		out.RawString(prefix)
+		out.Raw((in.PrevClosePrice).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"lastPrice\":"
+		out.RawString(prefix)
+		out.Raw((in.LastPrice).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"lastQty\":"
+		out.RawString(prefix)
+		out.Raw((in.LastQuantity).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"openPrice\":"
+		out.RawString(prefix)
+		out.Raw((in.OpenPrice).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"highPrice\":"
+		out.RawString(prefix)
+		out.Raw((in.HighPrice).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"lowPrice\":"
+		out.RawString(prefix)
+		out.Raw((in.LowPrice).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"volume\":"
+		out.RawString(prefix)
+		out.Raw((in.Volume).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"quoteVolume\":"
+		out.RawString(prefix)
+		out.Raw((in.QuoteVolume).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"openTime\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.OpenTime))
+	}
+	{
+		const prefix string = ",\"closeTime\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.CloseTime))
+	}
+	{
+		const prefix string = ",\"firstId\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.FirstID))
+	}
+	{
+		const prefix string = ",\"lastId\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.LastID))
+	}
+	{
+		const prefix string = ",\"count\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Count))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PriceChangeStatsV2) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonE769a587EncodeGithubComWardCapGoBinanceFutures3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PriceChangeStatsV2) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonE769a587EncodeGithubComWardCapGoBinanceFutures3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PriceChangeStatsV2) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonE769a587DecodeGithubComWardCapGoBinanceFutures3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PriceChangeStatsV2) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonE769a587DecodeGithubComWardCapGoBinanceFutures3(l, v)
+}
+func easyjsonE769a587DecodeGithubComWardCapGoBinanceFutures4(in *jlexer.Lexer, out *PremiumIndexV2) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "symbol":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Symbol = string(in.String())
+			}
+		case "markPrice":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.MarkPrice).UnmarshalJSON(data))
+				}
+			}
+		case "lastFundingRate":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.LastFundingRate).UnmarshalJSON(data))
+				}
+			}
+		case "nextFundingTime":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.NextFundingTime = int64(in.Int64())
+			}
+		case "time":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Time = int64(in.Int64())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonE769a587EncodeGithubComWardCapGoBinanceFutures4(out *jwriter.Writer, in PremiumIndexV2) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"symbol\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Symbol))
+	}
+	{
+		const prefix string = ",\"markPrice\":"
+		out.RawString(prefix)
+		out.Raw((in.MarkPrice).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"lastFundingRate\":"
+		out.RawString(prefix)
+		out.Raw((in.LastFundingRate).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"nextFundingTime\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.NextFundingTime))
+	}
+	{
+		const prefix string = ",\"time\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Time))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PremiumIndexV2) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonE769a587EncodeGithubComWardCapGoBinanceFutures4(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PremiumIndexV2) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonE769a587EncodeGithubComWardCapGoBinanceFutures4(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PremiumIndexV2) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonE769a587DecodeGithubComWardCapGoBinanceFutures4(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PremiumIndexV2) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonE769a587DecodeGithubComWardCapGoBinanceFutures4(l, v)
+}
+func easyjsonE769a587DecodeGithubComWardCapGoBinanceFutures5(in *jlexer.Lexer, out *PositionRiskV2) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "entryPrice":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.EntryPrice).UnmarshalJSON(data))
+				}
+			}
+		case "breakEvenPrice":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.BreakEvenPrice).UnmarshalJSON(data))
+				}
+			}
+		case "marginType":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.MarginType = string(in.String())
+			}
+		case "isAutoAddMargin":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.IsAutoAddMargin = string(in.String())
+			}
+		case "isolatedMargin":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.IsolatedMargin).UnmarshalJSON(data))
+				}
+			}
+		case "leverage":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.Leverage).UnmarshalJSON(data))
+				}
+			}
+		case "liquidationPrice":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.LiquidationPrice).UnmarshalJSON(data))
+				}
+			}
+		case "markPrice":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.MarkPrice).UnmarshalJSON(data))
+				}
+			}
+		case "maxNotionalValue":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.MaxNotionalValue).UnmarshalJSON(data))
+				}
+			}
+		case "positionAmt":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.PositionAmt).UnmarshalJSON(data))
+				}
+			}
+		case "symbol":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Symbol = string(in.String())
+			}
+		case "unRealizedProfit":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.UnRealizedProfit).UnmarshalJSON(data))
+				}
+			}
+		case "positionSide":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.PositionSide = string(in.String())
+			}
+		case "notional":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.Notional).UnmarshalJSON(data))
+				}
+			}
+		case "isolatedWallet":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.IsolatedWallet).UnmarshalJSON(data))
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonE769a587EncodeGithubComWardCapGoBinanceFutures5(out *jwriter.Writer, in PositionRiskV2) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"entryPrice\":"
+		out.RawString(prefix[1:])
+		out.Raw((in.EntryPrice).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"breakEvenPrice\":"
+		out.RawString(prefix)
+		out.Raw((in.BreakEvenPrice).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"marginType\":"
+		out.RawString(prefix)
+		out.String(string(in.MarginType))
+	}
+	{
+		const prefix string = ",\"isAutoAddMargin\":"
+		out.RawString(prefix)
+		out.String(string(in.IsAutoAddMargin))
+	}
+	{
+		const prefix string = ",\"isolatedMargin\":"
+		out.RawString(prefix)
+		out.Raw((in.IsolatedMargin).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"leverage\":"
+		out.RawString(prefix)
+		out.Raw((in.Leverage).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"liquidationPrice\":"
+		out.RawString(prefix)
+		out.Raw((in.LiquidationPrice).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"markPrice\":"
+		out.RawString(prefix)
+		out.Raw((in.MarkPrice).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"maxNotionalValue\":"
+		out.RawString(prefix)
+		out.Raw((in.MaxNotionalValue).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"positionAmt\":"
+		out.RawString(prefix)
+		out.Raw((in.PositionAmt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"symbol\":"
+		out.RawString(prefix)
+		out.String(string(in.Symbol))
+	}
+	{
+		const prefix string = ",\"unRealizedProfit\":"
+		out.RawString(prefix)
+		out.Raw((in.UnRealizedProfit).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"positionSide\":"
+		out.RawString(prefix)
+		out.String(string(in.PositionSide))
+	}
+	{
+		const prefix string = ",\"notional\":"
+		out.RawString(prefix)
+		out.Raw((in.Notional).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"isolatedWallet\":"
+		out.RawString(prefix)
+		out.Raw((in.IsolatedWallet).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PositionRiskV2) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonE769a587EncodeGithubComWardCapGoBinanceFutures5(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PositionRiskV2) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonE769a587EncodeGithubComWardCapGoBinanceFutures5(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PositionRiskV2) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonE769a587DecodeGithubComWardCapGoBinanceFutures5(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PositionRiskV2) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonE769a587DecodeGithubComWardCapGoBinanceFutures5(l, v)
+}
+func easyjsonE769a587DecodeGithubComWardCapGoBinanceFutures6(in *jlexer.Lexer, out *PositionMarginHistoryV2) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "amount":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.Amount).UnmarshalJSON(data))
+				}
+			}
+		case "asset":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Asset = string(in.String())
+			}
+		case "symbol":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Symbol = string(in.String())
+			}
+		case "time":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Time = int64(in.Int64())
+			}
+		case "type":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Type = int(in.Int())
+			}
+		case "positionSide":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.PositionSide = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonE769a587EncodeGithubComWardCapGoBinanceFutures6(out *jwriter.Writer, in PositionMarginHistoryV2) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"amount\":"
+		out.RawString(prefix[1:])
+		out.Raw((in.Amount).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"asset\":"
+		out.RawString(prefix)
+		out.String(string(in.Asset))
+	}
+	{
+		const prefix string = ",\"symbol\":"
+		out.RawString(prefix)
+		out.String(string(in.Symbol))
+	}
+	{
+		const prefix string = ",\"time\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Time))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.Int(int(in.Type))
+	}
+	{
+		const prefix string = ",\"positionSide\":"
+		out.RawString(prefix)
+		out.String(string(in.PositionSide))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PositionMarginHistoryV2) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonE769a587EncodeGithubComWardCapGoBinanceFutures6(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PositionMarginHistoryV2) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonE769a587EncodeGithubComWardCapGoBinanceFutures6(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PositionMarginHistoryV2) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonE769a587DecodeGithubComWardCapGoBinanceFutures6(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PositionMarginHistoryV2) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonE769a587DecodeGithubComWardCapGoBinanceFutures6(l, v)
+}
+func easyjsonE769a587DecodeGithubComWardCapGoBinanceFutures7(in *jlexer.Lexer, out *OrderV2) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "symbol":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Symbol = string(in.String())
+			}
+		case "orderId":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.OrderID = int64(in.Int64())
+			}
+		case "clientOrderId":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ClientOrderID = string(in.String())
+			}
+		case "price":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.Price).UnmarshalJSON(data))
+				}
+			}
+		case "reduceOnly":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ReduceOnly = bool(in.Bool())
+			}
+		case "origQty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.OrigQuantity).UnmarshalJSON(data))
+				}
+			}
+		case "executedQty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.ExecutedQuantity).UnmarshalJSON(data))
+				}
+			}
+		case "cumQty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.CumQuantity).UnmarshalJSON(data))
+				}
+			}
+		case "cumQuote":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.CumQuote).UnmarshalJSON(data))
+				}
+			}
+		case "status":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Status = OrderStatusType(in.String())
+			}
+		case "timeInForce":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TimeInForce = TimeInForceType(in.String())
+			}
+		case "type":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Type = OrderType(in.String())
+			}
+		case "side":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Side = SideType(in.String())
+			}
+		case "stopPrice":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.StopPrice).UnmarshalJSON(data))
+				}
+			}
+		case "time":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Time = int64(in.Int64())
+			}
+		case "updateTime":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.UpdateTime = int64(in.Int64())
+			}
+		case "workingType":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.WorkingType = WorkingType(in.String())
+			}
+		case "activatePrice":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.ActivatePrice).UnmarshalJSON(data))
+				}
+			}
+		case "priceRate":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.PriceRate).UnmarshalJSON(data))
+				}
+			}
+		case "avgPrice":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.AvgPrice).UnmarshalJSON(data))
+				}
+			}
+		case "origType":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.OrigType = string(in.String())
+			}
+		case "positionSide":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.PositionSide = PositionSideType(in.String())
+			}
+		case "priceProtect":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.PriceProtect = bool(in.Bool())
+			}
+		case "closePosition":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ClosePosition = bool(in.Bool())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonE769a587EncodeGithubComWardCapGoBinanceFutures7(out *jwriter.Writer, in OrderV2) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"symbol\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Symbol))
+	}
+	{
+		const prefix string = ",\"orderId\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.OrderID))
+	}
+	{
+		const prefix string = ",\"clientOrderId\":"
+		out.RawString(prefix)
+		out.String(string(in.ClientOrderID))
+	}
+	{
+		const prefix string = ",\"price\":"
+		out.RawString(prefix)
+		out.Raw((in.Price).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"reduceOnly\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.ReduceOnly))
+	}
+	{
+		const prefix string = ",\"origQty\":"
+		out.RawString(prefix)
+		out.Raw((in.OrigQuantity).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"executedQty\":"
+		out.RawString(prefix)
+		out.Raw((in.ExecutedQuantity).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"cumQty\":"
+		out.RawString(prefix)
+		out.Raw((in.CumQuantity).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"cumQuote\":"
+		out.RawString(prefix)
+		out.Raw((in.CumQuote).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	{
+		const prefix string = ",\"timeInForce\":"
+		out.RawString(prefix)
+		out.String(string(in.TimeInForce))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"side\":"
+		out.RawString(prefix)
+		out.String(string(in.Side))
+	}
+	{
+		const prefix string = ",\"stopPrice\":"
+		out.RawString(prefix)
+		out.Raw((in.StopPrice).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"time\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Time))
+	}
+	{
+		const prefix string = ",\"updateTime\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.UpdateTime))
+	}
+	{
+		const prefix string = ",\"workingType\":"
+		out.RawString(prefix)
+		out.String(string(in.WorkingType))
+	}
+	{
+		const prefix string = ",\"activatePrice\":"
+		out.RawString(prefix)
+		out.Raw((in.ActivatePrice).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"priceRate\":"
+		out.RawString(prefix)
+		out.Raw((in.PriceRate).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"avgPrice\":"
+		out.RawString(prefix)
+		out.Raw((in.AvgPrice).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"origType\":"
+		out.RawString(prefix)
+		out.String(string(in.OrigType))
+	}
+	{
+		const prefix string = ",\"positionSide\":"
+		out.RawString(prefix)
+		out.String(string(in.PositionSide))
+	}
+	{
+		const prefix string = ",\"priceProtect\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.PriceProtect))
+	}
+	{
+		const prefix string = ",\"closePosition\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.ClosePosition))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v OrderV2) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonE769a587EncodeGithubComWardCapGoBinanceFutures7(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v OrderV2) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonE769a587EncodeGithubComWardCapGoBinanceFutures7(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *OrderV2) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonE769a587DecodeGithubComWardCapGoBinanceFutures7(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *OrderV2) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonE769a587DecodeGithubComWardCapGoBinanceFutures7(l, v)
+}
+func easyjsonE769a587DecodeGithubComWardCapGoBinanceFutures8(in *jlexer.Lexer, out *IncomeHistoryV2) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "asset":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Asset = string(in.String())
+			}
+		case "income":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.Income).UnmarshalJSON(data))
+				}
+			}
+		case "incomeType":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.IncomeType = string(in.String())
+			}
+		case "info":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Info = string(in.String())
+			}
+		case "symbol":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Symbol = string(in.String())
+			}
+		case "time":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Time = int64(in.Int64())
+			}
+		case "tranId":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TranID = int64(in.Int64())
+			}
+		case "tradeId":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TradeID = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonE769a587EncodeGithubComWardCapGoBinanceFutures8(out *jwriter.Writer, in IncomeHistoryV2) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"asset\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Asset))
+	}
+	{
+		const prefix string = ",\"income\":"
+		out.RawString(prefix)
+		out.Raw((in.Income).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"incomeType\":"
+		out.RawString(prefix)
+		out.String(string(in.IncomeType))
+	}
+	{
+		const prefix string = ",\"info\":"
+		out.RawString(prefix)
+		out.String(string(in.Info))
+	}
+	{
+		const prefix string = ",\"symbol\":"
+		out.RawString(prefix)
+		out.String(string(in.Symbol))
+	}
+	{
+		const prefix string = ",\"time\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Time))
+	}
+	{
+		const prefix string = ",\"tranId\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.TranID))
+	}
+	{
+		const prefix string = ",\"tradeId\":"
+		out.RawString(prefix)
+		out.String(string(in.TradeID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v IncomeHistoryV2) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonE769a587EncodeGithubComWardCapGoBinanceFutures8(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v IncomeHistoryV2) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonE769a587EncodeGithubComWardCapGoBinanceFutures8(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *IncomeHistoryV2) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonE769a587DecodeGithubComWardCapGoBinanceFutures8(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *IncomeHistoryV2) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonE769a587DecodeGithubComWardCapGoBinanceFutures8(l, v)
+}
+func easyjsonE769a587DecodeGithubComWardCapGoBinanceFutures9(in *jlexer.Lexer, out *FundingRateV2) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "symbol":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Symbol = string(in.String())
+			}
+		case "fundingRate":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.FundingRate).UnmarshalJSON(data))
+				}
+			}
+		case "fundingTime":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.FundingTime = int64(in.Int64())
+			}
+		case "time":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Time = int64(in.Int64())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonE769a587EncodeGithubComWardCapGoBinanceFutures9(out *jwriter.Writer, in FundingRateV2) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"symbol\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Symbol))
+	}
+	{
+		const prefix string = ",\"fundingRate\":"
+		out.RawString(prefix)
+		out.Raw((in.FundingRate).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"fundingTime\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.FundingTime))
+	}
+	{
+		const prefix string = ",\"time\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Time))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v FundingRateV2) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonE769a587EncodeGithubComWardCapGoBinanceFutures9(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v FundingRateV2) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonE769a587EncodeGithubComWardCapGoBinanceFutures9(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *FundingRateV2) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonE769a587DecodeGithubComWardCapGoBinanceFutures9(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *FundingRateV2) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonE769a587DecodeGithubComWardCapGoBinanceFutures9(l, v)
+}
+func easyjsonE769a587DecodeGithubComWardCapGoBinanceFutures10(in *jlexer.Lexer, out *CreateOrderResponseV2) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "symbol":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Symbol = string(in.String())
+			}
+		case "orderId":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.OrderID = int64(in.Int64())
+			}
+		case "clientOrderId":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ClientOrderID = string(in.String())
+			}
+		case "price":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.Price).UnmarshalJSON(data))
+				}
+			}
+		case "origQty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.OrigQuantity).UnmarshalJSON(data))
+				}
+			}
+		case "executedQty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.ExecutedQuantity).UnmarshalJSON(data))
+				}
+			}
+		case "cumQuote":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.CumQuote).UnmarshalJSON(data))
+				}
+			}
+		case "reduceOnly":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ReduceOnly = bool(in.Bool())
+			}
+		case "status":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Status = OrderStatusType(in.String())
+			}
+		case "stopPrice":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.StopPrice).UnmarshalJSON(data))
+				}
+			}
+		case "timeInForce":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TimeInForce = TimeInForceType(in.String())
+			}
+		case "type":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Type = OrderType(in.String())
+			}
+		case "side":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Side = SideType(in.String())
+			}
+		case "updateTime":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.UpdateTime = int64(in.Int64())
+			}
+		case "workingType":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.WorkingType = WorkingType(in.String())
+			}
+		case "activatePrice":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.ActivatePrice).UnmarshalJSON(data))
+				}
+			}
+		case "priceRate":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.PriceRate).UnmarshalJSON(data))
+				}
+			}
+		case "avgPrice":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.AvgPrice).UnmarshalJSON(data))
+				}
+			}
+		case "positionSide":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.PositionSide = PositionSideType(in.String())
+			}
+		case "closePosition":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ClosePosition = bool(in.Bool())
+			}
+		case "priceProtect":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.PriceProtect = bool(in.Bool())
+			}
+		case "rateLimitOrder10s":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.RateLimitOrder10s = string(in.String())
+			}
+		case "rateLimitOrder1m":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.RateLimitOrder1m = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonE769a587EncodeGithubComWardCapGoBinanceFutures10(out *jwriter.Writer, in CreateOrderResponseV2) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"symbol\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Symbol))
+	}
+	{
+		const prefix string = ",\"orderId\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.OrderID))
+	}
+	{
+		const prefix string = ",\"clientOrderId\":"
+		out.RawString(prefix)
+		out.String(string(in.ClientOrderID))
+	}
+	{
+		const prefix string = ",\"price\":"
+		out.RawString(prefix)
+		out.Raw((in.Price).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"origQty\":"
+		out.RawString(prefix)
+		out.Raw((in.OrigQuantity).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"executedQty\":"
+		out.RawString(prefix)
+		out.Raw((in.ExecutedQuantity).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"cumQuote\":"
+		out.RawString(prefix)
+		out.Raw((in.CumQuote).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"reduceOnly\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.ReduceOnly))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	{
+		const prefix string = ",\"stopPrice\":"
+		out.RawString(prefix)
+		out.Raw((in.StopPrice).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"timeInForce\":"
+		out.RawString(prefix)
+		out.String(string(in.TimeInForce))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"side\":"
+		out.RawString(prefix)
+		out.String(string(in.Side))
+	}
+	{
+		const prefix string = ",\"updateTime\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.UpdateTime))
+	}
+	{
+		const prefix string = ",\"workingType\":"
+		out.RawString(prefix)
+		out.String(string(in.WorkingType))
+	}
+	{
+		const prefix string = ",\"activatePrice\":"
+		out.RawString(prefix)
+		out.Raw((in.ActivatePrice).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"priceRate\":"
+		out.RawString(prefix)
+		out.Raw((in.PriceRate).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"avgPrice\":"
+		out.RawString(prefix)
+		out.Raw((in.AvgPrice).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"positionSide\":"
+		out.RawString(prefix)
+		out.String(string(in.PositionSide))
+	}
+	{
+		const prefix string = ",\"closePosition\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.ClosePosition))
+	}
+	{
+		const prefix string = ",\"priceProtect\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.PriceProtect))
+	}
+	if in.RateLimitOrder10s != "" {
+		const prefix string = ",\"rateLimitOrder10s\":"
+		out.RawString(prefix)
+		out.String(string(in.RateLimitOrder10s))
+	}
+	if in.RateLimitOrder1m != "" {
+		const prefix string = ",\"rateLimitOrder1m\":"
+		out.RawString(prefix)
+		out.String(string(in.RateLimitOrder1m))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CreateOrderResponseV2) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonE769a587EncodeGithubComWardCapGoBinanceFutures10(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CreateOrderResponseV2) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonE769a587EncodeGithubComWardCapGoBinanceFutures10(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CreateOrderResponseV2) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonE769a587DecodeGithubComWardCapGoBinanceFutures10(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CreateOrderResponseV2) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonE769a587DecodeGithubComWardCapGoBinanceFutures10(l, v)
+}
+func easyjsonE769a587DecodeGithubComWardCapGoBinanceFutures11(in *jlexer.Lexer, out *CommissionRateV2) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "symbol":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Symbol = string(in.String())
+			}
+		case "makerCommissionRate":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.MakerCommissionRate).UnmarshalJSON(data))
+				}
+			}
+		case "takerCommissionRate":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.TakerCommissionRate).UnmarshalJSON(data))
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonE769a587EncodeGithubComWardCapGoBinanceFutures11(out *jwriter.Writer, in CommissionRateV2) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"symbol\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Symbol))
+	}
+	{
+		const prefix string = ",\"makerCommissionRate\":"
+		out.RawString(prefix)
+		out.Raw((in.MakerCommissionRate).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"takerCommissionRate\":"
+		out.RawString(prefix)
+		out.Raw((in.TakerCommissionRate).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CommissionRateV2) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonE769a587EncodeGithubComWardCapGoBinanceFutures11(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CommissionRateV2) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonE769a587EncodeGithubComWardCapGoBinanceFutures11(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CommissionRateV2) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonE769a587DecodeGithubComWardCapGoBinanceFutures11(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CommissionRateV2) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonE769a587DecodeGithubComWardCapGoBinanceFutures11(l, v)
+}
+func easyjsonE769a587DecodeGithubComWardCapGoBinanceFutures12(in *jlexer.Lexer, out *CancelOrderResponseV2) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "clientOrderId":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ClientOrderID = string(in.String())
+			}
+		case "cumQty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.CumQuantity).UnmarshalJSON(data))
+				}
+			}
+		case "cumQuote":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.CumQuote).UnmarshalJSON(data))
+				}
+			}
+		case "executedQty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.ExecutedQuantity).UnmarshalJSON(data))
+				}
+			}
+		case "orderId":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.OrderID = int64(in.Int64())
+			}
+		case "origQty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.OrigQuantity).UnmarshalJSON(data))
+				}
+			}
+		case "price":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.Price).UnmarshalJSON(data))
+				}
+			}
+		case "reduceOnly":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ReduceOnly = bool(in.Bool())
+			}
+		case "side":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Side = SideType(in.String())
+			}
+		case "status":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Status = OrderStatusType(in.String())
+			}
+		case "stopPrice":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.StopPrice).UnmarshalJSON(data))
+				}
+			}
+		case "symbol":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Symbol = string(in.String())
+			}
+		case "timeInForce":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TimeInForce = TimeInForceType(in.String())
+			}
+		case "type":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Type = OrderType(in.String())
+			}
+		case "updateTime":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.UpdateTime = int64(in.Int64())
+			}
+		case "workingType":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.WorkingType = WorkingType(in.String())
+			}
+		case "activatePrice":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.ActivatePrice).UnmarshalJSON(data))
+				}
+			}
+		case "priceRate":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.PriceRate).UnmarshalJSON(data))
+				}
+			}
+		case "origType":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.OrigType = string(in.String())
+			}
+		case "positionSide":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.PositionSide = PositionSideType(in.String())
+			}
+		case "priceProtect":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.PriceProtect = bool(in.Bool())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonE769a587EncodeGithubComWardCapGoBinanceFutures12(out *jwriter.Writer, in CancelOrderResponseV2) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"clientOrderId\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ClientOrderID))
+	}
+	{
+		const prefix string = ",\"cumQty\":"
+		out.RawString(prefix)
+		out.Raw((in.CumQuantity).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"cumQuote\":"
+		out.RawString(prefix)
+		out.Raw((in.CumQuote).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"executedQty\":"
+		out.RawString(prefix)
+		out.Raw((in.ExecutedQuantity).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"orderId\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.OrderID))
+	}
+	{
+		const prefix string = ",\"origQty\":"
+		out.RawString(prefix)
+		out.Raw((in.OrigQuantity).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"price\":"
+		out.RawString(prefix)
+		out.Raw((in.Price).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"reduceOnly\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.ReduceOnly))
+	}
+	{
+		const prefix string = ",\"side\":"
+		out.RawString(prefix)
+		out.String(string(in.Side))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	{
+		const prefix string = ",\"stopPrice\":"
+		out.RawString(prefix)
+		out.Raw((in.StopPrice).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"symbol\":"
+		out.RawString(prefix)
+		out.String(string(in.Symbol))
+	}
+	{
+		const prefix string = ",\"timeInForce\":"
+		out.RawString(prefix)
+		out.String(string(in.TimeInForce))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"updateTime\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.UpdateTime))
+	}
+	{
+		const prefix string = ",\"workingType\":"
+		out.RawString(prefix)
+		out.String(string(in.WorkingType))
+	}
+	{
+		const prefix string = ",\"activatePrice\":"
+		out.RawString(prefix)
+		out.Raw((in.ActivatePrice).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"priceRate\":"
+		out.RawString(prefix)
+		out.Raw((in.PriceRate).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"origType\":"
+		out.RawString(prefix)
+		out.String(string(in.OrigType))
+	}
+	{
+		const prefix string = ",\"positionSide\":"
+		out.RawString(prefix)
+		out.String(string(in.PositionSide))
+	}
+	{
+		const prefix string = ",\"priceProtect\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.PriceProtect))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CancelOrderResponseV2) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonE769a587EncodeGithubComWardCapGoBinanceFutures12(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CancelOrderResponseV2) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonE769a587EncodeGithubComWardCapGoBinanceFutures12(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CancelOrderResponseV2) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonE769a587DecodeGithubComWardCapGoBinanceFutures12(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CancelOrderResponseV2) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonE769a587DecodeGithubComWardCapGoBinanceFutures12(l, v)
+}
+func easyjsonE769a587DecodeGithubComWardCapGoBinanceFutures13(in *jlexer.Lexer, out *BookTickerV2) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "symbol":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Symbol = string(in.String())
+			}
+		case "bidPrice":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.BidPrice).UnmarshalJSON(data))
+				}
+			}
+		case "bidQty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.BidQuantity).UnmarshalJSON(data))
+				}
+			}
+		case "askPrice":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.AskPrice).UnmarshalJSON(data))
+				}
+			}
+		case "askQty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.AskQuantity).UnmarshalJSON(data))
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonE769a587EncodeGithubComWardCapGoBinanceFutures13(out *jwriter.Writer, in BookTickerV2) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"symbol\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Symbol))
+	}
+	{
+		const prefix string = ",\"bidPrice\":"
+		out.RawString(prefix)
+		out.Raw((in.BidPrice).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"bidQty\":"
+		out.RawString(prefix)
+		out.Raw((in.BidQuantity).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"askPrice\":"
+		out.RawString(prefix)
+		out.Raw((in.AskPrice).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"askQty\":"
+		out.RawString(prefix)
+		out.Raw((in.AskQuantity).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v BookTickerV2) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonE769a587EncodeGithubComWardCapGoBinanceFutures13(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v BookTickerV2) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonE769a587EncodeGithubComWardCapGoBinanceFutures13(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *BookTickerV2) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonE769a587DecodeGithubComWardCapGoBinanceFutures13(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *BookTickerV2) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonE769a587DecodeGithubComWardCapGoBinanceFutures13(l, v)
+}
+func easyjsonE769a587DecodeGithubComWardCapGoBinanceFutures14(in *jlexer.Lexer, out *BalanceV2) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "accountAlias":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.AccountAlias = string(in.String())
+			}
+		case "asset":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Asset = string(in.String())
+			}
+		case "balance":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.Balance).UnmarshalJSON(data))
+				}
+			}
+		case "crossWalletBalance":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.CrossWalletBalance).UnmarshalJSON(data))
+				}
+			}
+		case "crossUnPnl":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.CrossUnPnl).UnmarshalJSON(data))
+				}
+			}
+		case "availableBalance":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.AvailableBalance).UnmarshalJSON(data))
+				}
+			}
+		case "maxWithdrawAmount":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.MaxWithdrawAmount).UnmarshalJSON(data))
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonE769a587EncodeGithubComWardCapGoBinanceFutures14(out *jwriter.Writer, in BalanceV2) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"accountAlias\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.AccountAlias))
+	}
+	{
+		const prefix string = ",\"asset\":"
+		out.RawString(prefix)
+		out.String(string(in.Asset))
+	}
+	{
+		const prefix string = ",\"balance\":"
+		out.RawString(prefix)
+		out.Raw((in.Balance).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"crossWalletBalance\":"
+		out.RawString(prefix)
+		out.Raw((in.CrossWalletBalance).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"crossUnPnl\":"
+		out.RawString(prefix)
+		out.Raw((in.CrossUnPnl).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"availableBalance\":"
+		out.RawString(prefix)
+		out.Raw((in.AvailableBalance).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"maxWithdrawAmount\":"
+		out.RawString(prefix)
+		out.Raw((in.MaxWithdrawAmount).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v BalanceV2) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonE769a587EncodeGithubComWardCapGoBinanceFutures14(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v BalanceV2) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonE769a587EncodeGithubComWardCapGoBinanceFutures14(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *BalanceV2) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonE769a587DecodeGithubComWardCapGoBinanceFutures14(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *BalanceV2) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonE769a587DecodeGithubComWardCapGoBinanceFutures14(l, v)
+}
+func easyjsonE769a587DecodeGithubComWardCapGoBinanceFutures15(in *jlexer.Lexer, out *AggTradeV2) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "a":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.AggTradeID = int64(in.Int64())
+			}
+		case "p":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.Price).UnmarshalJSON(data))
+				}
+			}
+		case "q":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.Quantity).UnmarshalJSON(data))
+				}
+			}
+		case "f":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.FirstTradeID = int64(in.Int64())
+			}
+		case "l":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.LastTradeID = int64(in.Int64())
+			}
+		case "T":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Timestamp = int64(in.Int64())
+			}
+		case "m":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.IsBuyerMaker = bool(in.Bool())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonE769a587EncodeGithubComWardCapGoBinanceFutures15(out *jwriter.Writer, in AggTradeV2) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"a\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.AggTradeID))
+	}
+	{
+		const prefix string = ",\"p\":"
+		out.RawString(prefix)
+		out.Raw((in.Price).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"q\":"
+		out.RawString(prefix)
+		out.Raw((in.Quantity).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"f\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.FirstTradeID))
+	}
+	{
+		const prefix string = ",\"l\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.LastTradeID))
+	}
+	{
+		const prefix string = ",\"T\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Timestamp))
+	}
+	{
+		const prefix string = ",\"m\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsBuyerMaker))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AggTradeV2) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonE769a587EncodeGithubComWardCapGoBinanceFutures15(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v AggTradeV2) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonE769a587EncodeGithubComWardCapGoBinanceFutures15(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AggTradeV2) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonE769a587DecodeGithubComWardCapGoBinanceFutures15(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *AggTradeV2) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonE769a587DecodeGithubComWardCapGoBinanceFutures15(l, v)
+}
+func easyjsonE769a587DecodeGithubComWardCapGoBinanceFutures16(in *jlexer.Lexer, out *AccountV2) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "assets":
+			if in.IsNull() {
+				in.Skip()
+				out.Assets = nil
+			} else {
+				in.Delim('[')
+				if out.Assets == nil {
+					if !in.IsDelim(']') {
+						out.Assets = make([]*AccountAssetV2, 0, 8)
+					} else {
+						out.Assets = []*AccountAssetV2{}
+					}
+				} else {
+					out.Assets = (out.Assets)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 *AccountAssetV2
+					if in.IsNull() {
+						in.Skip()
+						v1 = nil
+					} else {
+						if v1 == nil {
+							v1 = new(AccountAssetV2)
+						}
+						if in.IsNull() {
+							in.Skip()
+						} else {
+							(*v1).UnmarshalEasyJSON(in)
+						}
+					}
+					out.Assets = append(out.Assets, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "feeTier":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.FeeTier = int(in.Int())
+			}
+		case "canTrade":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.CanTrade = bool(in.Bool())
+			}
+		case "canDeposit":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.CanDeposit = bool(in.Bool())
+			}
+		case "canWithdraw":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.CanWithdraw = bool(in.Bool())
+			}
+		case "updateTime":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.UpdateTime = int64(in.Int64())
+			}
+		case "multiAssetsMargin":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.MultiAssetsMargin = bool(in.Bool())
+			}
+		case "totalInitialMargin":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.TotalInitialMargin).UnmarshalJSON(data))
+				}
+			}
+		case "totalMaintMargin":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.TotalMaintMargin).UnmarshalJSON(data))
+				}
+			}
+		case "totalWalletBalance":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.TotalWalletBalance).UnmarshalJSON(data))
+				}
+			}
+		case "totalUnrealizedProfit":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.TotalUnrealizedProfit).UnmarshalJSON(data))
+				}
+			}
+		case "totalMarginBalance":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.TotalMarginBalance).UnmarshalJSON(data))
+				}
+			}
+		case "totalPositionInitialMargin":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.TotalPositionInitialMargin).UnmarshalJSON(data))
+				}
+			}
+		case "totalOpenOrderInitialMargin":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.TotalOpenOrderInitialMargin).UnmarshalJSON(data))
+				}
+			}
+		case "totalCrossWalletBalance":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.TotalCrossWalletBalance).UnmarshalJSON(data))
+				}
+			}
+		case "totalCrossUnPnl":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.TotalCrossUnPnl).UnmarshalJSON(data))
+				}
+			}
+		case "availableBalance":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.AvailableBalance).UnmarshalJSON(data))
+				}
+			}
+		case "maxWithdrawAmount":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.MaxWithdrawAmount).UnmarshalJSON(data))
+				}
+			}
+		case "positions":
+			if in.IsNull() {
+				in.Skip()
+				out.Positions = nil
+			} else {
+				in.Delim('[')
+				if out.Positions == nil {
+					if !in.IsDelim(']') {
+						out.Positions = make([]*AccountPositionV2, 0, 8)
+					} else {
+						out.Positions = []*AccountPositionV2{}
+					}
+				} else {
+					out.Positions = (out.Positions)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v2 *AccountPositionV2
+					if in.IsNull() {
+						in.Skip()
+						v2 = nil
+					} else {
+						if v2 == nil {
+							v2 = new(AccountPositionV2)
+						}
+						if in.IsNull() {
+							in.Skip()
+						} else {
+							(*v2).UnmarshalEasyJSON(in)
+						}
+					}
+					out.Positions = append(out.Positions, v2)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonE769a587EncodeGithubComWardCapGoBinanceFutures16(out *jwriter.Writer, in AccountV2) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"assets\":"
+		out.RawString(prefix[1:])
+		if in.Assets == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v3, v4 := range in.Assets {
+				if v3 > 0 {
+					out.RawByte(',')
+				}
+				if v4 == nil {
+					out.RawString("null")
+				} else {
+					(*v4).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"feeTier\":"
+		out.RawString(prefix)
+		out.Int(int(in.FeeTier))
+	}
+	{
+		const prefix string = ",\"canTrade\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CanTrade))
+	}
+	{
+		const prefix string = ",\"canDeposit\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CanDeposit))
+	}
+	{
+		const prefix string = ",\"canWithdraw\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CanWithdraw))
+	}
+	{
+		const prefix string = ",\"updateTime\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.UpdateTime))
+	}
+	{
+		const prefix string = ",\"multiAssetsMargin\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.MultiAssetsMargin))
+	}
+	{
+		const prefix string = ",\"totalInitialMargin\":"
+		out.RawString(prefix)
+		out.Raw((in.TotalInitialMargin).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"totalMaintMargin\":"
+		out.RawString(prefix)
+		out.Raw((in.TotalMaintMargin).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"totalWalletBalance\":"
+		out.RawString(prefix)
+		out.Raw((in.TotalWalletBalance).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"totalUnrealizedProfit\":"
+		out.RawString(prefix)
+		out.Raw((in.TotalUnrealizedProfit).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"totalMarginBalance\":"
+		out.RawString(prefix)
+		out.Raw((in.TotalMarginBalance).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"totalPositionInitialMargin\":"
+		out.RawString(prefix)
+		out.Raw((in.TotalPositionInitialMargin).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"totalOpenOrderInitialMargin\":"
+		out.RawString(prefix)
+		out.Raw((in.TotalOpenOrderInitialMargin).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"totalCrossWalletBalance\":"
+		out.RawString(prefix)
+		out.Raw((in.TotalCrossWalletBalance).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"totalCrossUnPnl\":"
+		out.RawString(prefix)
+		out.Raw((in.TotalCrossUnPnl).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"availableBalance\":"
+		out.RawString(prefix)
+		out.Raw((in.AvailableBalance).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"maxWithdrawAmount\":"
+		out.RawString(prefix)
+		out.Raw((in.MaxWithdrawAmount).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"positions\":"
+		out.RawString(prefix)
+		if in.Positions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v5, v6 := range in.Positions {
+				if v5 > 0 {
+					out.RawByte(',')
+				}
+				if v6 == nil {
+					out.RawString("null")
+				} else {
+					(*v6).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AccountV2) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonE769a587EncodeGithubComWardCapGoBinanceFutures16(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v AccountV2) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonE769a587EncodeGithubComWardCapGoBinanceFutures16(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AccountV2) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonE769a587DecodeGithubComWardCapGoBinanceFutures16(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *AccountV2) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonE769a587DecodeGithubComWardCapGoBinanceFutures16(l, v)
+}
+func easyjsonE769a587DecodeGithubComWardCapGoBinanceFutures17(in *jlexer.Lexer, out *AccountTradeV2) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "buyer":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Buyer = bool(in.Bool())
+			}
+		case "commission":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.Commission).UnmarshalJSON(data))
+				}
+			}
+		case "commissionAsset":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.CommissionAsset = string(in.String())
+			}
+		case "id":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ID = int64(in.Int64())
+			}
+		case "maker":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Maker = bool(in.Bool())
+			}
+		case "orderId":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.OrderID = int64(in.Int64())
+			}
+		case "price":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.Price).UnmarshalJSON(data))
+				}
+			}
+		case "qty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.Quantity).UnmarshalJSON(data))
+				}
+			}
+		case "quoteQty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.QuoteQuantity).UnmarshalJSON(data))
+				}
+			}
+		case "realizedPnl":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.RealizedPnl).UnmarshalJSON(data))
+				}
+			}
+		case "side":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Side = SideType(in.String())
+			}
+		case "positionSide":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.PositionSide = PositionSideType(in.String())
+			}
+		case "symbol":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Symbol = string(in.String())
+			}
+		case "time":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Time = int64(in.Int64())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonE769a587EncodeGithubComWardCapGoBinanceFutures17(out *jwriter.Writer, in AccountTradeV2) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"buyer\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.Buyer))
+	}
+	{
+		const prefix string = ",\"commission\":"
+		out.RawString(prefix)
+		out.Raw((in.Commission).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"commissionAsset\":"
+		out.RawString(prefix)
+		out.String(string(in.CommissionAsset))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.ID))
+	}
+	{
+		const prefix string = ",\"maker\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Maker))
+	}
+	{
+		const prefix string = ",\"orderId\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.OrderID))
+	}
+	{
+		const prefix string = ",\"price\":"
+		out.RawString(prefix)
+		out.Raw((in.Price).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"qty\":"
+		out.RawString(prefix)
+		out.Raw((in.Quantity).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"quoteQty\":"
+		out.RawString(prefix)
+		out.Raw((in.QuoteQuantity).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"realizedPnl\":"
+		out.RawString(prefix)
+		out.Raw((in.RealizedPnl).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"side\":"
+		out.RawString(prefix)
+		out.String(string(in.Side))
+	}
+	{
+		const prefix string = ",\"positionSide\":"
+		out.RawString(prefix)
+		out.String(string(in.PositionSide))
+	}
+	{
+		const prefix string = ",\"symbol\":"
+		out.RawString(prefix)
+		out.String(string(in.Symbol))
+	}
+	{
+		const prefix string = ",\"time\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Time))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AccountTradeV2) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonE769a587EncodeGithubComWardCapGoBinanceFutures17(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v AccountTradeV2) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonE769a587EncodeGithubComWardCapGoBinanceFutures17(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AccountTradeV2) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonE769a587DecodeGithubComWardCapGoBinanceFutures17(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *AccountTradeV2) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonE769a587DecodeGithubComWardCapGoBinanceFutures17(l, v)
+}
+func easyjsonE769a587DecodeGithubComWardCapGoBinanceFutures18(in *jlexer.Lexer, out *AccountPositionV2) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "isolated":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Isolated = bool(in.Bool())
+			}
+		case "leverage":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.Leverage).UnmarshalJSON(data))
+				}
+			}
+		case "initialMargin":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.InitialMargin).UnmarshalJSON(data))
+				}
+			}
+		case "maintMargin":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.MaintMargin).UnmarshalJSON(data))
+				}
+			}
+		case "openOrderInitialMargin":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.OpenOrderInitialMargin).UnmarshalJSON(data))
+				}
+			}
+		case "positionInitialMargin":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.PositionInitialMargin).UnmarshalJSON(data))
+				}
+			}
+		case "symbol":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Symbol = string(in.String())
+			}
+		case "unrealizedProfit":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.UnrealizedProfit).UnmarshalJSON(data))
+				}
+			}
+		case "entryPrice":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.EntryPrice).UnmarshalJSON(data))
+				}
+			}
+		case "maxNotional":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.MaxNotional).UnmarshalJSON(data))
+				}
+			}
+		case "positionSide":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.PositionSide = PositionSideType(in.String())
+			}
+		case "positionAmt":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.PositionAmt).UnmarshalJSON(data))
+				}
+			}
+		case "notional":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.Notional).UnmarshalJSON(data))
+				}
+			}
+		case "bidNotional":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.BidNotional).UnmarshalJSON(data))
+				}
+			}
+		case "askNotional":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.AskNotional).UnmarshalJSON(data))
+				}
+			}
+		case "updateTime":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.UpdateTime = int64(in.Int64())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonE769a587EncodeGithubComWardCapGoBinanceFutures18(out *jwriter.Writer, in AccountPositionV2) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"isolated\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.Isolated))
+	}
+	{
+		const prefix string = ",\"leverage\":"
+		out.RawString(prefix)
+		out.Raw((in.Leverage).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"initialMargin\":"
+		out.RawString(prefix)
+		out.Raw((in.InitialMargin).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"maintMargin\":"
+		out.RawString(prefix)
+		out.Raw((in.MaintMargin).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"openOrderInitialMargin\":"
+		out.RawString(prefix)
+		out.Raw((in.OpenOrderInitialMargin).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"positionInitialMargin\":"
+		out.RawString(prefix)
+		out.Raw((in.PositionInitialMargin).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"symbol\":"
+		out.RawString(prefix)
+		out.String(string(in.Symbol))
+	}
+	{
+		const prefix string = ",\"unrealizedProfit\":"
+		out.RawString(prefix)
+		out.Raw((in.UnrealizedProfit).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"entryPrice\":"
+		out.RawString(prefix)
+		out.Raw((in.EntryPrice).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"maxNotional\":"
+		out.RawString(prefix)
+		out.Raw((in.MaxNotional).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"positionSide\":"
+		out.RawString(prefix)
+		out.String(string(in.PositionSide))
+	}
+	{
+		const prefix string = ",\"positionAmt\":"
+		out.RawString(prefix)
+		out.Raw((in.PositionAmt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"notional\":"
+		out.RawString(prefix)
+		out.Raw((in.Notional).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"bidNotional\":"
+		out.RawString(prefix)
+		out.Raw((in.BidNotional).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"askNotional\":"
+		out.RawString(prefix)
+		out.Raw((in.AskNotional).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"updateTime\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.UpdateTime))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AccountPositionV2) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonE769a587EncodeGithubComWardCapGoBinanceFutures18(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v AccountPositionV2) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonE769a587EncodeGithubComWardCapGoBinanceFutures18(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AccountPositionV2) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonE769a587DecodeGithubComWardCapGoBinanceFutures18(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *AccountPositionV2) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonE769a587DecodeGithubComWardCapGoBinanceFutures18(l, v)
+}
+func easyjsonE769a587DecodeGithubComWardCapGoBinanceFutures19(in *jlexer.Lexer, out *AccountAssetV2) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "asset":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Asset = string(in.String())
+			}
+		case "initialMargin":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.InitialMargin).UnmarshalJSON(data))
+				}
+			}
+		case "maintMargin":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.MaintMargin).UnmarshalJSON(data))
+				}
+			}
+		case "marginBalance":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.MarginBalance).UnmarshalJSON(data))
+				}
+			}
+		case "maxWithdrawAmount":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.MaxWithdrawAmount).UnmarshalJSON(data))
+				}
+			}
+		case "openOrderInitialMargin":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.OpenOrderInitialMargin).UnmarshalJSON(data))
+				}
+			}
+		case "positionInitialMargin":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.PositionInitialMargin).UnmarshalJSON(data))
+				}
+			}
+		case "unrealizedProfit":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.UnrealizedProfit).UnmarshalJSON(data))
+				}
+			}
+		case "walletBalance":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.WalletBalance).UnmarshalJSON(data))
+				}
+			}
+		case "crossWalletBalance":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.CrossWalletBalance).UnmarshalJSON(data))
+				}
+			}
+		case "crossUnPnl":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.CrossUnPnl).UnmarshalJSON(data))
+				}
+			}
+		case "availableBalance":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.AvailableBalance).UnmarshalJSON(data))
+				}
+			}
+		case "marginAvailable":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.MarginAvailable = bool(in.Bool())
+			}
+		case "updateTime":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.UpdateTime = int64(in.Int64())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonE769a587EncodeGithubComWardCapGoBinanceFutures19(out *jwriter.Writer, in AccountAssetV2) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"asset\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Asset))
+	}
+	{
+		const prefix string = ",\"initialMargin\":"
+		out.RawString(prefix)
+		out.Raw((in.InitialMargin).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"maintMargin\":"
+		out.RawString(prefix)
+		out.Raw((in.MaintMargin).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"marginBalance\":"
+		out.RawString(prefix)
+		out.Raw((in.MarginBalance).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"maxWithdrawAmount\":"
+		out.RawString(prefix)
+		out.Raw((in.MaxWithdrawAmount).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"openOrderInitialMargin\":"
+		out.RawString(prefix)
+		out.Raw((in.OpenOrderInitialMargin).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"positionInitialMargin\":"
+		out.RawString(prefix)
+		out.Raw((in.PositionInitialMargin).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"unrealizedProfit\":"
+		out.RawString(prefix)
+		out.Raw((in.UnrealizedProfit).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"walletBalance\":"
+		out.RawString(prefix)
+		out.Raw((in.WalletBalance).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"crossWalletBalance\":"
+		out.RawString(prefix)
+		out.Raw((in.CrossWalletBalance).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"crossUnPnl\":"
+		out.RawString(prefix)
+		out.Raw((in.CrossUnPnl).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"availableBalance\":"
+		out.RawString(prefix)
+		out.Raw((in.AvailableBalance).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"marginAvailable\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.MarginAvailable))
+	}
+	{
+		const prefix string = ",\"updateTime\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.UpdateTime))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AccountAssetV2) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonE769a587EncodeGithubComWardCapGoBinanceFutures19(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v AccountAssetV2) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonE769a587EncodeGithubComWardCapGoBinanceFutures19(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AccountAssetV2) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonE769a587DecodeGithubComWardCapGoBinanceFutures19(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *AccountAssetV2) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonE769a587DecodeGithubComWardCapGoBinanceFutures19(l, v)
+}
@@ -21,12 +21,12 @@ func (s *GetOpenInterestService) Symbol(symbol string) *GetOpenInterestService {
 // Do send request
 func (s *GetOpenInterestService) Do(ctx context.Context, opts ...RequestOption) (res *OpenInterest, err error) {
 	r := &request{
-		service:  "GetOpenInterestService",
-		method:   http.MethodGet,
-		endpoint: "/fapi/v1/openInterest",
+		Service:  "GetOpenInterestService",
+		Method:   http.MethodGet,
+		Endpoint: "/fapi/v1/openInterest",
 	}
-	r.setParam("symbol", s.symbol)
-	data, _, err := s.c.callAPI(ctx, r, opts...)
+	r.SetParam("symbol", s.symbol)
+	data, _, err := s.c.CallAPI(ctx, r, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -83,25 +83,25 @@ func (s *OpenInterestStatisticsService) EndTime(endTime int64) *OpenInterestStat
 // Do send request
 func (s *OpenInterestStatisticsService) Do(ctx context.Context, opts ...RequestOption) (res []*OpenInterestStatistic, err error) {
 	r := &request{
-		service:  "OpenInterestStatisticsService",
-		method:   http.MethodGet,
-		endpoint: "/futures/data/openInterestHist",
+		Service:  "OpenInterestStatisticsService",
+		Method:   http.MethodGet,
+		Endpoint: "/futures/data/openInterestHist",
 	}
 
-	r.setParam("symbol", s.symbol)
-	r.setParam("period", s.period)
+	r.SetParam("symbol", s.symbol)
+	r.SetParam("period", s.period)
 
 	if s.limit != nil {
-		r.setParam("limit", *s.limit)
+		r.SetParam("limit", *s.limit)
 	}
 	if s.startTime != nil {
-		r.setParam("startTime", *s.startTime)
+		r.SetParam("startTime", *s.startTime)
 	}
 	if s.endTime != nil {
-		r.setParam("endTime", *s.endTime)
+		r.SetParam("endTime", *s.endTime)
 	}
 
-	data, _, err := s.c.callAPI(ctx, r, opts...)
+	data, _, err := s.c.CallAPI(ctx, r, opts...)
 	if err != nil {
 		return []*OpenInterestStatistic{}, err
 	}
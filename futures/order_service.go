@@ -7,6 +7,9 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+
+	"github.com/shopspring/decimal"
+	"github.com/ward-cap/go-binance/common"
 )
 
 // CreateOrderService create order
@@ -66,6 +69,11 @@ func (s *CreateOrderService) Quantity(quantity string) *CreateOrderService {
 	return s
 }
 
+// QuantityDecimal set quantity from a decimal
+func (s *CreateOrderService) QuantityDecimal(quantity decimal.Decimal) *CreateOrderService {
+	return s.Quantity(quantity.String())
+}
+
 // ReduceOnly set reduceOnly
 func (s *CreateOrderService) ReduceOnly(reduceOnly bool) *CreateOrderService {
 	s.reduceOnly = &reduceOnly
@@ -78,6 +86,11 @@ func (s *CreateOrderService) Price(price string) *CreateOrderService {
 	return s
 }
 
+// PriceDecimal set price from a decimal
+func (s *CreateOrderService) PriceDecimal(price decimal.Decimal) *CreateOrderService {
+	return s.Price(price.String())
+}
+
 // NewClientOrderID set newClientOrderID
 func (s *CreateOrderService) NewClientOrderID(newClientOrderID string) *CreateOrderService {
 	s.newClientOrderID = &newClientOrderID
@@ -90,6 +103,11 @@ func (s *CreateOrderService) StopPrice(stopPrice string) *CreateOrderService {
 	return s
 }
 
+// StopPriceDecimal set stopPrice from a decimal
+func (s *CreateOrderService) StopPriceDecimal(stopPrice decimal.Decimal) *CreateOrderService {
+	return s.StopPrice(stopPrice.String())
+}
+
 // WorkingType set workingType
 func (s *CreateOrderService) WorkingType(workingType WorkingType) *CreateOrderService {
 	s.workingType = &workingType
@@ -102,12 +120,22 @@ func (s *CreateOrderService) ActivationPrice(activationPrice string) *CreateOrde
 	return s
 }
 
+// ActivationPriceDecimal set activationPrice from a decimal
+func (s *CreateOrderService) ActivationPriceDecimal(activationPrice decimal.Decimal) *CreateOrderService {
+	return s.ActivationPrice(activationPrice.String())
+}
+
 // CallbackRate set callbackRate
 func (s *CreateOrderService) CallbackRate(callbackRate string) *CreateOrderService {
 	s.callbackRate = &callbackRate
 	return s
 }
 
+// CallbackRateDecimal set callbackRate from a decimal
+func (s *CreateOrderService) CallbackRateDecimal(callbackRate decimal.Decimal) *CreateOrderService {
+	return s.CallbackRate(callbackRate.String())
+}
+
 // PriceProtect set priceProtect
 func (s *CreateOrderService) PriceProtect(priceProtect bool) *CreateOrderService {
 	s.priceProtect = &priceProtect
@@ -126,13 +154,12 @@ func (s *CreateOrderService) ClosePosition(closePosition bool) *CreateOrderServi
 	return s
 }
 
-func (s *CreateOrderService) createOrder(ctx context.Context, endpoint string, opts ...RequestOption) (data []byte, header *http.Header, err error) {
-
+func (s *CreateOrderService) newRequest(endpoint string) *request {
 	r := &request{
-		service:  "CreateOrderService",
-		method:   http.MethodPost,
-		endpoint: endpoint,
-		secType:  secTypeSigned,
+		Service:  "CreateOrderService",
+		Method:   http.MethodPost,
+		Endpoint: endpoint,
+		SecType:  secTypeSigned,
 	}
 	m := params{
 		"symbol":           s.symbol,
@@ -176,20 +203,32 @@ func (s *CreateOrderService) createOrder(ctx context.Context, endpoint string, o
 	if s.closePosition != nil {
 		m["closePosition"] = *s.closePosition
 	}
-	r.setFormParams(m)
-	data, header, err = s.c.callAPI(ctx, r, opts...)
+	r.SetFormParams(m)
+	return r
+}
+
+func (s *CreateOrderService) createOrder(ctx context.Context, endpoint string, opts ...RequestOption) (data []byte, header *http.Header, recovered bool, err error) {
+	r := s.newRequest(endpoint)
+	data, header, err = s.c.CallAPI(ctx, r, opts...)
 	if err != nil {
-		return []byte{}, &http.Header{}, err
+		return []byte{}, &http.Header{}, false, err
 	}
-	return data, header, nil
+	return data, header, r.Recovered, nil
 }
 
 // Do send request
 func (s *CreateOrderService) Do(ctx context.Context, opts ...RequestOption) (res *CreateOrderResponse, err error) {
-	data, header, err := s.createOrder(ctx, "/fapi/v1/order", opts...)
+	data, header, recovered, err := s.createOrder(ctx, "/fapi/v1/order", opts...)
 	if err != nil {
 		return nil, err
 	}
+	if recovered {
+		order := new(Order)
+		if err = json.Unmarshal(data, order); err != nil {
+			return nil, err
+		}
+		return recoveredOrderResponse(order), nil
+	}
 	res = new(CreateOrderResponse)
 	err = json.Unmarshal(data, res)
 	res.RateLimitOrder10s = header.Get("X-Mbx-Order-Count-10s")
@@ -201,6 +240,58 @@ func (s *CreateOrderService) Do(ctx context.Context, opts ...RequestOption) (res
 	return res, nil
 }
 
+// DoV2 send request and return the decimal typed CreateOrderResponseV2
+func (s *CreateOrderService) DoV2(ctx context.Context, opts ...RequestOption) (res *CreateOrderResponseV2, err error) {
+	data, header, recovered, err := s.createOrder(ctx, "/fapi/v1/order", opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(CreateOrderResponseV2)
+	// the queried order shares the field names of the placement response
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	res.Recovered = recovered
+	res.RateLimitOrder10s = header.Get("X-Mbx-Order-Count-10s")
+	res.RateLimitOrder1m = header.Get("X-Mbx-Order-Count-1m")
+	return res, nil
+}
+
+// recoveredOrderResponse convert a queried order to the placement response
+// of the order
+func recoveredOrderResponse(o *Order) *CreateOrderResponse {
+	res := &CreateOrderResponse{
+		Symbol:        o.Symbol,
+		OrderID:       o.OrderID,
+		ClientOrderID: o.ClientOrderID,
+		Price:         o.Price.String(),
+		OrigQuantity:  o.OrigQuantity.String(),
+		CumQuote:      o.CumQuote,
+		ReduceOnly:    o.ReduceOnly,
+		Status:        o.Status,
+		TimeInForce:   o.TimeInForce,
+		Type:          o.Type,
+		Side:          o.Side,
+		UpdateTime:    o.UpdateTime,
+		WorkingType:   o.WorkingType,
+		ActivatePrice: o.ActivatePrice,
+		PriceRate:     o.PriceRate,
+		AvgPrice:      o.AvgPrice,
+		PositionSide:  o.PositionSide,
+		ClosePosition: o.ClosePosition,
+		PriceProtect:  o.PriceProtect,
+		Recovered:     true,
+	}
+	if o.ExecutedQuantity.Valid {
+		res.ExecutedQuantity = o.ExecutedQuantity.Decimal.String()
+	}
+	if o.StopPrice.Valid {
+		res.StopPrice = o.StopPrice.Decimal.String()
+	}
+	return res
+}
+
 // ListOpenOrdersService list opened orders
 type ListOpenOrdersService struct {
 	c      *Client
@@ -213,18 +304,22 @@ func (s *ListOpenOrdersService) Symbol(symbol string) *ListOpenOrdersService {
 	return s
 }
 
-// Do send request
-func (s *ListOpenOrdersService) Do(ctx context.Context, opts ...RequestOption) (res []*Order, err error) {
+func (s *ListOpenOrdersService) newRequest() *request {
 	r := &request{
-		service:  "ListOpenOrdersService",
-		method:   http.MethodGet,
-		endpoint: "/fapi/v1/openOrders",
-		secType:  secTypeSigned,
+		Service:  "ListOpenOrdersService",
+		Method:   http.MethodGet,
+		Endpoint: "/fapi/v1/openOrders",
+		SecType:  secTypeSigned,
 	}
 	if s.symbol != "" {
-		r.setParam("symbol", s.symbol)
+		r.SetParam("symbol", s.symbol)
 	}
-	data, _, err := s.c.callAPI(ctx, r, opts...)
+	return r
+}
+
+// Do send request
+func (s *ListOpenOrdersService) Do(ctx context.Context, opts ...RequestOption) (res []*Order, err error) {
+	data, _, err := s.c.CallAPI(ctx, s.newRequest(), opts...)
 	if err != nil {
 		return []*Order{}, err
 	}
@@ -236,6 +331,20 @@ func (s *ListOpenOrdersService) Do(ctx context.Context, opts ...RequestOption) (
 	return res, nil
 }
 
+// DoV2 send request and return the decimal typed OrderV2
+func (s *ListOpenOrdersService) DoV2(ctx context.Context, opts ...RequestOption) (res []*OrderV2, err error) {
+	data, _, err := s.c.CallAPI(ctx, s.newRequest(), opts...)
+	if err != nil {
+		return []*OrderV2{}, err
+	}
+	res = make([]*OrderV2, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*OrderV2{}, err
+	}
+	return res, nil
+}
+
 // GetOpenOrderService query current open order
 type GetOpenOrderService struct {
 	c                 *Client
@@ -261,22 +370,22 @@ func (s *GetOpenOrderService) OrigClientOrderID(origClientOrderID string) *GetOp
 
 func (s *GetOpenOrderService) Do(ctx context.Context, opts ...RequestOption) (res *Order, err error) {
 	r := &request{
-		service:  "GetOpenOrderService",
-		method:   http.MethodGet,
-		endpoint: "/fapi/v1/openOrder",
-		secType:  secTypeSigned,
+		Service:  "GetOpenOrderService",
+		Method:   http.MethodGet,
+		Endpoint: "/fapi/v1/openOrder",
+		SecType:  secTypeSigned,
 	}
-	r.setParam("symbol", s.symbol)
+	r.SetParam("symbol", s.symbol)
 	if s.orderID == nil && s.origClientOrderID == nil {
 		return nil, errors.New("either orderId or origClientOrderId must be sent")
 	}
 	if s.orderID != nil {
-		r.setParam("orderId", *s.orderID)
+		r.SetParam("orderId", *s.orderID)
 	}
 	if s.origClientOrderID != nil {
-		r.setParam("origClientOrderId", *s.origClientOrderID)
+		r.SetParam("origClientOrderId", *s.origClientOrderID)
 	}
-	data, _, err := s.c.callAPI(ctx, r, opts...)
+	data, _, err := s.c.CallAPI(ctx, r, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -314,22 +423,26 @@ func (s *GetOrderService) OrigClientOrderID(origClientOrderID string) *GetOrderS
 	return s
 }
 
-// Do send request
-func (s *GetOrderService) Do(ctx context.Context, opts ...RequestOption) (res *Order, err error) {
+func (s *GetOrderService) newRequest() *request {
 	r := &request{
-		service:  "GetOrderService",
-		method:   http.MethodGet,
-		endpoint: "/fapi/v1/order",
-		secType:  secTypeSigned,
+		Service:  "GetOrderService",
+		Method:   http.MethodGet,
+		Endpoint: "/fapi/v1/order",
+		SecType:  secTypeSigned,
 	}
-	r.setParam("symbol", s.symbol)
+	r.SetParam("symbol", s.symbol)
 	if s.orderID != nil {
-		r.setParam("orderId", *s.orderID)
+		r.SetParam("orderId", *s.orderID)
 	}
 	if s.origClientOrderID != nil {
-		r.setParam("origClientOrderId", *s.origClientOrderID)
+		r.SetParam("origClientOrderId", *s.origClientOrderID)
 	}
-	data, _, err := s.c.callAPI(ctx, r, opts...)
+	return r
+}
+
+// Do send request
+func (s *GetOrderService) Do(ctx context.Context, opts ...RequestOption) (res *Order, err error) {
+	data, _, err := s.c.CallAPI(ctx, s.newRequest(), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -341,6 +454,20 @@ func (s *GetOrderService) Do(ctx context.Context, opts ...RequestOption) (res *O
 	return res, nil
 }
 
+// DoV2 send request and return the decimal typed OrderV2
+func (s *GetOrderService) DoV2(ctx context.Context, opts ...RequestOption) (res *OrderV2, err error) {
+	data, _, err := s.c.CallAPI(ctx, s.newRequest(), opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(OrderV2)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
 // ListOrdersService all account orders; active, canceled, or filled
 type ListOrdersService struct {
 	c         *Client
@@ -381,28 +508,32 @@ func (s *ListOrdersService) Limit(limit int) *ListOrdersService {
 	return s
 }
 
-// Do send request
-func (s *ListOrdersService) Do(ctx context.Context, opts ...RequestOption) (res []*Order, err error) {
+func (s *ListOrdersService) newRequest() *request {
 	r := &request{
-		service:  "ListOrdersService",
-		method:   http.MethodGet,
-		endpoint: "/fapi/v1/allOrders",
-		secType:  secTypeSigned,
+		Service:  "ListOrdersService",
+		Method:   http.MethodGet,
+		Endpoint: "/fapi/v1/allOrders",
+		SecType:  secTypeSigned,
 	}
-	r.setParam("symbol", s.symbol)
+	r.SetParam("symbol", s.symbol)
 	if s.orderID != nil {
-		r.setParam("orderId", *s.orderID)
+		r.SetParam("orderId", *s.orderID)
 	}
 	if s.startTime != nil {
-		r.setParam("startTime", *s.startTime)
+		r.SetParam("startTime", *s.startTime)
 	}
 	if s.endTime != nil {
-		r.setParam("endTime", *s.endTime)
+		r.SetParam("endTime", *s.endTime)
 	}
 	if s.limit != nil {
-		r.setParam("limit", *s.limit)
+		r.SetParam("limit", *s.limit)
 	}
-	data, _, err := s.c.callAPI(ctx, r, opts...)
+	return r
+}
+
+// Do send request
+func (s *ListOrdersService) Do(ctx context.Context, opts ...RequestOption) (res []*Order, err error) {
+	data, _, err := s.c.CallAPI(ctx, s.newRequest(), opts...)
 	if err != nil {
 		return []*Order{}, err
 	}
@@ -414,6 +545,20 @@ func (s *ListOrdersService) Do(ctx context.Context, opts ...RequestOption) (res
 	return res, nil
 }
 
+// DoV2 send request and return the decimal typed OrderV2
+func (s *ListOrdersService) DoV2(ctx context.Context, opts ...RequestOption) (res []*OrderV2, err error) {
+	data, _, err := s.c.CallAPI(ctx, s.newRequest(), opts...)
+	if err != nil {
+		return []*OrderV2{}, err
+	}
+	res = make([]*OrderV2, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*OrderV2{}, err
+	}
+	return res, nil
+}
+
 // CancelOrderService cancel an order
 type CancelOrderService struct {
 	c                 *Client
@@ -440,22 +585,26 @@ func (s *CancelOrderService) OrigClientOrderID(origClientOrderID string) *Cancel
 	return s
 }
 
-// Do send request
-func (s *CancelOrderService) Do(ctx context.Context, opts ...RequestOption) (res *CancelOrderResponse, err error) {
+func (s *CancelOrderService) newRequest() *request {
 	r := &request{
-		service:  "CancelOrderService",
-		method:   http.MethodDelete,
-		endpoint: "/fapi/v1/order",
-		secType:  secTypeSigned,
+		Service:  "CancelOrderService",
+		Method:   http.MethodDelete,
+		Endpoint: "/fapi/v1/order",
+		SecType:  secTypeSigned,
 	}
-	r.setFormParam("symbol", s.symbol)
+	r.SetFormParam("symbol", s.symbol)
 	if s.orderID != nil {
-		r.setFormParam("orderId", *s.orderID)
+		r.SetFormParam("orderId", *s.orderID)
 	}
 	if s.origClientOrderID != nil {
-		r.setFormParam("origClientOrderId", *s.origClientOrderID)
+		r.SetFormParam("origClientOrderId", *s.origClientOrderID)
 	}
-	data, _, err := s.c.callAPI(ctx, r, opts...)
+	return r
+}
+
+// Do send request
+func (s *CancelOrderService) Do(ctx context.Context, opts ...RequestOption) (res *CancelOrderResponse, err error) {
+	data, _, err := s.c.CallAPI(ctx, s.newRequest(), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -467,6 +616,20 @@ func (s *CancelOrderService) Do(ctx context.Context, opts ...RequestOption) (res
 	return res, nil
 }
 
+// DoV2 send request and return the decimal typed CancelOrderResponseV2
+func (s *CancelOrderService) DoV2(ctx context.Context, opts ...RequestOption) (res *CancelOrderResponseV2, err error) {
+	data, _, err := s.c.CallAPI(ctx, s.newRequest(), opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(CancelOrderResponseV2)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
 // CancelAllOpenOrdersService cancel all open orders
 type CancelAllOpenOrdersService struct {
 	c      *Client
@@ -482,13 +645,13 @@ func (s *CancelAllOpenOrdersService) Symbol(symbol string) *CancelAllOpenOrdersS
 // Do send request
 func (s *CancelAllOpenOrdersService) Do(ctx context.Context, opts ...RequestOption) (err error) {
 	r := &request{
-		service:  "CancelAllOpenOrdersService",
-		method:   http.MethodDelete,
-		endpoint: "/fapi/v1/allOpenOrders",
-		secType:  secTypeSigned,
+		Service:  "CancelAllOpenOrdersService",
+		Method:   http.MethodDelete,
+		Endpoint: "/fapi/v1/allOpenOrders",
+		SecType:  secTypeSigned,
 	}
-	r.setFormParam("symbol", s.symbol)
-	_, _, err = s.c.callAPI(ctx, r, opts...)
+	r.SetFormParam("symbol", s.symbol)
+	_, _, err = s.c.CallAPI(ctx, r, opts...)
 	if err != nil {
 		return err
 	}
@@ -524,21 +687,21 @@ func (s *CancelMultiplesOrdersService) OrigClientOrderIDList(origClientOrderIDLi
 // Do send request
 func (s *CancelMultiplesOrdersService) Do(ctx context.Context, opts ...RequestOption) (res []*CancelOrderResponse, err error) {
 	r := &request{
-		service:  "CancelMultiplesOrdersService",
-		method:   http.MethodDelete,
-		endpoint: "/fapi/v1/batchOrders",
-		secType:  secTypeSigned,
+		Service:  "CancelMultiplesOrdersService",
+		Method:   http.MethodDelete,
+		Endpoint: "/fapi/v1/batchOrders",
+		SecType:  secTypeSigned,
 	}
-	r.setFormParam("symbol", s.symbol)
+	r.SetFormParam("symbol", s.symbol)
 	if s.orderIDList != nil {
 		// convert a slice of integers to a string e.g. [1 2 3] => "[1,2,3]"
 		orderIDListString := strings.Join(strings.Fields(fmt.Sprint(s.orderIDList)), ",")
-		r.setFormParam("orderIdList", orderIDListString)
+		r.SetFormParam("orderIdList", orderIDListString)
 	}
 	if s.origClientOrderIDList != nil {
-		r.setFormParam("origClientOrderIdList", s.origClientOrderIDList)
+		r.SetFormParam("origClientOrderIdList", s.origClientOrderIDList)
 	}
-	data, _, err := s.c.callAPI(ctx, r, opts...)
+	data, _, err := s.c.CallAPI(ctx, r, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -586,24 +749,24 @@ func (s *ListLiquidationOrdersService) Limit(limit int) *ListLiquidationOrdersSe
 // Do send request
 func (s *ListLiquidationOrdersService) Do(ctx context.Context, opts ...RequestOption) (res []*LiquidationOrder, err error) {
 	r := &request{
-		service:  "ListLiquidationOrdersService",
-		method:   http.MethodGet,
-		endpoint: "/fapi/v1/allForceOrders",
-		secType:  secTypeNone,
+		Service:  "ListLiquidationOrdersService",
+		Method:   http.MethodGet,
+		Endpoint: "/fapi/v1/allForceOrders",
+		SecType:  secTypeNone,
 	}
 	if s.symbol != nil {
-		r.setParam("symbol", *s.symbol)
+		r.SetParam("symbol", *s.symbol)
 	}
 	if s.startTime != nil {
-		r.setParam("startTime", *s.startTime)
+		r.SetParam("startTime", *s.startTime)
 	}
 	if s.endTime != nil {
-		r.setParam("endTime", *s.endTime)
+		r.SetParam("endTime", *s.endTime)
 	}
 	if s.limit != nil {
-		r.setParam("limit", *s.limit)
+		r.SetParam("limit", *s.limit)
 	}
-	data, _, err := s.c.callAPI(ctx, r, opts...)
+	data, _, err := s.c.CallAPI(ctx, r, opts...)
 	if err != nil {
 		return []*LiquidationOrder{}, err
 	}
@@ -655,29 +818,33 @@ func (s *ListUserLiquidationOrdersService) Limit(limit int) *ListUserLiquidation
 	return s
 }
 
-// Do send request
-func (s *ListUserLiquidationOrdersService) Do(ctx context.Context, opts ...RequestOption) (res []*UserLiquidationOrder, err error) {
+func (s *ListUserLiquidationOrdersService) newRequest() *request {
 	r := &request{
-		service:  "ListUserLiquidationOrdersService",
-		method:   http.MethodGet,
-		endpoint: "/fapi/v1/forceOrders",
-		secType:  secTypeSigned,
+		Service:  "ListUserLiquidationOrdersService",
+		Method:   http.MethodGet,
+		Endpoint: "/fapi/v1/forceOrders",
+		SecType:  secTypeSigned,
 	}
 
-	r.setParam("autoCloseType", s.autoCloseType)
+	r.SetParam("autoCloseType", s.autoCloseType)
 	if s.symbol != nil {
-		r.setParam("symbol", *s.symbol)
+		r.SetParam("symbol", *s.symbol)
 	}
 	if s.startTime != nil {
-		r.setParam("startTime", *s.startTime)
+		r.SetParam("startTime", *s.startTime)
 	}
 	if s.endTime != nil {
-		r.setParam("endTime", *s.endTime)
+		r.SetParam("endTime", *s.endTime)
 	}
 	if s.limit != nil {
-		r.setParam("limit", *s.limit)
+		r.SetParam("limit", *s.limit)
 	}
-	data, _, err := s.c.callAPI(ctx, r, opts...)
+	return r
+}
+
+// Do send request
+func (s *ListUserLiquidationOrdersService) Do(ctx context.Context, opts ...RequestOption) (res []*UserLiquidationOrder, err error) {
+	data, _, err := s.c.CallAPI(ctx, s.newRequest(), opts...)
 	if err != nil {
 		return []*UserLiquidationOrder{}, err
 	}
@@ -689,6 +856,20 @@ func (s *ListUserLiquidationOrdersService) Do(ctx context.Context, opts ...Reque
 	return res, nil
 }
 
+// DoV2 send request and return the decimal typed UserLiquidationOrderV2
+func (s *ListUserLiquidationOrdersService) DoV2(ctx context.Context, opts ...RequestOption) (res []*UserLiquidationOrderV2, err error) {
+	data, _, err := s.c.CallAPI(ctx, s.newRequest(), opts...)
+	if err != nil {
+		return []*UserLiquidationOrderV2{}, err
+	}
+	res = make([]*UserLiquidationOrderV2, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*UserLiquidationOrderV2{}, err
+	}
+	return res, nil
+}
+
 type CreateBatchOrdersService struct {
 	c      *Client
 	orders []*CreateOrderService
@@ -701,10 +882,10 @@ func (s *CreateBatchOrdersService) OrderList(orders []*CreateOrderService) *Crea
 
 func (s *CreateBatchOrdersService) Do(ctx context.Context, opts ...RequestOption) (res *CreateBatchOrdersResponse, err error) {
 	r := &request{
-		service:  "CreateBatchOrdersService",
-		method:   http.MethodPost,
-		endpoint: "/fapi/v1/batchOrders",
-		secType:  secTypeSigned,
+		Service:  "CreateBatchOrdersService",
+		Method:   http.MethodPost,
+		Endpoint: "/fapi/v1/batchOrders",
+		SecType:  secTypeSigned,
 	}
 
 	orders := []params{}
@@ -760,9 +941,9 @@ func (s *CreateBatchOrdersService) Do(ctx context.Context, opts ...RequestOption
 		"batchOrders": string(b),
 	}
 
-	r.setFormParams(m)
+	r.SetFormParams(m)
 
-	data, _, err := s.c.callAPI(ctx, r, opts...)
+	data, _, err := s.c.CallAPI(ctx, r, opts...)
 
 	if err != nil {
 		return &CreateBatchOrdersResponse{}, err
@@ -794,3 +975,285 @@ func (s *CreateBatchOrdersService) Do(ctx context.Context, opts ...RequestOption
 	return batchCreateOrdersResponse, nil
 
 }
+
+// ModifyOrderService modify the price or quantity of an open limit order in
+// place, keeping its queue priority when only the quantity is decreased
+type ModifyOrderService struct {
+	c                 *Client
+	symbol            string
+	orderID           *int64
+	origClientOrderID *string
+	side              SideType
+	quantity          string
+	price             *string
+	priceMatch        *PriceMatchType
+}
+
+// Symbol set symbol
+func (s *ModifyOrderService) Symbol(symbol string) *ModifyOrderService {
+	s.symbol = symbol
+	return s
+}
+
+// OrderID set orderID
+func (s *ModifyOrderService) OrderID(orderID int64) *ModifyOrderService {
+	s.orderID = &orderID
+	return s
+}
+
+// OrigClientOrderID set origClientOrderID
+func (s *ModifyOrderService) OrigClientOrderID(origClientOrderID string) *ModifyOrderService {
+	s.origClientOrderID = &origClientOrderID
+	return s
+}
+
+// Side set side, it must be the side of the order
+func (s *ModifyOrderService) Side(side SideType) *ModifyOrderService {
+	s.side = side
+	return s
+}
+
+// Quantity set quantity
+func (s *ModifyOrderService) Quantity(quantity string) *ModifyOrderService {
+	s.quantity = quantity
+	return s
+}
+
+// QuantityDecimal set quantity from a decimal
+func (s *ModifyOrderService) QuantityDecimal(quantity decimal.Decimal) *ModifyOrderService {
+	return s.Quantity(quantity.String())
+}
+
+// Price set price
+func (s *ModifyOrderService) Price(price string) *ModifyOrderService {
+	s.price = &price
+	return s
+}
+
+// PriceDecimal set price from a decimal
+func (s *ModifyOrderService) PriceDecimal(price decimal.Decimal) *ModifyOrderService {
+	return s.Price(price.String())
+}
+
+// PriceMatch set priceMatch, the price is then set by the order book and
+// must not be sent
+func (s *ModifyOrderService) PriceMatch(priceMatch PriceMatchType) *ModifyOrderService {
+	s.priceMatch = &priceMatch
+	return s
+}
+
+func (s *ModifyOrderService) params() params {
+	m := params{
+		"symbol":   s.symbol,
+		"side":     s.side,
+		"quantity": s.quantity,
+	}
+	if s.orderID != nil {
+		m["orderId"] = *s.orderID
+	}
+	if s.origClientOrderID != nil {
+		m["origClientOrderId"] = *s.origClientOrderID
+	}
+	if s.price != nil {
+		m["price"] = *s.price
+	}
+	if s.priceMatch != nil {
+		m["priceMatch"] = *s.priceMatch
+	}
+	return m
+}
+
+func (s *ModifyOrderService) newRequest() *request {
+	r := &request{
+		Service:  "ModifyOrderService",
+		Method:   http.MethodPut,
+		Endpoint: "/fapi/v1/order",
+		SecType:  secTypeSigned,
+	}
+	r.SetFormParams(s.params())
+	return r
+}
+
+func (s *ModifyOrderService) validate() error {
+	if s.orderID == nil && s.origClientOrderID == nil {
+		return errors.New("either orderId or origClientOrderId must be sent")
+	}
+	if s.price != nil && s.priceMatch != nil {
+		return errors.New("price and priceMatch cannot be sent together")
+	}
+	return nil
+}
+
+// Do send request
+func (s *ModifyOrderService) Do(ctx context.Context, opts ...RequestOption) (res *Order, err error) {
+	if err := s.validate(); err != nil {
+		return nil, err
+	}
+	data, _, err := s.c.CallAPI(ctx, s.newRequest(), opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(Order)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// maxModifyBatchOrders is the number of orders a batch can modify
+const maxModifyBatchOrders = 5
+
+// ModifyBatchOrdersService modify up to 5 orders in one request
+type ModifyBatchOrdersService struct {
+	c      *Client
+	orders []*ModifyOrderService
+}
+
+// OrderList set the orders to modify
+func (s *ModifyBatchOrdersService) OrderList(orders []*ModifyOrderService) *ModifyBatchOrdersService {
+	s.orders = orders
+	return s
+}
+
+// Do send request. Orders which failed are returned in Errors instead of
+// failing the whole batch. Orders and Errors are aligned with the order list:
+// for each index exactly one of Orders[i] and Errors[i] is set.
+func (s *ModifyBatchOrdersService) Do(ctx context.Context, opts ...RequestOption) (res *ModifyBatchOrdersResponse, err error) {
+	if len(s.orders) == 0 || len(s.orders) > maxModifyBatchOrders {
+		return nil, fmt.Errorf("between 1 and %d orders must be sent", maxModifyBatchOrders)
+	}
+	r := &request{
+		Service:  "ModifyBatchOrdersService",
+		Method:   http.MethodPut,
+		Endpoint: "/fapi/v1/batchOrders",
+		SecType:  secTypeSigned,
+	}
+	orders := make([]params, 0, len(s.orders))
+	for i, order := range s.orders {
+		if err := order.validate(); err != nil {
+			return nil, fmt.Errorf("order %d: %w", i, err)
+		}
+		orders = append(orders, order.params())
+	}
+	b, err := json.Marshal(orders)
+	if err != nil {
+		return nil, err
+	}
+	r.SetFormParam("batchOrders", string(b))
+	data, _, err := s.c.CallAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	rawMessages := make([]json.RawMessage, 0)
+	err = json.Unmarshal(data, &rawMessages)
+	if err != nil {
+		return nil, err
+	}
+	if len(rawMessages) != len(s.orders) {
+		return nil, fmt.Errorf("got %d results for %d orders", len(rawMessages), len(s.orders))
+	}
+	res = &ModifyBatchOrdersResponse{
+		Orders: make([]*Order, len(rawMessages)),
+		Errors: make([]*common.APIError, len(rawMessages)),
+	}
+	for i, raw := range rawMessages {
+		apiErr := new(common.APIError)
+		if err := json.Unmarshal(raw, apiErr); err == nil && apiErr.Code != 0 {
+			apiErr.Endpoint = r.Endpoint
+			apiErr.Service = r.Service
+			res.Errors[i] = apiErr
+			continue
+		}
+		o := new(Order)
+		if err := json.Unmarshal(raw, o); err != nil {
+			return nil, err
+		}
+		res.Orders[i] = o
+	}
+	return res, nil
+}
+
+// GetOrderAmendmentHistoryService list the price and quantity modifications
+// of an order
+type GetOrderAmendmentHistoryService struct {
+	c                 *Client
+	symbol            string
+	orderID           *int64
+	origClientOrderID *string
+	startTime         *int64
+	endTime           *int64
+	limit             *int
+}
+
+// Symbol set symbol
+func (s *GetOrderAmendmentHistoryService) Symbol(symbol string) *GetOrderAmendmentHistoryService {
+	s.symbol = symbol
+	return s
+}
+
+// OrderID set orderID
+func (s *GetOrderAmendmentHistoryService) OrderID(orderID int64) *GetOrderAmendmentHistoryService {
+	s.orderID = &orderID
+	return s
+}
+
+// OrigClientOrderID set origClientOrderID
+func (s *GetOrderAmendmentHistoryService) OrigClientOrderID(origClientOrderID string) *GetOrderAmendmentHistoryService {
+	s.origClientOrderID = &origClientOrderID
+	return s
+}
+
+// StartTime set startTime
+func (s *GetOrderAmendmentHistoryService) StartTime(startTime int64) *GetOrderAmendmentHistoryService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *GetOrderAmendmentHistoryService) EndTime(endTime int64) *GetOrderAmendmentHistoryService {
+	s.endTime = &endTime
+	return s
+}
+
+// Limit set limit
+func (s *GetOrderAmendmentHistoryService) Limit(limit int) *GetOrderAmendmentHistoryService {
+	s.limit = &limit
+	return s
+}
+
+// Do send request
+func (s *GetOrderAmendmentHistoryService) Do(ctx context.Context, opts ...RequestOption) (res []*OrderAmendment, err error) {
+	r := &request{
+		Service:  "GetOrderAmendmentHistoryService",
+		Method:   http.MethodGet,
+		Endpoint: "/fapi/v1/orderAmendment",
+		SecType:  secTypeSigned,
+	}
+	r.SetParam("symbol", s.symbol)
+	if s.orderID != nil {
+		r.SetParam("orderId", *s.orderID)
+	}
+	if s.origClientOrderID != nil {
+		r.SetParam("origClientOrderId", *s.origClientOrderID)
+	}
+	if s.startTime != nil {
+		r.SetParam("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.SetParam("endTime", *s.endTime)
+	}
+	if s.limit != nil {
+		r.SetParam("limit", *s.limit)
+	}
+	data, _, err := s.c.CallAPI(ctx, r, opts...)
+	if err != nil {
+		return []*OrderAmendment{}, err
+	}
+	res = make([]*OrderAmendment, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*OrderAmendment{}, err
+	}
+	return res, nil
+}
@@ -0,0 +1,154 @@
+package futures
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/shopspring/decimal"
+	"github.com/ward-cap/go-binance/common"
+)
+
+// OrderValidator normalize orders to the exchange filters of their symbol and
+// check them before they are sent, so filter failures (-1013) are caught
+// client side. Violations are returned as a *common.FilterError.
+type OrderValidator struct {
+	// PriceRounding round price and stopPrice to tickSize
+	PriceRounding common.RoundingMode
+	// QuantityRounding round quantity to stepSize
+	QuantityRounding common.RoundingMode
+
+	mu         sync.RWMutex
+	symbols    map[string]*SymbolInfo
+	registry   *SymbolRegistry
+	markPrices map[string]decimal.Decimal
+	openOrders map[string]int
+}
+
+// NewOrderValidator init an order validator with the symbols of exchangeInfo.
+// Prices are rounded half up and quantities down.
+func NewOrderValidator(info *ExchangeInfo) *OrderValidator {
+	v := &OrderValidator{
+		PriceRounding:    common.RoundHalfUp,
+		QuantityRounding: common.RoundDown,
+		markPrices:       map[string]decimal.Decimal{},
+		openOrders:       map[string]int{},
+	}
+	v.SetExchangeInfo(info)
+	return v
+}
+
+// NewRegistryOrderValidator init an order validator reading the symbols of
+// r, so it follows the refreshes of the registry and shares its parsed
+// filters. Prices are rounded half up and quantities down.
+func NewRegistryOrderValidator(r *SymbolRegistry) *OrderValidator {
+	v := NewOrderValidator(&ExchangeInfo{})
+	v.registry = r
+	return v
+}
+
+// SetExchangeInfo replace the cached symbols, their filters are parsed once
+// here. A validator reading a SymbolRegistry ignores them.
+func (v *OrderValidator) SetExchangeInfo(info *ExchangeInfo) {
+	symbols := make(map[string]*SymbolInfo, len(info.Symbols))
+	for _, s := range info.Symbols {
+		symbols[s.Symbol] = newSymbolInfo(s)
+	}
+	v.mu.Lock()
+	v.symbols = symbols
+	v.mu.Unlock()
+}
+
+func (v *OrderValidator) symbol(name string) (*SymbolInfo, bool) {
+	if v.registry != nil {
+		return v.registry.Get(name)
+	}
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+	sym, ok := v.symbols[name]
+	return sym, ok
+}
+
+// SetMarkPrice set the mark price of symbol, used by the PERCENT_PRICE filter
+// and for the notional of market orders. Those checks are skipped until it
+// is set.
+func (v *OrderValidator) SetMarkPrice(symbol string, price decimal.Decimal) {
+	v.mu.Lock()
+	v.markPrices[symbol] = price
+	v.mu.Unlock()
+}
+
+// SetOpenOrders set the number of open orders on symbol, used by the
+// MAX_NUM_ORDERS filter. The check is skipped until it is set.
+func (v *OrderValidator) SetOpenOrders(symbol string, openOrders int) {
+	v.mu.Lock()
+	v.openOrders[symbol] = openOrders
+	v.mu.Unlock()
+}
+
+// Validate round the price, stopPrice and quantity of s in place and check s
+// against the filters of its symbol. Reduce only and close position orders
+// are exempt from MIN_NOTIONAL.
+func (v *OrderValidator) Validate(s *CreateOrderService) error {
+	sym, ok := v.symbol(s.symbol)
+	v.mu.RLock()
+	markPrice, hasMarkPrice := v.markPrices[s.symbol]
+	openOrders, hasOpenOrders := v.openOrders[s.symbol]
+	v.mu.RUnlock()
+	if !ok {
+		return fmt.Errorf("futures: symbol %s not found in exchangeInfo", s.symbol)
+	}
+
+	var vs common.FilterViolations
+	var price, quantity decimal.Decimal
+	var hasPrice, hasQuantity bool
+	if f := sym.Price; f != nil {
+		filter := string(SymbolFilterTypePrice)
+		min, max, tick := common.ParseFilterDecimal(f.MinPrice), common.ParseFilterDecimal(f.MaxPrice), common.ParseFilterDecimal(f.TickSize)
+		price, hasPrice = vs.Step(filter, "price", s.price, min, max, tick, v.PriceRounding)
+		vs.Step(filter, "stopPrice", s.stopPrice, min, max, tick, v.PriceRounding)
+	} else if s.price != nil {
+		price, hasPrice = vs.Parse(string(SymbolFilterTypePrice), "price", *s.price)
+	}
+
+	var market bool
+	switch s.orderType {
+	case OrderTypeMarket, OrderTypeStopMarket, OrderTypeTakeProfitMarket, OrderTypeTrailingStopMarket:
+		market = true
+	}
+	if s.quantity != "" {
+		filter, lotSize := string(SymbolFilterTypeLotSize), sym.LotSize
+		if market {
+			filter, lotSize = string(SymbolFilterTypeMarketLotSize), (*LotSizeFilter)(sym.MarketLotSize)
+		}
+		if lotSize != nil {
+			min, max, step := common.ParseFilterDecimal(lotSize.MinQuantity), common.ParseFilterDecimal(lotSize.MaxQuantity), common.ParseFilterDecimal(lotSize.StepSize)
+			quantity, hasQuantity = vs.Step(filter, "quantity", &s.quantity, min, max, step, v.QuantityRounding)
+		} else {
+			quantity, hasQuantity = vs.Parse(filter, "quantity", s.quantity)
+		}
+	}
+
+	exempt := (s.reduceOnly != nil && *s.reduceOnly) || (s.closePosition != nil && *s.closePosition)
+	if f := sym.MinNotional; f != nil && hasQuantity && !exempt {
+		switch {
+		case !market && hasPrice:
+			vs.Min(string(SymbolFilterTypeMinNotional), "notional", quantity.Mul(price), common.ParseFilterDecimal(f.Notional))
+		case market && hasMarkPrice:
+			vs.Min(string(SymbolFilterTypeMinNotional), "notional", quantity.Mul(markPrice), common.ParseFilterDecimal(f.Notional))
+		}
+	}
+
+	if f := sym.PercentPrice; f != nil && hasPrice && hasMarkPrice && !market {
+		filter := string(SymbolFilterTypePercentPrice)
+		if s.side == SideTypeSell {
+			vs.Min(filter, "price", price, markPrice.Mul(common.ParseFilterDecimal(f.MultiplierDown)))
+		} else {
+			vs.Max(filter, "price", price, markPrice.Mul(common.ParseFilterDecimal(f.MultiplierUp)))
+		}
+	}
+
+	if f := sym.MaxNumOrders; f != nil && hasOpenOrders {
+		vs.Max(string(SymbolFilterTypeMaxNumOrders), "openOrders", decimal.NewFromInt(int64(openOrders+1)), decimal.NewFromInt(f.Limit))
+	}
+	return vs.Err(s.symbol)
+}
@@ -0,0 +1,84 @@
+package futures
+
+import (
+	"context"
+	"time"
+
+	"github.com/ward-cap/go-binance/common"
+)
+
+// OrderBook is a local order book of one symbol, kept in sync from the
+// <symbol>@depth@100ms stream and DepthService snapshots. The pu field of
+// every event is checked against the previous event.
+type OrderBook struct {
+	*common.OrderBook
+	c          *Client
+	symbol     string
+	limit      int
+	errHandler ErrHandler
+}
+
+// NewOrderBook init a local order book, limit is the depth of the REST
+// snapshot and may be 0 for the exchange default
+func (c *Client) NewOrderBook(symbol string, limit int, errHandler ErrHandler) *OrderBook {
+	b := &OrderBook{
+		c:          c,
+		symbol:     symbol,
+		limit:      limit,
+		errHandler: errHandler,
+	}
+	b.OrderBook = common.NewOrderBook(common.OrderBookConfig{
+		Symbol:            symbol,
+		Snapshot:          b.snapshot,
+		CheckPrevUpdateID: true,
+		OnError:           b.handleError,
+	})
+	return b
+}
+
+// Run subscribe the diff depth stream and keep the book in sync until ctx is done
+func (b *OrderBook) Run(ctx context.Context) error {
+	stream := b.c.NewWsMarketStream(b.handleError)
+	_, err := stream.SubscribeDepth(ctx, b.symbol, 100*time.Millisecond, func(event *WsDepthEvent) {
+		b.Push(common.OrderBookDiff{
+			Time:             event.Time,
+			FirstUpdateID:    event.FirstUpdateID,
+			LastUpdateID:     event.LastUpdateID,
+			PrevLastUpdateID: event.PrevLastUpdateID,
+			Bids:             event.Bids,
+			Asks:             event.Asks,
+		})
+	})
+	if err != nil {
+		return err
+	}
+	if err = stream.Connect(ctx); err != nil {
+		return err
+	}
+	defer stream.Close()
+
+	return b.OrderBook.Run(ctx)
+}
+
+func (b *OrderBook) snapshot(ctx context.Context) (*common.OrderBookSnapshot, error) {
+	s := b.c.NewDepthService().Symbol(b.symbol)
+	if b.limit > 0 {
+		s.Limit(b.limit)
+	}
+	res, err := s.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &common.OrderBookSnapshot{
+		LastUpdateID: res.LastUpdateID,
+		Time:         res.Time,
+		Bids:         res.Bids,
+		Asks:         res.Asks,
+	}, nil
+}
+
+func (b *OrderBook) handleError(err error) {
+	if b.errHandler != nil {
+		b.errHandler(err)
+	}
+}
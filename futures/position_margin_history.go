@@ -46,29 +46,32 @@ func (s *GetPositionMarginHistoryService) Limit(limit int64) *GetPositionMarginH
 	return s
 }
 
-// Do send request
-func (s *GetPositionMarginHistoryService) Do(ctx context.Context, opts ...RequestOption) (res []*PositionMarginHistory, err error) {
+func (s *GetPositionMarginHistoryService) newRequest() *request {
 	r := &request{
-		service:  "GetPositionMarginHistoryService",
-		method:   http.MethodGet,
-		endpoint: "/fapi/v1/positionMargin/history",
-		secType:  secTypeSigned,
+		Service:  "GetPositionMarginHistoryService",
+		Method:   http.MethodGet,
+		Endpoint: "/fapi/v1/positionMargin/history",
+		SecType:  secTypeSigned,
 	}
-	r.setParam("symbol", s.symbol)
+	r.SetParam("symbol", s.symbol)
 	if s._type != nil {
-		r.setParam("type", *s._type)
+		r.SetParam("type", *s._type)
 	}
 	if s.startTime != nil {
-		r.setParam("startTime", *s.startTime)
+		r.SetParam("startTime", *s.startTime)
 	}
 	if s.endTime != nil {
-		r.setParam("endTime", *s.endTime)
+		r.SetParam("endTime", *s.endTime)
 	}
 	if s.limit != nil {
-		r.setParam("limit", *s.limit)
+		r.SetParam("limit", *s.limit)
 	}
+	return r
+}
 
-	data, _, err := s.c.callAPI(ctx, r, opts...)
+// Do send request
+func (s *GetPositionMarginHistoryService) Do(ctx context.Context, opts ...RequestOption) (res []*PositionMarginHistory, err error) {
+	data, _, err := s.c.CallAPI(ctx, s.newRequest(), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -79,3 +82,17 @@ func (s *GetPositionMarginHistoryService) Do(ctx context.Context, opts ...Reques
 	}
 	return res, nil
 }
+
+// DoV2 send request and return the decimal typed PositionMarginHistoryV2
+func (s *GetPositionMarginHistoryService) DoV2(ctx context.Context, opts ...RequestOption) (res []*PositionMarginHistoryV2, err error) {
+	data, _, err := s.c.CallAPI(ctx, s.newRequest(), opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = make([]*PositionMarginHistoryV2, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -18,18 +18,22 @@ func (s *GetPositionRiskService) Symbol(symbol string) *GetPositionRiskService {
 	return s
 }
 
-// Do send request
-func (s *GetPositionRiskService) Do(ctx context.Context, opts ...RequestOption) (res []*PositionRisk, err error) {
+func (s *GetPositionRiskService) newRequest() *request {
 	r := &request{
-		service:  "GetPositionRiskService",
-		method:   http.MethodGet,
-		endpoint: "/fapi/v2/positionRisk",
-		secType:  secTypeSigned,
+		Service:  "GetPositionRiskService",
+		Method:   http.MethodGet,
+		Endpoint: "/fapi/v2/positionRisk",
+		SecType:  secTypeSigned,
 	}
 	if s.symbol != "" {
-		r.setParam("symbol", s.symbol)
+		r.SetParam("symbol", s.symbol)
 	}
-	data, _, err := s.c.callAPI(ctx, r, opts...)
+	return r
+}
+
+// Do send request
+func (s *GetPositionRiskService) Do(ctx context.Context, opts ...RequestOption) (res []*PositionRisk, err error) {
+	data, _, err := s.c.CallAPI(ctx, s.newRequest(), opts...)
 	if err != nil {
 		return []*PositionRisk{}, err
 	}
@@ -40,3 +44,17 @@ func (s *GetPositionRiskService) Do(ctx context.Context, opts ...RequestOption)
 	}
 	return res, nil
 }
+
+// DoV2 send request and return the decimal typed PositionRiskV2
+func (s *GetPositionRiskService) DoV2(ctx context.Context, opts ...RequestOption) (res []*PositionRiskV2, err error) {
+	data, _, err := s.c.CallAPI(ctx, s.newRequest(), opts...)
+	if err != nil {
+		return []*PositionRiskV2{}, err
+	}
+	res = make([]*PositionRiskV2, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*PositionRiskV2{}, err
+	}
+	return res, nil
+}
@@ -29,17 +29,17 @@ func (s *ChangeLeverageService) Leverage(leverage int) *ChangeLeverageService {
 // Do send request
 func (s *ChangeLeverageService) Do(ctx context.Context, opts ...RequestOption) (res *SymbolLeverage, err error) {
 	r := &request{
-		service:    "ChangeLeverageService",
-		method:     http.MethodPost,
-		endpoint:   "/fapi/v1/leverage",
-		secType:    secTypeSigned,
-		recvWindow: time.Second * 20,
+		Service:    "ChangeLeverageService",
+		Method:     http.MethodPost,
+		Endpoint:   "/fapi/v1/leverage",
+		SecType:    secTypeSigned,
+		RecvWindow: time.Second * 20,
 	}
-	r.setFormParams(params{
+	r.SetFormParams(params{
 		"symbol":   s.symbol,
 		"leverage": s.leverage,
 	})
-	data, _, err := s.c.callAPI(ctx, r, opts...)
+	data, _, err := s.c.CallAPI(ctx, r, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -73,16 +73,16 @@ func (s *ChangeMarginTypeService) MarginType(marginType MarginType) *ChangeMargi
 // Do send request
 func (s *ChangeMarginTypeService) Do(ctx context.Context, opts ...RequestOption) (err error) {
 	r := &request{
-		service:  "ChangeMarginTypeService",
-		method:   http.MethodPost,
-		endpoint: "/fapi/v1/marginType",
-		secType:  secTypeSigned,
+		Service:  "ChangeMarginTypeService",
+		Method:   http.MethodPost,
+		Endpoint: "/fapi/v1/marginType",
+		SecType:  secTypeSigned,
 	}
-	r.setFormParams(params{
+	r.SetFormParams(params{
 		"symbol":     s.symbol,
 		"marginType": s.marginType,
 	})
-	_, _, err = s.c.callAPI(ctx, r, opts...)
+	_, _, err = s.c.CallAPI(ctx, r, opts...)
 	if err != nil {
 		return err
 	}
@@ -125,10 +125,10 @@ func (s *UpdatePositionMarginService) Type(actionType int) *UpdatePositionMargin
 // Do send request
 func (s *UpdatePositionMarginService) Do(ctx context.Context, opts ...RequestOption) (err error) {
 	r := &request{
-		service:  "UpdatePositionMarginService",
-		method:   http.MethodPost,
-		endpoint: "/fapi/v1/positionMargin",
-		secType:  secTypeSigned,
+		Service:  "UpdatePositionMarginService",
+		Method:   http.MethodPost,
+		Endpoint: "/fapi/v1/positionMargin",
+		SecType:  secTypeSigned,
 	}
 	m := params{
 		"symbol": s.symbol,
@@ -138,9 +138,9 @@ func (s *UpdatePositionMarginService) Do(ctx context.Context, opts ...RequestOpt
 	if s.positionSide != nil {
 		m["positionSide"] = *s.positionSide
 	}
-	r.setFormParams(m)
+	r.SetFormParams(m)
 
-	_, _, err = s.c.callAPI(ctx, r, opts...)
+	_, _, err = s.c.CallAPI(ctx, r, opts...)
 	if err != nil {
 		return err
 	}
@@ -162,15 +162,15 @@ func (s *ChangePositionModeService) DualSide(dualSide bool) *ChangePositionModeS
 // Do send request
 func (s *ChangePositionModeService) Do(ctx context.Context, opts ...RequestOption) (err error) {
 	r := &request{
-		service:  "ChangePositionModeService",
-		method:   http.MethodPost,
-		endpoint: "/fapi/v1/positionSide/dual",
-		secType:  secTypeSigned,
+		Service:  "ChangePositionModeService",
+		Method:   http.MethodPost,
+		Endpoint: "/fapi/v1/positionSide/dual",
+		SecType:  secTypeSigned,
 	}
-	r.setFormParams(params{
+	r.SetFormParams(params{
 		"dualSidePosition": s.dualSide,
 	})
-	_, _, err = s.c.callAPI(ctx, r, opts...)
+	_, _, err = s.c.CallAPI(ctx, r, opts...)
 	if err != nil {
 		return err
 	}
@@ -185,13 +185,13 @@ type GetPositionModeService struct {
 // Do send request
 func (s *GetPositionModeService) Do(ctx context.Context, opts ...RequestOption) (res *PositionMode, err error) {
 	r := &request{
-		service:  "GetPositionModeService",
-		method:   http.MethodGet,
-		endpoint: "/fapi/v1/positionSide/dual",
-		secType:  secTypeSigned,
+		Service:  "GetPositionModeService",
+		Method:   http.MethodGet,
+		Endpoint: "/fapi/v1/positionSide/dual",
+		SecType:  secTypeSigned,
 	}
-	r.setFormParams(params{})
-	data, _, err := s.c.callAPI(ctx, r, opts...)
+	r.SetFormParams(params{})
+	data, _, err := s.c.CallAPI(ctx, r, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -218,15 +218,15 @@ func (s *ChangeMultiAssetModeService) MultiAssetsMargin(multiAssetsMargin bool)
 // Do send request
 func (s *ChangeMultiAssetModeService) Do(ctx context.Context, opts ...RequestOption) (err error) {
 	r := &request{
-		service:  "ChangeMultiAssetModeService",
-		method:   http.MethodPost,
-		endpoint: "/fapi/v1/multiAssetsMargin",
-		secType:  secTypeSigned,
+		Service:  "ChangeMultiAssetModeService",
+		Method:   http.MethodPost,
+		Endpoint: "/fapi/v1/multiAssetsMargin",
+		SecType:  secTypeSigned,
 	}
-	r.setFormParams(params{
+	r.SetFormParams(params{
 		"multiAssetsMargin": s.multiAssetsMargin,
 	})
-	_, _, err = s.c.callAPI(ctx, r, opts...)
+	_, _, err = s.c.CallAPI(ctx, r, opts...)
 	if err != nil {
 		return err
 	}
@@ -241,13 +241,13 @@ type GetMultiAssetModeService struct {
 // Do send request
 func (s *GetMultiAssetModeService) Do(ctx context.Context, opts ...RequestOption) (res *MultiAssetMode, err error) {
 	r := &request{
-		service:  "GetMultiAssetModeService",
-		method:   http.MethodGet,
-		endpoint: "/fapi/v1/multiAssetsMargin",
-		secType:  secTypeSigned,
+		Service:  "GetMultiAssetModeService",
+		Method:   http.MethodGet,
+		Endpoint: "/fapi/v1/multiAssetsMargin",
+		SecType:  secTypeSigned,
 	}
-	r.setFormParams(params{})
-	data, _, err := s.c.callAPI(ctx, r, opts...)
+	r.SetFormParams(params{})
+	data, _, err := s.c.CallAPI(ctx, r, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -48,22 +48,22 @@ func (piks *PremiumIndexKlinesService) EndTime(endTime int64) *PremiumIndexKline
 // Do send request
 func (piks *PremiumIndexKlinesService) Do(ctx context.Context, opts ...RequestOption) (res []*Kline, err error) {
 	r := &request{
-		service:  "PremiumIndexKlinesService",
-		method:   http.MethodGet,
-		endpoint: "/fapi/v1/premiumIndexKlines",
+		Service:  "PremiumIndexKlinesService",
+		Method:   http.MethodGet,
+		Endpoint: "/fapi/v1/premiumIndexKlines",
 	}
-	r.setParam("symbol", piks.symbol)
-	r.setParam("interval", piks.interval)
+	r.SetParam("symbol", piks.symbol)
+	r.SetParam("interval", piks.interval)
 	if piks.limit != nil {
-		r.setParam("limit", *piks.limit)
+		r.SetParam("limit", *piks.limit)
 	}
 	if piks.startTime != nil {
-		r.setParam("startTime", *piks.startTime)
+		r.SetParam("startTime", *piks.startTime)
 	}
 	if piks.endTime != nil {
-		r.setParam("endTime", *piks.endTime)
+		r.SetParam("endTime", *piks.endTime)
 	}
-	data, _, err := piks.c.callAPI(ctx, r, opts...)
+	data, _, err := piks.c.CallAPI(ctx, r, opts...)
 	if err != nil {
 		return []*Kline{}, err
 	}
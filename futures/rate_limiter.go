@@ -0,0 +1,145 @@
+package futures
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/ward-cap/go-binance/common"
+)
+
+// endpointWeights lists the request weight of /fapi endpoints, keyed by
+// method and endpoint. Endpoints missing here weigh 1.
+var endpointWeights = map[string]int64{
+	"GET /fapi/v1/trades":              5,
+	"GET /fapi/v1/historicalTrades":    20,
+	"GET /fapi/v1/aggTrades":           20,
+	"GET /fapi/v1/fundingRate":         1,
+	"GET /fapi/v1/allOrders":           5,
+	"GET /fapi/v1/userTrades":          5,
+	"GET /fapi/v1/income":              30,
+	"GET /fapi/v1/forceOrders":         20,
+	"GET /fapi/v1/adlQuantile":         5,
+	"GET /fapi/v1/commissionRate":      20,
+	"GET /fapi/v1/leverageBracket":     1,
+	"GET /fapi/v1/orderAmendment":      1,
+	"GET /fapi/v2/balance":             5,
+	"GET /fapi/v3/balance":             5,
+	"GET /fapi/v2/account":             5,
+	"GET /fapi/v3/account":             5,
+	"GET /fapi/v2/positionRisk":        5,
+	"GET /fapi/v3/positionRisk":        5,
+	"POST /fapi/v1/order":              0,
+	"PUT /fapi/v1/order":               1,
+	"POST /fapi/v1/batchOrders":        5,
+	"PUT /fapi/v1/batchOrders":         5,
+	"DELETE /fapi/v1/batchOrders":      1,
+	"POST /fapi/v1/countdownCancelAll": 10,
+}
+
+// endpointOrders lists the endpoints counting against the order rate limits
+var endpointOrders = map[string]int64{
+	"POST /fapi/v1/order":       1,
+	"PUT /fapi/v1/order":        1,
+	"POST /fapi/v1/batchOrders": 5,
+	"PUT /fapi/v1/batchOrders":  5,
+}
+
+// requestCost return the weight and order count of r
+func requestCost(r *request) common.RequestCost {
+	if !strings.HasPrefix(r.Endpoint, "/fapi/") {
+		return common.RequestCost{}
+	}
+	key := r.Method + " " + r.Endpoint
+	cost := common.RequestCost{Requests: 1, Weight: 1, Orders: endpointOrders[key]}
+	if weight, ok := endpointWeights[key]; ok {
+		cost.Weight = weight
+	}
+
+	hasSymbol := r.Query.Get("symbol") != ""
+	switch key {
+	case "GET /fapi/v1/depth":
+		cost.Weight = depthWeight(r.Query.Get("limit"))
+	case "GET /fapi/v1/klines", "GET /fapi/v1/continuousKlines", "GET /fapi/v1/indexPriceKlines", "GET /fapi/v1/markPriceKlines":
+		cost.Weight = klinesWeight(r.Query.Get("limit"))
+	case "GET /fapi/v1/ticker/24hr":
+		if !hasSymbol {
+			cost.Weight = 40
+		}
+	case "GET /fapi/v1/ticker/price", "GET /fapi/v2/ticker/price":
+		if !hasSymbol {
+			cost.Weight = 2
+		}
+	case "GET /fapi/v1/ticker/bookTicker":
+		if !hasSymbol {
+			cost.Weight = 5
+		} else {
+			cost.Weight = 2
+		}
+	case "GET /fapi/v1/premiumIndex":
+		if !hasSymbol {
+			cost.Weight = 10
+		}
+	case "GET /fapi/v1/openOrders":
+		if !hasSymbol {
+			cost.Weight = 40
+		}
+	}
+	return cost
+}
+
+func depthWeight(limit string) int64 {
+	n, err := strconv.Atoi(limit)
+	if err != nil {
+		n = 500
+	}
+	switch {
+	case n <= 50:
+		return 2
+	case n <= 100:
+		return 5
+	case n <= 500:
+		return 10
+	default:
+		return 20
+	}
+}
+
+func klinesWeight(limit string) int64 {
+	n, err := strconv.Atoi(limit)
+	if err != nil {
+		n = 500
+	}
+	switch {
+	case n < 100:
+		return 1
+	case n < 500:
+		return 2
+	case n <= 1000:
+		return 5
+	default:
+		return 10
+	}
+}
+
+// EnableRateLimiter fetch the rate limits from exchangeInfo and throttle
+// every later request with them. In fail fast mode requests that would exceed
+// a limit return a *common.RateLimitError instead of waiting. Call it before
+// the client is shared between goroutines.
+func (c *Client) EnableRateLimiter(ctx context.Context, failFast bool) error {
+	info, err := c.NewExchangeInfoService().Do(ctx)
+	if err != nil {
+		return err
+	}
+	limits := make([]common.RateLimit, len(info.RateLimits))
+	for i, limit := range info.RateLimits {
+		limits[i] = common.RateLimit{
+			RateLimitType: limit.RateLimitType,
+			Interval:      limit.Interval,
+			IntervalNum:   limit.IntervalNum,
+			Limit:         limit.Limit,
+		}
+	}
+	c.RateLimiter = common.NewRateLimiter(limits, failFast)
+	return nil
+}
@@ -19,17 +19,17 @@ func (s *ReferralOverview) Type(_type int) *ReferralOverview {
 // Do send request
 func (s *ReferralOverview) Do(ctx context.Context, opts ...RequestOption) (res ReferralOverviewResponse, err error) {
 	r := &request{
-		service:  "ReferralOverview",
-		method:   http.MethodGet,
-		endpoint: "/fapi/v1/apiReferral/overview",
-		secType:  secTypeSigned,
+		Service:  "ReferralOverview",
+		Method:   http.MethodGet,
+		Endpoint: "/fapi/v1/apiReferral/overview",
+		SecType:  secTypeSigned,
 	}
 
 	if s._type != nil {
-		r.setParam("type", s._type)
+		r.SetParam("type", s._type)
 	}
 
-	data, _, err := s.c.callAPI(ctx, r, opts...)
+	data, _, err := s.c.CallAPI(ctx, r, opts...)
 	if err != nil {
 		return res, err
 	}
@@ -1,115 +1,41 @@
 package futures
 
 import (
-	"fmt"
-	"io"
 	"net/http"
-	"net/url"
 	"time"
-)
-
-type secType int
 
-const (
-	secTypeNone secType = iota
-	secTypeAPIKey
-	secTypeSigned
+	"github.com/ward-cap/go-binance/common"
 )
 
-type params map[string]interface{}
-
-// request define an API request
-type request struct {
-	method     string
-	endpoint   string
-	query      url.Values
-	form       url.Values
-	recvWindow time.Duration
-	secType    secType
-	header     http.Header
-	body       io.Reader
-	fullURL    string
-	service    string
-}
-
-// setParam set param with key/value to query string
-func (r *request) setParam(key string, value interface{}) *request {
-	if r.query == nil {
-		r.query = url.Values{}
-	}
-	r.query.Set(key, fmt.Sprintf("%v", value))
-	return r
-}
+type request = common.Request
 
-// setParams set params with key/values to query string
-func (r *request) setParams(m params) *request {
-	for k, v := range m {
-		r.setParam(k, v)
-	}
-	return r
-}
+type params = common.Params
 
-// setFormParam set param with key/value to request form body
-func (r *request) setFormParam(key string, value interface{}) *request {
-	if r.form == nil {
-		r.form = url.Values{}
-	}
-	r.form.Set(key, fmt.Sprintf("%v", value))
-	return r
-}
-
-// setFormParams set params with key/values to request form body
-func (r *request) setFormParams(m params) *request {
-	for k, v := range m {
-		r.setFormParam(k, v)
-	}
-	return r
-}
-
-func (r *request) validate() (err error) {
-	if r.query == nil {
-		r.query = url.Values{}
-	}
-	if r.form == nil {
-		r.form = url.Values{}
-	}
-	return nil
-}
+const (
+	secTypeNone   = common.SecTypeNone
+	secTypeAPIKey = common.SecTypeAPIKey
+	secTypeSigned = common.SecTypeSigned
+)
 
 // RequestOption define option type for request
-type RequestOption func(*request)
+type RequestOption = common.RequestOption
 
 // WithRecvWindow set recvWindow param for the request
 func WithRecvWindow(recvWindow time.Duration) RequestOption {
-	return func(r *request) {
-		r.recvWindow = recvWindow
-	}
+	return common.WithRecvWindow(recvWindow)
 }
 
 // WithHeader set or add a header value to the request
 func WithHeader(key, value string, replace bool) RequestOption {
-	return func(r *request) {
-		if r.header == nil {
-			r.header = http.Header{}
-		}
-		if replace {
-			r.header.Set(key, value)
-		} else {
-			r.header.Add(key, value)
-		}
-	}
+	return common.WithHeader(key, value, replace)
 }
 
 // WithHeaders set or replace the headers of the request
 func WithHeaders(header http.Header) RequestOption {
-	return func(r *request) {
-		r.header = header.Clone()
-	}
+	return common.WithHeaders(header)
 }
 
 // WithExtraForm add extra form data of the request
 func WithExtraForm(m map[string]any) RequestOption {
-	return func(r *request) {
-		r.setFormParams(m)
-	}
+	return common.WithExtraForm(m)
 }
@@ -3,6 +3,9 @@ package futures
 import (
 	"context"
 	"net/http"
+	"sync/atomic"
+
+	"github.com/ward-cap/go-binance/common"
 )
 
 // PingService ping server
@@ -13,11 +16,11 @@ type PingService struct {
 // Do send request
 func (s *PingService) Do(ctx context.Context, opts ...RequestOption) (err error) {
 	r := &request{
-		service:  "PingService",
-		method:   http.MethodGet,
-		endpoint: "/fapi/v1/ping",
+		Service:  "PingService",
+		Method:   http.MethodGet,
+		Endpoint: "/fapi/v1/ping",
 	}
-	_, _, err = s.c.callAPI(ctx, r, opts...)
+	_, _, err = s.c.CallAPI(ctx, r, opts...)
 	return err
 }
 
@@ -29,11 +32,11 @@ type ServerTimeService struct {
 // Do send request
 func (s *ServerTimeService) Do(ctx context.Context, opts ...RequestOption) (serverTime int64, err error) {
 	r := &request{
-		service:  "ServerTimeService",
-		method:   http.MethodGet,
-		endpoint: "/fapi/v1/time",
+		Service:  "ServerTimeService",
+		Method:   http.MethodGet,
+		Endpoint: "/fapi/v1/time",
 	}
-	data, _, err := s.c.callAPI(ctx, r, opts...)
+	data, _, err := s.c.CallAPI(ctx, r, opts...)
 	if err != nil {
 		return 0, err
 	}
@@ -47,11 +50,10 @@ type SetServerTimeService struct {
 
 // Do send request
 func (s *SetServerTimeService) Do(ctx context.Context) (timeOffset int64, err error) {
-	serverTime, err := s.c.NewServerTimeService().Do(ctx)
+	sample, err := common.MeasureTimeOffset(ctx, s.c.serverTime)
 	if err != nil {
 		return 0, err
 	}
-	timeOffset = currentTimestamp() - serverTime
-	s.c.TimeOffset = timeOffset
-	return timeOffset, nil
+	atomic.StoreInt64(&s.c.TimeOffset, sample.Offset)
+	return sample.Offset, nil
 }
@@ -0,0 +1,81 @@
+package futures
+
+import (
+	"context"
+
+	"github.com/ward-cap/go-binance/common"
+)
+
+// SymbolInfo is a symbol of a SymbolRegistry with its filters parsed once on
+// load. A filter the symbol does not have is nil.
+type SymbolInfo struct {
+	Symbol
+	LotSize          *LotSizeFilter
+	Price            *PriceFilter
+	PercentPrice     *PercentPriceFilter
+	MarketLotSize    *MarketLotSizeFilter
+	MaxNumOrders     *MaxNumOrdersFilter
+	MaxNumAlgoOrders *MaxNumAlgoOrdersFilter
+	MinNotional      *MinNotionalFilter
+}
+
+func newSymbolInfo(s Symbol) *SymbolInfo {
+	return &SymbolInfo{
+		Symbol:           s,
+		LotSize:          s.LotSizeFilter(),
+		Price:            s.PriceFilter(),
+		PercentPrice:     s.PercentPriceFilter(),
+		MarketLotSize:    s.MarketLotSizeFilter(),
+		MaxNumOrders:     s.MaxNumOrdersFilter(),
+		MaxNumAlgoOrders: s.MaxNumAlgoOrdersFilter(),
+		MinNotional:      s.MinNotionalFilter(),
+	}
+}
+
+// SymbolRegistry is a cache of the exchangeInfo symbols, indexed by name,
+// base asset, quote asset and status. Call Refresh to load it, and Run to
+// keep it refreshed and emit events on listings, delistings and status
+// changes.
+type SymbolRegistry struct {
+	*common.SymbolRegistry[*SymbolInfo]
+	c *Client
+}
+
+// NewSymbolRegistry init a symbol registry, refresh errors are logged to the
+// client Logger unless OnError is set
+func (c *Client) NewSymbolRegistry() *SymbolRegistry {
+	r := &SymbolRegistry{c: c}
+	r.SymbolRegistry = common.NewSymbolRegistry(r.load, symbolKey)
+	r.OnError = func(err error) {
+		if c.Logger != nil {
+			c.Logger.Errorw("binance symbol registry", "binance.package", "futures", "error", err)
+		}
+	}
+	return r
+}
+
+// ByStatus return the symbols whose status is status
+func (r *SymbolRegistry) ByStatus(status SymbolStatusType) []*SymbolInfo {
+	return r.SymbolRegistry.ByStatus(string(status))
+}
+
+func (r *SymbolRegistry) load(ctx context.Context) ([]*SymbolInfo, error) {
+	res, err := r.c.NewExchangeInfoService().Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	symbols := make([]*SymbolInfo, len(res.Symbols))
+	for i, s := range res.Symbols {
+		symbols[i] = newSymbolInfo(s)
+	}
+	return symbols, nil
+}
+
+func symbolKey(s *SymbolInfo) common.SymbolKey {
+	return common.SymbolKey{
+		Symbol:     s.Symbol.Symbol,
+		BaseAsset:  s.BaseAsset,
+		QuoteAsset: s.QuoteAsset,
+		Status:     s.Status,
+	}
+}
@@ -19,17 +19,21 @@ func (s *ListBookTickersService) Symbol(symbol string) *ListBookTickersService {
 	return s
 }
 
-// Do send request
-func (s *ListBookTickersService) Do(ctx context.Context, opts ...RequestOption) (res []*BookTicker, err error) {
+func (s *ListBookTickersService) newRequest() *request {
 	r := &request{
-		service:  "ListBookTickersService",
-		method:   http.MethodGet,
-		endpoint: "/fapi/v1/ticker/bookTicker",
+		Service:  "ListBookTickersService",
+		Method:   http.MethodGet,
+		Endpoint: "/fapi/v1/ticker/bookTicker",
 	}
 	if s.symbol != nil {
-		r.setParam("symbol", *s.symbol)
+		r.SetParam("symbol", *s.symbol)
 	}
-	data, _, err := s.c.callAPI(ctx, r, opts...)
+	return r
+}
+
+// Do send request
+func (s *ListBookTickersService) Do(ctx context.Context, opts ...RequestOption) (res []*BookTicker, err error) {
+	data, _, err := s.c.CallAPI(ctx, s.newRequest(), opts...)
 	data = common.ToJSONList(data)
 	if err != nil {
 		return []*BookTicker{}, err
@@ -42,6 +46,21 @@ func (s *ListBookTickersService) Do(ctx context.Context, opts ...RequestOption)
 	return res, nil
 }
 
+// DoV2 send request and return the decimal typed BookTickerV2
+func (s *ListBookTickersService) DoV2(ctx context.Context, opts ...RequestOption) (res []*BookTickerV2, err error) {
+	data, _, err := s.c.CallAPI(ctx, s.newRequest(), opts...)
+	if err != nil {
+		return []*BookTickerV2{}, err
+	}
+	data = common.ToJSONList(data)
+	res = make([]*BookTickerV2, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*BookTickerV2{}, err
+	}
+	return res, nil
+}
+
 // ListPricesService list latest price for a symbol or symbols
 type ListPricesService struct {
 	c      *Client
@@ -54,17 +73,21 @@ func (s *ListPricesService) Symbol(symbol string) *ListPricesService {
 	return s
 }
 
-// Do send request
-func (s *ListPricesService) Do(ctx context.Context, opts ...RequestOption) (res []*SymbolPrice, err error) {
+func (s *ListPricesService) newRequest() *request {
 	r := &request{
-		service:  "ListPricesService",
-		method:   http.MethodGet,
-		endpoint: "/fapi/v2/ticker/price",
+		Service:  "ListPricesService",
+		Method:   http.MethodGet,
+		Endpoint: "/fapi/v2/ticker/price",
 	}
 	if s.symbol != nil {
-		r.setParam("symbol", *s.symbol)
+		r.SetParam("symbol", *s.symbol)
 	}
-	data, _, err := s.c.callAPI(ctx, r, opts...)
+	return r
+}
+
+// Do send request
+func (s *ListPricesService) Do(ctx context.Context, opts ...RequestOption) (res []*SymbolPrice, err error) {
+	data, _, err := s.c.CallAPI(ctx, s.newRequest(), opts...)
 	if err != nil {
 		return []*SymbolPrice{}, err
 	}
@@ -77,6 +100,21 @@ func (s *ListPricesService) Do(ctx context.Context, opts ...RequestOption) (res
 	return res, nil
 }
 
+// DoV2 send request and return the decimal typed SymbolPriceV2
+func (s *ListPricesService) DoV2(ctx context.Context, opts ...RequestOption) (res []*SymbolPriceV2, err error) {
+	data, _, err := s.c.CallAPI(ctx, s.newRequest(), opts...)
+	if err != nil {
+		return []*SymbolPriceV2{}, err
+	}
+	data = common.ToJSONList(data)
+	res = make([]*SymbolPriceV2, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*SymbolPriceV2{}, err
+	}
+	return res, nil
+}
+
 // ListPriceChangeStatsService show stats of price change in last 24 hours for all symbols
 type ListPriceChangeStatsService struct {
 	c      *Client
@@ -89,17 +127,21 @@ func (s *ListPriceChangeStatsService) Symbol(symbol string) *ListPriceChangeStat
 	return s
 }
 
-// Do send request
-func (s *ListPriceChangeStatsService) Do(ctx context.Context, opts ...RequestOption) (res []*PriceChangeStats, err error) {
+func (s *ListPriceChangeStatsService) newRequest() *request {
 	r := &request{
-		service:  "ListPriceChangeStatsService",
-		method:   http.MethodGet,
-		endpoint: "/fapi/v1/ticker/24hr",
+		Service:  "ListPriceChangeStatsService",
+		Method:   http.MethodGet,
+		Endpoint: "/fapi/v1/ticker/24hr",
 	}
 	if s.symbol != nil {
-		r.setParam("symbol", *s.symbol)
+		r.SetParam("symbol", *s.symbol)
 	}
-	data, _, err := s.c.callAPI(ctx, r, opts...)
+	return r
+}
+
+// Do send request
+func (s *ListPriceChangeStatsService) Do(ctx context.Context, opts ...RequestOption) (res []*PriceChangeStats, err error) {
+	data, _, err := s.c.CallAPI(ctx, s.newRequest(), opts...)
 	if err != nil {
 		return res, err
 	}
@@ -111,3 +153,18 @@ func (s *ListPriceChangeStatsService) Do(ctx context.Context, opts ...RequestOpt
 	}
 	return res, nil
 }
+
+// DoV2 send request and return the decimal typed PriceChangeStatsV2
+func (s *ListPriceChangeStatsService) DoV2(ctx context.Context, opts ...RequestOption) (res []*PriceChangeStatsV2, err error) {
+	data, _, err := s.c.CallAPI(ctx, s.newRequest(), opts...)
+	if err != nil {
+		return []*PriceChangeStatsV2{}, err
+	}
+	data = common.ToJSONList(data)
+	res = make([]*PriceChangeStatsV2, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*PriceChangeStatsV2{}, err
+	}
+	return res, nil
+}
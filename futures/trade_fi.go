@@ -13,13 +13,13 @@ type SignTradeFiService struct {
 
 func (s *SignTradeFiService) Do(ctx context.Context, opts ...RequestOption) (res common.APIError, err error) {
 	r := &request{
-		service:  "SignTradeFiService",
-		method:   http.MethodPost,
-		endpoint: "/fapi/v1/stock/contract",
-		secType:  secTypeSigned,
+		Service:  "SignTradeFiService",
+		Method:   http.MethodPost,
+		Endpoint: "/fapi/v1/stock/contract",
+		SecType:  secTypeSigned,
 	}
 
-	data, _, err := s.c.callAPI(ctx, r, opts...)
+	data, _, err := s.c.CallAPI(ctx, r, opts...)
 	if err != nil {
 		return common.APIError{}, err
 	}
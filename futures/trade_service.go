@@ -32,23 +32,26 @@ func (s *HistoricalTradesService) FromID(fromID int64) *HistoricalTradesService
 	return s
 }
 
-// Do send request
-func (s *HistoricalTradesService) Do(ctx context.Context, opts ...RequestOption) (res []*Trade, err error) {
+func (s *HistoricalTradesService) newRequest() *request {
 	r := &request{
-		service:  "HistoricalTradesService",
-		method:   http.MethodGet,
-		endpoint: "/fapi/v1/historicalTrades",
-		secType:  secTypeAPIKey,
+		Service:  "HistoricalTradesService",
+		Method:   http.MethodGet,
+		Endpoint: "/fapi/v1/historicalTrades",
+		SecType:  secTypeAPIKey,
 	}
-	r.setParam("symbol", s.symbol)
+	r.SetParam("symbol", s.symbol)
 	if s.limit != nil {
-		r.setParam("limit", *s.limit)
+		r.SetParam("limit", *s.limit)
 	}
 	if s.fromID != nil {
-		r.setParam("fromId", *s.fromID)
+		r.SetParam("fromId", *s.fromID)
 	}
+	return r
+}
 
-	data, _, err := s.c.callAPI(ctx, r, opts...)
+// Do send request
+func (s *HistoricalTradesService) Do(ctx context.Context, opts ...RequestOption) (res []*Trade, err error) {
+	data, _, err := s.c.CallAPI(ctx, s.newRequest(), opts...)
 	if err != nil {
 		return
 	}
@@ -60,6 +63,20 @@ func (s *HistoricalTradesService) Do(ctx context.Context, opts ...RequestOption)
 	return
 }
 
+// DoV2 send request and return the decimal typed TradeV2
+func (s *HistoricalTradesService) DoV2(ctx context.Context, opts ...RequestOption) (res []*TradeV2, err error) {
+	data, _, err := s.c.CallAPI(ctx, s.newRequest(), opts...)
+	if err != nil {
+		return []*TradeV2{}, err
+	}
+	res = make([]*TradeV2, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*TradeV2{}, err
+	}
+	return res, nil
+}
+
 // AggTradesService list aggregate trades
 type AggTradesService struct {
 	c         *Client
@@ -100,27 +117,31 @@ func (s *AggTradesService) Limit(limit int) *AggTradesService {
 	return s
 }
 
-// Do send request
-func (s *AggTradesService) Do(ctx context.Context, opts ...RequestOption) (res []*AggTrade, err error) {
+func (s *AggTradesService) newRequest() *request {
 	r := &request{
-		service:  "AggTradesService",
-		method:   http.MethodGet,
-		endpoint: "/fapi/v1/aggTrades",
+		Service:  "AggTradesService",
+		Method:   http.MethodGet,
+		Endpoint: "/fapi/v1/aggTrades",
 	}
-	r.setParam("symbol", s.symbol)
+	r.SetParam("symbol", s.symbol)
 	if s.fromID != nil {
-		r.setParam("fromId", *s.fromID)
+		r.SetParam("fromId", *s.fromID)
 	}
 	if s.startTime != nil {
-		r.setParam("startTime", *s.startTime)
+		r.SetParam("startTime", *s.startTime)
 	}
 	if s.endTime != nil {
-		r.setParam("endTime", *s.endTime)
+		r.SetParam("endTime", *s.endTime)
 	}
 	if s.limit != nil {
-		r.setParam("limit", *s.limit)
+		r.SetParam("limit", *s.limit)
 	}
-	data, _, err := s.c.callAPI(ctx, r, opts...)
+	return r
+}
+
+// Do send request
+func (s *AggTradesService) Do(ctx context.Context, opts ...RequestOption) (res []*AggTrade, err error) {
+	data, _, err := s.c.CallAPI(ctx, s.newRequest(), opts...)
 	if err != nil {
 		return []*AggTrade{}, err
 	}
@@ -132,6 +153,20 @@ func (s *AggTradesService) Do(ctx context.Context, opts ...RequestOption) (res [
 	return res, nil
 }
 
+// DoV2 send request and return the decimal typed AggTradeV2
+func (s *AggTradesService) DoV2(ctx context.Context, opts ...RequestOption) (res []*AggTradeV2, err error) {
+	data, _, err := s.c.CallAPI(ctx, s.newRequest(), opts...)
+	if err != nil {
+		return []*AggTradeV2{}, err
+	}
+	res = make([]*AggTradeV2, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*AggTradeV2{}, err
+	}
+	return res, nil
+}
+
 // RecentTradesService list recent trades
 type RecentTradesService struct {
 	c      *Client
@@ -151,18 +186,22 @@ func (s *RecentTradesService) Limit(limit int) *RecentTradesService {
 	return s
 }
 
-// Do send request
-func (s *RecentTradesService) Do(ctx context.Context, opts ...RequestOption) (res []*Trade, err error) {
+func (s *RecentTradesService) newRequest() *request {
 	r := &request{
-		service:  "RecentTradesService",
-		method:   http.MethodGet,
-		endpoint: "/fapi/v1/trades",
+		Service:  "RecentTradesService",
+		Method:   http.MethodGet,
+		Endpoint: "/fapi/v1/trades",
 	}
-	r.setParam("symbol", s.symbol)
+	r.SetParam("symbol", s.symbol)
 	if s.limit != nil {
-		r.setParam("limit", *s.limit)
+		r.SetParam("limit", *s.limit)
 	}
-	data, _, err := s.c.callAPI(ctx, r, opts...)
+	return r
+}
+
+// Do send request
+func (s *RecentTradesService) Do(ctx context.Context, opts ...RequestOption) (res []*Trade, err error) {
+	data, _, err := s.c.CallAPI(ctx, s.newRequest(), opts...)
 	if err != nil {
 		return []*Trade{}, err
 	}
@@ -174,6 +213,20 @@ func (s *RecentTradesService) Do(ctx context.Context, opts ...RequestOption) (re
 	return res, nil
 }
 
+// DoV2 send request and return the decimal typed TradeV2
+func (s *RecentTradesService) DoV2(ctx context.Context, opts ...RequestOption) (res []*TradeV2, err error) {
+	data, _, err := s.c.CallAPI(ctx, s.newRequest(), opts...)
+	if err != nil {
+		return []*TradeV2{}, err
+	}
+	res = make([]*TradeV2, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*TradeV2{}, err
+	}
+	return res, nil
+}
+
 // ListAccountTradeService define account trade list service
 type ListAccountTradeService struct {
 	c         *Client
@@ -221,31 +274,35 @@ func (s *ListAccountTradeService) Limit(limit int) *ListAccountTradeService {
 	return s
 }
 
-// Do send request
-func (s *ListAccountTradeService) Do(ctx context.Context, opts ...RequestOption) (res []*AccountTrade, err error) {
+func (s *ListAccountTradeService) newRequest() *request {
 	r := &request{
-		service:  "ListAccountTradeService",
-		method:   http.MethodGet,
-		endpoint: "/fapi/v1/userTrades",
-		secType:  secTypeSigned,
+		Service:  "ListAccountTradeService",
+		Method:   http.MethodGet,
+		Endpoint: "/fapi/v1/userTrades",
+		SecType:  secTypeSigned,
 	}
-	r.setParam("symbol", s.symbol)
+	r.SetParam("symbol", s.symbol)
 	if s.orderId != nil {
-		r.setParam("orderId", *s.orderId)
+		r.SetParam("orderId", *s.orderId)
 	}
 	if s.startTime != nil {
-		r.setParam("startTime", *s.startTime)
+		r.SetParam("startTime", *s.startTime)
 	}
 	if s.endTime != nil {
-		r.setParam("endTime", *s.endTime)
+		r.SetParam("endTime", *s.endTime)
 	}
 	if s.fromID != nil {
-		r.setParam("fromID", *s.fromID)
+		r.SetParam("fromID", *s.fromID)
 	}
 	if s.limit != nil {
-		r.setParam("limit", *s.limit)
+		r.SetParam("limit", *s.limit)
 	}
-	data, _, err := s.c.callAPI(ctx, r, opts...)
+	return r
+}
+
+// Do send request
+func (s *ListAccountTradeService) Do(ctx context.Context, opts ...RequestOption) (res []*AccountTrade, err error) {
+	data, _, err := s.c.CallAPI(ctx, s.newRequest(), opts...)
 	if err != nil {
 		return []*AccountTrade{}, err
 	}
@@ -256,3 +313,17 @@ func (s *ListAccountTradeService) Do(ctx context.Context, opts ...RequestOption)
 	}
 	return res, nil
 }
+
+// DoV2 send request and return the decimal typed AccountTradeV2
+func (s *ListAccountTradeService) DoV2(ctx context.Context, opts ...RequestOption) (res []*AccountTradeV2, err error) {
+	data, _, err := s.c.CallAPI(ctx, s.newRequest(), opts...)
+	if err != nil {
+		return []*AccountTradeV2{}, err
+	}
+	res = make([]*AccountTradeV2, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*AccountTradeV2{}, err
+	}
+	return res, nil
+}
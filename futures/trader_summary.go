@@ -44,27 +44,27 @@ func (s *TraderSummaryService) EndTime(endTime int64) *TraderSummaryService {
 // Do send request
 func (s *TraderSummaryService) Do(ctx context.Context, opts ...RequestOption) (res []*TraderSummaryResponse, err error) {
 	r := &request{
-		service:  "TraderSummaryService",
-		method:   http.MethodGet,
-		endpoint: "/fapi/v1/apiReferral/traderSummary",
-		secType:  secTypeSigned,
+		Service:  "TraderSummaryService",
+		Method:   http.MethodGet,
+		Endpoint: "/fapi/v1/apiReferral/traderSummary",
+		SecType:  secTypeSigned,
 	}
 	if s.customerId != nil {
-		r.setParam("customerId", *s.customerId)
+		r.SetParam("customerId", *s.customerId)
 	}
 	if s._type != nil {
-		r.setParam("type", s._type)
+		r.SetParam("type", s._type)
 	}
 	if s.limit != nil {
-		r.setParam("limit", *s.limit)
+		r.SetParam("limit", *s.limit)
 	}
 	if s.startTime != nil {
-		r.setParam("startTime", *s.startTime)
+		r.SetParam("startTime", *s.startTime)
 	}
 	if s.endTime != nil {
-		r.setParam("endTime", *s.endTime)
+		r.SetParam("endTime", *s.endTime)
 	}
-	data, _, err := s.c.callAPI(ctx, r, opts...)
+	data, _, err := s.c.CallAPI(ctx, r, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -13,12 +13,12 @@ type StartUserStreamService struct {
 // Do send request
 func (s *StartUserStreamService) Do(ctx context.Context, opts ...RequestOption) (listenKey string, err error) {
 	r := &request{
-		service:  "StartUserStreamService",
-		method:   http.MethodPost,
-		endpoint: "/fapi/v1/listenKey",
-		secType:  secTypeSigned,
+		Service:  "StartUserStreamService",
+		Method:   http.MethodPost,
+		Endpoint: "/fapi/v1/listenKey",
+		SecType:  secTypeSigned,
 	}
-	data, _, err := s.c.callAPI(ctx, r, opts...)
+	data, _, err := s.c.CallAPI(ctx, r, opts...)
 	if err != nil {
 		return "", err
 	}
@@ -40,13 +40,13 @@ func (s *KeepaliveUserStreamService) ListenKey(listenKey string) *KeepaliveUserS
 // Do send request
 func (s *KeepaliveUserStreamService) Do(ctx context.Context, opts ...RequestOption) (err error) {
 	r := &request{
-		service:  "KeepaliveUserStreamService",
-		method:   http.MethodPut,
-		endpoint: "/fapi/v1/listenKey",
-		secType:  secTypeSigned,
+		Service:  "KeepaliveUserStreamService",
+		Method:   http.MethodPut,
+		Endpoint: "/fapi/v1/listenKey",
+		SecType:  secTypeSigned,
 	}
-	r.setFormParam("listenKey", s.listenKey)
-	_, _, err = s.c.callAPI(ctx, r, opts...)
+	r.SetFormParam("listenKey", s.listenKey)
+	_, _, err = s.c.CallAPI(ctx, r, opts...)
 	return err
 }
 
@@ -65,12 +65,12 @@ func (s *CloseUserStreamService) ListenKey(listenKey string) *CloseUserStreamSer
 // Do send request
 func (s *CloseUserStreamService) Do(ctx context.Context, opts ...RequestOption) (err error) {
 	r := &request{
-		service:  "CloseUserStreamService",
-		method:   http.MethodDelete,
-		endpoint: "/fapi/v1/listenKey",
-		secType:  secTypeSigned,
+		Service:  "CloseUserStreamService",
+		Method:   http.MethodDelete,
+		Endpoint: "/fapi/v1/listenKey",
+		SecType:  secTypeSigned,
 	}
-	r.setFormParam("listenKey", s.listenKey)
-	_, _, err = s.c.callAPI(ctx, r, opts...)
+	r.SetFormParam("listenKey", s.listenKey)
+	_, _, err = s.c.CallAPI(ctx, r, opts...)
 	return err
 }
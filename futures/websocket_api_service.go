@@ -0,0 +1,279 @@
+package futures
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"sync"
+	"sync/atomic"
+
+	"github.com/ward-cap/go-binance/common"
+)
+
+// wsAPIIntParams lists the parameters the WebSocket API expects as JSON
+// numbers, every other parameter is sent as a string
+var wsAPIIntParams = map[string]bool{
+	"orderId":      true,
+	"recvWindow":   true,
+	"timestamp":    true,
+	"goodTillDate": true,
+}
+
+// WsAPIClient runs futures services over one persistent WebSocket API
+// connection instead of one HTTP request per call. Signed requests are signed
+// with the client signer unless the session has been authenticated with SessionLogon.
+type WsAPIClient struct {
+	c    *Client
+	conn *common.WsAPIConn
+
+	mu       sync.Mutex
+	loggedOn bool
+}
+
+// NewWsAPIClient init WebSocket API client, the connection is opened by
+// Connect or by the first call
+func (c *Client) NewWsAPIClient() *WsAPIClient {
+	w := &WsAPIClient{c: c}
+	w.conn = common.NewWsAPIConn(common.WsConfig{
+		Endpoint: c.BaseWsAPIURL,
+		Logger:   c.Logger,
+		Package:  "futures",
+	}, func(error) {
+		w.setLoggedOn(false)
+	})
+	return w
+}
+
+// Connect open the connection
+func (w *WsAPIClient) Connect(ctx context.Context) error {
+	return w.conn.Connect(ctx)
+}
+
+// Close close the connection
+func (w *WsAPIClient) Close() error {
+	return w.conn.Close()
+}
+
+// LoggedOn report whether the session is authenticated with SessionLogon.
+// The session is reset when the connection drops.
+func (w *WsAPIClient) LoggedOn() bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.loggedOn
+}
+
+// SessionLogon authenticate the connection, later signed requests are sent
+// without apiKey and signature. The client Signer must be a
+// common.Ed25519Signer, ErrSessionLogonSigner is returned otherwise.
+func (w *WsAPIClient) SessionLogon(ctx context.Context, opts ...RequestOption) error {
+	if _, ok := w.c.Signer.(*common.Ed25519Signer); !ok {
+		return common.ErrSessionLogonSigner
+	}
+	r := &request{Service: "SessionLogon", SecType: secTypeSigned}
+	m, err := w.params(r, false, opts...)
+	if err != nil {
+		return err
+	}
+	if _, err = w.conn.Call(ctx, "session.logon", m); err != nil {
+		return err
+	}
+	w.setLoggedOn(true)
+	return nil
+}
+
+// SessionLogout forget the session authentication, the connection stays open
+func (w *WsAPIClient) SessionLogout(ctx context.Context) error {
+	if _, err := w.conn.Call(ctx, "session.logout", nil); err != nil {
+		return err
+	}
+	w.setLoggedOn(false)
+	return nil
+}
+
+// CreateOrder send the order of s with order.place
+func (w *WsAPIClient) CreateOrder(ctx context.Context, s *CreateOrderService, opts ...RequestOption) (res *CreateOrderResponse, err error) {
+	data, rateLimits, err := w.call(ctx, "order.place", s.newRequest("/fapi/v1/order"), opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(CreateOrderResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	for _, limit := range rateLimits {
+		if limit.RateLimitType != "ORDERS" {
+			continue
+		}
+		switch {
+		case limit.Interval == "SECOND" && limit.IntervalNum == 10:
+			res.RateLimitOrder10s = strconv.Itoa(limit.Count)
+		case limit.Interval == "MINUTE" && limit.IntervalNum == 1:
+			res.RateLimitOrder1m = strconv.Itoa(limit.Count)
+		}
+	}
+	return res, nil
+}
+
+// CancelOrder cancel the order of s with order.cancel
+func (w *WsAPIClient) CancelOrder(ctx context.Context, s *CancelOrderService, opts ...RequestOption) (res *CancelOrderResponse, err error) {
+	data, _, err := w.call(ctx, "order.cancel", s.newRequest(), opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(CancelOrderResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ModifyOrder modify the order of s with order.modify
+func (w *WsAPIClient) ModifyOrder(ctx context.Context, s *ModifyOrderService, opts ...RequestOption) (res *Order, err error) {
+	if err := s.validate(); err != nil {
+		return nil, err
+	}
+	data, _, err := w.call(ctx, "order.modify", s.newRequest(), opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(Order)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetOrder query the order of s with order.status
+func (w *WsAPIClient) GetOrder(ctx context.Context, s *GetOrderService, opts ...RequestOption) (res *Order, err error) {
+	data, _, err := w.call(ctx, "order.status", s.newRequest(), opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(Order)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetPositionRisk query the positions of s with account.position
+func (w *WsAPIClient) GetPositionRisk(ctx context.Context, s *GetPositionRiskService, opts ...RequestOption) (res []*PositionRisk, err error) {
+	data, _, err := w.call(ctx, "account.position", s.newRequest(), opts...)
+	if err != nil {
+		return []*PositionRisk{}, err
+	}
+	res = make([]*PositionRisk, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*PositionRisk{}, err
+	}
+	return res, nil
+}
+
+// GetBalance query the account balance with account.balance
+func (w *WsAPIClient) GetBalance(ctx context.Context, s *GetBalanceService, opts ...RequestOption) (res []*Balance, err error) {
+	data, _, err := w.call(ctx, "account.balance", s.newRequest(), opts...)
+	if err != nil {
+		return []*Balance{}, err
+	}
+	res = make([]*Balance, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*Balance{}, err
+	}
+	return res, nil
+}
+
+func (w *WsAPIClient) call(ctx context.Context, method string, r *request, opts ...RequestOption) (data []byte, rateLimits []common.WsAPIRateLimit, err error) {
+	ctx, span := common.StartRequestSpan(ctx, "go-binance/futures", r.Service)
+	defer func() { common.EndRequestSpan(span, err) }()
+
+	m, err := w.params(r, w.LoggedOn(), opts...)
+	if err != nil {
+		return nil, nil, err
+	}
+	res, err := w.conn.Call(ctx, method, m)
+	if err != nil {
+		var apiErr *common.APIError
+		if errors.As(err, &apiErr) {
+			apiErr.Service = r.Service
+		}
+		if w.c.ClockSync != nil && errors.Is(err, common.ErrInvalidTimestamp) {
+			_ = w.c.ClockSync.Resync(ctx)
+		}
+		return nil, nil, err
+	}
+	return res.Result, res.RateLimits, nil
+}
+
+// params turn the query and form of r into WebSocket API params, adding the
+// timestamp, apiKey and signature the security type of r asks for
+func (w *WsAPIClient) params(r *request, loggedOn bool, opts ...RequestOption) (map[string]any, error) {
+	for _, opt := range opts {
+		opt(r)
+	}
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+
+	// a JSON params object holds one value per key, a key set more than
+	// once, or in both the query and the form, is rejected rather than
+	// sending only its first value
+	values := url.Values{}
+	for _, params := range []url.Values{r.Query, r.Form} {
+		for k, vs := range params {
+			for _, v := range vs {
+				if v != "" {
+					values[k] = append(values[k], v)
+				}
+			}
+		}
+	}
+	for k, vs := range values {
+		if len(vs) > 1 {
+			return nil, fmt.Errorf("param %s has %d values, the WebSocket API takes one", k, len(vs))
+		}
+	}
+	if r.RecvWindow > 0 {
+		values.Set(recvWindowKey, strconv.FormatInt(r.RecvWindow.Milliseconds(), 10))
+	}
+	if r.SecType == secTypeSigned {
+		values.Set(timestampKey, strconv.FormatInt(currentTimestamp()-atomic.LoadInt64(&w.c.TimeOffset), 10))
+	}
+	if (r.SecType == secTypeAPIKey || r.SecType == secTypeSigned) && !loggedOn {
+		values.Set("apiKey", w.c.APIKey)
+	}
+	if r.SecType == secTypeSigned && !loggedOn {
+		signature, err := w.c.Signature(values.Encode())
+		if err != nil {
+			return nil, err
+		}
+		values.Set(signatureKey, signature)
+	}
+
+	m := make(map[string]any, len(values))
+	for k := range values {
+		v := values.Get(k)
+		if wsAPIIntParams[k] {
+			if n, err := strconv.ParseInt(v, 10, 64); err == nil {
+				m[k] = n
+				continue
+			}
+		}
+		m[k] = v
+	}
+	return m, nil
+}
+
+func (w *WsAPIClient) setLoggedOn(loggedOn bool) {
+	w.mu.Lock()
+	w.loggedOn = loggedOn
+	w.mu.Unlock()
+}
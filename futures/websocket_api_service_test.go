@@ -0,0 +1,314 @@
+package futures
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/gorilla/websocket"
+	"github.com/ward-cap/go-binance/common"
+)
+
+type wsAPITestRequest struct {
+	ID     int64          `json:"id"`
+	Method string         `json:"method"`
+	Params map[string]any `json:"params"`
+}
+
+// param return a param as sent on the wire, empty when missing
+func (r wsAPITestRequest) param(key string) string {
+	v, ok := r.Params[key]
+	if !ok {
+		return ""
+	}
+	return fmt.Sprint(v)
+}
+
+// wsAPITestServer is a fake WebSocket API endpoint. session.logon checks the
+// Ed25519 signature, every other method answers with the result set for it
+// or, without one, echoes the orderId param. When hold is set the responses
+// are held until that many requests arrived and sent in reverse order.
+type wsAPITestServer struct {
+	*httptest.Server
+	t   *testing.T
+	key ed25519.PublicKey
+
+	mu       sync.Mutex
+	hold     int
+	requests []wsAPITestRequest
+	results  map[string]string
+	errors   map[string]string
+}
+
+func newWsAPITestServer(t *testing.T, key ed25519.PublicKey) *wsAPITestServer {
+	s := &wsAPITestServer{t: t, key: key, results: map[string]string{}, errors: map[string]string{}}
+	upgrader := websocket.Upgrader{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		var held []string
+		for {
+			_, message, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			dec := json.NewDecoder(bytes.NewReader(message))
+			dec.UseNumber()
+			var req wsAPITestRequest
+			if err := dec.Decode(&req); err != nil {
+				t.Errorf("decode request: %v", err)
+				return
+			}
+			s.mu.Lock()
+			s.requests = append(s.requests, req)
+			result, apiErr, hold := s.results[req.Method], s.errors[req.Method], s.hold
+			s.mu.Unlock()
+
+			switch {
+			case req.Method == "session.logon" && !s.verify(req):
+				apiErr = `{"code":-1022,"msg":"Signature for this request is not valid."}`
+			case result == "" && req.param("orderId") != "":
+				result = fmt.Sprintf(`{"orderId":%s}`, req.param("orderId"))
+			case result == "":
+				result = "{}"
+			}
+			resp := fmt.Sprintf(`{"id":%d,"status":200,"result":%s}`, req.ID, result)
+			if apiErr != "" {
+				resp = fmt.Sprintf(`{"id":%d,"status":400,"error":%s}`, req.ID, apiErr)
+			}
+			held = append(held, resp)
+			if len(held) < hold {
+				continue
+			}
+			for i := len(held) - 1; i >= 0; i-- {
+				if err := conn.WriteMessage(websocket.TextMessage, []byte(held[i])); err != nil {
+					return
+				}
+			}
+			held = held[:0]
+		}
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+// verify check the signature over the other params sorted by key
+func (s *wsAPITestServer) verify(req wsAPITestRequest) bool {
+	values := url.Values{}
+	for k := range req.Params {
+		if k != signatureKey {
+			values.Set(k, req.param(k))
+		}
+	}
+	sig, err := base64.StdEncoding.DecodeString(req.param(signatureKey))
+	if err != nil {
+		return false
+	}
+	return ed25519.Verify(s.key, []byte(values.Encode()), sig)
+}
+
+func (s *wsAPITestServer) requestLog() []wsAPITestRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]wsAPITestRequest(nil), s.requests...)
+}
+
+func (s *wsAPITestServer) lastRequest() wsAPITestRequest {
+	s.t.Helper()
+	requests := s.requestLog()
+	if len(requests) == 0 {
+		s.t.Fatal("no request received")
+	}
+	return requests[len(requests)-1]
+}
+
+func newTestWsAPIClient(t *testing.T) (*WsAPIClient, *wsAPITestServer) {
+	t.Helper()
+	pub, priv, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := newWsAPITestServer(t, pub)
+	c := NewClient("api-key", "", nil)
+	c.Signer = common.NewEd25519Signer(priv)
+	c.BaseWsAPIURL = "ws" + strings.TrimPrefix(srv.URL, "http")
+	w := c.NewWsAPIClient()
+	t.Cleanup(func() { _ = w.Close() })
+	return w, srv
+}
+
+func TestWsAPISessionLogonRejectsNonEd25519Signer(t *testing.T) {
+	w, srv := newTestWsAPIClient(t)
+	for name, signer := range map[string]common.Signer{
+		"default": nil,
+		"hmac":    common.NewHMACSigner("secret"),
+		"rsa":     &common.RSASigner{},
+	} {
+		w.c.Signer = signer
+		if err := w.SessionLogon(context.Background()); !errors.Is(err, common.ErrSessionLogonSigner) {
+			t.Fatalf("%s: SessionLogon error = %v, want ErrSessionLogonSigner", name, err)
+		}
+	}
+	if n := len(srv.requestLog()); n != 0 {
+		t.Fatalf("requests sent = %d, want 0", n)
+	}
+}
+
+func TestWsAPISessionLogon(t *testing.T) {
+	w, srv := newTestWsAPIClient(t)
+	if err := w.SessionLogon(context.Background()); err != nil {
+		t.Fatalf("SessionLogon: %v", err)
+	}
+	if !w.LoggedOn() {
+		t.Fatal("LoggedOn = false after SessionLogon")
+	}
+	logon := srv.lastRequest()
+	if logon.Method != "session.logon" || logon.param("apiKey") != "api-key" || logon.param(timestampKey) == "" {
+		t.Fatalf("logon request = %+v", logon)
+	}
+
+	srv.mu.Lock()
+	srv.results["account.balance"] = `[{"asset":"USDT","balance":"10"}]`
+	srv.mu.Unlock()
+	if _, err := w.GetBalance(context.Background(), w.c.NewGetBalanceService()); err != nil {
+		t.Fatalf("GetBalance: %v", err)
+	}
+	req := srv.lastRequest()
+	if _, ok := req.Params["apiKey"]; ok {
+		t.Fatalf("apiKey sent after logon: %+v", req.Params)
+	}
+	if _, ok := req.Params[signatureKey]; ok {
+		t.Fatalf("signature sent after logon: %+v", req.Params)
+	}
+
+	if err := w.SessionLogout(context.Background()); err != nil {
+		t.Fatalf("SessionLogout: %v", err)
+	}
+	if w.LoggedOn() {
+		t.Fatal("LoggedOn = true after SessionLogout")
+	}
+}
+
+func TestWsAPISignedRequestWithoutLogon(t *testing.T) {
+	w, srv := newTestWsAPIClient(t)
+	srv.mu.Lock()
+	srv.results["order.place"] = `{"symbol":"BTCUSDT","orderId":7,"clientOrderId":"abc"}`
+	srv.mu.Unlock()
+
+	s := w.c.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).
+		Type(OrderTypeLimit).TimeInForce(TimeInForceTypeGTC).
+		Quantity("1").Price("10").NewClientOrderID("abc")
+	res, err := w.CreateOrder(context.Background(), s)
+	if err != nil {
+		t.Fatalf("CreateOrder: %v", err)
+	}
+	if res.OrderID != 7 || res.ClientOrderID != "abc" {
+		t.Fatalf("response = %+v", res)
+	}
+	req := srv.lastRequest()
+	if !srv.verify(req) {
+		t.Fatalf("signature does not verify: %+v", req.Params)
+	}
+	if req.param("apiKey") != "api-key" || req.param("symbol") != "BTCUSDT" {
+		t.Fatalf("params = %+v", req.Params)
+	}
+	if _, ok := req.Params[timestampKey].(json.Number); !ok {
+		t.Fatalf("timestamp sent as %T, want a JSON number", req.Params[timestampKey])
+	}
+}
+
+func TestWsAPIResponsesMatchRequestIDs(t *testing.T) {
+	w, srv := newTestWsAPIClient(t)
+	if err := w.Connect(context.Background()); err != nil {
+		t.Fatalf("Connect: %v", err)
+	}
+	const n = 4
+	srv.mu.Lock()
+	srv.hold = n
+	srv.mu.Unlock()
+
+	var wg sync.WaitGroup
+	got := make([]int64, n)
+	errs := make([]error, n)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			s := w.c.NewGetOrderService().Symbol("BTCUSDT").OrderID(int64(i + 1))
+			order, err := w.GetOrder(context.Background(), s)
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			got[i] = order.OrderID
+		}(i)
+	}
+	wg.Wait()
+	for i := 0; i < n; i++ {
+		if errs[i] != nil {
+			t.Fatalf("GetOrder %d: %v", i+1, errs[i])
+		}
+		if got[i] != int64(i+1) {
+			t.Fatalf("GetOrder %d got order %d", i+1, got[i])
+		}
+	}
+}
+
+func TestWsAPIErrorResponse(t *testing.T) {
+	w, srv := newTestWsAPIClient(t)
+	srv.mu.Lock()
+	srv.errors["order.status"] = `{"code":-2013,"msg":"Order does not exist."}`
+	srv.mu.Unlock()
+
+	_, err := w.GetOrder(context.Background(), w.c.NewGetOrderService().Symbol("BTCUSDT").OrderID(1))
+	var apiErr *common.APIError
+	if !errors.As(err, &apiErr) || apiErr.Code != common.ErrCodeNoSuchOrder {
+		t.Fatalf("GetOrder error = %v, want -2013", err)
+	}
+	if apiErr.Service != "GetOrderService" || apiErr.Endpoint != "order.status" || apiErr.StatusCode != http.StatusBadRequest {
+		t.Fatalf("api error = %+v", apiErr)
+	}
+	if !errors.Is(err, common.ErrNoSuchOrder) {
+		t.Fatalf("error %v is not ErrNoSuchOrder", err)
+	}
+}
+
+func TestWsAPIModifyOrderValidates(t *testing.T) {
+	w, srv := newTestWsAPIClient(t)
+	for name, s := range map[string]*ModifyOrderService{
+		"no order id": w.c.NewModifyOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).Quantity("1").Price("10"),
+		"price and priceMatch": w.c.NewModifyOrderService().Symbol("BTCUSDT").OrderID(1).Side(SideTypeBuy).
+			Quantity("1").Price("10").PriceMatch(PriceMatchTypeOpponent),
+	} {
+		if _, err := w.ModifyOrder(context.Background(), s); err == nil {
+			t.Fatalf("%s: ModifyOrder succeeded, want a validation error", name)
+		}
+	}
+	if n := len(srv.requestLog()); n != 0 {
+		t.Fatalf("requests sent = %d, want 0", n)
+	}
+}
+
+func TestWsAPIRejectsMultiValueParam(t *testing.T) {
+	w, srv := newTestWsAPIClient(t)
+	s := w.c.NewGetOrderService().Symbol("BTCUSDT").OrderID(1)
+	_, err := w.GetOrder(context.Background(), s, WithExtraForm(map[string]any{"symbol": "ETHUSDT"}))
+	if err == nil || !strings.Contains(err.Error(), "symbol") {
+		t.Fatalf("GetOrder error = %v, want a multi-value symbol error", err)
+	}
+	if n := len(srv.requestLog()); n != 0 {
+		t.Fatalf("requests sent = %d, want 0", n)
+	}
+}
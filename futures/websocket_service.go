@@ -0,0 +1,338 @@
+package futures
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ward-cap/go-binance/common"
+)
+
+// WsHandler handle raw websocket message
+type WsHandler func(message []byte)
+
+// ErrHandler handles errors
+type ErrHandler func(err error)
+
+// WsMarkPriceHandler handle websocket mark price event
+type WsMarkPriceHandler func(event *WsMarkPriceEvent)
+
+// WsAllMarkPriceHandler handle websocket all market mark price event
+type WsAllMarkPriceHandler func(event WsAllMarkPriceEvent)
+
+// WsKlineHandler handle websocket kline event
+type WsKlineHandler func(event *WsKlineEvent)
+
+// WsContinuousKlineHandler handle websocket continuous kline event
+type WsContinuousKlineHandler func(event *WsContinuousKlineEvent)
+
+// WsAggTradeHandler handle websocket aggregate trade event
+type WsAggTradeHandler func(event *WsAggTradeEvent)
+
+// WsBookTickerHandler handle websocket book ticker event
+type WsBookTickerHandler func(event *WsBookTickerEvent)
+
+// WsDepthHandler handle websocket diff depth event
+type WsDepthHandler func(event *WsDepthEvent)
+
+// WsLiquidationOrderHandler handle websocket liquidation order event
+type WsLiquidationOrderHandler func(event *WsLiquidationOrderEvent)
+
+// WsCompositeIndexHandler handle websocket composite index event
+type WsCompositeIndexHandler func(event *WsCompositeIndexEvent)
+
+// WsContractInfoHandler handle websocket contract info event
+type WsContractInfoHandler func(event *WsContractInfoEvent)
+
+// WsMarketStream streams futures market data over one combined stream
+// connection. Streams may be added and removed while connected; the
+// connection is re-established and resubscribed automatically.
+type WsMarketStream struct {
+	ws         *common.WsStream
+	mu         sync.RWMutex
+	handlers   map[string]WsHandler
+	errHandler ErrHandler
+}
+
+// NewWsMarketStream init market data websocket stream
+func (c *Client) NewWsMarketStream(errHandler ErrHandler) *WsMarketStream {
+	s := &WsMarketStream{
+		handlers:   map[string]WsHandler{},
+		errHandler: errHandler,
+	}
+	s.ws = common.NewWsStream(common.WsConfig{
+		Endpoint: fmt.Sprintf("%s/stream", c.BaseWsURL),
+		Logger:   c.Logger,
+		Package:  "futures",
+	}, s.dispatch, s.handleError)
+	return s
+}
+
+// Connect open the connection and subscribe the streams registered so far
+func (s *WsMarketStream) Connect(ctx context.Context) error {
+	return s.ws.Connect(ctx)
+}
+
+// Close close the connection, no reconnect is attempted afterwards
+func (s *WsMarketStream) Close() error {
+	return s.ws.Close()
+}
+
+// Done is closed once the stream has been closed
+func (s *WsMarketStream) Done() <-chan struct{} {
+	return s.ws.Done()
+}
+
+// Streams return the subscribed stream names
+func (s *WsMarketStream) Streams() []string {
+	return s.ws.Streams()
+}
+
+// Subscribe subscribe a raw stream by name, e.g. "btcusdt@markPrice@1s"
+func (s *WsMarketStream) Subscribe(ctx context.Context, stream string, handler WsHandler) error {
+	s.mu.Lock()
+	s.handlers[stream] = handler
+	s.mu.Unlock()
+
+	if err := s.ws.Subscribe(ctx, stream); err != nil {
+		s.mu.Lock()
+		delete(s.handlers, stream)
+		s.mu.Unlock()
+		return err
+	}
+	return nil
+}
+
+// Unsubscribe unsubscribe streams by name. The handlers are kept until the
+// unsubscription is acknowledged, so a failed request leaves them in place.
+func (s *WsMarketStream) Unsubscribe(ctx context.Context, streams ...string) error {
+	if err := s.ws.Unsubscribe(ctx, streams...); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	for _, stream := range streams {
+		delete(s.handlers, stream)
+	}
+	s.mu.Unlock()
+	return nil
+}
+
+// SubscribeMarkPrice subscribe <symbol>@markPrice and return the stream name.
+// rate may be 0 (3s) or 1s.
+func (s *WsMarketStream) SubscribeMarkPrice(ctx context.Context, symbol string, rate time.Duration, handler WsMarkPriceHandler) (string, error) {
+	stream := fmt.Sprintf("%s@markPrice%s", strings.ToLower(symbol), markPriceRateSuffix(rate))
+	return stream, s.Subscribe(ctx, stream, func(message []byte) {
+		event := new(WsMarkPriceEvent)
+		if err := event.UnmarshalJSON(message); err != nil {
+			s.handleError(err)
+			return
+		}
+		handler(event)
+	})
+}
+
+// SubscribeAllMarkPrice subscribe !markPrice@arr and return the stream name.
+// rate may be 0 (3s) or 1s.
+func (s *WsMarketStream) SubscribeAllMarkPrice(ctx context.Context, rate time.Duration, handler WsAllMarkPriceHandler) (string, error) {
+	stream := fmt.Sprintf("!markPrice@arr%s", markPriceRateSuffix(rate))
+	return stream, s.Subscribe(ctx, stream, func(message []byte) {
+		var event WsAllMarkPriceEvent
+		if err := event.UnmarshalJSON(message); err != nil {
+			s.handleError(err)
+			return
+		}
+		handler(event)
+	})
+}
+
+// SubscribeKline subscribe <symbol>@kline_<interval> and return the stream name
+func (s *WsMarketStream) SubscribeKline(ctx context.Context, symbol, interval string, handler WsKlineHandler) (string, error) {
+	stream := fmt.Sprintf("%s@kline_%s", strings.ToLower(symbol), interval)
+	return stream, s.Subscribe(ctx, stream, func(message []byte) {
+		event := new(WsKlineEvent)
+		if err := event.UnmarshalJSON(message); err != nil {
+			s.handleError(err)
+			return
+		}
+		handler(event)
+	})
+}
+
+// SubscribeContinuousKline subscribe <pair>_<contractType>@continuousKline_<interval> and return the stream name
+func (s *WsMarketStream) SubscribeContinuousKline(ctx context.Context, pair string, contractType ContractType, interval string, handler WsContinuousKlineHandler) (string, error) {
+	stream := fmt.Sprintf("%s_%s@continuousKline_%s", strings.ToLower(pair), strings.ToLower(string(contractType)), interval)
+	return stream, s.Subscribe(ctx, stream, func(message []byte) {
+		event := new(WsContinuousKlineEvent)
+		if err := event.UnmarshalJSON(message); err != nil {
+			s.handleError(err)
+			return
+		}
+		handler(event)
+	})
+}
+
+// SubscribeAggTrade subscribe <symbol>@aggTrade and return the stream name
+func (s *WsMarketStream) SubscribeAggTrade(ctx context.Context, symbol string, handler WsAggTradeHandler) (string, error) {
+	stream := fmt.Sprintf("%s@aggTrade", strings.ToLower(symbol))
+	return stream, s.Subscribe(ctx, stream, func(message []byte) {
+		event := new(WsAggTradeEvent)
+		if err := event.UnmarshalJSON(message); err != nil {
+			s.handleError(err)
+			return
+		}
+		handler(event)
+	})
+}
+
+// SubscribeBookTicker subscribe <symbol>@bookTicker and return the stream name
+func (s *WsMarketStream) SubscribeBookTicker(ctx context.Context, symbol string, handler WsBookTickerHandler) (string, error) {
+	stream := fmt.Sprintf("%s@bookTicker", strings.ToLower(symbol))
+	return stream, s.Subscribe(ctx, stream, func(message []byte) {
+		event := new(WsBookTickerEvent)
+		if err := event.UnmarshalJSON(message); err != nil {
+			s.handleError(err)
+			return
+		}
+		handler(event)
+	})
+}
+
+// SubscribeDepth subscribe <symbol>@depth diff stream and return the stream name.
+// rate may be 0 (250ms), 100ms or 500ms.
+func (s *WsMarketStream) SubscribeDepth(ctx context.Context, symbol string, rate time.Duration, handler WsDepthHandler) (string, error) {
+	stream := fmt.Sprintf("%s@depth%s", strings.ToLower(symbol), depthRateSuffix(rate))
+	return stream, s.Subscribe(ctx, stream, func(message []byte) {
+		event, err := parseWsDepthEvent(message)
+		if err != nil {
+			s.handleError(err)
+			return
+		}
+		handler(event)
+	})
+}
+
+// SubscribeLiquidationOrder subscribe <symbol>@forceOrder and return the stream name
+func (s *WsMarketStream) SubscribeLiquidationOrder(ctx context.Context, symbol string, handler WsLiquidationOrderHandler) (string, error) {
+	stream := fmt.Sprintf("%s@forceOrder", strings.ToLower(symbol))
+	return stream, s.subscribeLiquidationOrder(ctx, stream, handler)
+}
+
+// SubscribeAllLiquidationOrder subscribe !forceOrder@arr and return the stream name
+func (s *WsMarketStream) SubscribeAllLiquidationOrder(ctx context.Context, handler WsLiquidationOrderHandler) (string, error) {
+	stream := "!forceOrder@arr"
+	return stream, s.subscribeLiquidationOrder(ctx, stream, handler)
+}
+
+func (s *WsMarketStream) subscribeLiquidationOrder(ctx context.Context, stream string, handler WsLiquidationOrderHandler) error {
+	return s.Subscribe(ctx, stream, func(message []byte) {
+		event := new(WsLiquidationOrderEvent)
+		if err := event.UnmarshalJSON(message); err != nil {
+			s.handleError(err)
+			return
+		}
+		handler(event)
+	})
+}
+
+// SubscribeCompositeIndex subscribe <symbol>@compositeIndex and return the stream name
+func (s *WsMarketStream) SubscribeCompositeIndex(ctx context.Context, symbol string, handler WsCompositeIndexHandler) (string, error) {
+	stream := fmt.Sprintf("%s@compositeIndex", strings.ToLower(symbol))
+	return stream, s.Subscribe(ctx, stream, func(message []byte) {
+		event := new(WsCompositeIndexEvent)
+		if err := event.UnmarshalJSON(message); err != nil {
+			s.handleError(err)
+			return
+		}
+		handler(event)
+	})
+}
+
+// SubscribeContractInfo subscribe !contractInfo and return the stream name
+func (s *WsMarketStream) SubscribeContractInfo(ctx context.Context, handler WsContractInfoHandler) (string, error) {
+	stream := "!contractInfo"
+	return stream, s.Subscribe(ctx, stream, func(message []byte) {
+		event := new(WsContractInfoEvent)
+		if err := event.UnmarshalJSON(message); err != nil {
+			s.handleError(err)
+			return
+		}
+		handler(event)
+	})
+}
+
+func (s *WsMarketStream) dispatch(stream string, data []byte) {
+	s.mu.RLock()
+	handler, ok := s.handlers[stream]
+	s.mu.RUnlock()
+	if !ok {
+		return
+	}
+	handler(data)
+}
+
+func (s *WsMarketStream) handleError(err error) {
+	if s.errHandler != nil {
+		s.errHandler(err)
+	}
+}
+
+// PremiumIndex convert the event to the PremiumIndex shape returned by PremiumIndexService
+func (e *WsMarkPriceEvent) PremiumIndex() *PremiumIndex {
+	return &PremiumIndex{
+		Symbol:          e.Symbol,
+		MarkPrice:       e.MarkPrice,
+		LastFundingRate: e.FundingRate,
+		NextFundingTime: e.NextFundingTime,
+		Time:            e.Time,
+	}
+}
+
+// Kline convert the websocket kline to the Kline shape returned by KlinesService
+func (k *WsKline) Kline() *Kline {
+	return &Kline{
+		OpenTime:                 k.StartTime,
+		Open:                     k.Open,
+		High:                     k.High,
+		Low:                      k.Low,
+		Close:                    k.Close,
+		Volume:                   k.Volume,
+		CloseTime:                k.EndTime,
+		QuoteAssetVolume:         k.QuoteVolume,
+		TradeNum:                 k.TradeNum,
+		TakerBuyBaseAssetVolume:  k.ActiveBuyVolume,
+		TakerBuyQuoteAssetVolume: k.ActiveBuyQuoteVolume,
+	}
+}
+
+// ContinuousKline convert the websocket kline to the ContinuousKline shape returned by ContinuousKlinesService
+func (k *WsContinuousKline) ContinuousKline() *ContinuousKline {
+	return &ContinuousKline{
+		OpenTime:                 k.StartTime,
+		Open:                     k.Open,
+		High:                     k.High,
+		Low:                      k.Low,
+		Close:                    k.Close,
+		Volume:                   k.Volume,
+		CloseTime:                k.EndTime,
+		QuoteAssetVolume:         k.QuoteVolume,
+		TradeNum:                 k.TradeNum,
+		TakerBuyBaseAssetVolume:  k.ActiveBuyVolume,
+		TakerBuyQuoteAssetVolume: k.ActiveBuyQuoteVolume,
+	}
+}
+
+func markPriceRateSuffix(rate time.Duration) string {
+	if rate == time.Second {
+		return "@1s"
+	}
+	return ""
+}
+
+func depthRateSuffix(rate time.Duration) string {
+	if rate <= 0 || rate == 250*time.Millisecond {
+		return ""
+	}
+	return fmt.Sprintf("@%dms", rate.Milliseconds())
+}
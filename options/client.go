@@ -0,0 +1,251 @@
+package options
+
+import (
+	"net/http"
+
+	jsoniter "github.com/json-iterator/go"
+	"github.com/ward-cap/go-binance/common"
+)
+
+// SideType define side type of order
+type SideType string
+
+// OptionSideType define the side of an option contract
+type OptionSideType string
+
+// PositionSideType define position side type
+type PositionSideType string
+
+// OrderType define order type
+type OrderType string
+
+// TimeInForceType define time in force type of order
+type TimeInForceType string
+
+// NewOrderRespType define response JSON verbosity
+type NewOrderRespType string
+
+// OrderStatusType define order status type
+type OrderStatusType string
+
+// SymbolFilterType define symbol filter type
+type SymbolFilterType string
+
+// StrikeResultType define the exercise result of an expired option
+type StrikeResultType string
+
+// RiskLevelType define the risk level of an account
+type RiskLevelType string
+
+// UserDataEventType define user data event type
+type UserDataEventType string
+
+// Endpoints
+const (
+	baseApiMainUrl = "https://eapi.binance.com"
+	baseWsMainUrl  = "wss://nbstream.binance.com/eoptions"
+)
+
+// Environment define the REST and websocket endpoints of a deployment. They
+// are always set together so a client never mixes hosts.
+type Environment struct {
+	BaseURL   string
+	BaseWsURL string
+}
+
+// MainnetEnvironment return the endpoints of the production deployment,
+// options have no testnet
+func MainnetEnvironment() Environment {
+	return Environment{
+		BaseURL:   baseApiMainUrl,
+		BaseWsURL: baseWsMainUrl,
+	}
+}
+
+// Redefining the standard package
+var jsonCodec = jsoniter.ConfigCompatibleWithStandardLibrary
+
+// Global enums
+const (
+	SideTypeBuy  SideType = "BUY"
+	SideTypeSell SideType = "SELL"
+
+	OptionSideTypeCall OptionSideType = "CALL"
+	OptionSideTypePut  OptionSideType = "PUT"
+
+	PositionSideTypeLong  PositionSideType = "LONG"
+	PositionSideTypeShort PositionSideType = "SHORT"
+
+	OrderTypeLimit OrderType = "LIMIT"
+
+	TimeInForceTypeGTC TimeInForceType = "GTC" // Good Till Cancel
+	TimeInForceTypeIOC TimeInForceType = "IOC" // Immediate or Cancel
+	TimeInForceTypeFOK TimeInForceType = "FOK" // Fill or Kill
+
+	NewOrderRespTypeACK    NewOrderRespType = "ACK"
+	NewOrderRespTypeRESULT NewOrderRespType = "RESULT"
+
+	OrderStatusTypeAccepted        OrderStatusType = "ACCEPTED"
+	OrderStatusTypeRejected        OrderStatusType = "REJECTED"
+	OrderStatusTypePartiallyFilled OrderStatusType = "PARTIALLY_FILLED"
+	OrderStatusTypeFilled          OrderStatusType = "FILLED"
+	OrderStatusTypeCancelled       OrderStatusType = "CANCELLED"
+
+	SymbolFilterTypePrice   SymbolFilterType = "PRICE_FILTER"
+	SymbolFilterTypeLotSize SymbolFilterType = "LOT_SIZE"
+
+	StrikeResultTypeRealisticValueStricken StrikeResultType = "REALISTIC_VALUE_STRICKEN"
+	StrikeResultTypeExtrinsicValueExpired  StrikeResultType = "EXTRINSIC_VALUE_EXPIRED"
+
+	RiskLevelTypeNormal     RiskLevelType = "NORMAL"
+	RiskLevelTypeReduceOnly RiskLevelType = "REDUCE_ONLY"
+
+	UserDataEventTypeListenKeyExpired UserDataEventType = "listenKeyExpired"
+	UserDataEventTypeAccountUpdate    UserDataEventType = "ACCOUNT_UPDATE"
+	UserDataEventTypeOrderTradeUpdate UserDataEventType = "ORDER_TRADE_UPDATE"
+	UserDataEventTypeRiskLevelChange  UserDataEventType = "RISK_LEVEL_CHANGE"
+)
+
+// NewClient initialize an API client instance with API key and secret key.
+// You should always call this function before using this SDK.
+// Services will be created by the form client.NewXXXService().
+func NewClient(apiKey, secretKey string, client *http.Client) *Client {
+	return &Client{
+		RESTClient: common.NewRESTClient(restConfig, apiKey, secretKey, MainnetEnvironment().BaseURL, client),
+		BaseWsURL:  MainnetEnvironment().BaseWsURL,
+	}
+}
+
+// restConfig define the package settings of the REST client
+var restConfig = common.RESTConfig{
+	Package: "options",
+	Cost:    requestCost,
+	RetryOrders: map[string]common.OrderLookup{
+		"/eapi/v1/order": {ClientOrderIDParam: "clientOrderId", LookupParam: "clientOrderId"},
+	},
+}
+
+// Client define API client, requests are signed and sent by the embedded
+// common.RESTClient
+type Client struct {
+	common.RESTClient
+	BaseWsURL string
+}
+
+// SetApiEndpoint set api Endpoint, only BaseURL is changed
+//
+// Deprecated: use SetEnvironment, which sets the websocket endpoint too.
+func (c *Client) SetApiEndpoint(url string) *Client {
+	c.BaseURL = url
+	return c
+}
+
+// SetEnvironment set the REST and websocket endpoints of env
+func (c *Client) SetEnvironment(env Environment) *Client {
+	c.BaseURL = env.BaseURL
+	c.BaseWsURL = env.BaseWsURL
+	return c
+}
+
+// Environment return the endpoints the client uses
+func (c *Client) Environment() Environment {
+	return Environment{
+		BaseURL:   c.BaseURL,
+		BaseWsURL: c.BaseWsURL,
+	}
+}
+
+// NewPingService init ping service
+func (c *Client) NewPingService() *PingService {
+	return &PingService{c: c}
+}
+
+// NewServerTimeService init server time service
+func (c *Client) NewServerTimeService() *ServerTimeService {
+	return &ServerTimeService{c: c}
+}
+
+// NewSetServerTimeService init set server time service
+func (c *Client) NewSetServerTimeService() *SetServerTimeService {
+	return &SetServerTimeService{c: c}
+}
+
+// NewExchangeInfoService init exchange info service
+func (c *Client) NewExchangeInfoService() *ExchangeInfoService {
+	return &ExchangeInfoService{c: c}
+}
+
+// NewMarkPriceService init mark price service
+func (c *Client) NewMarkPriceService() *MarkPriceService {
+	return &MarkPriceService{c: c}
+}
+
+// NewKlinesService init klines service
+func (c *Client) NewKlinesService() *KlinesService {
+	return &KlinesService{c: c}
+}
+
+// NewDepthService init depth service
+func (c *Client) NewDepthService() *DepthService {
+	return &DepthService{c: c}
+}
+
+// NewExerciseHistoryService init exercise history service
+func (c *Client) NewExerciseHistoryService() *ExerciseHistoryService {
+	return &ExerciseHistoryService{c: c}
+}
+
+// NewCreateOrderService init creating order service
+func (c *Client) NewCreateOrderService() *CreateOrderService {
+	return &CreateOrderService{c: c}
+}
+
+// NewCreateBatchOrdersService init creating batch orders service
+func (c *Client) NewCreateBatchOrdersService() *CreateBatchOrdersService {
+	return &CreateBatchOrdersService{c: c}
+}
+
+// NewGetOrderService init get order service
+func (c *Client) NewGetOrderService() *GetOrderService {
+	return &GetOrderService{c: c}
+}
+
+// NewListOpenOrdersService init list open orders service
+func (c *Client) NewListOpenOrdersService() *ListOpenOrdersService {
+	return &ListOpenOrdersService{c: c}
+}
+
+// NewCancelOrderService init cancel order service
+func (c *Client) NewCancelOrderService() *CancelOrderService {
+	return &CancelOrderService{c: c}
+}
+
+// NewCancelMultipleOrdersService init cancel multiple orders service
+func (c *Client) NewCancelMultipleOrdersService() *CancelMultipleOrdersService {
+	return &CancelMultipleOrdersService{c: c}
+}
+
+// NewCancelAllOpenOrdersService init cancel all open orders service
+func (c *Client) NewCancelAllOpenOrdersService() *CancelAllOpenOrdersService {
+	return &CancelAllOpenOrdersService{c: c}
+}
+
+// NewGetPositionService init getting position service
+func (c *Client) NewGetPositionService() *GetPositionService {
+	return &GetPositionService{c: c}
+}
+
+// NewStartUserStreamService init starting user stream service
+func (c *Client) NewStartUserStreamService() *StartUserStreamService {
+	return &StartUserStreamService{c: c}
+}
+
+// NewKeepaliveUserStreamService init keep alive user stream service
+func (c *Client) NewKeepaliveUserStreamService() *KeepaliveUserStreamService {
+	return &KeepaliveUserStreamService{c: c}
+}
+
+// NewCloseUserStreamService init closing user stream service
+func (c *Client) NewCloseUserStreamService() *CloseUserStreamService {
+	return &CloseUserStreamService{c: c}
+}
@@ -0,0 +1,43 @@
+package options
+
+import (
+	"context"
+	"net/http"
+)
+
+// DepthService show depth info
+type DepthService struct {
+	c      *Client
+	symbol string
+	limit  *int
+}
+
+// Symbol set symbol
+func (s *DepthService) Symbol(symbol string) *DepthService {
+	s.symbol = symbol
+	return s
+}
+
+// Limit set limit, one of 10, 20, 50, 100, 500 or 1000
+func (s *DepthService) Limit(limit int) *DepthService {
+	s.limit = &limit
+	return s
+}
+
+// Do send request
+func (s *DepthService) Do(ctx context.Context, opts ...RequestOption) (res *DepthResponse, err error) {
+	r := &request{
+		Service:  "DepthService",
+		Method:   http.MethodGet,
+		Endpoint: "/eapi/v1/depth",
+	}
+	r.SetParam("symbol", s.symbol)
+	if s.limit != nil {
+		r.SetParam("limit", *s.limit)
+	}
+	data, _, err := s.c.CallAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return parseDepth(data)
+}
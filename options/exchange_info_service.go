@@ -0,0 +1,71 @@
+package options
+
+import (
+	"context"
+	"net/http"
+)
+
+// ExchangeInfoService exchange info service
+type ExchangeInfoService struct {
+	c *Client
+}
+
+// Do send request
+func (s *ExchangeInfoService) Do(ctx context.Context, opts ...RequestOption) (res *ExchangeInfo, err error) {
+	r := &request{
+		Service:  "ExchangeInfoService",
+		Method:   http.MethodGet,
+		Endpoint: "/eapi/v1/exchangeInfo",
+		SecType:  secTypeNone,
+	}
+	data, _, err := s.c.CallAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(ExchangeInfo)
+	err = jsonCodec.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// PriceFilter return price filter of symbol
+func (s *Symbol) PriceFilter() *PriceFilter {
+	for _, filter := range s.Filters {
+		if filter["filterType"].(string) == string(SymbolFilterTypePrice) {
+			f := &PriceFilter{}
+			if i, ok := filter["maxPrice"]; ok {
+				f.MaxPrice = i.(string)
+			}
+			if i, ok := filter["minPrice"]; ok {
+				f.MinPrice = i.(string)
+			}
+			if i, ok := filter["tickSize"]; ok {
+				f.TickSize = i.(string)
+			}
+			return f
+		}
+	}
+	return nil
+}
+
+// LotSizeFilter return lot size filter of symbol
+func (s *Symbol) LotSizeFilter() *LotSizeFilter {
+	for _, filter := range s.Filters {
+		if filter["filterType"].(string) == string(SymbolFilterTypeLotSize) {
+			f := &LotSizeFilter{}
+			if i, ok := filter["maxQty"]; ok {
+				f.MaxQuantity = i.(string)
+			}
+			if i, ok := filter["minQty"]; ok {
+				f.MinQuantity = i.(string)
+			}
+			if i, ok := filter["stepSize"]; ok {
+				f.StepSize = i.(string)
+			}
+			return f
+		}
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+package options
+
+import (
+	"context"
+	"net/http"
+)
+
+// ExerciseHistoryService list the exercise results of expired options
+type ExerciseHistoryService struct {
+	c          *Client
+	underlying *string
+	startTime  *int64
+	endTime    *int64
+	limit      *int
+}
+
+// Underlying set underlying, e.g. BTCUSDT
+func (s *ExerciseHistoryService) Underlying(underlying string) *ExerciseHistoryService {
+	s.underlying = &underlying
+	return s
+}
+
+// StartTime set startTime
+func (s *ExerciseHistoryService) StartTime(startTime int64) *ExerciseHistoryService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *ExerciseHistoryService) EndTime(endTime int64) *ExerciseHistoryService {
+	s.endTime = &endTime
+	return s
+}
+
+// Limit set limit
+func (s *ExerciseHistoryService) Limit(limit int) *ExerciseHistoryService {
+	s.limit = &limit
+	return s
+}
+
+// Do send request
+func (s *ExerciseHistoryService) Do(ctx context.Context, opts ...RequestOption) (res []*ExerciseHistory, err error) {
+	r := &request{
+		Service:  "ExerciseHistoryService",
+		Method:   http.MethodGet,
+		Endpoint: "/eapi/v1/exerciseHistory",
+		SecType:  secTypeNone,
+	}
+	if s.underlying != nil {
+		r.SetParam("underlying", *s.underlying)
+	}
+	if s.startTime != nil {
+		r.SetParam("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.SetParam("endTime", *s.endTime)
+	}
+	if s.limit != nil {
+		r.SetParam("limit", *s.limit)
+	}
+	data, _, err := s.c.CallAPI(ctx, r, opts...)
+	if err != nil {
+		return []*ExerciseHistory{}, err
+	}
+	res = make([]*ExerciseHistory, 0)
+	err = jsonCodec.Unmarshal(data, &res)
+	if err != nil {
+		return []*ExerciseHistory{}, err
+	}
+	return res, nil
+}
@@ -0,0 +1,116 @@
+package options
+
+import (
+	"encoding/json"
+	"strconv"
+)
+
+type listenKeyResponse struct {
+	ListenKey string `json:"listenKey"`
+}
+
+type serverTimeResponse struct {
+	ServerTime int64 `json:"serverTime"`
+}
+
+type depthPayload struct {
+	TradeTime int64             `json:"T"`
+	UpdateID  int64             `json:"u"`
+	Bids      []priceLevelTuple `json:"bids"`
+	Asks      []priceLevelTuple `json:"asks"`
+}
+
+type wsUserDataEventHeader struct {
+	Event     UserDataEventType `json:"e"`
+	Time      json.RawMessage   `json:"E"`
+	ListenKey string            `json:"listenKey"`
+}
+
+type priceLevelTuple [2]string
+
+func parseListenKey(data []byte) (string, error) {
+	var res listenKeyResponse
+	if err := jsonCodec.Unmarshal(data, &res); err != nil {
+		return "", err
+	}
+	return res.ListenKey, nil
+}
+
+func parseServerTime(data []byte) (int64, error) {
+	var res serverTimeResponse
+	if err := jsonCodec.Unmarshal(data, &res); err != nil {
+		return 0, err
+	}
+	return res.ServerTime, nil
+}
+
+func parseDepth(data []byte) (*DepthResponse, error) {
+	var payload depthPayload
+	if err := jsonCodec.Unmarshal(data, &payload); err != nil {
+		return nil, err
+	}
+	res := &DepthResponse{
+		TradeTime: payload.TradeTime,
+		UpdateID:  payload.UpdateID,
+	}
+	res.Bids = make([]Bid, len(payload.Bids))
+	for i, bid := range payload.Bids {
+		res.Bids[i] = Bid{Price: bid[0], Quantity: bid[1]}
+	}
+	res.Asks = make([]Ask, len(payload.Asks))
+	for i, ask := range payload.Asks {
+		res.Asks[i] = Ask{Price: ask[0], Quantity: ask[1]}
+	}
+	return res, nil
+}
+
+func parseWsUserDataEvent(data []byte) (*WsUserDataEvent, error) {
+	var header wsUserDataEventHeader
+	if err := jsonCodec.Unmarshal(data, &header); err != nil {
+		return nil, err
+	}
+	res := &WsUserDataEvent{Event: header.Event}
+	if len(header.Time) > 0 {
+		t, err := rawInt64(header.Time)
+		if err != nil {
+			return nil, err
+		}
+		res.Time = t
+	}
+
+	var err error
+	switch header.Event {
+	case UserDataEventTypeAccountUpdate:
+		res.AccountUpdate = new(WsAccountUpdateEvent)
+		err = res.AccountUpdate.UnmarshalJSON(data)
+	case UserDataEventTypeOrderTradeUpdate:
+		res.OrderTradeUpdate = new(WsOrderTradeUpdateEvent)
+		err = res.OrderTradeUpdate.UnmarshalJSON(data)
+	case UserDataEventTypeRiskLevelChange:
+		res.RiskLevelChange = new(WsRiskLevelChangeEvent)
+		err = res.RiskLevelChange.UnmarshalJSON(data)
+	case UserDataEventTypeListenKeyExpired:
+		// the event time is sent as a string here, so build it from the header
+		res.ListenKeyExpired = &WsListenKeyExpiredEvent{
+			Event:     header.Event,
+			Time:      res.Time,
+			ListenKey: header.ListenKey,
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func rawInt64(data json.RawMessage) (int64, error) {
+	var n int64
+	if err := jsonCodec.Unmarshal(data, &n); err == nil {
+		return n, nil
+	}
+	var s string
+	if err := jsonCodec.Unmarshal(data, &s); err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(s, 10, 64)
+}
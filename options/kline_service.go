@@ -0,0 +1,76 @@
+package options
+
+import (
+	"context"
+	"net/http"
+)
+
+// KlinesService list klines
+type KlinesService struct {
+	c         *Client
+	symbol    string
+	interval  string
+	limit     *int
+	startTime *int64
+	endTime   *int64
+}
+
+// Symbol set symbol
+func (s *KlinesService) Symbol(symbol string) *KlinesService {
+	s.symbol = symbol
+	return s
+}
+
+// Interval set interval
+func (s *KlinesService) Interval(interval string) *KlinesService {
+	s.interval = interval
+	return s
+}
+
+// Limit set limit
+func (s *KlinesService) Limit(limit int) *KlinesService {
+	s.limit = &limit
+	return s
+}
+
+// StartTime set startTime
+func (s *KlinesService) StartTime(startTime int64) *KlinesService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *KlinesService) EndTime(endTime int64) *KlinesService {
+	s.endTime = &endTime
+	return s
+}
+
+// Do send request
+func (s *KlinesService) Do(ctx context.Context, opts ...RequestOption) (res []*Kline, err error) {
+	r := &request{
+		Service:  "KlinesService",
+		Method:   http.MethodGet,
+		Endpoint: "/eapi/v1/klines",
+	}
+	r.SetParam("symbol", s.symbol)
+	r.SetParam("interval", s.interval)
+	if s.limit != nil {
+		r.SetParam("limit", *s.limit)
+	}
+	if s.startTime != nil {
+		r.SetParam("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.SetParam("endTime", *s.endTime)
+	}
+	data, _, err := s.c.CallAPI(ctx, r, opts...)
+	if err != nil {
+		return []*Kline{}, err
+	}
+	res = make([]*Kline, 0)
+	err = jsonCodec.Unmarshal(data, &res)
+	if err != nil {
+		return []*Kline{}, err
+	}
+	return res, nil
+}
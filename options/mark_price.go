@@ -0,0 +1,42 @@
+package options
+
+import (
+	"context"
+	"net/http"
+)
+
+// MarkPriceService get the mark price, implied volatilities and Greeks of
+// options
+type MarkPriceService struct {
+	c      *Client
+	symbol *string
+}
+
+// Symbol set symbol, all options are returned when it is not set
+func (s *MarkPriceService) Symbol(symbol string) *MarkPriceService {
+	s.symbol = &symbol
+	return s
+}
+
+// Do send request
+func (s *MarkPriceService) Do(ctx context.Context, opts ...RequestOption) (res []*MarkPrice, err error) {
+	r := &request{
+		Service:  "MarkPriceService",
+		Method:   http.MethodGet,
+		Endpoint: "/eapi/v1/mark",
+		SecType:  secTypeNone,
+	}
+	if s.symbol != nil {
+		r.SetParam("symbol", *s.symbol)
+	}
+	data, _, err := s.c.CallAPI(ctx, r, opts...)
+	if err != nil {
+		return []*MarkPrice{}, err
+	}
+	res = make([]*MarkPrice, 0)
+	err = jsonCodec.Unmarshal(data, &res)
+	if err != nil {
+		return []*MarkPrice{}, err
+	}
+	return res, nil
+}
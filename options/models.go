@@ -0,0 +1,320 @@
+package options
+
+import (
+	"github.com/ward-cap/go-binance/common"
+)
+
+//go:generate easyjson -all models.go
+
+// Ask is a type alias for PriceLevel.
+type Ask = common.PriceLevel
+
+// Bid is a type alias for PriceLevel.
+type Bid = common.PriceLevel
+
+// CreateBatchOrdersResponse define create batch orders response. Orders are
+// the placed orders and Errors the rejected ones, both indexed like the order
+// list with nil at the indexes of the other one.
+//
+//easyjson:json
+type CreateBatchOrdersResponse struct {
+	Orders []*Order
+	Errors []*common.APIError
+}
+
+// DepthResponse define depth info with bids and asks
+//
+//easyjson:json
+type DepthResponse struct {
+	TradeTime int64 `json:"T"`
+	UpdateID  int64 `json:"u"`
+	Bids      []Bid `json:"bids"`
+	Asks      []Ask `json:"asks"`
+}
+
+// ExchangeInfo exchange info
+//
+//easyjson:json
+type ExchangeInfo struct {
+	Timezone        string           `json:"timezone"`
+	ServerTime      int64            `json:"serverTime"`
+	OptionContracts []OptionContract `json:"optionContracts"`
+	OptionAssets    []OptionAsset    `json:"optionAssets"`
+	OptionSymbols   []Symbol         `json:"optionSymbols"`
+	RateLimits      []RateLimit      `json:"rateLimits"`
+}
+
+// ExerciseHistory define the exercise result of an expired option
+//
+//easyjson:json
+type ExerciseHistory struct {
+	Symbol          string           `json:"symbol"`
+	StrikePrice     string           `json:"strikePrice"`
+	RealStrikePrice string           `json:"realStrikePrice"`
+	ExpiryDate      int64            `json:"expiryDate"`
+	StrikeResult    StrikeResultType `json:"strikeResult"`
+}
+
+// Kline define kline info
+//
+//easyjson:json
+type Kline struct {
+	OpenTime    int64  `json:"openTime"`
+	Open        string `json:"open"`
+	High        string `json:"high"`
+	Low         string `json:"low"`
+	Close       string `json:"close"`
+	Volume      string `json:"volume"`
+	Amount      string `json:"amount"`
+	Interval    string `json:"interval"`
+	TradeCount  int64  `json:"tradeCount"`
+	TakerVolume string `json:"takerVolume"`
+	TakerAmount string `json:"takerAmount"`
+	CloseTime   int64  `json:"closeTime"`
+}
+
+// LotSizeFilter define lot size filter of symbol
+//
+//easyjson:json
+type LotSizeFilter struct {
+	MaxQuantity string `json:"maxQty"`
+	MinQuantity string `json:"minQty"`
+	StepSize    string `json:"stepSize"`
+}
+
+// MarkPrice define the mark price, implied volatilities and Greeks of an
+// option
+//
+//easyjson:json
+type MarkPrice struct {
+	Symbol           string `json:"symbol"`
+	MarkPrice        string `json:"markPrice"`
+	BidIV            string `json:"bidIV"`
+	AskIV            string `json:"askIV"`
+	MarkIV           string `json:"markIV"`
+	Delta            string `json:"delta"`
+	Theta            string `json:"theta"`
+	Gamma            string `json:"gamma"`
+	Vega             string `json:"vega"`
+	HighPriceLimit   string `json:"highPriceLimit"`
+	LowPriceLimit    string `json:"lowPriceLimit"`
+	RiskFreeInterest string `json:"riskFreeInterest"`
+}
+
+// OptionAsset define an asset of exchange info
+//
+//easyjson:json
+type OptionAsset struct {
+	Name string `json:"name"`
+}
+
+// OptionContract define an underlying of exchange info
+//
+//easyjson:json
+type OptionContract struct {
+	BaseAsset   string `json:"baseAsset"`
+	QuoteAsset  string `json:"quoteAsset"`
+	Underlying  string `json:"underlying"`
+	SettleAsset string `json:"settleAsset"`
+}
+
+// Order define order info
+//
+//easyjson:json
+type Order struct {
+	OrderID          int64           `json:"orderId"`
+	Symbol           string          `json:"symbol"`
+	Price            string          `json:"price"`
+	Quantity         string          `json:"quantity"`
+	ExecutedQuantity string          `json:"executedQty"`
+	Fee              string          `json:"fee"`
+	Side             SideType        `json:"side"`
+	Type             OrderType       `json:"type"`
+	TimeInForce      TimeInForceType `json:"timeInForce"`
+	ReduceOnly       bool            `json:"reduceOnly"`
+	PostOnly         bool            `json:"postOnly"`
+	CreateTime       int64           `json:"createTime"`
+	UpdateTime       int64           `json:"updateTime"`
+	Status           OrderStatusType `json:"status"`
+	AvgPrice         string          `json:"avgPrice"`
+	ClientOrderID    string          `json:"clientOrderId"`
+	PriceScale       int             `json:"priceScale"`
+	QuantityScale    int             `json:"quantityScale"`
+	OptionSide       OptionSideType  `json:"optionSide"`
+	QuoteAsset       string          `json:"quoteAsset"`
+	Mmp              bool            `json:"mmp"`
+}
+
+// Position define position info
+//
+//easyjson:json
+type Position struct {
+	Symbol        string           `json:"symbol"`
+	Side          PositionSideType `json:"side"`
+	Quantity      string           `json:"quantity"`
+	ReducibleQty  string           `json:"reducibleQty"`
+	EntryPrice    string           `json:"entryPrice"`
+	MarkValue     string           `json:"markValue"`
+	MarkPrice     string           `json:"markPrice"`
+	Ror           string           `json:"ror"`
+	UnrealizedPNL string           `json:"unrealizedPNL"`
+	StrikePrice   string           `json:"strikePrice"`
+	PositionCost  string           `json:"positionCost"`
+	ExpiryDate    int64            `json:"expiryDate"`
+	PriceScale    int              `json:"priceScale"`
+	QuantityScale int              `json:"quantityScale"`
+	OptionSide    OptionSideType   `json:"optionSide"`
+	QuoteAsset    string           `json:"quoteAsset"`
+}
+
+// PriceFilter define price filter of symbol
+//
+//easyjson:json
+type PriceFilter struct {
+	MaxPrice string `json:"maxPrice"`
+	MinPrice string `json:"minPrice"`
+	TickSize string `json:"tickSize"`
+}
+
+// RateLimit struct
+//
+//easyjson:json
+type RateLimit struct {
+	RateLimitType string `json:"rateLimitType"`
+	Interval      string `json:"interval"`
+	IntervalNum   int64  `json:"intervalNum"`
+	Limit         int64  `json:"limit"`
+}
+
+// Symbol option symbol
+//
+//easyjson:json
+type Symbol struct {
+	Symbol               string                   `json:"symbol"`
+	Side                 OptionSideType           `json:"side"`
+	StrikePrice          string                   `json:"strikePrice"`
+	Underlying           string                   `json:"underlying"`
+	Unit                 int64                    `json:"unit"`
+	ExpiryDate           int64                    `json:"expiryDate"`
+	Status               string                   `json:"status"`
+	MakerFeeRate         string                   `json:"makerFeeRate"`
+	TakerFeeRate         string                   `json:"takerFeeRate"`
+	MinQuantity          string                   `json:"minQty"`
+	MaxQuantity          string                   `json:"maxQty"`
+	InitialMargin        string                   `json:"initialMargin"`
+	MaintenanceMargin    string                   `json:"maintenanceMargin"`
+	MinInitialMargin     string                   `json:"minInitialMargin"`
+	MinMaintenanceMargin string                   `json:"minMaintenanceMargin"`
+	PriceScale           int                      `json:"priceScale"`
+	QuantityScale        int                      `json:"quantityScale"`
+	QuoteAsset           string                   `json:"quoteAsset"`
+	Filters              []map[string]interface{} `json:"filters"`
+}
+
+// WsAccountUpdateEvent define websocket account update event
+//
+//easyjson:json
+type WsAccountUpdateEvent struct {
+	Event     UserDataEventType `json:"e"`
+	Time      int64             `json:"E"`
+	Balances  []WsBalance       `json:"B"`
+	Greeks    []WsGreeks        `json:"G"`
+	Positions []WsPosition      `json:"P"`
+	UID       int64             `json:"uid"`
+}
+
+// WsBalance define balance of an account update event
+//
+//easyjson:json
+type WsBalance struct {
+	Asset             string `json:"a"`
+	Balance           string `json:"b"`
+	MarginBalance     string `json:"m"`
+	UnrealizedProfit  string `json:"u"`
+	MaintenanceMargin string `json:"M"`
+	InitialMargin     string `json:"i"`
+}
+
+// WsGreeks define the Greeks of an underlying of an account update event
+//
+//easyjson:json
+type WsGreeks struct {
+	Underlying string  `json:"ui"`
+	Delta      float64 `json:"d"`
+	Theta      float64 `json:"t"`
+	Gamma      float64 `json:"g"`
+	Vega       float64 `json:"v"`
+}
+
+// WsListenKeyExpiredEvent define websocket listen key expired event
+//
+//easyjson:json
+type WsListenKeyExpiredEvent struct {
+	Event     UserDataEventType `json:"e"`
+	Time      int64             `json:"E"`
+	ListenKey string            `json:"listenKey"`
+}
+
+// WsOrderFill define a fill of an order trade update
+//
+//easyjson:json
+type WsOrderFill struct {
+	TradeID  string `json:"t"`
+	Price    string `json:"p"`
+	Quantity string `json:"q"`
+	Time     int64  `json:"T"`
+	Maker    string `json:"m"`
+	Fee      string `json:"f"`
+}
+
+// WsOrderTradeUpdate define an order of an order trade update event
+//
+//easyjson:json
+type WsOrderTradeUpdate struct {
+	CreateTime       int64           `json:"T"`
+	UpdateTime       int64           `json:"t"`
+	Symbol           string          `json:"s"`
+	ClientOrderID    string          `json:"c"`
+	OrderID          string          `json:"oid"`
+	Price            string          `json:"p"`
+	Quantity         string          `json:"q"`
+	ReduceOnly       bool            `json:"r"`
+	PostOnly         bool            `json:"po"`
+	Status           OrderStatusType `json:"S"`
+	ExecutedQuantity string          `json:"e"`
+	ExecutedCost     string          `json:"ec"`
+	Fee              string          `json:"f"`
+	TimeInForce      TimeInForceType `json:"tif"`
+	Type             OrderType       `json:"oty"`
+	Fills            []WsOrderFill   `json:"fi"`
+}
+
+// WsOrderTradeUpdateEvent define websocket order trade update event
+//
+//easyjson:json
+type WsOrderTradeUpdateEvent struct {
+	Event  UserDataEventType    `json:"e"`
+	Time   int64                `json:"E"`
+	Orders []WsOrderTradeUpdate `json:"o"`
+}
+
+// WsPosition define position of an account update event
+//
+//easyjson:json
+type WsPosition struct {
+	Symbol       string `json:"s"`
+	Quantity     string `json:"c"`
+	ReducibleQty string `json:"r"`
+	AvgPrice     string `json:"p"`
+}
+
+// WsRiskLevelChangeEvent define websocket risk level change event
+//
+//easyjson:json
+type WsRiskLevelChangeEvent struct {
+	Event             UserDataEventType `json:"e"`
+	Time              int64             `json:"E"`
+	RiskLevel         RiskLevelType     `json:"s"`
+	MarginBalance     string            `json:"mb"`
+	MaintenanceMargin string            `json:"mm"`
+}
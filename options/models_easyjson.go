@@ -0,0 +1,3598 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package options
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+	common "github.com/ward-cap/go-binance/common"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceOptions(in *jlexer.Lexer, out *WsRiskLevelChangeEvent) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "e":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Event = UserDataEventType(in.String())
+			}
+		case "E":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Time = int64(in.Int64())
+			}
+		case "s":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.RiskLevel = RiskLevelType(in.String())
+			}
+		case "mb":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.MarginBalance = string(in.String())
+			}
+		case "mm":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.MaintenanceMargin = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceOptions(out *jwriter.Writer, in WsRiskLevelChangeEvent) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"e\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Event))
+	}
+	{
+		const prefix string = ",\"E\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Time))
+	}
+	{
+		const prefix string = ",\"s\":"
+		out.RawString(prefix)
+		out.String(string(in.RiskLevel))
+	}
+	{
+		const prefix string = ",\"mb\":"
+		out.RawString(prefix)
+		out.String(string(in.MarginBalance))
+	}
+	{
+		const prefix string = ",\"mm\":"
+		out.RawString(prefix)
+		out.String(string(in.MaintenanceMargin))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v WsRiskLevelChangeEvent) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceOptions(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v WsRiskLevelChangeEvent) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceOptions(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *WsRiskLevelChangeEvent) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceOptions(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *WsRiskLevelChangeEvent) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceOptions(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceOptions1(in *jlexer.Lexer, out *WsPosition) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "s":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Symbol = string(in.String())
+			}
+		case "c":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Quantity = string(in.String())
+			}
+		case "r":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ReducibleQty = string(in.String())
+			}
+		case "p":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.AvgPrice = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceOptions1(out *jwriter.Writer, in WsPosition) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"s\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Symbol))
+	}
+	{
+		const prefix string = ",\"c\":"
+		out.RawString(prefix)
+		out.String(string(in.Quantity))
+	}
+	{
+		const prefix string = ",\"r\":"
+		out.RawString(prefix)
+		out.String(string(in.ReducibleQty))
+	}
+	{
+		const prefix string = ",\"p\":"
+		out.RawString(prefix)
+		out.String(string(in.AvgPrice))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v WsPosition) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceOptions1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v WsPosition) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceOptions1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *WsPosition) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceOptions1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *WsPosition) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceOptions1(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceOptions2(in *jlexer.Lexer, out *WsOrderTradeUpdateEvent) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "e":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Event = UserDataEventType(in.String())
+			}
+		case "E":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Time = int64(in.Int64())
+			}
+		case "o":
+			if in.IsNull() {
+				in.Skip()
+				out.Orders = nil
+			} else {
+				in.Delim('[')
+				if out.Orders == nil {
+					if !in.IsDelim(']') {
+						out.Orders = make([]WsOrderTradeUpdate, 0, 0)
+					} else {
+						out.Orders = []WsOrderTradeUpdate{}
+					}
+				} else {
+					out.Orders = (out.Orders)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 WsOrderTradeUpdate
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v1).UnmarshalEasyJSON(in)
+					}
+					out.Orders = append(out.Orders, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceOptions2(out *jwriter.Writer, in WsOrderTradeUpdateEvent) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"e\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Event))
+	}
+	{
+		const prefix string = ",\"E\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Time))
+	}
+	{
+		const prefix string = ",\"o\":"
+		out.RawString(prefix)
+		if in.Orders == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v2, v3 := range in.Orders {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				(v3).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v WsOrderTradeUpdateEvent) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceOptions2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v WsOrderTradeUpdateEvent) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceOptions2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *WsOrderTradeUpdateEvent) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceOptions2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *WsOrderTradeUpdateEvent) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceOptions2(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceOptions3(in *jlexer.Lexer, out *WsOrderTradeUpdate) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "T":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.CreateTime = int64(in.Int64())
+			}
+		case "t":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.UpdateTime = int64(in.Int64())
+			}
+		case "s":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Symbol = string(in.String())
+			}
+		case "c":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ClientOrderID = string(in.String())
+			}
+		case "oid":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.OrderID = string(in.String())
+			}
+		case "p":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Price = string(in.String())
+			}
+		case "q":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Quantity = string(in.String())
+			}
+		case "r":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ReduceOnly = bool(in.Bool())
+			}
+		case "po":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.PostOnly = bool(in.Bool())
+			}
+		case "S":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Status = OrderStatusType(in.String())
+			}
+		case "e":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ExecutedQuantity = string(in.String())
+			}
+		case "ec":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ExecutedCost = string(in.String())
+			}
+		case "f":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Fee = string(in.String())
+			}
+		case "tif":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TimeInForce = TimeInForceType(in.String())
+			}
+		case "oty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Type = OrderType(in.String())
+			}
+		case "fi":
+			if in.IsNull() {
+				in.Skip()
+				out.Fills = nil
+			} else {
+				in.Delim('[')
+				if out.Fills == nil {
+					if !in.IsDelim(']') {
+						out.Fills = make([]WsOrderFill, 0, 0)
+					} else {
+						out.Fills = []WsOrderFill{}
+					}
+				} else {
+					out.Fills = (out.Fills)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v4 WsOrderFill
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v4).UnmarshalEasyJSON(in)
+					}
+					out.Fills = append(out.Fills, v4)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceOptions3(out *jwriter.Writer, in WsOrderTradeUpdate) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"T\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.CreateTime))
+	}
+	{
+		const prefix string = ",\"t\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.UpdateTime))
+	}
+	{
+		const prefix string = ",\"s\":"
+		out.RawString(prefix)
+		out.String(string(in.Symbol))
+	}
+	{
+		const prefix string = ",\"c\":"
+		out.RawString(prefix)
+		out.String(string(in.ClientOrderID))
+	}
+	{
+		const prefix string = ",\"oid\":"
+		out.RawString(prefix)
+		out.String(string(in.OrderID))
+	}
+	{
+		const prefix string = ",\"p\":"
+		out.RawString(prefix)
+		out.String(string(in.Price))
+	}
+	{
+		const prefix string = ",\"q\":"
+		out.RawString(prefix)
+		out.String(string(in.Quantity))
+	}
+	{
+		const prefix string = ",\"r\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.ReduceOnly))
+	}
+	{
+		const prefix string = ",\"po\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.PostOnly))
+	}
+	{
+		const prefix string = ",\"S\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	{
+		const prefix string = ",\"e\":"
+		out.RawString(prefix)
+		out.String(string(in.ExecutedQuantity))
+	}
+	{
+		const prefix string = ",\"ec\":"
+		out.RawString(prefix)
+		out.String(string(in.ExecutedCost))
+	}
+	{
+		const prefix string = ",\"f\":"
+		out.RawString(prefix)
+		out.String(string(in.Fee))
+	}
+	{
+		const prefix string = ",\"tif\":"
+		out.RawString(prefix)
+		out.String(string(in.TimeInForce))
+	}
+	{
+		const prefix string = ",\"oty\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"fi\":"
+		out.RawString(prefix)
+		if in.Fills == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v5, v6 := range in.Fills {
+				if v5 > 0 {
+					out.RawByte(',')
+				}
+				(v6).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v WsOrderTradeUpdate) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceOptions3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v WsOrderTradeUpdate) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceOptions3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *WsOrderTradeUpdate) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceOptions3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *WsOrderTradeUpdate) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceOptions3(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceOptions4(in *jlexer.Lexer, out *WsOrderFill) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "t":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TradeID = string(in.String())
+			}
+		case "p":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Price = string(in.String())
+			}
+		case "q":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Quantity = string(in.String())
+			}
+		case "T":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Time = int64(in.Int64())
+			}
+		case "m":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Maker = string(in.String())
+			}
+		case "f":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Fee = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceOptions4(out *jwriter.Writer, in WsOrderFill) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"t\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.TradeID))
+	}
+	{
+		const prefix string = ",\"p\":"
+		out.RawString(prefix)
+		out.String(string(in.Price))
+	}
+	{
+		const prefix string = ",\"q\":"
+		out.RawString(prefix)
+		out.String(string(in.Quantity))
+	}
+	{
+		const prefix string = ",\"T\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Time))
+	}
+	{
+		const prefix string = ",\"m\":"
+		out.RawString(prefix)
+		out.String(string(in.Maker))
+	}
+	{
+		const prefix string = ",\"f\":"
+		out.RawString(prefix)
+		out.String(string(in.Fee))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v WsOrderFill) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceOptions4(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v WsOrderFill) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceOptions4(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *WsOrderFill) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceOptions4(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *WsOrderFill) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceOptions4(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceOptions5(in *jlexer.Lexer, out *WsListenKeyExpiredEvent) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "e":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Event = UserDataEventType(in.String())
+			}
+		case "E":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Time = int64(in.Int64())
+			}
+		case "listenKey":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ListenKey = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceOptions5(out *jwriter.Writer, in WsListenKeyExpiredEvent) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"e\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Event))
+	}
+	{
+		const prefix string = ",\"E\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Time))
+	}
+	{
+		const prefix string = ",\"listenKey\":"
+		out.RawString(prefix)
+		out.String(string(in.ListenKey))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v WsListenKeyExpiredEvent) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceOptions5(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v WsListenKeyExpiredEvent) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceOptions5(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *WsListenKeyExpiredEvent) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceOptions5(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *WsListenKeyExpiredEvent) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceOptions5(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceOptions6(in *jlexer.Lexer, out *WsGreeks) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "ui":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Underlying = string(in.String())
+			}
+		case "d":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Delta = float64(in.Float64())
+			}
+		case "t":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Theta = float64(in.Float64())
+			}
+		case "g":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Gamma = float64(in.Float64())
+			}
+		case "v":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Vega = float64(in.Float64())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceOptions6(out *jwriter.Writer, in WsGreeks) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"ui\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Underlying))
+	}
+	{
+		const prefix string = ",\"d\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Delta))
+	}
+	{
+		const prefix string = ",\"t\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Theta))
+	}
+	{
+		const prefix string = ",\"g\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Gamma))
+	}
+	{
+		const prefix string = ",\"v\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Vega))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v WsGreeks) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceOptions6(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v WsGreeks) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceOptions6(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *WsGreeks) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceOptions6(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *WsGreeks) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceOptions6(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceOptions7(in *jlexer.Lexer, out *WsBalance) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "a":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Asset = string(in.String())
+			}
+		case "b":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Balance = string(in.String())
+			}
+		case "m":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.MarginBalance = string(in.String())
+			}
+		case "u":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.UnrealizedProfit = string(in.String())
+			}
+		case "M":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.MaintenanceMargin = string(in.String())
+			}
+		case "i":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.InitialMargin = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceOptions7(out *jwriter.Writer, in WsBalance) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"a\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Asset))
+	}
+	{
+		const prefix string = ",\"b\":"
+		out.RawString(prefix)
+		out.String(string(in.Balance))
+	}
+	{
+		const prefix string = ",\"m\":"
+		out.RawString(prefix)
+		out.String(string(in.MarginBalance))
+	}
+	{
+		const prefix string = ",\"u\":"
+		out.RawString(prefix)
+		out.String(string(in.UnrealizedProfit))
+	}
+	{
+		const prefix string = ",\"M\":"
+		out.RawString(prefix)
+		out.String(string(in.MaintenanceMargin))
+	}
+	{
+		const prefix string = ",\"i\":"
+		out.RawString(prefix)
+		out.String(string(in.InitialMargin))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v WsBalance) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceOptions7(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v WsBalance) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceOptions7(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *WsBalance) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceOptions7(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *WsBalance) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceOptions7(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceOptions8(in *jlexer.Lexer, out *WsAccountUpdateEvent) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "e":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Event = UserDataEventType(in.String())
+			}
+		case "E":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Time = int64(in.Int64())
+			}
+		case "B":
+			if in.IsNull() {
+				in.Skip()
+				out.Balances = nil
+			} else {
+				in.Delim('[')
+				if out.Balances == nil {
+					if !in.IsDelim(']') {
+						out.Balances = make([]WsBalance, 0, 0)
+					} else {
+						out.Balances = []WsBalance{}
+					}
+				} else {
+					out.Balances = (out.Balances)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v7 WsBalance
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v7).UnmarshalEasyJSON(in)
+					}
+					out.Balances = append(out.Balances, v7)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "G":
+			if in.IsNull() {
+				in.Skip()
+				out.Greeks = nil
+			} else {
+				in.Delim('[')
+				if out.Greeks == nil {
+					if !in.IsDelim(']') {
+						out.Greeks = make([]WsGreeks, 0, 1)
+					} else {
+						out.Greeks = []WsGreeks{}
+					}
+				} else {
+					out.Greeks = (out.Greeks)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v8 WsGreeks
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v8).UnmarshalEasyJSON(in)
+					}
+					out.Greeks = append(out.Greeks, v8)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "P":
+			if in.IsNull() {
+				in.Skip()
+				out.Positions = nil
+			} else {
+				in.Delim('[')
+				if out.Positions == nil {
+					if !in.IsDelim(']') {
+						out.Positions = make([]WsPosition, 0, 1)
+					} else {
+						out.Positions = []WsPosition{}
+					}
+				} else {
+					out.Positions = (out.Positions)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v9 WsPosition
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v9).UnmarshalEasyJSON(in)
+					}
+					out.Positions = append(out.Positions, v9)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "uid":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.UID = int64(in.Int64())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceOptions8(out *jwriter.Writer, in WsAccountUpdateEvent) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"e\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Event))
+	}
+	{
+		const prefix string = ",\"E\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Time))
+	}
+	{
+		const prefix string = ",\"B\":"
+		out.RawString(prefix)
+		if in.Balances == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v10, v11 := range in.Balances {
+				if v10 > 0 {
+					out.RawByte(',')
+				}
+				(v11).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"G\":"
+		out.RawString(prefix)
+		if in.Greeks == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v12, v13 := range in.Greeks {
+				if v12 > 0 {
+					out.RawByte(',')
+				}
+				(v13).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"P\":"
+		out.RawString(prefix)
+		if in.Positions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v14, v15 := range in.Positions {
+				if v14 > 0 {
+					out.RawByte(',')
+				}
+				(v15).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"uid\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.UID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v WsAccountUpdateEvent) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceOptions8(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v WsAccountUpdateEvent) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceOptions8(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *WsAccountUpdateEvent) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceOptions8(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *WsAccountUpdateEvent) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceOptions8(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceOptions9(in *jlexer.Lexer, out *Symbol) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "symbol":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Symbol = string(in.String())
+			}
+		case "side":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Side = OptionSideType(in.String())
+			}
+		case "strikePrice":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.StrikePrice = string(in.String())
+			}
+		case "underlying":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Underlying = string(in.String())
+			}
+		case "unit":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Unit = int64(in.Int64())
+			}
+		case "expiryDate":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ExpiryDate = int64(in.Int64())
+			}
+		case "status":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Status = string(in.String())
+			}
+		case "makerFeeRate":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.MakerFeeRate = string(in.String())
+			}
+		case "takerFeeRate":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TakerFeeRate = string(in.String())
+			}
+		case "minQty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.MinQuantity = string(in.String())
+			}
+		case "maxQty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.MaxQuantity = string(in.String())
+			}
+		case "initialMargin":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.InitialMargin = string(in.String())
+			}
+		case "maintenanceMargin":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.MaintenanceMargin = string(in.String())
+			}
+		case "minInitialMargin":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.MinInitialMargin = string(in.String())
+			}
+		case "minMaintenanceMargin":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.MinMaintenanceMargin = string(in.String())
+			}
+		case "priceScale":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.PriceScale = int(in.Int())
+			}
+		case "quantityScale":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.QuantityScale = int(in.Int())
+			}
+		case "quoteAsset":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.QuoteAsset = string(in.String())
+			}
+		case "filters":
+			if in.IsNull() {
+				in.Skip()
+				out.Filters = nil
+			} else {
+				in.Delim('[')
+				if out.Filters == nil {
+					if !in.IsDelim(']') {
+						out.Filters = make([]map[string]interface{}, 0, 8)
+					} else {
+						out.Filters = []map[string]interface{}{}
+					}
+				} else {
+					out.Filters = (out.Filters)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v16 map[string]interface{}
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						in.Delim('{')
+						v16 = make(map[string]interface{})
+						for !in.IsDelim('}') {
+							key := string(in.String())
+							in.WantColon()
+							var v17 interface{}
+							if m, ok := v17.(easyjson.Unmarshaler); ok {
+								m.UnmarshalEasyJSON(in)
+							} else if m, ok := v17.(json.Unmarshaler); ok {
+								_ = m.UnmarshalJSON(in.Raw())
+							} else {
+								v17 = in.Interface()
+							}
+							(v16)[key] = v17
+							in.WantComma()
+						}
+						in.Delim('}')
+					}
+					out.Filters = append(out.Filters, v16)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceOptions9(out *jwriter.Writer, in Symbol) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"symbol\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Symbol))
+	}
+	{
+		const prefix string = ",\"side\":"
+		out.RawString(prefix)
+		out.String(string(in.Side))
+	}
+	{
+		const prefix string = ",\"strikePrice\":"
+		out.RawString(prefix)
+		out.String(string(in.StrikePrice))
+	}
+	{
+		const prefix string = ",\"underlying\":"
+		out.RawString(prefix)
+		out.String(string(in.Underlying))
+	}
+	{
+		const prefix string = ",\"unit\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Unit))
+	}
+	{
+		const prefix string = ",\"expiryDate\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.ExpiryDate))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	{
+		const prefix string = ",\"makerFeeRate\":"
+		out.RawString(prefix)
+		out.String(string(in.MakerFeeRate))
+	}
+	{
+		const prefix string = ",\"takerFeeRate\":"
+		out.RawString(prefix)
+		out.String(string(in.TakerFeeRate))
+	}
+	{
+		const prefix string = ",\"minQty\":"
+		out.RawString(prefix)
+		out.String(string(in.MinQuantity))
+	}
+	{
+		const prefix string = ",\"maxQty\":"
+		out.RawString(prefix)
+		out.String(string(in.MaxQuantity))
+	}
+	{
+		const prefix string = ",\"initialMargin\":"
+		out.RawString(prefix)
+		out.String(string(in.InitialMargin))
+	}
+	{
+		const prefix string = ",\"maintenanceMargin\":"
+		out.RawString(prefix)
+		out.String(string(in.MaintenanceMargin))
+	}
+	{
+		const prefix string = ",\"minInitialMargin\":"
+		out.RawString(prefix)
+		out.String(string(in.MinInitialMargin))
+	}
+	{
+		const prefix string = ",\"minMaintenanceMargin\":"
+		out.RawString(prefix)
+		out.String(string(in.MinMaintenanceMargin))
+	}
+	{
+		const prefix string = ",\"priceScale\":"
+		out.RawString(prefix)
+		out.Int(int(in.PriceScale))
+	}
+	{
+		const prefix string = ",\"quantityScale\":"
+		out.RawString(prefix)
+		out.Int(int(in.QuantityScale))
+	}
+	{
+		const prefix string = ",\"quoteAsset\":"
+		out.RawString(prefix)
+		out.String(string(in.QuoteAsset))
+	}
+	{
+		const prefix string = ",\"filters\":"
+		out.RawString(prefix)
+		if in.Filters == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v18, v19 := range in.Filters {
+				if v18 > 0 {
+					out.RawByte(',')
+				}
+				if v19 == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+					out.RawString(`null`)
+				} else {
+					out.RawByte('{')
+					v20First := true
+					for v20Name, v20Value := range v19 {
+						if v20First {
+							v20First = false
+						} else {
+							out.RawByte(',')
+						}
+						out.String(string(v20Name))
+						out.RawByte(':')
+						if m, ok := v20Value.(easyjson.Marshaler); ok {
+							m.MarshalEasyJSON(out)
+						} else if m, ok := v20Value.(json.Marshaler); ok {
+							out.Raw(m.MarshalJSON())
+						} else {
+							out.Raw(json.Marshal(v20Value))
+						}
+					}
+					out.RawByte('}')
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Symbol) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceOptions9(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Symbol) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceOptions9(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Symbol) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceOptions9(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Symbol) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceOptions9(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceOptions10(in *jlexer.Lexer, out *RateLimit) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "rateLimitType":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.RateLimitType = string(in.String())
+			}
+		case "interval":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Interval = string(in.String())
+			}
+		case "intervalNum":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.IntervalNum = int64(in.Int64())
+			}
+		case "limit":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Limit = int64(in.Int64())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceOptions10(out *jwriter.Writer, in RateLimit) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"rateLimitType\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.RateLimitType))
+	}
+	{
+		const prefix string = ",\"interval\":"
+		out.RawString(prefix)
+		out.String(string(in.Interval))
+	}
+	{
+		const prefix string = ",\"intervalNum\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.IntervalNum))
+	}
+	{
+		const prefix string = ",\"limit\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Limit))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RateLimit) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceOptions10(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RateLimit) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceOptions10(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RateLimit) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceOptions10(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RateLimit) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceOptions10(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceOptions11(in *jlexer.Lexer, out *PriceFilter) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "maxPrice":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.MaxPrice = string(in.String())
+			}
+		case "minPrice":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.MinPrice = string(in.String())
+			}
+		case "tickSize":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TickSize = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceOptions11(out *jwriter.Writer, in PriceFilter) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"maxPrice\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.MaxPrice))
+	}
+	{
+		const prefix string = ",\"minPrice\":"
+		out.RawString(prefix)
+		out.String(string(in.MinPrice))
+	}
+	{
+		const prefix string = ",\"tickSize\":"
+		out.RawString(prefix)
+		out.String(string(in.TickSize))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PriceFilter) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceOptions11(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PriceFilter) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceOptions11(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PriceFilter) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceOptions11(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PriceFilter) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceOptions11(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceOptions12(in *jlexer.Lexer, out *Position) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "symbol":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Symbol = string(in.String())
+			}
+		case "side":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Side = PositionSideType(in.String())
+			}
+		case "quantity":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Quantity = string(in.String())
+			}
+		case "reducibleQty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ReducibleQty = string(in.String())
+			}
+		case "entryPrice":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.EntryPrice = string(in.String())
+			}
+		case "markValue":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.MarkValue = string(in.String())
+			}
+		case "markPrice":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.MarkPrice = string(in.String())
+			}
+		case "ror":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Ror = string(in.String())
+			}
+		case "unrealizedPNL":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.UnrealizedPNL = string(in.String())
+			}
+		case "strikePrice":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.StrikePrice = string(in.String())
+			}
+		case "positionCost":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.PositionCost = string(in.String())
+			}
+		case "expiryDate":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ExpiryDate = int64(in.Int64())
+			}
+		case "priceScale":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.PriceScale = int(in.Int())
+			}
+		case "quantityScale":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.QuantityScale = int(in.Int())
+			}
+		case "optionSide":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.OptionSide = OptionSideType(in.String())
+			}
+		case "quoteAsset":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.QuoteAsset = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceOptions12(out *jwriter.Writer, in Position) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"symbol\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Symbol))
+	}
+	{
+		const prefix string = ",\"side\":"
+		out.RawString(prefix)
+		out.String(string(in.Side))
+	}
+	{
+		const prefix string = ",\"quantity\":"
+		out.RawString(prefix)
+		out.String(string(in.Quantity))
+	}
+	{
+		const prefix string = ",\"reducibleQty\":"
+		out.RawString(prefix)
+		out.String(string(in.ReducibleQty))
+	}
+	{
+		const prefix string = ",\"entryPrice\":"
+		out.RawString(prefix)
+		out.String(string(in.EntryPrice))
+	}
+	{
+		const prefix string = ",\"markValue\":"
+		out.RawString(prefix)
+		out.String(string(in.MarkValue))
+	}
+	{
+		const prefix string = ",\"markPrice\":"
+		out.RawString(prefix)
+		out.String(string(in.MarkPrice))
+	}
+	{
+		const prefix string = ",\"ror\":"
+		out.RawString(prefix)
+		out.String(string(in.Ror))
+	}
+	{
+		const prefix string = ",\"unrealizedPNL\":"
+		out.RawString(prefix)
+		out.String(string(in.UnrealizedPNL))
+	}
+	{
+		const prefix string = ",\"strikePrice\":"
+		out.RawString(prefix)
+		out.String(string(in.StrikePrice))
+	}
+	{
+		const prefix string = ",\"positionCost\":"
+		out.RawString(prefix)
+		out.String(string(in.PositionCost))
+	}
+	{
+		const prefix string = ",\"expiryDate\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.ExpiryDate))
+	}
+	{
+		const prefix string = ",\"priceScale\":"
+		out.RawString(prefix)
+		out.Int(int(in.PriceScale))
+	}
+	{
+		const prefix string = ",\"quantityScale\":"
+		out.RawString(prefix)
+		out.Int(int(in.QuantityScale))
+	}
+	{
+		const prefix string = ",\"optionSide\":"
+		out.RawString(prefix)
+		out.String(string(in.OptionSide))
+	}
+	{
+		const prefix string = ",\"quoteAsset\":"
+		out.RawString(prefix)
+		out.String(string(in.QuoteAsset))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Position) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceOptions12(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Position) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceOptions12(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Position) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceOptions12(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Position) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceOptions12(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceOptions13(in *jlexer.Lexer, out *Order) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "orderId":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.OrderID = int64(in.Int64())
+			}
+		case "symbol":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Symbol = string(in.String())
+			}
+		case "price":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Price = string(in.String())
+			}
+		case "quantity":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Quantity = string(in.String())
+			}
+		case "executedQty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ExecutedQuantity = string(in.String())
+			}
+		case "fee":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Fee = string(in.String())
+			}
+		case "side":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Side = SideType(in.String())
+			}
+		case "type":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Type = OrderType(in.String())
+			}
+		case "timeInForce":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TimeInForce = TimeInForceType(in.String())
+			}
+		case "reduceOnly":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ReduceOnly = bool(in.Bool())
+			}
+		case "postOnly":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.PostOnly = bool(in.Bool())
+			}
+		case "createTime":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.CreateTime = int64(in.Int64())
+			}
+		case "updateTime":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.UpdateTime = int64(in.Int64())
+			}
+		case "status":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Status = OrderStatusType(in.String())
+			}
+		case "avgPrice":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.AvgPrice = string(in.String())
+			}
+		case "clientOrderId":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ClientOrderID = string(in.String())
+			}
+		case "priceScale":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.PriceScale = int(in.Int())
+			}
+		case "quantityScale":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.QuantityScale = int(in.Int())
+			}
+		case "optionSide":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.OptionSide = OptionSideType(in.String())
+			}
+		case "quoteAsset":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.QuoteAsset = string(in.String())
+			}
+		case "mmp":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Mmp = bool(in.Bool())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceOptions13(out *jwriter.Writer, in Order) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"orderId\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.OrderID))
+	}
+	{
+		const prefix string = ",\"symbol\":"
+		out.RawString(prefix)
+		out.String(string(in.Symbol))
+	}
+	{
+		const prefix string = ",\"price\":"
+		out.RawString(prefix)
+		out.String(string(in.Price))
+	}
+	{
+		const prefix string = ",\"quantity\":"
+		out.RawString(prefix)
+		out.String(string(in.Quantity))
+	}
+	{
+		const prefix string = ",\"executedQty\":"
+		out.RawString(prefix)
+		out.String(string(in.ExecutedQuantity))
+	}
+	{
+		const prefix string = ",\"fee\":"
+		out.RawString(prefix)
+		out.String(string(in.Fee))
+	}
+	{
+		const prefix string = ",\"side\":"
+		out.RawString(prefix)
+		out.String(string(in.Side))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"timeInForce\":"
+		out.RawString(prefix)
+		out.String(string(in.TimeInForce))
+	}
+	{
+		const prefix string = ",\"reduceOnly\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.ReduceOnly))
+	}
+	{
+		const prefix string = ",\"postOnly\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.PostOnly))
+	}
+	{
+		const prefix string = ",\"createTime\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.CreateTime))
+	}
+	{
+		const prefix string = ",\"updateTime\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.UpdateTime))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	{
+		const prefix string = ",\"avgPrice\":"
+		out.RawString(prefix)
+		out.String(string(in.AvgPrice))
+	}
+	{
+		const prefix string = ",\"clientOrderId\":"
+		out.RawString(prefix)
+		out.String(string(in.ClientOrderID))
+	}
+	{
+		const prefix string = ",\"priceScale\":"
+		out.RawString(prefix)
+		out.Int(int(in.PriceScale))
+	}
+	{
+		const prefix string = ",\"quantityScale\":"
+		out.RawString(prefix)
+		out.Int(int(in.QuantityScale))
+	}
+	{
+		const prefix string = ",\"optionSide\":"
+		out.RawString(prefix)
+		out.String(string(in.OptionSide))
+	}
+	{
+		const prefix string = ",\"quoteAsset\":"
+		out.RawString(prefix)
+		out.String(string(in.QuoteAsset))
+	}
+	{
+		const prefix string = ",\"mmp\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Mmp))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Order) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceOptions13(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Order) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceOptions13(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Order) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceOptions13(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Order) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceOptions13(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceOptions14(in *jlexer.Lexer, out *OptionContract) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "baseAsset":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.BaseAsset = string(in.String())
+			}
+		case "quoteAsset":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.QuoteAsset = string(in.String())
+			}
+		case "underlying":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Underlying = string(in.String())
+			}
+		case "settleAsset":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.SettleAsset = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceOptions14(out *jwriter.Writer, in OptionContract) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"baseAsset\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.BaseAsset))
+	}
+	{
+		const prefix string = ",\"quoteAsset\":"
+		out.RawString(prefix)
+		out.String(string(in.QuoteAsset))
+	}
+	{
+		const prefix string = ",\"underlying\":"
+		out.RawString(prefix)
+		out.String(string(in.Underlying))
+	}
+	{
+		const prefix string = ",\"settleAsset\":"
+		out.RawString(prefix)
+		out.String(string(in.SettleAsset))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v OptionContract) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceOptions14(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v OptionContract) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceOptions14(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *OptionContract) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceOptions14(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *OptionContract) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceOptions14(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceOptions15(in *jlexer.Lexer, out *OptionAsset) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "name":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Name = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceOptions15(out *jwriter.Writer, in OptionAsset) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v OptionAsset) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceOptions15(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v OptionAsset) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceOptions15(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *OptionAsset) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceOptions15(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *OptionAsset) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceOptions15(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceOptions16(in *jlexer.Lexer, out *MarkPrice) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "symbol":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Symbol = string(in.String())
+			}
+		case "markPrice":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.MarkPrice = string(in.String())
+			}
+		case "bidIV":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.BidIV = string(in.String())
+			}
+		case "askIV":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.AskIV = string(in.String())
+			}
+		case "markIV":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.MarkIV = string(in.String())
+			}
+		case "delta":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Delta = string(in.String())
+			}
+		case "theta":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Theta = string(in.String())
+			}
+		case "gamma":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Gamma = string(in.String())
+			}
+		case "vega":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Vega = string(in.String())
+			}
+		case "highPriceLimit":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.HighPriceLimit = string(in.String())
+			}
+		case "lowPriceLimit":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.LowPriceLimit = string(in.String())
+			}
+		case "riskFreeInterest":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.RiskFreeInterest = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceOptions16(out *jwriter.Writer, in MarkPrice) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"symbol\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Symbol))
+	}
+	{
+		const prefix string = ",\"markPrice\":"
+		out.RawString(prefix)
+		out.String(string(in.MarkPrice))
+	}
+	{
+		const prefix string = ",\"bidIV\":"
+		out.RawString(prefix)
+		out.String(string(in.BidIV))
+	}
+	{
+		const prefix string = ",\"askIV\":"
+		out.RawString(prefix)
+		out.String(string(in.AskIV))
+	}
+	{
+		const prefix string = ",\"markIV\":"
+		out.RawString(prefix)
+		out.String(string(in.MarkIV))
+	}
+	{
+		const prefix string = ",\"delta\":"
+		out.RawString(prefix)
+		out.String(string(in.Delta))
+	}
+	{
+		const prefix string = ",\"theta\":"
+		out.RawString(prefix)
+		out.String(string(in.Theta))
+	}
+	{
+		const prefix string = ",\"gamma\":"
+		out.RawString(prefix)
+		out.String(string(in.Gamma))
+	}
+	{
+		const prefix string = ",\"vega\":"
+		out.RawString(prefix)
+		out.String(string(in.Vega))
+	}
+	{
+		const prefix string = ",\"highPriceLimit\":"
+		out.RawString(prefix)
+		out.String(string(in.HighPriceLimit))
+	}
+	{
+		const prefix string = ",\"lowPriceLimit\":"
+		out.RawString(prefix)
+		out.String(string(in.LowPriceLimit))
+	}
+	{
+		const prefix string = ",\"riskFreeInterest\":"
+		out.RawString(prefix)
+		out.String(string(in.RiskFreeInterest))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MarkPrice) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceOptions16(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MarkPrice) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceOptions16(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MarkPrice) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceOptions16(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MarkPrice) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceOptions16(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceOptions17(in *jlexer.Lexer, out *LotSizeFilter) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "maxQty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.MaxQuantity = string(in.String())
+			}
+		case "minQty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.MinQuantity = string(in.String())
+			}
+		case "stepSize":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.StepSize = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceOptions17(out *jwriter.Writer, in LotSizeFilter) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"maxQty\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.MaxQuantity))
+	}
+	{
+		const prefix string = ",\"minQty\":"
+		out.RawString(prefix)
+		out.String(string(in.MinQuantity))
+	}
+	{
+		const prefix string = ",\"stepSize\":"
+		out.RawString(prefix)
+		out.String(string(in.StepSize))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v LotSizeFilter) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceOptions17(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v LotSizeFilter) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceOptions17(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *LotSizeFilter) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceOptions17(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *LotSizeFilter) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceOptions17(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceOptions18(in *jlexer.Lexer, out *Kline) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "openTime":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.OpenTime = int64(in.Int64())
+			}
+		case "open":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Open = string(in.String())
+			}
+		case "high":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.High = string(in.String())
+			}
+		case "low":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Low = string(in.String())
+			}
+		case "close":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Close = string(in.String())
+			}
+		case "volume":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Volume = string(in.String())
+			}
+		case "amount":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Amount = string(in.String())
+			}
+		case "interval":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Interval = string(in.String())
+			}
+		case "tradeCount":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TradeCount = int64(in.Int64())
+			}
+		case "takerVolume":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TakerVolume = string(in.String())
+			}
+		case "takerAmount":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TakerAmount = string(in.String())
+			}
+		case "closeTime":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.CloseTime = int64(in.Int64())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceOptions18(out *jwriter.Writer, in Kline) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"openTime\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.OpenTime))
+	}
+	{
+		const prefix string = ",\"open\":"
+		out.RawString(prefix)
+		out.String(string(in.Open))
+	}
+	{
+		const prefix string = ",\"high\":"
+		out.RawString(prefix)
+		out.String(string(in.High))
+	}
+	{
+		const prefix string = ",\"low\":"
+		out.RawString(prefix)
+		out.String(string(in.Low))
+	}
+	{
+		const prefix string = ",\"close\":"
+		out.RawString(prefix)
+		out.String(string(in.Close))
+	}
+	{
+		const prefix string = ",\"volume\":"
+		out.RawString(prefix)
+		out.String(string(in.Volume))
+	}
+	{
+		const prefix string = ",\"amount\":"
+		out.RawString(prefix)
+		out.String(string(in.Amount))
+	}
+	{
+		const prefix string = ",\"interval\":"
+		out.RawString(prefix)
+		out.String(string(in.Interval))
+	}
+	{
+		const prefix string = ",\"tradeCount\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.TradeCount))
+	}
+	{
+		const prefix string = ",\"takerVolume\":"
+		out.RawString(prefix)
+		out.String(string(in.TakerVolume))
+	}
+	{
+		const prefix string = ",\"takerAmount\":"
+		out.RawString(prefix)
+		out.String(string(in.TakerAmount))
+	}
+	{
+		const prefix string = ",\"closeTime\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.CloseTime))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Kline) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceOptions18(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Kline) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceOptions18(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Kline) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceOptions18(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Kline) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceOptions18(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceOptions19(in *jlexer.Lexer, out *ExerciseHistory) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "symbol":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Symbol = string(in.String())
+			}
+		case "strikePrice":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.StrikePrice = string(in.String())
+			}
+		case "realStrikePrice":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.RealStrikePrice = string(in.String())
+			}
+		case "expiryDate":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ExpiryDate = int64(in.Int64())
+			}
+		case "strikeResult":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.StrikeResult = StrikeResultType(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceOptions19(out *jwriter.Writer, in ExerciseHistory) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"symbol\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Symbol))
+	}
+	{
+		const prefix string = ",\"strikePrice\":"
+		out.RawString(prefix)
+		out.String(string(in.StrikePrice))
+	}
+	{
+		const prefix string = ",\"realStrikePrice\":"
+		out.RawString(prefix)
+		out.String(string(in.RealStrikePrice))
+	}
+	{
+		const prefix string = ",\"expiryDate\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.ExpiryDate))
+	}
+	{
+		const prefix string = ",\"strikeResult\":"
+		out.RawString(prefix)
+		out.String(string(in.StrikeResult))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ExerciseHistory) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceOptions19(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ExerciseHistory) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceOptions19(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ExerciseHistory) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceOptions19(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ExerciseHistory) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceOptions19(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceOptions20(in *jlexer.Lexer, out *ExchangeInfo) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "timezone":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Timezone = string(in.String())
+			}
+		case "serverTime":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ServerTime = int64(in.Int64())
+			}
+		case "optionContracts":
+			if in.IsNull() {
+				in.Skip()
+				out.OptionContracts = nil
+			} else {
+				in.Delim('[')
+				if out.OptionContracts == nil {
+					if !in.IsDelim(']') {
+						out.OptionContracts = make([]OptionContract, 0, 1)
+					} else {
+						out.OptionContracts = []OptionContract{}
+					}
+				} else {
+					out.OptionContracts = (out.OptionContracts)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v21 OptionContract
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v21).UnmarshalEasyJSON(in)
+					}
+					out.OptionContracts = append(out.OptionContracts, v21)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "optionAssets":
+			if in.IsNull() {
+				in.Skip()
+				out.OptionAssets = nil
+			} else {
+				in.Delim('[')
+				if out.OptionAssets == nil {
+					if !in.IsDelim(']') {
+						out.OptionAssets = make([]OptionAsset, 0, 4)
+					} else {
+						out.OptionAssets = []OptionAsset{}
+					}
+				} else {
+					out.OptionAssets = (out.OptionAssets)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v22 OptionAsset
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v22).UnmarshalEasyJSON(in)
+					}
+					out.OptionAssets = append(out.OptionAssets, v22)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "optionSymbols":
+			if in.IsNull() {
+				in.Skip()
+				out.OptionSymbols = nil
+			} else {
+				in.Delim('[')
+				if out.OptionSymbols == nil {
+					if !in.IsDelim(']') {
+						out.OptionSymbols = make([]Symbol, 0, 0)
+					} else {
+						out.OptionSymbols = []Symbol{}
+					}
+				} else {
+					out.OptionSymbols = (out.OptionSymbols)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v23 Symbol
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v23).UnmarshalEasyJSON(in)
+					}
+					out.OptionSymbols = append(out.OptionSymbols, v23)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "rateLimits":
+			if in.IsNull() {
+				in.Skip()
+				out.RateLimits = nil
+			} else {
+				in.Delim('[')
+				if out.RateLimits == nil {
+					if !in.IsDelim(']') {
+						out.RateLimits = make([]RateLimit, 0, 1)
+					} else {
+						out.RateLimits = []RateLimit{}
+					}
+				} else {
+					out.RateLimits = (out.RateLimits)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v24 RateLimit
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v24).UnmarshalEasyJSON(in)
+					}
+					out.RateLimits = append(out.RateLimits, v24)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceOptions20(out *jwriter.Writer, in ExchangeInfo) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"timezone\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Timezone))
+	}
+	{
+		const prefix string = ",\"serverTime\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.ServerTime))
+	}
+	{
+		const prefix string = ",\"optionContracts\":"
+		out.RawString(prefix)
+		if in.OptionContracts == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v25, v26 := range in.OptionContracts {
+				if v25 > 0 {
+					out.RawByte(',')
+				}
+				(v26).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"optionAssets\":"
+		out.RawString(prefix)
+		if in.OptionAssets == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v27, v28 := range in.OptionAssets {
+				if v27 > 0 {
+					out.RawByte(',')
+				}
+				(v28).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"optionSymbols\":"
+		out.RawString(prefix)
+		if in.OptionSymbols == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v29, v30 := range in.OptionSymbols {
+				if v29 > 0 {
+					out.RawByte(',')
+				}
+				(v30).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"rateLimits\":"
+		out.RawString(prefix)
+		if in.RateLimits == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v31, v32 := range in.RateLimits {
+				if v31 > 0 {
+					out.RawByte(',')
+				}
+				(v32).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ExchangeInfo) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceOptions20(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ExchangeInfo) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceOptions20(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ExchangeInfo) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceOptions20(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ExchangeInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceOptions20(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceOptions21(in *jlexer.Lexer, out *DepthResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "T":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TradeTime = int64(in.Int64())
+			}
+		case "u":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.UpdateID = int64(in.Int64())
+			}
+		case "bids":
+			if in.IsNull() {
+				in.Skip()
+				out.Bids = nil
+			} else {
+				in.Delim('[')
+				if out.Bids == nil {
+					if !in.IsDelim(']') {
+						out.Bids = make([]common.PriceLevel, 0, 2)
+					} else {
+						out.Bids = []common.PriceLevel{}
+					}
+				} else {
+					out.Bids = (out.Bids)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v33 common.PriceLevel
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v33).UnmarshalEasyJSON(in)
+					}
+					out.Bids = append(out.Bids, v33)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "asks":
+			if in.IsNull() {
+				in.Skip()
+				out.Asks = nil
+			} else {
+				in.Delim('[')
+				if out.Asks == nil {
+					if !in.IsDelim(']') {
+						out.Asks = make([]common.PriceLevel, 0, 2)
+					} else {
+						out.Asks = []common.PriceLevel{}
+					}
+				} else {
+					out.Asks = (out.Asks)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v34 common.PriceLevel
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v34).UnmarshalEasyJSON(in)
+					}
+					out.Asks = append(out.Asks, v34)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceOptions21(out *jwriter.Writer, in DepthResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"T\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.TradeTime))
+	}
+	{
+		const prefix string = ",\"u\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.UpdateID))
+	}
+	{
+		const prefix string = ",\"bids\":"
+		out.RawString(prefix)
+		if in.Bids == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v35, v36 := range in.Bids {
+				if v35 > 0 {
+					out.RawByte(',')
+				}
+				(v36).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"asks\":"
+		out.RawString(prefix)
+		if in.Asks == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v37, v38 := range in.Asks {
+				if v37 > 0 {
+					out.RawByte(',')
+				}
+				(v38).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v DepthResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceOptions21(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v DepthResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceOptions21(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *DepthResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceOptions21(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *DepthResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceOptions21(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceOptions22(in *jlexer.Lexer, out *CreateBatchOrdersResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "Orders":
+			if in.IsNull() {
+				in.Skip()
+				out.Orders = nil
+			} else {
+				in.Delim('[')
+				if out.Orders == nil {
+					if !in.IsDelim(']') {
+						out.Orders = make([]*Order, 0, 8)
+					} else {
+						out.Orders = []*Order{}
+					}
+				} else {
+					out.Orders = (out.Orders)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v39 *Order
+					if in.IsNull() {
+						in.Skip()
+						v39 = nil
+					} else {
+						if v39 == nil {
+							v39 = new(Order)
+						}
+						if in.IsNull() {
+							in.Skip()
+						} else {
+							(*v39).UnmarshalEasyJSON(in)
+						}
+					}
+					out.Orders = append(out.Orders, v39)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "Errors":
+			if in.IsNull() {
+				in.Skip()
+				out.Errors = nil
+			} else {
+				in.Delim('[')
+				if out.Errors == nil {
+					if !in.IsDelim(']') {
+						out.Errors = make([]*common.APIError, 0, 8)
+					} else {
+						out.Errors = []*common.APIError{}
+					}
+				} else {
+					out.Errors = (out.Errors)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v40 *common.APIError
+					if in.IsNull() {
+						in.Skip()
+						v40 = nil
+					} else {
+						if v40 == nil {
+							v40 = new(common.APIError)
+						}
+						if in.IsNull() {
+							in.Skip()
+						} else {
+							(*v40).UnmarshalEasyJSON(in)
+						}
+					}
+					out.Errors = append(out.Errors, v40)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceOptions22(out *jwriter.Writer, in CreateBatchOrdersResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Orders\":"
+		out.RawString(prefix[1:])
+		if in.Orders == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v41, v42 := range in.Orders {
+				if v41 > 0 {
+					out.RawByte(',')
+				}
+				if v42 == nil {
+					out.RawString("null")
+				} else {
+					(*v42).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"Errors\":"
+		out.RawString(prefix)
+		if in.Errors == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v43, v44 := range in.Errors {
+				if v43 > 0 {
+					out.RawByte(',')
+				}
+				if v44 == nil {
+					out.RawString("null")
+				} else {
+					(*v44).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CreateBatchOrdersResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceOptions22(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CreateBatchOrdersResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceOptions22(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CreateBatchOrdersResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceOptions22(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CreateBatchOrdersResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceOptions22(l, v)
+}
@@ -0,0 +1,484 @@
+package options
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/shopspring/decimal"
+	"github.com/ward-cap/go-binance/common"
+)
+
+// CreateOrderService create order
+type CreateOrderService struct {
+	c                *Client
+	symbol           string
+	side             SideType
+	orderType        OrderType
+	quantity         string
+	price            *string
+	timeInForce      *TimeInForceType
+	reduceOnly       *bool
+	postOnly         *bool
+	newOrderRespType *NewOrderRespType
+	clientOrderID    *string
+	isMmp            *bool
+}
+
+// Symbol set symbol
+func (s *CreateOrderService) Symbol(symbol string) *CreateOrderService {
+	s.symbol = symbol
+	return s
+}
+
+// Side set side
+func (s *CreateOrderService) Side(side SideType) *CreateOrderService {
+	s.side = side
+	return s
+}
+
+// Type set type
+func (s *CreateOrderService) Type(orderType OrderType) *CreateOrderService {
+	s.orderType = orderType
+	return s
+}
+
+// Quantity set quantity
+func (s *CreateOrderService) Quantity(quantity string) *CreateOrderService {
+	s.quantity = quantity
+	return s
+}
+
+// QuantityDecimal set quantity from a decimal
+func (s *CreateOrderService) QuantityDecimal(quantity decimal.Decimal) *CreateOrderService {
+	return s.Quantity(quantity.String())
+}
+
+// Price set price
+func (s *CreateOrderService) Price(price string) *CreateOrderService {
+	s.price = &price
+	return s
+}
+
+// PriceDecimal set price from a decimal
+func (s *CreateOrderService) PriceDecimal(price decimal.Decimal) *CreateOrderService {
+	return s.Price(price.String())
+}
+
+// TimeInForce set timeInForce
+func (s *CreateOrderService) TimeInForce(timeInForce TimeInForceType) *CreateOrderService {
+	s.timeInForce = &timeInForce
+	return s
+}
+
+// ReduceOnly set reduceOnly
+func (s *CreateOrderService) ReduceOnly(reduceOnly bool) *CreateOrderService {
+	s.reduceOnly = &reduceOnly
+	return s
+}
+
+// PostOnly set postOnly
+func (s *CreateOrderService) PostOnly(postOnly bool) *CreateOrderService {
+	s.postOnly = &postOnly
+	return s
+}
+
+// NewOrderResponseType set newOrderResponseType
+func (s *CreateOrderService) NewOrderResponseType(newOrderResponseType NewOrderRespType) *CreateOrderService {
+	s.newOrderRespType = &newOrderResponseType
+	return s
+}
+
+// ClientOrderID set clientOrderID
+func (s *CreateOrderService) ClientOrderID(clientOrderID string) *CreateOrderService {
+	s.clientOrderID = &clientOrderID
+	return s
+}
+
+// IsMmp set isMmp, marking the order as a market maker protection order
+func (s *CreateOrderService) IsMmp(isMmp bool) *CreateOrderService {
+	s.isMmp = &isMmp
+	return s
+}
+
+func (s *CreateOrderService) params() params {
+	m := params{
+		"symbol":   s.symbol,
+		"side":     s.side,
+		"type":     s.orderType,
+		"quantity": s.quantity,
+	}
+	if s.price != nil {
+		m["price"] = *s.price
+	}
+	if s.timeInForce != nil {
+		m["timeInForce"] = *s.timeInForce
+	}
+	if s.reduceOnly != nil {
+		m["reduceOnly"] = *s.reduceOnly
+	}
+	if s.postOnly != nil {
+		m["postOnly"] = *s.postOnly
+	}
+	if s.newOrderRespType != nil {
+		m["newOrderRespType"] = *s.newOrderRespType
+	}
+	if s.clientOrderID != nil {
+		m["clientOrderId"] = *s.clientOrderID
+	}
+	if s.isMmp != nil {
+		m["isMmp"] = *s.isMmp
+	}
+	return m
+}
+
+// Do send request
+func (s *CreateOrderService) Do(ctx context.Context, opts ...RequestOption) (res *Order, err error) {
+	r := &request{
+		Service:  "CreateOrderService",
+		Method:   http.MethodPost,
+		Endpoint: "/eapi/v1/order",
+		SecType:  secTypeSigned,
+	}
+	r.SetFormParams(s.params())
+	data, _, err := s.c.CallAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(Order)
+	err = jsonCodec.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// maxBatchOrders is the number of orders a batch can place
+const maxBatchOrders = 10
+
+// CreateBatchOrdersService create up to 10 orders at once
+type CreateBatchOrdersService struct {
+	c      *Client
+	orders []*CreateOrderService
+}
+
+// OrderList set the orders to place
+func (s *CreateBatchOrdersService) OrderList(orders []*CreateOrderService) *CreateBatchOrdersService {
+	s.orders = orders
+	return s
+}
+
+// Do send request. Orders which failed are returned in Errors instead of
+// failing the whole batch. Orders and Errors are aligned with the order list:
+// for each index exactly one of Orders[i] and Errors[i] is set.
+func (s *CreateBatchOrdersService) Do(ctx context.Context, opts ...RequestOption) (res *CreateBatchOrdersResponse, err error) {
+	if len(s.orders) == 0 || len(s.orders) > maxBatchOrders {
+		return nil, fmt.Errorf("between 1 and %d orders must be sent", maxBatchOrders)
+	}
+	r := &request{
+		Service:  "CreateBatchOrdersService",
+		Method:   http.MethodPost,
+		Endpoint: "/eapi/v1/batchOrders",
+		SecType:  secTypeSigned,
+	}
+	orders := make([]params, len(s.orders))
+	for i, order := range s.orders {
+		orders[i] = order.params()
+	}
+	b, err := jsonCodec.Marshal(orders)
+	if err != nil {
+		return nil, err
+	}
+	r.SetFormParam("orders", string(b))
+	data, _, err := s.c.CallAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	rawMessages := make([]json.RawMessage, 0)
+	err = jsonCodec.Unmarshal(data, &rawMessages)
+	if err != nil {
+		return nil, err
+	}
+	if len(rawMessages) != len(s.orders) {
+		return nil, fmt.Errorf("got %d results for %d orders", len(rawMessages), len(s.orders))
+	}
+	res = &CreateBatchOrdersResponse{
+		Orders: make([]*Order, len(rawMessages)),
+		Errors: make([]*common.APIError, len(rawMessages)),
+	}
+	for i, raw := range rawMessages {
+		apiErr := new(common.APIError)
+		if err := jsonCodec.Unmarshal(raw, apiErr); err == nil && apiErr.Code != 0 {
+			apiErr.Endpoint = r.Endpoint
+			apiErr.Service = r.Service
+			res.Errors[i] = apiErr
+			continue
+		}
+		o := new(Order)
+		if err := jsonCodec.Unmarshal(raw, o); err != nil {
+			return nil, err
+		}
+		res.Orders[i] = o
+	}
+	return res, nil
+}
+
+// GetOrderService get an order
+type GetOrderService struct {
+	c             *Client
+	symbol        string
+	orderID       *int64
+	clientOrderID *string
+}
+
+// Symbol set symbol
+func (s *GetOrderService) Symbol(symbol string) *GetOrderService {
+	s.symbol = symbol
+	return s
+}
+
+// OrderID set orderID
+func (s *GetOrderService) OrderID(orderID int64) *GetOrderService {
+	s.orderID = &orderID
+	return s
+}
+
+// ClientOrderID set clientOrderID
+func (s *GetOrderService) ClientOrderID(clientOrderID string) *GetOrderService {
+	s.clientOrderID = &clientOrderID
+	return s
+}
+
+// Do send request
+func (s *GetOrderService) Do(ctx context.Context, opts ...RequestOption) (res *Order, err error) {
+	r := &request{
+		Service:  "GetOrderService",
+		Method:   http.MethodGet,
+		Endpoint: "/eapi/v1/order",
+		SecType:  secTypeSigned,
+	}
+	if s.orderID == nil && s.clientOrderID == nil {
+		return nil, errors.New("either orderId or clientOrderId must be sent")
+	}
+	r.SetParam("symbol", s.symbol)
+	if s.orderID != nil {
+		r.SetParam("orderId", *s.orderID)
+	}
+	if s.clientOrderID != nil {
+		r.SetParam("clientOrderId", *s.clientOrderID)
+	}
+	data, _, err := s.c.CallAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(Order)
+	err = jsonCodec.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ListOpenOrdersService list opened orders
+type ListOpenOrdersService struct {
+	c         *Client
+	symbol    string
+	orderID   *int64
+	startTime *int64
+	endTime   *int64
+}
+
+// Symbol set symbol
+func (s *ListOpenOrdersService) Symbol(symbol string) *ListOpenOrdersService {
+	s.symbol = symbol
+	return s
+}
+
+// OrderID set orderID, listing the open orders from it
+func (s *ListOpenOrdersService) OrderID(orderID int64) *ListOpenOrdersService {
+	s.orderID = &orderID
+	return s
+}
+
+// StartTime set startTime
+func (s *ListOpenOrdersService) StartTime(startTime int64) *ListOpenOrdersService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *ListOpenOrdersService) EndTime(endTime int64) *ListOpenOrdersService {
+	s.endTime = &endTime
+	return s
+}
+
+// Do send request
+func (s *ListOpenOrdersService) Do(ctx context.Context, opts ...RequestOption) (res []*Order, err error) {
+	r := &request{
+		Service:  "ListOpenOrdersService",
+		Method:   http.MethodGet,
+		Endpoint: "/eapi/v1/openOrders",
+		SecType:  secTypeSigned,
+	}
+	if s.symbol != "" {
+		r.SetParam("symbol", s.symbol)
+	}
+	if s.orderID != nil {
+		r.SetParam("orderId", *s.orderID)
+	}
+	if s.startTime != nil {
+		r.SetParam("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.SetParam("endTime", *s.endTime)
+	}
+	data, _, err := s.c.CallAPI(ctx, r, opts...)
+	if err != nil {
+		return []*Order{}, err
+	}
+	res = make([]*Order, 0)
+	err = jsonCodec.Unmarshal(data, &res)
+	if err != nil {
+		return []*Order{}, err
+	}
+	return res, nil
+}
+
+// CancelOrderService cancel an order
+type CancelOrderService struct {
+	c             *Client
+	symbol        string
+	orderID       *int64
+	clientOrderID *string
+}
+
+// Symbol set symbol
+func (s *CancelOrderService) Symbol(symbol string) *CancelOrderService {
+	s.symbol = symbol
+	return s
+}
+
+// OrderID set orderID
+func (s *CancelOrderService) OrderID(orderID int64) *CancelOrderService {
+	s.orderID = &orderID
+	return s
+}
+
+// ClientOrderID set clientOrderID
+func (s *CancelOrderService) ClientOrderID(clientOrderID string) *CancelOrderService {
+	s.clientOrderID = &clientOrderID
+	return s
+}
+
+// Do send request
+func (s *CancelOrderService) Do(ctx context.Context, opts ...RequestOption) (res *Order, err error) {
+	r := &request{
+		Service:  "CancelOrderService",
+		Method:   http.MethodDelete,
+		Endpoint: "/eapi/v1/order",
+		SecType:  secTypeSigned,
+	}
+	r.SetFormParam("symbol", s.symbol)
+	if s.orderID != nil {
+		r.SetFormParam("orderId", *s.orderID)
+	}
+	if s.clientOrderID != nil {
+		r.SetFormParam("clientOrderId", *s.clientOrderID)
+	}
+	data, _, err := s.c.CallAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(Order)
+	err = jsonCodec.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CancelMultipleOrdersService cancel a list of orders of a symbol
+type CancelMultipleOrdersService struct {
+	c              *Client
+	symbol         string
+	orderIDs       []int64
+	clientOrderIDs []string
+}
+
+// Symbol set symbol
+func (s *CancelMultipleOrdersService) Symbol(symbol string) *CancelMultipleOrdersService {
+	s.symbol = symbol
+	return s
+}
+
+// OrderIDList set orderIds
+func (s *CancelMultipleOrdersService) OrderIDList(orderIDs []int64) *CancelMultipleOrdersService {
+	s.orderIDs = orderIDs
+	return s
+}
+
+// ClientOrderIDList set clientOrderIds
+func (s *CancelMultipleOrdersService) ClientOrderIDList(clientOrderIDs []string) *CancelMultipleOrdersService {
+	s.clientOrderIDs = clientOrderIDs
+	return s
+}
+
+// Do send request
+func (s *CancelMultipleOrdersService) Do(ctx context.Context, opts ...RequestOption) (res []*Order, err error) {
+	r := &request{
+		Service:  "CancelMultipleOrdersService",
+		Method:   http.MethodDelete,
+		Endpoint: "/eapi/v1/batchOrders",
+		SecType:  secTypeSigned,
+	}
+	r.SetFormParam("symbol", s.symbol)
+	if s.orderIDs != nil {
+		// convert a slice of integers to a string e.g. [1 2 3] => "[1,2,3]"
+		r.SetFormParam("orderIds", strings.Join(strings.Fields(fmt.Sprint(s.orderIDs)), ","))
+	}
+	if s.clientOrderIDs != nil {
+		b, err := jsonCodec.Marshal(s.clientOrderIDs)
+		if err != nil {
+			return nil, err
+		}
+		r.SetFormParam("clientOrderIds", string(b))
+	}
+	data, _, err := s.c.CallAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = make([]*Order, 0)
+	err = jsonCodec.Unmarshal(data, &res)
+	if err != nil {
+		return []*Order{}, err
+	}
+	return res, nil
+}
+
+// CancelAllOpenOrdersService cancel all open orders of a symbol
+type CancelAllOpenOrdersService struct {
+	c      *Client
+	symbol string
+}
+
+// Symbol set symbol
+func (s *CancelAllOpenOrdersService) Symbol(symbol string) *CancelAllOpenOrdersService {
+	s.symbol = symbol
+	return s
+}
+
+// Do send request
+func (s *CancelAllOpenOrdersService) Do(ctx context.Context, opts ...RequestOption) (err error) {
+	r := &request{
+		Service:  "CancelAllOpenOrdersService",
+		Method:   http.MethodDelete,
+		Endpoint: "/eapi/v1/allOpenOrders",
+		SecType:  secTypeSigned,
+	}
+	r.SetFormParam("symbol", s.symbol)
+	_, _, err = s.c.CallAPI(ctx, r, opts...)
+	return err
+}
@@ -0,0 +1,41 @@
+package options
+
+import (
+	"context"
+	"net/http"
+)
+
+// GetPositionService get option positions
+type GetPositionService struct {
+	c      *Client
+	symbol string
+}
+
+// Symbol set symbol
+func (s *GetPositionService) Symbol(symbol string) *GetPositionService {
+	s.symbol = symbol
+	return s
+}
+
+// Do send request
+func (s *GetPositionService) Do(ctx context.Context, opts ...RequestOption) (res []*Position, err error) {
+	r := &request{
+		Service:  "GetPositionService",
+		Method:   http.MethodGet,
+		Endpoint: "/eapi/v1/position",
+		SecType:  secTypeSigned,
+	}
+	if s.symbol != "" {
+		r.SetParam("symbol", s.symbol)
+	}
+	data, _, err := s.c.CallAPI(ctx, r, opts...)
+	if err != nil {
+		return []*Position{}, err
+	}
+	res = make([]*Position, 0)
+	err = jsonCodec.Unmarshal(data, &res)
+	if err != nil {
+		return []*Position{}, err
+	}
+	return res, nil
+}
@@ -0,0 +1,86 @@
+package options
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/ward-cap/go-binance/common"
+)
+
+// endpointWeights lists the request weight of /eapi endpoints, keyed by
+// method and endpoint. Endpoints missing here weigh 1.
+var endpointWeights = map[string]int64{
+	"GET /eapi/v1/mark":             5,
+	"GET /eapi/v1/exerciseHistory":  3,
+	"GET /eapi/v1/position":         5,
+	"POST /eapi/v1/order":           0,
+	"POST /eapi/v1/batchOrders":     5,
+	"DELETE /eapi/v1/batchOrders":   1,
+	"DELETE /eapi/v1/allOpenOrders": 1,
+}
+
+// endpointOrders lists the endpoints counting against the order rate limits
+var endpointOrders = map[string]int64{
+	"POST /eapi/v1/order":       1,
+	"POST /eapi/v1/batchOrders": 5,
+}
+
+// requestCost return the weight and order count of r
+func requestCost(r *request) common.RequestCost {
+	if !strings.HasPrefix(r.Endpoint, "/eapi/") {
+		return common.RequestCost{}
+	}
+	key := r.Method + " " + r.Endpoint
+	cost := common.RequestCost{Requests: 1, Weight: 1, Orders: endpointOrders[key]}
+	if weight, ok := endpointWeights[key]; ok {
+		cost.Weight = weight
+	}
+
+	switch key {
+	case "GET /eapi/v1/depth":
+		cost.Weight = depthWeight(r.Query.Get("limit"))
+	case "GET /eapi/v1/openOrders":
+		if r.Query.Get("symbol") == "" {
+			cost.Weight = 40
+		}
+	}
+	return cost
+}
+
+func depthWeight(limit string) int64 {
+	n, err := strconv.Atoi(limit)
+	if err != nil {
+		n = 100
+	}
+	switch {
+	case n <= 100:
+		return 2
+	case n <= 500:
+		return 5
+	default:
+		return 10
+	}
+}
+
+// EnableRateLimiter fetch the rate limits from exchangeInfo and throttle
+// every later request with them. In fail fast mode requests that would exceed
+// a limit return a *common.RateLimitError instead of waiting. Call it before
+// the client is shared between goroutines.
+func (c *Client) EnableRateLimiter(ctx context.Context, failFast bool) error {
+	info, err := c.NewExchangeInfoService().Do(ctx)
+	if err != nil {
+		return err
+	}
+	limits := make([]common.RateLimit, len(info.RateLimits))
+	for i, limit := range info.RateLimits {
+		limits[i] = common.RateLimit{
+			RateLimitType: limit.RateLimitType,
+			Interval:      limit.Interval,
+			IntervalNum:   limit.IntervalNum,
+			Limit:         limit.Limit,
+		}
+	}
+	c.RateLimiter = common.NewRateLimiter(limits, failFast)
+	return nil
+}
@@ -0,0 +1,41 @@
+package options
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/ward-cap/go-binance/common"
+)
+
+type request = common.Request
+
+type params = common.Params
+
+const (
+	secTypeNone   = common.SecTypeNone
+	secTypeAPIKey = common.SecTypeAPIKey
+	secTypeSigned = common.SecTypeSigned
+)
+
+// RequestOption define option type for request
+type RequestOption = common.RequestOption
+
+// WithRecvWindow set recvWindow param for the request
+func WithRecvWindow(recvWindow time.Duration) RequestOption {
+	return common.WithRecvWindow(recvWindow)
+}
+
+// WithHeader set or add a header value to the request
+func WithHeader(key, value string, replace bool) RequestOption {
+	return common.WithHeader(key, value, replace)
+}
+
+// WithHeaders set or replace the headers of the request
+func WithHeaders(header http.Header) RequestOption {
+	return common.WithHeaders(header)
+}
+
+// WithExtraForm add extra form data of the request
+func WithExtraForm(m map[string]any) RequestOption {
+	return common.WithExtraForm(m)
+}
@@ -0,0 +1,59 @@
+package options
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+
+	"github.com/ward-cap/go-binance/common"
+)
+
+// PingService ping server
+type PingService struct {
+	c *Client
+}
+
+// Do send request
+func (s *PingService) Do(ctx context.Context, opts ...RequestOption) (err error) {
+	r := &request{
+		Service:  "PingService",
+		Method:   http.MethodGet,
+		Endpoint: "/eapi/v1/ping",
+	}
+	_, _, err = s.c.CallAPI(ctx, r, opts...)
+	return err
+}
+
+// ServerTimeService get server time
+type ServerTimeService struct {
+	c *Client
+}
+
+// Do send request
+func (s *ServerTimeService) Do(ctx context.Context, opts ...RequestOption) (serverTime int64, err error) {
+	r := &request{
+		Service:  "ServerTimeService",
+		Method:   http.MethodGet,
+		Endpoint: "/eapi/v1/time",
+	}
+	data, _, err := s.c.CallAPI(ctx, r, opts...)
+	if err != nil {
+		return 0, err
+	}
+	return parseServerTime(data)
+}
+
+// SetServerTimeService set server time
+type SetServerTimeService struct {
+	c *Client
+}
+
+// Do send request
+func (s *SetServerTimeService) Do(ctx context.Context) (timeOffset int64, err error) {
+	sample, err := common.MeasureTimeOffset(ctx, s.c.serverTime)
+	if err != nil {
+		return 0, err
+	}
+	atomic.StoreInt64(&s.c.TimeOffset, sample.Offset)
+	return sample.Offset, nil
+}
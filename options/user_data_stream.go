@@ -0,0 +1,98 @@
+package options
+
+import (
+	"context"
+	"time"
+
+	"github.com/ward-cap/go-binance/common"
+)
+
+// DefaultListenKeyKeepaliveInterval is how often the listen key is extended,
+// Binance expires a listen key 60 minutes after the last keepalive.
+const DefaultListenKeyKeepaliveInterval = common.DefaultListenKeyKeepaliveInterval
+
+// WsUserDataEvent define websocket user data event. Event tells which of the
+// typed fields is set; unknown event types are delivered with only Event and
+// Time filled in.
+type WsUserDataEvent struct {
+	Event            UserDataEventType
+	Time             int64
+	AccountUpdate    *WsAccountUpdateEvent
+	OrderTradeUpdate *WsOrderTradeUpdateEvent
+	RiskLevelChange  *WsRiskLevelChangeEvent
+	ListenKeyExpired *WsListenKeyExpiredEvent
+}
+
+// UnmarshalJSON decode the event header and the typed event it announces
+func (e *WsUserDataEvent) UnmarshalJSON(data []byte) error {
+	event, err := parseWsUserDataEvent(data)
+	if err != nil {
+		return err
+	}
+	*e = *event
+	return nil
+}
+
+// WsUserDataHandler handle websocket user data event
+type WsUserDataHandler func(event *WsUserDataEvent)
+
+// ErrHandler handles errors
+type ErrHandler func(err error)
+
+// UserDataStream consumes the options user data stream of the account. It creates the
+// listen key, keeps it alive, and rotates it when Binance expires it.
+type UserDataStream struct {
+	stream *common.UserStream[*WsUserDataEvent]
+}
+
+// NewUserDataStream init user data stream consumer, handler may be nil when
+// events are read from the Events channel
+func (c *Client) NewUserDataStream(handler WsUserDataHandler, errHandler ErrHandler) *UserDataStream {
+	cfg := common.UserStreamConfig[*WsUserDataEvent]{
+		BaseWsURL: c.BaseWsURL,
+		Logger:    c.Logger,
+		Package:   "options",
+		Start: func(ctx context.Context) (string, error) {
+			return c.NewStartUserStreamService().Do(ctx)
+		},
+		Keepalive: func(ctx context.Context, listenKey string) error {
+			return c.NewKeepaliveUserStreamService().ListenKey(listenKey).Do(ctx)
+		},
+		Close: func(ctx context.Context, listenKey string) error {
+			return c.NewCloseUserStreamService().ListenKey(listenKey).Do(ctx)
+		},
+		Parse: parseWsUserDataEvent,
+		Expired: func(event *WsUserDataEvent) bool {
+			return event.Event == UserDataEventTypeListenKeyExpired
+		},
+	}
+	return &UserDataStream{
+		stream: common.NewUserStream(cfg, handler, common.WsErrHandler(errHandler)),
+	}
+}
+
+// KeepaliveInterval set the listen key keepalive interval
+func (s *UserDataStream) KeepaliveInterval(interval time.Duration) *UserDataStream {
+	s.stream.KeepaliveInterval(interval)
+	return s
+}
+
+// Events set a channel that receives every event after the handler. A send
+// blocks the stream until the channel is read or Run returns.
+func (s *UserDataStream) Events(events chan<- *WsUserDataEvent) *UserDataStream {
+	s.stream.Events(events)
+	return s
+}
+
+// ListenKey return the listen key in use, empty when not running
+func (s *UserDataStream) ListenKey() string {
+	return s.stream.ListenKey()
+}
+
+// Run create the listen key and deliver events until ctx is done. Only a
+// failure to create the first listen key is returned; later failures are
+// reported to the error handler and retried with backoff. On return the
+// listen key is closed and ctx.Err() is returned.
+func (s *UserDataStream) Run(ctx context.Context) error {
+	return s.stream.Run(ctx)
+}
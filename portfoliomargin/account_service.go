@@ -0,0 +1,158 @@
+package portfoliomargin
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/ward-cap/go-binance/common"
+)
+
+// GetBalanceService get the unified account balance of every asset across
+// the margin, UM and CM wallets
+type GetBalanceService struct {
+	c     *Client
+	asset *string
+}
+
+// Asset set asset
+func (s *GetBalanceService) Asset(asset string) *GetBalanceService {
+	s.asset = &asset
+	return s
+}
+
+// Do send request
+func (s *GetBalanceService) Do(ctx context.Context, opts ...RequestOption) (res []*Balance, err error) {
+	r := &request{
+		Service:  "GetBalanceService",
+		Method:   http.MethodGet,
+		Endpoint: "/papi/v1/balance",
+		SecType:  secTypeSigned,
+	}
+	if s.asset != nil {
+		r.SetParam("asset", *s.asset)
+	}
+	data, _, err := s.c.CallAPI(ctx, r, opts...)
+	if err != nil {
+		return []*Balance{}, err
+	}
+	data = common.ToJSONList(data)
+	res = make([]*Balance, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*Balance{}, err
+	}
+	return res, nil
+}
+
+// GetAccountService get the unified account info
+type GetAccountService struct {
+	c *Client
+}
+
+// Do send request
+func (s *GetAccountService) Do(ctx context.Context, opts ...RequestOption) (res *Account, err error) {
+	r := &request{
+		Service:  "GetAccountService",
+		Method:   http.MethodGet,
+		Endpoint: "/papi/v1/account",
+		SecType:  secTypeSigned,
+	}
+	data, _, err := s.c.CallAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(Account)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// AutoCollectionService collect the free funds of the UM and CM wallets back
+// to the margin wallet
+type AutoCollectionService struct {
+	c *Client
+}
+
+// Do send request
+func (s *AutoCollectionService) Do(ctx context.Context, opts ...RequestOption) (err error) {
+	r := &request{
+		Service:  "AutoCollectionService",
+		Method:   http.MethodPost,
+		Endpoint: "/papi/v1/auto-collection",
+		SecType:  secTypeSigned,
+	}
+	_, _, err = s.c.CallAPI(ctx, r, opts...)
+	return err
+}
+
+// AssetCollectionService collect the free funds of one asset of the UM and
+// CM wallets back to the margin wallet
+type AssetCollectionService struct {
+	c     *Client
+	asset string
+}
+
+// Asset set asset
+func (s *AssetCollectionService) Asset(asset string) *AssetCollectionService {
+	s.asset = asset
+	return s
+}
+
+// Do send request
+func (s *AssetCollectionService) Do(ctx context.Context, opts ...RequestOption) (err error) {
+	r := &request{
+		Service:  "AssetCollectionService",
+		Method:   http.MethodPost,
+		Endpoint: "/papi/v1/asset-collection",
+		SecType:  secTypeSigned,
+	}
+	r.SetFormParam("asset", s.asset)
+	_, _, err = s.c.CallAPI(ctx, r, opts...)
+	return err
+}
+
+// BNBTransferService transfer BNB between the margin and UM wallets
+type BNBTransferService struct {
+	c            *Client
+	amount       string
+	transferSide TransferSideType
+}
+
+// Amount set amount
+func (s *BNBTransferService) Amount(amount string) *BNBTransferService {
+	s.amount = amount
+	return s
+}
+
+// TransferSide set transferSide
+func (s *BNBTransferService) TransferSide(transferSide TransferSideType) *BNBTransferService {
+	s.transferSide = transferSide
+	return s
+}
+
+// Do send request
+func (s *BNBTransferService) Do(ctx context.Context, opts ...RequestOption) (res *TransactionResponse, err error) {
+	r := &request{
+		Service:  "BNBTransferService",
+		Method:   http.MethodPost,
+		Endpoint: "/papi/v1/bnb-transfer",
+		SecType:  secTypeSigned,
+	}
+	r.SetFormParams(params{
+		"amount":       s.amount,
+		"transferSide": s.transferSide,
+	})
+	data, _, err := s.c.CallAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(TransactionResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -0,0 +1,273 @@
+package portfoliomargin
+
+import (
+	"net/http"
+
+	"github.com/ward-cap/go-binance/common"
+	"github.com/ward-cap/go-binance/futures"
+)
+
+// SideType define side type of order
+type SideType string
+
+// PositionSideType define position side type of order
+type PositionSideType string
+
+// OrderType define order type
+type OrderType string
+
+// TimeInForceType define time in force type of order
+type TimeInForceType string
+
+// NewOrderRespType define response JSON verbosity
+type NewOrderRespType string
+
+// OrderStatusType define order status type
+type OrderStatusType string
+
+// SideEffectType define side effect type of margin orders
+type SideEffectType string
+
+// SelfTradePreventionMode define the self trade prevention mode of an order
+type SelfTradePreventionMode string
+
+// PriceMatchType define the price match mode of an order
+type PriceMatchType string
+
+// TransferSideType define the direction of a BNB transfer
+type TransferSideType string
+
+// BusinessUnitType define the account a user data event belongs to
+type BusinessUnitType string
+
+// UserDataEventType define user data event type, the UM and CM events share
+// the futures types
+type UserDataEventType = futures.UserDataEventType
+
+// Endpoints
+const (
+	baseApiMainUrl = "https://papi.binance.com"
+	baseWsMainUrl  = "wss://fstream.binance.com/pm"
+)
+
+// Environment define the REST and websocket endpoints of a deployment. They
+// are always set together so a client never mixes hosts.
+type Environment struct {
+	BaseURL   string
+	BaseWsURL string
+}
+
+// MainnetEnvironment return the endpoints of the production deployment,
+// Portfolio Margin has no testnet
+func MainnetEnvironment() Environment {
+	return Environment{
+		BaseURL:   baseApiMainUrl,
+		BaseWsURL: baseWsMainUrl,
+	}
+}
+
+// Global enums
+const (
+	SideTypeBuy  SideType = "BUY"
+	SideTypeSell SideType = "SELL"
+
+	PositionSideTypeBoth  PositionSideType = "BOTH"
+	PositionSideTypeLong  PositionSideType = "LONG"
+	PositionSideTypeShort PositionSideType = "SHORT"
+
+	OrderTypeLimit           OrderType = "LIMIT"
+	OrderTypeMarket          OrderType = "MARKET"
+	OrderTypeLimitMaker      OrderType = "LIMIT_MAKER"
+	OrderTypeStopLoss        OrderType = "STOP_LOSS"
+	OrderTypeStopLossLimit   OrderType = "STOP_LOSS_LIMIT"
+	OrderTypeTakeProfit      OrderType = "TAKE_PROFIT"
+	OrderTypeTakeProfitLimit OrderType = "TAKE_PROFIT_LIMIT"
+
+	TimeInForceTypeGTC TimeInForceType = "GTC" // Good Till Cancel
+	TimeInForceTypeIOC TimeInForceType = "IOC" // Immediate or Cancel
+	TimeInForceTypeFOK TimeInForceType = "FOK" // Fill or Kill
+	TimeInForceTypeGTX TimeInForceType = "GTX" // Good Till Crossing (Post Only)
+	TimeInForceTypeGTD TimeInForceType = "GTD" // Good Till Date
+
+	NewOrderRespTypeACK    NewOrderRespType = "ACK"
+	NewOrderRespTypeRESULT NewOrderRespType = "RESULT"
+	NewOrderRespTypeFULL   NewOrderRespType = "FULL"
+
+	OrderStatusTypeNew             OrderStatusType = "NEW"
+	OrderStatusTypePartiallyFilled OrderStatusType = "PARTIALLY_FILLED"
+	OrderStatusTypeFilled          OrderStatusType = "FILLED"
+	OrderStatusTypeCanceled        OrderStatusType = "CANCELED"
+	OrderStatusTypeRejected        OrderStatusType = "REJECTED"
+	OrderStatusTypeExpired         OrderStatusType = "EXPIRED"
+	OrderStatusTypeExpiredInMatch  OrderStatusType = "EXPIRED_IN_MATCH"
+
+	SideEffectTypeNoSideEffect SideEffectType = "NO_SIDE_EFFECT"
+	SideEffectTypeMarginBuy    SideEffectType = "MARGIN_BUY"
+	SideEffectTypeAutoRepay    SideEffectType = "AUTO_REPAY"
+
+	SelfTradePreventionModeNone        SelfTradePreventionMode = "NONE"
+	SelfTradePreventionModeExpireTaker SelfTradePreventionMode = "EXPIRE_TAKER"
+	SelfTradePreventionModeExpireBoth  SelfTradePreventionMode = "EXPIRE_BOTH"
+	SelfTradePreventionModeExpireMaker SelfTradePreventionMode = "EXPIRE_MAKER"
+
+	PriceMatchTypeNone       PriceMatchType = "NONE"
+	PriceMatchTypeOpponent   PriceMatchType = "OPPONENT"
+	PriceMatchTypeOpponent5  PriceMatchType = "OPPONENT_5"
+	PriceMatchTypeOpponent10 PriceMatchType = "OPPONENT_10"
+	PriceMatchTypeOpponent20 PriceMatchType = "OPPONENT_20"
+	PriceMatchTypeQueue      PriceMatchType = "QUEUE"
+	PriceMatchTypeQueue5     PriceMatchType = "QUEUE_5"
+	PriceMatchTypeQueue10    PriceMatchType = "QUEUE_10"
+	PriceMatchTypeQueue20    PriceMatchType = "QUEUE_20"
+
+	TransferSideTypeToUM   TransferSideType = "TO_UM"
+	TransferSideTypeFromUM TransferSideType = "FROM_UM"
+
+	BusinessUnitTypeUM BusinessUnitType = "UM"
+	BusinessUnitTypeCM BusinessUnitType = "CM"
+
+	UserDataEventTypeListenKeyExpired                          = futures.UserDataEventTypeListenKeyExpired
+	UserDataEventTypeAccountUpdate                             = futures.UserDataEventTypeAccountUpdate
+	UserDataEventTypeOrderTradeUpdate                          = futures.UserDataEventTypeOrderTradeUpdate
+	UserDataEventTypeAccountConfigUpdate                       = futures.UserDataEventTypeAccountConfigUpdate
+	UserDataEventTypeRiskLevelChange         UserDataEventType = "riskLevelChange"
+	UserDataEventTypeOutboundAccountPosition UserDataEventType = "outboundAccountPosition"
+	UserDataEventTypeBalanceUpdate           UserDataEventType = "balanceUpdate"
+	UserDataEventTypeExecutionReport         UserDataEventType = "executionReport"
+)
+
+// NewClient initialize an API client instance with API key and secret key.
+// You should always call this function before using this SDK.
+// Services will be created by the form client.NewXXXService().
+func NewClient(apiKey, secretKey string, client *http.Client) *Client {
+	return &Client{
+		RESTClient: common.NewRESTClient(restConfig, apiKey, secretKey, MainnetEnvironment().BaseURL, client),
+		BaseWsURL:  MainnetEnvironment().BaseWsURL,
+	}
+}
+
+// restConfig define the package settings of the REST client
+var restConfig = common.RESTConfig{
+	Package: "portfoliomargin",
+	Cost:    requestCost,
+	RetryOrders: map[string]common.OrderLookup{
+		"/papi/v1/um/order":     {ClientOrderIDParam: "newClientOrderId", LookupParam: "origClientOrderId"},
+		"/papi/v1/cm/order":     {ClientOrderIDParam: "newClientOrderId", LookupParam: "origClientOrderId"},
+		"/papi/v1/margin/order": {ClientOrderIDParam: "newClientOrderId", LookupParam: "origClientOrderId"},
+	},
+}
+
+// Client define API client, requests are signed and sent by the embedded
+// common.RESTClient
+type Client struct {
+	common.RESTClient
+	BaseWsURL string
+}
+
+// SetApiEndpoint set api Endpoint, only BaseURL is changed
+//
+// Deprecated: use SetEnvironment, which sets the websocket endpoint too.
+func (c *Client) SetApiEndpoint(url string) *Client {
+	c.BaseURL = url
+	return c
+}
+
+// SetEnvironment set the REST and websocket endpoints of env
+func (c *Client) SetEnvironment(env Environment) *Client {
+	c.BaseURL = env.BaseURL
+	c.BaseWsURL = env.BaseWsURL
+	return c
+}
+
+// Environment return the endpoints the client uses
+func (c *Client) Environment() Environment {
+	return Environment{
+		BaseURL:   c.BaseURL,
+		BaseWsURL: c.BaseWsURL,
+	}
+}
+
+// NewPingService init ping service
+func (c *Client) NewPingService() *PingService {
+	return &PingService{c: c}
+}
+
+// NewCreateUMOrderService init creating UM order service
+func (c *Client) NewCreateUMOrderService() *CreateUMOrderService {
+	return &CreateUMOrderService{c: c}
+}
+
+// NewCancelUMOrderService init cancel UM order service
+func (c *Client) NewCancelUMOrderService() *CancelUMOrderService {
+	return &CancelUMOrderService{c: c}
+}
+
+// NewCreateCMOrderService init creating CM order service
+func (c *Client) NewCreateCMOrderService() *CreateCMOrderService {
+	return &CreateCMOrderService{c: c}
+}
+
+// NewCancelCMOrderService init cancel CM order service
+func (c *Client) NewCancelCMOrderService() *CancelCMOrderService {
+	return &CancelCMOrderService{c: c}
+}
+
+// NewCreateMarginOrderService init creating margin order service
+func (c *Client) NewCreateMarginOrderService() *CreateMarginOrderService {
+	return &CreateMarginOrderService{c: c}
+}
+
+// NewCancelMarginOrderService init cancel margin order service
+func (c *Client) NewCancelMarginOrderService() *CancelMarginOrderService {
+	return &CancelMarginOrderService{c: c}
+}
+
+// NewGetBalanceService init getting balance service
+func (c *Client) NewGetBalanceService() *GetBalanceService {
+	return &GetBalanceService{c: c}
+}
+
+// NewGetAccountService init getting account service
+func (c *Client) NewGetAccountService() *GetAccountService {
+	return &GetAccountService{c: c}
+}
+
+// NewGetUMPositionRiskService init getting UM position risk service
+func (c *Client) NewGetUMPositionRiskService() *GetUMPositionRiskService {
+	return &GetUMPositionRiskService{c: c}
+}
+
+// NewGetCMPositionRiskService init getting CM position risk service
+func (c *Client) NewGetCMPositionRiskService() *GetCMPositionRiskService {
+	return &GetCMPositionRiskService{c: c}
+}
+
+// NewAutoCollectionService init fund auto-collection service
+func (c *Client) NewAutoCollectionService() *AutoCollectionService {
+	return &AutoCollectionService{c: c}
+}
+
+// NewAssetCollectionService init fund collection by asset service
+func (c *Client) NewAssetCollectionService() *AssetCollectionService {
+	return &AssetCollectionService{c: c}
+}
+
+// NewBNBTransferService init BNB transfer service
+func (c *Client) NewBNBTransferService() *BNBTransferService {
+	return &BNBTransferService{c: c}
+}
+
+// NewStartUserStreamService init starting user stream service
+func (c *Client) NewStartUserStreamService() *StartUserStreamService {
+	return &StartUserStreamService{c: c}
+}
+
+// NewKeepaliveUserStreamService init keep alive user stream service
+func (c *Client) NewKeepaliveUserStreamService() *KeepaliveUserStreamService {
+	return &KeepaliveUserStreamService{c: c}
+}
+
+// NewCloseUserStreamService init closing user stream service
+func (c *Client) NewCloseUserStreamService() *CloseUserStreamService {
+	return &CloseUserStreamService{c: c}
+}
@@ -0,0 +1,17 @@
+package portfoliomargin
+
+import (
+	"context"
+	"time"
+
+	"github.com/ward-cap/go-binance/common"
+)
+
+// EnableClockSync sync TimeOffset with serverTime, then keep syncing it every
+// interval until ctx is done. A -1021 error (timestamp outside recvWindow)
+// triggers an immediate resync. Portfolio Margin has no time endpoint, so
+// serverTime is usually the server time service of a futures client. Call it
+// before the client is shared between goroutines.
+func (c *Client) EnableClockSync(ctx context.Context, interval time.Duration, serverTime common.ServerTimeFunc) (*common.ClockSync, error) {
+	return c.StartClockSync(ctx, interval, serverTime)
+}
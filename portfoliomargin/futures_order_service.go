@@ -0,0 +1,405 @@
+package portfoliomargin
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/shopspring/decimal"
+)
+
+// CreateUMOrderService create a UM futures order
+type CreateUMOrderService struct {
+	c                       *Client
+	symbol                  string
+	side                    SideType
+	positionSide            *PositionSideType
+	orderType               OrderType
+	timeInForce             *TimeInForceType
+	quantity                string
+	reduceOnly              *bool
+	price                   *string
+	newClientOrderID        *string
+	newOrderRespType        *NewOrderRespType
+	priceMatch              *PriceMatchType
+	selfTradePreventionMode *SelfTradePreventionMode
+	goodTillDate            *int64
+}
+
+// Symbol set symbol
+func (s *CreateUMOrderService) Symbol(symbol string) *CreateUMOrderService {
+	s.symbol = symbol
+	return s
+}
+
+// Side set side
+func (s *CreateUMOrderService) Side(side SideType) *CreateUMOrderService {
+	s.side = side
+	return s
+}
+
+// PositionSide set positionSide
+func (s *CreateUMOrderService) PositionSide(positionSide PositionSideType) *CreateUMOrderService {
+	s.positionSide = &positionSide
+	return s
+}
+
+// Type set type
+func (s *CreateUMOrderService) Type(orderType OrderType) *CreateUMOrderService {
+	s.orderType = orderType
+	return s
+}
+
+// TimeInForce set timeInForce
+func (s *CreateUMOrderService) TimeInForce(timeInForce TimeInForceType) *CreateUMOrderService {
+	s.timeInForce = &timeInForce
+	return s
+}
+
+// Quantity set quantity
+func (s *CreateUMOrderService) Quantity(quantity string) *CreateUMOrderService {
+	s.quantity = quantity
+	return s
+}
+
+// QuantityDecimal set quantity from a decimal
+func (s *CreateUMOrderService) QuantityDecimal(quantity decimal.Decimal) *CreateUMOrderService {
+	return s.Quantity(quantity.String())
+}
+
+// ReduceOnly set reduceOnly
+func (s *CreateUMOrderService) ReduceOnly(reduceOnly bool) *CreateUMOrderService {
+	s.reduceOnly = &reduceOnly
+	return s
+}
+
+// Price set price
+func (s *CreateUMOrderService) Price(price string) *CreateUMOrderService {
+	s.price = &price
+	return s
+}
+
+// PriceDecimal set price from a decimal
+func (s *CreateUMOrderService) PriceDecimal(price decimal.Decimal) *CreateUMOrderService {
+	return s.Price(price.String())
+}
+
+// NewClientOrderID set newClientOrderID
+func (s *CreateUMOrderService) NewClientOrderID(newClientOrderID string) *CreateUMOrderService {
+	s.newClientOrderID = &newClientOrderID
+	return s
+}
+
+// NewOrderResponseType set newOrderResponseType
+func (s *CreateUMOrderService) NewOrderResponseType(newOrderRespType NewOrderRespType) *CreateUMOrderService {
+	s.newOrderRespType = &newOrderRespType
+	return s
+}
+
+// PriceMatch set priceMatch
+func (s *CreateUMOrderService) PriceMatch(priceMatch PriceMatchType) *CreateUMOrderService {
+	s.priceMatch = &priceMatch
+	return s
+}
+
+// SelfTradePreventionMode set selfTradePreventionMode
+func (s *CreateUMOrderService) SelfTradePreventionMode(selfTradePreventionMode SelfTradePreventionMode) *CreateUMOrderService {
+	s.selfTradePreventionMode = &selfTradePreventionMode
+	return s
+}
+
+// GoodTillDate set goodTillDate
+func (s *CreateUMOrderService) GoodTillDate(goodTillDate int64) *CreateUMOrderService {
+	s.goodTillDate = &goodTillDate
+	return s
+}
+
+// Do send request
+func (s *CreateUMOrderService) Do(ctx context.Context, opts ...RequestOption) (res *UMOrder, err error) {
+	r := &request{
+		Service:  "CreateUMOrderService",
+		Method:   http.MethodPost,
+		Endpoint: "/papi/v1/um/order",
+		SecType:  secTypeSigned,
+	}
+	m := params{
+		"symbol":   s.symbol,
+		"side":     s.side,
+		"type":     s.orderType,
+		"quantity": s.quantity,
+	}
+	if s.positionSide != nil {
+		m["positionSide"] = *s.positionSide
+	}
+	if s.timeInForce != nil {
+		m["timeInForce"] = *s.timeInForce
+	}
+	if s.reduceOnly != nil {
+		m["reduceOnly"] = *s.reduceOnly
+	}
+	if s.price != nil {
+		m["price"] = *s.price
+	}
+	if s.newClientOrderID != nil {
+		m["newClientOrderId"] = *s.newClientOrderID
+	}
+	if s.newOrderRespType != nil {
+		m["newOrderRespType"] = *s.newOrderRespType
+	}
+	if s.priceMatch != nil {
+		m["priceMatch"] = *s.priceMatch
+	}
+	if s.selfTradePreventionMode != nil {
+		m["selfTradePreventionMode"] = *s.selfTradePreventionMode
+	}
+	if s.goodTillDate != nil {
+		m["goodTillDate"] = *s.goodTillDate
+	}
+	r.SetFormParams(m)
+	data, _, err := s.c.CallAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(UMOrder)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CancelUMOrderService cancel a UM futures order
+type CancelUMOrderService struct {
+	c                 *Client
+	symbol            string
+	orderID           *int64
+	origClientOrderID *string
+}
+
+// Symbol set symbol
+func (s *CancelUMOrderService) Symbol(symbol string) *CancelUMOrderService {
+	s.symbol = symbol
+	return s
+}
+
+// OrderID set orderID
+func (s *CancelUMOrderService) OrderID(orderID int64) *CancelUMOrderService {
+	s.orderID = &orderID
+	return s
+}
+
+// OrigClientOrderID set origClientOrderID
+func (s *CancelUMOrderService) OrigClientOrderID(origClientOrderID string) *CancelUMOrderService {
+	s.origClientOrderID = &origClientOrderID
+	return s
+}
+
+// Do send request
+func (s *CancelUMOrderService) Do(ctx context.Context, opts ...RequestOption) (res *UMOrder, err error) {
+	r := &request{
+		Service:  "CancelUMOrderService",
+		Method:   http.MethodDelete,
+		Endpoint: "/papi/v1/um/order",
+		SecType:  secTypeSigned,
+	}
+	r.SetFormParam("symbol", s.symbol)
+	if s.orderID != nil {
+		r.SetFormParam("orderId", *s.orderID)
+	}
+	if s.origClientOrderID != nil {
+		r.SetFormParam("origClientOrderId", *s.origClientOrderID)
+	}
+	data, _, err := s.c.CallAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(UMOrder)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CreateCMOrderService create a CM futures order
+type CreateCMOrderService struct {
+	c                *Client
+	symbol           string
+	side             SideType
+	positionSide     *PositionSideType
+	orderType        OrderType
+	timeInForce      *TimeInForceType
+	quantity         string
+	reduceOnly       *bool
+	price            *string
+	newClientOrderID *string
+	newOrderRespType *NewOrderRespType
+}
+
+// Symbol set symbol
+func (s *CreateCMOrderService) Symbol(symbol string) *CreateCMOrderService {
+	s.symbol = symbol
+	return s
+}
+
+// Side set side
+func (s *CreateCMOrderService) Side(side SideType) *CreateCMOrderService {
+	s.side = side
+	return s
+}
+
+// PositionSide set positionSide
+func (s *CreateCMOrderService) PositionSide(positionSide PositionSideType) *CreateCMOrderService {
+	s.positionSide = &positionSide
+	return s
+}
+
+// Type set type
+func (s *CreateCMOrderService) Type(orderType OrderType) *CreateCMOrderService {
+	s.orderType = orderType
+	return s
+}
+
+// TimeInForce set timeInForce
+func (s *CreateCMOrderService) TimeInForce(timeInForce TimeInForceType) *CreateCMOrderService {
+	s.timeInForce = &timeInForce
+	return s
+}
+
+// Quantity set quantity
+func (s *CreateCMOrderService) Quantity(quantity string) *CreateCMOrderService {
+	s.quantity = quantity
+	return s
+}
+
+// QuantityDecimal set quantity from a decimal
+func (s *CreateCMOrderService) QuantityDecimal(quantity decimal.Decimal) *CreateCMOrderService {
+	return s.Quantity(quantity.String())
+}
+
+// ReduceOnly set reduceOnly
+func (s *CreateCMOrderService) ReduceOnly(reduceOnly bool) *CreateCMOrderService {
+	s.reduceOnly = &reduceOnly
+	return s
+}
+
+// Price set price
+func (s *CreateCMOrderService) Price(price string) *CreateCMOrderService {
+	s.price = &price
+	return s
+}
+
+// PriceDecimal set price from a decimal
+func (s *CreateCMOrderService) PriceDecimal(price decimal.Decimal) *CreateCMOrderService {
+	return s.Price(price.String())
+}
+
+// NewClientOrderID set newClientOrderID
+func (s *CreateCMOrderService) NewClientOrderID(newClientOrderID string) *CreateCMOrderService {
+	s.newClientOrderID = &newClientOrderID
+	return s
+}
+
+// NewOrderResponseType set newOrderResponseType
+func (s *CreateCMOrderService) NewOrderResponseType(newOrderRespType NewOrderRespType) *CreateCMOrderService {
+	s.newOrderRespType = &newOrderRespType
+	return s
+}
+
+// Do send request
+func (s *CreateCMOrderService) Do(ctx context.Context, opts ...RequestOption) (res *CMOrder, err error) {
+	r := &request{
+		Service:  "CreateCMOrderService",
+		Method:   http.MethodPost,
+		Endpoint: "/papi/v1/cm/order",
+		SecType:  secTypeSigned,
+	}
+	m := params{
+		"symbol":   s.symbol,
+		"side":     s.side,
+		"type":     s.orderType,
+		"quantity": s.quantity,
+	}
+	if s.positionSide != nil {
+		m["positionSide"] = *s.positionSide
+	}
+	if s.timeInForce != nil {
+		m["timeInForce"] = *s.timeInForce
+	}
+	if s.reduceOnly != nil {
+		m["reduceOnly"] = *s.reduceOnly
+	}
+	if s.price != nil {
+		m["price"] = *s.price
+	}
+	if s.newClientOrderID != nil {
+		m["newClientOrderId"] = *s.newClientOrderID
+	}
+	if s.newOrderRespType != nil {
+		m["newOrderRespType"] = *s.newOrderRespType
+	}
+	r.SetFormParams(m)
+	data, _, err := s.c.CallAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(CMOrder)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CancelCMOrderService cancel a CM futures order
+type CancelCMOrderService struct {
+	c                 *Client
+	symbol            string
+	orderID           *int64
+	origClientOrderID *string
+}
+
+// Symbol set symbol
+func (s *CancelCMOrderService) Symbol(symbol string) *CancelCMOrderService {
+	s.symbol = symbol
+	return s
+}
+
+// OrderID set orderID
+func (s *CancelCMOrderService) OrderID(orderID int64) *CancelCMOrderService {
+	s.orderID = &orderID
+	return s
+}
+
+// OrigClientOrderID set origClientOrderID
+func (s *CancelCMOrderService) OrigClientOrderID(origClientOrderID string) *CancelCMOrderService {
+	s.origClientOrderID = &origClientOrderID
+	return s
+}
+
+// Do send request
+func (s *CancelCMOrderService) Do(ctx context.Context, opts ...RequestOption) (res *CMOrder, err error) {
+	r := &request{
+		Service:  "CancelCMOrderService",
+		Method:   http.MethodDelete,
+		Endpoint: "/papi/v1/cm/order",
+		SecType:  secTypeSigned,
+	}
+	r.SetFormParam("symbol", s.symbol)
+	if s.orderID != nil {
+		r.SetFormParam("orderId", *s.orderID)
+	}
+	if s.origClientOrderID != nil {
+		r.SetFormParam("origClientOrderId", *s.origClientOrderID)
+	}
+	data, _, err := s.c.CallAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(CMOrder)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -0,0 +1,91 @@
+package portfoliomargin
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/ward-cap/go-binance/futures"
+	binance "github.com/ward-cap/go-binance/services"
+)
+
+type listenKeyResponse struct {
+	ListenKey string `json:"listenKey"`
+}
+
+type wsUserDataEventHeader struct {
+	Event        UserDataEventType `json:"e"`
+	Time         json.RawMessage   `json:"E"`
+	BusinessUnit BusinessUnitType  `json:"fs"`
+	ListenKey    string            `json:"listenKey"`
+}
+
+func parseListenKey(data []byte) (string, error) {
+	var res listenKeyResponse
+	if err := json.Unmarshal(data, &res); err != nil {
+		return "", err
+	}
+	return res.ListenKey, nil
+}
+
+func parseWsUserDataEvent(data []byte) (*WsUserDataEvent, error) {
+	var header wsUserDataEventHeader
+	if err := json.Unmarshal(data, &header); err != nil {
+		return nil, err
+	}
+	res := &WsUserDataEvent{Event: header.Event, BusinessUnit: header.BusinessUnit}
+	if len(header.Time) > 0 {
+		t, err := rawInt64(header.Time)
+		if err != nil {
+			return nil, err
+		}
+		res.Time = t
+	}
+
+	var err error
+	switch header.Event {
+	case UserDataEventTypeAccountUpdate:
+		res.AccountUpdate = new(futures.WsAccountUpdateEvent)
+		err = res.AccountUpdate.UnmarshalJSON(data)
+	case UserDataEventTypeOrderTradeUpdate:
+		res.OrderTradeUpdate = new(futures.WsOrderTradeUpdateEvent)
+		err = res.OrderTradeUpdate.UnmarshalJSON(data)
+	case UserDataEventTypeAccountConfigUpdate:
+		res.AccountConfigUpdate = new(futures.WsAccountConfigUpdateEvent)
+		err = res.AccountConfigUpdate.UnmarshalJSON(data)
+	case UserDataEventTypeRiskLevelChange:
+		res.RiskLevelChange = new(WsRiskLevelChangeEvent)
+		err = res.RiskLevelChange.UnmarshalJSON(data)
+	case UserDataEventTypeOutboundAccountPosition:
+		res.MarginAccountUpdate = new(binance.WsAccountUpdateEvent)
+		err = res.MarginAccountUpdate.UnmarshalJSON(data)
+	case UserDataEventTypeBalanceUpdate:
+		res.MarginBalanceUpdate = new(binance.WsBalanceUpdateEvent)
+		err = res.MarginBalanceUpdate.UnmarshalJSON(data)
+	case UserDataEventTypeExecutionReport:
+		res.MarginExecutionReport = new(binance.WsExecutionReportEvent)
+		err = res.MarginExecutionReport.UnmarshalJSON(data)
+	case UserDataEventTypeListenKeyExpired:
+		// the event time is sent as a string here, so build it from the header
+		res.ListenKeyExpired = &futures.WsListenKeyExpiredEvent{
+			Event:     header.Event,
+			Time:      res.Time,
+			ListenKey: header.ListenKey,
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func rawInt64(data json.RawMessage) (int64, error) {
+	var n int64
+	if err := json.Unmarshal(data, &n); err == nil {
+		return n, nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(s, 10, 64)
+}
@@ -0,0 +1,245 @@
+package portfoliomargin
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/shopspring/decimal"
+)
+
+// CreateMarginOrderService create a cross margin order
+type CreateMarginOrderService struct {
+	c                       *Client
+	symbol                  string
+	side                    SideType
+	orderType               OrderType
+	quantity                *string
+	quoteOrderQuantity      *string
+	price                   *string
+	stopPrice               *string
+	newClientOrderID        *string
+	icebergQuantity         *string
+	newOrderRespType        *NewOrderRespType
+	sideEffectType          *SideEffectType
+	timeInForce             *TimeInForceType
+	selfTradePreventionMode *SelfTradePreventionMode
+	autoRepayAtCancel       *bool
+}
+
+// Symbol set symbol
+func (s *CreateMarginOrderService) Symbol(symbol string) *CreateMarginOrderService {
+	s.symbol = symbol
+	return s
+}
+
+// Side set side
+func (s *CreateMarginOrderService) Side(side SideType) *CreateMarginOrderService {
+	s.side = side
+	return s
+}
+
+// Type set type
+func (s *CreateMarginOrderService) Type(orderType OrderType) *CreateMarginOrderService {
+	s.orderType = orderType
+	return s
+}
+
+// Quantity set quantity
+func (s *CreateMarginOrderService) Quantity(quantity string) *CreateMarginOrderService {
+	s.quantity = &quantity
+	return s
+}
+
+// QuantityDecimal set quantity from a decimal
+func (s *CreateMarginOrderService) QuantityDecimal(quantity decimal.Decimal) *CreateMarginOrderService {
+	return s.Quantity(quantity.String())
+}
+
+// QuoteOrderQty set quoteOrderQty
+func (s *CreateMarginOrderService) QuoteOrderQty(quoteOrderQty string) *CreateMarginOrderService {
+	s.quoteOrderQuantity = &quoteOrderQty
+	return s
+}
+
+// Price set price
+func (s *CreateMarginOrderService) Price(price string) *CreateMarginOrderService {
+	s.price = &price
+	return s
+}
+
+// PriceDecimal set price from a decimal
+func (s *CreateMarginOrderService) PriceDecimal(price decimal.Decimal) *CreateMarginOrderService {
+	return s.Price(price.String())
+}
+
+// StopPrice set stopPrice
+func (s *CreateMarginOrderService) StopPrice(stopPrice string) *CreateMarginOrderService {
+	s.stopPrice = &stopPrice
+	return s
+}
+
+// NewClientOrderID set newClientOrderID
+func (s *CreateMarginOrderService) NewClientOrderID(newClientOrderID string) *CreateMarginOrderService {
+	s.newClientOrderID = &newClientOrderID
+	return s
+}
+
+// IcebergQuantity set icebergQuantity
+func (s *CreateMarginOrderService) IcebergQuantity(icebergQuantity string) *CreateMarginOrderService {
+	s.icebergQuantity = &icebergQuantity
+	return s
+}
+
+// NewOrderRespType set newOrderRespType
+func (s *CreateMarginOrderService) NewOrderRespType(newOrderRespType NewOrderRespType) *CreateMarginOrderService {
+	s.newOrderRespType = &newOrderRespType
+	return s
+}
+
+// SideEffectType set sideEffectType
+func (s *CreateMarginOrderService) SideEffectType(sideEffectType SideEffectType) *CreateMarginOrderService {
+	s.sideEffectType = &sideEffectType
+	return s
+}
+
+// TimeInForce set timeInForce
+func (s *CreateMarginOrderService) TimeInForce(timeInForce TimeInForceType) *CreateMarginOrderService {
+	s.timeInForce = &timeInForce
+	return s
+}
+
+// SelfTradePreventionMode set selfTradePreventionMode
+func (s *CreateMarginOrderService) SelfTradePreventionMode(selfTradePreventionMode SelfTradePreventionMode) *CreateMarginOrderService {
+	s.selfTradePreventionMode = &selfTradePreventionMode
+	return s
+}
+
+// AutoRepayAtCancel set autoRepayAtCancel, only used with AUTO_REPAY and
+// MARGIN_BUY side effects
+func (s *CreateMarginOrderService) AutoRepayAtCancel(autoRepayAtCancel bool) *CreateMarginOrderService {
+	s.autoRepayAtCancel = &autoRepayAtCancel
+	return s
+}
+
+// Do send request
+func (s *CreateMarginOrderService) Do(ctx context.Context, opts ...RequestOption) (res *MarginOrder, err error) {
+	r := &request{
+		Service:  "CreateMarginOrderService",
+		Method:   http.MethodPost,
+		Endpoint: "/papi/v1/margin/order",
+		SecType:  secTypeSigned,
+	}
+	m := params{
+		"symbol": s.symbol,
+		"side":   s.side,
+		"type":   s.orderType,
+	}
+	if s.quantity != nil {
+		m["quantity"] = *s.quantity
+	}
+	if s.quoteOrderQuantity != nil {
+		m["quoteOrderQty"] = *s.quoteOrderQuantity
+	}
+	if s.price != nil {
+		m["price"] = *s.price
+	}
+	if s.stopPrice != nil {
+		m["stopPrice"] = *s.stopPrice
+	}
+	if s.newClientOrderID != nil {
+		m["newClientOrderId"] = *s.newClientOrderID
+	}
+	if s.icebergQuantity != nil {
+		m["icebergQty"] = *s.icebergQuantity
+	}
+	if s.newOrderRespType != nil {
+		m["newOrderRespType"] = *s.newOrderRespType
+	}
+	if s.sideEffectType != nil {
+		m["sideEffectType"] = *s.sideEffectType
+	}
+	if s.timeInForce != nil {
+		m["timeInForce"] = *s.timeInForce
+	}
+	if s.selfTradePreventionMode != nil {
+		m["selfTradePreventionMode"] = *s.selfTradePreventionMode
+	}
+	if s.autoRepayAtCancel != nil {
+		m["autoRepayAtCancel"] = *s.autoRepayAtCancel
+	}
+	r.SetFormParams(m)
+	data, _, err := s.c.CallAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(MarginOrder)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CancelMarginOrderService cancel a cross margin order
+type CancelMarginOrderService struct {
+	c                 *Client
+	symbol            string
+	orderID           *int64
+	origClientOrderID *string
+	newClientOrderID  *string
+}
+
+// Symbol set symbol
+func (s *CancelMarginOrderService) Symbol(symbol string) *CancelMarginOrderService {
+	s.symbol = symbol
+	return s
+}
+
+// OrderID set orderID
+func (s *CancelMarginOrderService) OrderID(orderID int64) *CancelMarginOrderService {
+	s.orderID = &orderID
+	return s
+}
+
+// OrigClientOrderID set origClientOrderID
+func (s *CancelMarginOrderService) OrigClientOrderID(origClientOrderID string) *CancelMarginOrderService {
+	s.origClientOrderID = &origClientOrderID
+	return s
+}
+
+// NewClientOrderID set newClientOrderID, the id of the cancel
+func (s *CancelMarginOrderService) NewClientOrderID(newClientOrderID string) *CancelMarginOrderService {
+	s.newClientOrderID = &newClientOrderID
+	return s
+}
+
+// Do send request
+func (s *CancelMarginOrderService) Do(ctx context.Context, opts ...RequestOption) (res *MarginOrder, err error) {
+	r := &request{
+		Service:  "CancelMarginOrderService",
+		Method:   http.MethodDelete,
+		Endpoint: "/papi/v1/margin/order",
+		SecType:  secTypeSigned,
+	}
+	r.SetFormParam("symbol", s.symbol)
+	if s.orderID != nil {
+		r.SetFormParam("orderId", *s.orderID)
+	}
+	if s.origClientOrderID != nil {
+		r.SetFormParam("origClientOrderId", *s.origClientOrderID)
+	}
+	if s.newClientOrderID != nil {
+		r.SetFormParam("newClientOrderId", *s.newClientOrderID)
+	}
+	data, _, err := s.c.CallAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(MarginOrder)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
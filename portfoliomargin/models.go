@@ -0,0 +1,182 @@
+package portfoliomargin
+
+import (
+	"github.com/shopspring/decimal"
+)
+
+//go:generate easyjson -all models.go
+
+// Account define the unified account info, amounts are in USD
+//
+//easyjson:json
+type Account struct {
+	UniMMR                   string `json:"uniMMR"`
+	AccountEquity            string `json:"accountEquity"`
+	ActualEquity             string `json:"actualEquity"`
+	AccountInitialMargin     string `json:"accountInitialMargin"`
+	AccountMaintMargin       string `json:"accountMaintMargin"`
+	AccountStatus            string `json:"accountStatus"`
+	VirtualMaxWithdrawAmount string `json:"virtualMaxWithdrawAmount"`
+	TotalAvailableBalance    string `json:"totalAvailableBalance"`
+	TotalMarginOpenLoss      string `json:"totalMarginOpenLoss"`
+	UpdateTime               int64  `json:"updateTime"`
+}
+
+// Balance define the unified account balance of an asset across the cross
+// margin, UM and CM accounts
+//
+//easyjson:json
+type Balance struct {
+	Asset               string          `json:"asset"`
+	TotalWalletBalance  decimal.Decimal `json:"totalWalletBalance"`
+	CrossMarginAsset    decimal.Decimal `json:"crossMarginAsset"`
+	CrossMarginBorrowed decimal.Decimal `json:"crossMarginBorrowed"`
+	CrossMarginFree     decimal.Decimal `json:"crossMarginFree"`
+	CrossMarginInterest decimal.Decimal `json:"crossMarginInterest"`
+	CrossMarginLocked   decimal.Decimal `json:"crossMarginLocked"`
+	UMWalletBalance     decimal.Decimal `json:"umWalletBalance"`
+	UMUnrealizedPNL     decimal.Decimal `json:"umUnrealizedPNL"`
+	CMWalletBalance     decimal.Decimal `json:"cmWalletBalance"`
+	CMUnrealizedPNL     decimal.Decimal `json:"cmUnrealizedPNL"`
+	NegativeBalance     decimal.Decimal `json:"negativeBalance"`
+	UpdateTime          int64           `json:"updateTime"`
+}
+
+// CMOrder define CM futures order info, quantities are in contracts
+//
+//easyjson:json
+type CMOrder struct {
+	Symbol           string           `json:"symbol"`
+	Pair             string           `json:"pair"`
+	OrderID          int64            `json:"orderId"`
+	ClientOrderID    string           `json:"clientOrderId"`
+	Price            string           `json:"price"`
+	AvgPrice         string           `json:"avgPrice"`
+	OrigQuantity     string           `json:"origQty"`
+	ExecutedQuantity string           `json:"executedQty"`
+	CumQuantity      string           `json:"cumQty"`
+	CumBase          string           `json:"cumBase"`
+	ReduceOnly       bool             `json:"reduceOnly"`
+	Status           OrderStatusType  `json:"status"`
+	TimeInForce      TimeInForceType  `json:"timeInForce"`
+	Type             OrderType        `json:"type"`
+	Side             SideType         `json:"side"`
+	PositionSide     PositionSideType `json:"positionSide"`
+	UpdateTime       int64            `json:"updateTime"`
+}
+
+// CMPositionRisk define CM position risk info. PositionAmt and MaxQuantity
+// are in contracts, NotionalValue is in the margin asset.
+//
+//easyjson:json
+type CMPositionRisk struct {
+	Symbol           string           `json:"symbol"`
+	PositionAmt      decimal.Decimal  `json:"positionAmt"`
+	EntryPrice       decimal.Decimal  `json:"entryPrice"`
+	MarkPrice        string           `json:"markPrice"`
+	UnRealizedProfit string           `json:"unRealizedProfit"`
+	LiquidationPrice string           `json:"liquidationPrice"`
+	Leverage         string           `json:"leverage"`
+	MaxQuantity      string           `json:"maxQty"`
+	PositionSide     PositionSideType `json:"positionSide"`
+	NotionalValue    string           `json:"notionalValue"`
+	UpdateTime       int64            `json:"updateTime"`
+}
+
+// Fill define the fill of a margin order
+//
+//easyjson:json
+type Fill struct {
+	TradeID         int64  `json:"tradeId"`
+	Price           string `json:"price"`
+	Quantity        string `json:"qty"`
+	Commission      string `json:"commission"`
+	CommissionAsset string `json:"commissionAsset"`
+}
+
+// MarginOrder define cross margin order info, the fill fields are only set
+// for the RESULT and FULL response types
+//
+//easyjson:json
+type MarginOrder struct {
+	Symbol                   string                  `json:"symbol"`
+	OrderID                  int64                   `json:"orderId"`
+	ClientOrderID            string                  `json:"clientOrderId"`
+	OrigClientOrderID        string                  `json:"origClientOrderId,omitempty"`
+	TransactTime             int64                   `json:"transactTime"`
+	Price                    string                  `json:"price"`
+	OrigQuantity             string                  `json:"origQty"`
+	ExecutedQuantity         string                  `json:"executedQty"`
+	CummulativeQuoteQuantity string                  `json:"cummulativeQuoteQty"`
+	Status                   OrderStatusType         `json:"status"`
+	TimeInForce              TimeInForceType         `json:"timeInForce"`
+	Type                     OrderType               `json:"type"`
+	Side                     SideType                `json:"side"`
+	MarginBuyBorrowAmount    string                  `json:"marginBuyBorrowAmount,omitempty"`
+	MarginBuyBorrowAsset     string                  `json:"marginBuyBorrowAsset,omitempty"`
+	SelfTradePreventionMode  SelfTradePreventionMode `json:"selfTradePreventionMode"`
+	Fills                    []*Fill                 `json:"fills,omitempty"`
+}
+
+// TransactionResponse define the transaction id of a transfer
+//
+//easyjson:json
+type TransactionResponse struct {
+	TranID int64 `json:"tranId"`
+}
+
+// UMOrder define UM futures order info
+//
+//easyjson:json
+type UMOrder struct {
+	Symbol                  string                  `json:"symbol"`
+	OrderID                 int64                   `json:"orderId"`
+	ClientOrderID           string                  `json:"clientOrderId"`
+	Price                   string                  `json:"price"`
+	AvgPrice                string                  `json:"avgPrice"`
+	OrigQuantity            string                  `json:"origQty"`
+	ExecutedQuantity        string                  `json:"executedQty"`
+	CumQuantity             string                  `json:"cumQty"`
+	CumQuote                string                  `json:"cumQuote"`
+	ReduceOnly              bool                    `json:"reduceOnly"`
+	Status                  OrderStatusType         `json:"status"`
+	TimeInForce             TimeInForceType         `json:"timeInForce"`
+	Type                    OrderType               `json:"type"`
+	Side                    SideType                `json:"side"`
+	PositionSide            PositionSideType        `json:"positionSide"`
+	PriceMatch              PriceMatchType          `json:"priceMatch"`
+	SelfTradePreventionMode SelfTradePreventionMode `json:"selfTradePreventionMode"`
+	GoodTillDate            int64                   `json:"goodTillDate"`
+	UpdateTime              int64                   `json:"updateTime"`
+}
+
+// UMPositionRisk define UM position risk info
+//
+//easyjson:json
+type UMPositionRisk struct {
+	Symbol           string           `json:"symbol"`
+	PositionAmt      decimal.Decimal  `json:"positionAmt"`
+	EntryPrice       decimal.Decimal  `json:"entryPrice"`
+	MarkPrice        string           `json:"markPrice"`
+	UnRealizedProfit string           `json:"unRealizedProfit"`
+	LiquidationPrice string           `json:"liquidationPrice"`
+	Leverage         string           `json:"leverage"`
+	MaxNotionalValue string           `json:"maxNotionalValue"`
+	PositionSide     PositionSideType `json:"positionSide"`
+	Notional         string           `json:"notional"`
+	UpdateTime       int64            `json:"updateTime"`
+}
+
+// WsRiskLevelChangeEvent define websocket riskLevelChange event, sent when
+// the uniMMR of the account crosses a margin call or liquidation level
+//
+//easyjson:json
+type WsRiskLevelChangeEvent struct {
+	Event              UserDataEventType `json:"e"`
+	Time               int64             `json:"E"`
+	UniMMR             string            `json:"u"`
+	RiskLevel          string            `json:"s"`
+	AccountEquity      string            `json:"eq"`
+	ActualEquity       string            `json:"ae"`
+	AccountMaintMargin string            `json:"m"`
+}
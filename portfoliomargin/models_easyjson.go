@@ -0,0 +1,1853 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package portfoliomargin
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+	futures "github.com/ward-cap/go-binance/futures"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinancePortfoliomargin(in *jlexer.Lexer, out *WsRiskLevelChangeEvent) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "e":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Event = futures.UserDataEventType(in.String())
+			}
+		case "E":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Time = int64(in.Int64())
+			}
+		case "u":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.UniMMR = string(in.String())
+			}
+		case "s":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.RiskLevel = string(in.String())
+			}
+		case "eq":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.AccountEquity = string(in.String())
+			}
+		case "ae":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ActualEquity = string(in.String())
+			}
+		case "m":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.AccountMaintMargin = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinancePortfoliomargin(out *jwriter.Writer, in WsRiskLevelChangeEvent) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"e\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Event))
+	}
+	{
+		const prefix string = ",\"E\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Time))
+	}
+	{
+		const prefix string = ",\"u\":"
+		out.RawString(prefix)
+		out.String(string(in.UniMMR))
+	}
+	{
+		const prefix string = ",\"s\":"
+		out.RawString(prefix)
+		out.String(string(in.RiskLevel))
+	}
+	{
+		const prefix string = ",\"eq\":"
+		out.RawString(prefix)
+		out.String(string(in.AccountEquity))
+	}
+	{
+		const prefix string = ",\"ae\":"
+		out.RawString(prefix)
+		out.String(string(in.ActualEquity))
+	}
+	{
+		const prefix string = ",\"m\":"
+		out.RawString(prefix)
+		out.String(string(in.AccountMaintMargin))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v WsRiskLevelChangeEvent) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinancePortfoliomargin(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v WsRiskLevelChangeEvent) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinancePortfoliomargin(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *WsRiskLevelChangeEvent) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinancePortfoliomargin(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *WsRiskLevelChangeEvent) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinancePortfoliomargin(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinancePortfoliomargin1(in *jlexer.Lexer, out *UMPositionRisk) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "symbol":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Symbol = string(in.String())
+			}
+		case "positionAmt":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.PositionAmt).UnmarshalJSON(data))
+				}
+			}
+		case "entryPrice":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.EntryPrice).UnmarshalJSON(data))
+				}
+			}
+		case "markPrice":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.MarkPrice = string(in.String())
+			}
+		case "unRealizedProfit":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.UnRealizedProfit = string(in.String())
+			}
+		case "liquidationPrice":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.LiquidationPrice = string(in.String())
+			}
+		case "leverage":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Leverage = string(in.String())
+			}
+		case "maxNotionalValue":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.MaxNotionalValue = string(in.String())
+			}
+		case "positionSide":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.PositionSide = PositionSideType(in.String())
+			}
+		case "notional":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Notional = string(in.String())
+			}
+		case "updateTime":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.UpdateTime = int64(in.Int64())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinancePortfoliomargin1(out *jwriter.Writer, in UMPositionRisk) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"symbol\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Symbol))
+	}
+	{
+		const prefix string = ",\"positionAmt\":"
+		out.RawString(prefix)
+		out.Raw((in.PositionAmt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"entryPrice\":"
+		out.RawString(prefix)
+		out.Raw((in.EntryPrice).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"markPrice\":"
+		out.RawString(prefix)
+		out.String(string(in.MarkPrice))
+	}
+	{
+		const prefix string = ",\"unRealizedProfit\":"
+		out.RawString(prefix)
+		out.String(string(in.UnRealizedProfit))
+	}
+	{
+		const prefix string = ",\"liquidationPrice\":"
+		out.RawString(prefix)
+		out.String(string(in.LiquidationPrice))
+	}
+	{
+		const prefix string = ",\"leverage\":"
+		out.RawString(prefix)
+		out.String(string(in.Leverage))
+	}
+	{
+		const prefix string = ",\"maxNotionalValue\":"
+		out.RawString(prefix)
+		out.String(string(in.MaxNotionalValue))
+	}
+	{
+		const prefix string = ",\"positionSide\":"
+		out.RawString(prefix)
+		out.String(string(in.PositionSide))
+	}
+	{
+		const prefix string = ",\"notional\":"
+		out.RawString(prefix)
+		out.String(string(in.Notional))
+	}
+	{
+		const prefix string = ",\"updateTime\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.UpdateTime))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v UMPositionRisk) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinancePortfoliomargin1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v UMPositionRisk) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinancePortfoliomargin1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *UMPositionRisk) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinancePortfoliomargin1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *UMPositionRisk) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinancePortfoliomargin1(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinancePortfoliomargin2(in *jlexer.Lexer, out *UMOrder) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "symbol":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Symbol = string(in.String())
+			}
+		case "orderId":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.OrderID = int64(in.Int64())
+			}
+		case "clientOrderId":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ClientOrderID = string(in.String())
+			}
+		case "price":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Price = string(in.String())
+			}
+		case "avgPrice":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.AvgPrice = string(in.String())
+			}
+		case "origQty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.OrigQuantity = string(in.String())
+			}
+		case "executedQty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ExecutedQuantity = string(in.String())
+			}
+		case "cumQty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.CumQuantity = string(in.String())
+			}
+		case "cumQuote":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.CumQuote = string(in.String())
+			}
+		case "reduceOnly":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ReduceOnly = bool(in.Bool())
+			}
+		case "status":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Status = OrderStatusType(in.String())
+			}
+		case "timeInForce":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TimeInForce = TimeInForceType(in.String())
+			}
+		case "type":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Type = OrderType(in.String())
+			}
+		case "side":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Side = SideType(in.String())
+			}
+		case "positionSide":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.PositionSide = PositionSideType(in.String())
+			}
+		case "priceMatch":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.PriceMatch = PriceMatchType(in.String())
+			}
+		case "selfTradePreventionMode":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.SelfTradePreventionMode = SelfTradePreventionMode(in.String())
+			}
+		case "goodTillDate":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.GoodTillDate = int64(in.Int64())
+			}
+		case "updateTime":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.UpdateTime = int64(in.Int64())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinancePortfoliomargin2(out *jwriter.Writer, in UMOrder) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"symbol\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Symbol))
+	}
+	{
+		const prefix string = ",\"orderId\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.OrderID))
+	}
+	{
+		const prefix string = ",\"clientOrderId\":"
+		out.RawString(prefix)
+		out.String(string(in.ClientOrderID))
+	}
+	{
+		const prefix string = ",\"price\":"
+		out.RawString(prefix)
+		out.String(string(in.Price))
+	}
+	{
+		const prefix string = ",\"avgPrice\":"
+		out.RawString(prefix)
+		out.String(string(in.AvgPrice))
+	}
+	{
+		const prefix string = ",\"origQty\":"
+		out.RawString(prefix)
+		out.String(string(in.OrigQuantity))
+	}
+	{
+		const prefix string = ",\"executedQty\":"
+		out.RawString(prefix)
+		out.String(string(in.ExecutedQuantity))
+	}
+	{
+		const prefix string = ",\"cumQty\":"
+		out.RawString(prefix)
+		out.String(string(in.CumQuantity))
+	}
+	{
+		const prefix string = ",\"cumQuote\":"
+		out.RawString(prefix)
+		out.String(string(in.CumQuote))
+	}
+	{
+		const prefix string = ",\"reduceOnly\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.ReduceOnly))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	{
+		const prefix string = ",\"timeInForce\":"
+		out.RawString(prefix)
+		out.String(string(in.TimeInForce))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"side\":"
+		out.RawString(prefix)
+		out.String(string(in.Side))
+	}
+	{
+		const prefix string = ",\"positionSide\":"
+		out.RawString(prefix)
+		out.String(string(in.PositionSide))
+	}
+	{
+		const prefix string = ",\"priceMatch\":"
+		out.RawString(prefix)
+		out.String(string(in.PriceMatch))
+	}
+	{
+		const prefix string = ",\"selfTradePreventionMode\":"
+		out.RawString(prefix)
+		out.String(string(in.SelfTradePreventionMode))
+	}
+	{
+		const prefix string = ",\"goodTillDate\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.GoodTillDate))
+	}
+	{
+		const prefix string = ",\"updateTime\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.UpdateTime))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v UMOrder) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinancePortfoliomargin2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v UMOrder) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinancePortfoliomargin2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *UMOrder) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinancePortfoliomargin2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *UMOrder) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinancePortfoliomargin2(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinancePortfoliomargin3(in *jlexer.Lexer, out *TransactionResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "tranId":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TranID = int64(in.Int64())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinancePortfoliomargin3(out *jwriter.Writer, in TransactionResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"tranId\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.TranID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TransactionResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinancePortfoliomargin3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TransactionResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinancePortfoliomargin3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TransactionResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinancePortfoliomargin3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TransactionResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinancePortfoliomargin3(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinancePortfoliomargin4(in *jlexer.Lexer, out *MarginOrder) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "symbol":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Symbol = string(in.String())
+			}
+		case "orderId":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.OrderID = int64(in.Int64())
+			}
+		case "clientOrderId":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ClientOrderID = string(in.String())
+			}
+		case "origClientOrderId":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.OrigClientOrderID = string(in.String())
+			}
+		case "transactTime":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TransactTime = int64(in.Int64())
+			}
+		case "price":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Price = string(in.String())
+			}
+		case "origQty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.OrigQuantity = string(in.String())
+			}
+		case "executedQty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ExecutedQuantity = string(in.String())
+			}
+		case "cummulativeQuoteQty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.CummulativeQuoteQuantity = string(in.String())
+			}
+		case "status":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Status = OrderStatusType(in.String())
+			}
+		case "timeInForce":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TimeInForce = TimeInForceType(in.String())
+			}
+		case "type":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Type = OrderType(in.String())
+			}
+		case "side":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Side = SideType(in.String())
+			}
+		case "marginBuyBorrowAmount":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.MarginBuyBorrowAmount = string(in.String())
+			}
+		case "marginBuyBorrowAsset":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.MarginBuyBorrowAsset = string(in.String())
+			}
+		case "selfTradePreventionMode":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.SelfTradePreventionMode = SelfTradePreventionMode(in.String())
+			}
+		case "fills":
+			if in.IsNull() {
+				in.Skip()
+				out.Fills = nil
+			} else {
+				in.Delim('[')
+				if out.Fills == nil {
+					if !in.IsDelim(']') {
+						out.Fills = make([]*Fill, 0, 8)
+					} else {
+						out.Fills = []*Fill{}
+					}
+				} else {
+					out.Fills = (out.Fills)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 *Fill
+					if in.IsNull() {
+						in.Skip()
+						v1 = nil
+					} else {
+						if v1 == nil {
+							v1 = new(Fill)
+						}
+						if in.IsNull() {
+							in.Skip()
+						} else {
+							(*v1).UnmarshalEasyJSON(in)
+						}
+					}
+					out.Fills = append(out.Fills, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinancePortfoliomargin4(out *jwriter.Writer, in MarginOrder) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"symbol\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Symbol))
+	}
+	{
+		const prefix string = ",\"orderId\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.OrderID))
+	}
+	{
+		const prefix string = ",\"clientOrderId\":"
+		out.RawString(prefix)
+		out.String(string(in.ClientOrderID))
+	}
+	if in.OrigClientOrderID != "" {
+		const prefix string = ",\"origClientOrderId\":"
+		out.RawString(prefix)
+		out.String(string(in.OrigClientOrderID))
+	}
+	{
+		const prefix string = ",\"transactTime\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.TransactTime))
+	}
+	{
+		const prefix string = ",\"price\":"
+		out.RawString(prefix)
+		out.String(string(in.Price))
+	}
+	{
+		const prefix string = ",\"origQty\":"
+		out.RawString(prefix)
+		out.String(string(in.OrigQuantity))
+	}
+	{
+		const prefix string = ",\"executedQty\":"
+		out.RawString(prefix)
+		out.String(string(in.ExecutedQuantity))
+	}
+	{
+		const prefix string = ",\"cummulativeQuoteQty\":"
+		out.RawString(prefix)
+		out.String(string(in.CummulativeQuoteQuantity))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	{
+		const prefix string = ",\"timeInForce\":"
+		out.RawString(prefix)
+		out.String(string(in.TimeInForce))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"side\":"
+		out.RawString(prefix)
+		out.String(string(in.Side))
+	}
+	if in.MarginBuyBorrowAmount != "" {
+		const prefix string = ",\"marginBuyBorrowAmount\":"
+		out.RawString(prefix)
+		out.String(string(in.MarginBuyBorrowAmount))
+	}
+	if in.MarginBuyBorrowAsset != "" {
+		const prefix string = ",\"marginBuyBorrowAsset\":"
+		out.RawString(prefix)
+		out.String(string(in.MarginBuyBorrowAsset))
+	}
+	{
+		const prefix string = ",\"selfTradePreventionMode\":"
+		out.RawString(prefix)
+		out.String(string(in.SelfTradePreventionMode))
+	}
+	if len(in.Fills) != 0 {
+		const prefix string = ",\"fills\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v2, v3 := range in.Fills {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				if v3 == nil {
+					out.RawString("null")
+				} else {
+					(*v3).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MarginOrder) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinancePortfoliomargin4(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MarginOrder) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinancePortfoliomargin4(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MarginOrder) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinancePortfoliomargin4(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MarginOrder) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinancePortfoliomargin4(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinancePortfoliomargin5(in *jlexer.Lexer, out *Fill) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "tradeId":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TradeID = int64(in.Int64())
+			}
+		case "price":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Price = string(in.String())
+			}
+		case "qty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Quantity = string(in.String())
+			}
+		case "commission":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Commission = string(in.String())
+			}
+		case "commissionAsset":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.CommissionAsset = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinancePortfoliomargin5(out *jwriter.Writer, in Fill) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"tradeId\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.TradeID))
+	}
+	{
+		const prefix string = ",\"price\":"
+		out.RawString(prefix)
+		out.String(string(in.Price))
+	}
+	{
+		const prefix string = ",\"qty\":"
+		out.RawString(prefix)
+		out.String(string(in.Quantity))
+	}
+	{
+		const prefix string = ",\"commission\":"
+		out.RawString(prefix)
+		out.String(string(in.Commission))
+	}
+	{
+		const prefix string = ",\"commissionAsset\":"
+		out.RawString(prefix)
+		out.String(string(in.CommissionAsset))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Fill) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinancePortfoliomargin5(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Fill) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinancePortfoliomargin5(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Fill) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinancePortfoliomargin5(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Fill) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinancePortfoliomargin5(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinancePortfoliomargin6(in *jlexer.Lexer, out *CMPositionRisk) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "symbol":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Symbol = string(in.String())
+			}
+		case "positionAmt":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.PositionAmt).UnmarshalJSON(data))
+				}
+			}
+		case "entryPrice":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.EntryPrice).UnmarshalJSON(data))
+				}
+			}
+		case "markPrice":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.MarkPrice = string(in.String())
+			}
+		case "unRealizedProfit":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.UnRealizedProfit = string(in.String())
+			}
+		case "liquidationPrice":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.LiquidationPrice = string(in.String())
+			}
+		case "leverage":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Leverage = string(in.String())
+			}
+		case "maxQty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.MaxQuantity = string(in.String())
+			}
+		case "positionSide":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.PositionSide = PositionSideType(in.String())
+			}
+		case "notionalValue":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.NotionalValue = string(in.String())
+			}
+		case "updateTime":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.UpdateTime = int64(in.Int64())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinancePortfoliomargin6(out *jwriter.Writer, in CMPositionRisk) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"symbol\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Symbol))
+	}
+	{
+		const prefix string = ",\"positionAmt\":"
+		out.RawString(prefix)
+		out.Raw((in.PositionAmt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"entryPrice\":"
+		out.RawString(prefix)
+		out.Raw((in.EntryPrice).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"markPrice\":"
+		out.RawString(prefix)
+		out.String(string(in.MarkPrice))
+	}
+	{
+		const prefix string = ",\"unRealizedProfit\":"
+		out.RawString(prefix)
+		out.String(string(in.UnRealizedProfit))
+	}
+	{
+		const prefix string = ",\"liquidationPrice\":"
+		out.RawString(prefix)
+		out.String(string(in.LiquidationPrice))
+	}
+	{
+		const prefix string = ",\"leverage\":"
+		out.RawString(prefix)
+		out.String(string(in.Leverage))
+	}
+	{
+		const prefix string = ",\"maxQty\":"
+		out.RawString(prefix)
+		out.String(string(in.MaxQuantity))
+	}
+	{
+		const prefix string = ",\"positionSide\":"
+		out.RawString(prefix)
+		out.String(string(in.PositionSide))
+	}
+	{
+		const prefix string = ",\"notionalValue\":"
+		out.RawString(prefix)
+		out.String(string(in.NotionalValue))
+	}
+	{
+		const prefix string = ",\"updateTime\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.UpdateTime))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CMPositionRisk) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinancePortfoliomargin6(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CMPositionRisk) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinancePortfoliomargin6(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CMPositionRisk) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinancePortfoliomargin6(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CMPositionRisk) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinancePortfoliomargin6(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinancePortfoliomargin7(in *jlexer.Lexer, out *CMOrder) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "symbol":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Symbol = string(in.String())
+			}
+		case "pair":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Pair = string(in.String())
+			}
+		case "orderId":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.OrderID = int64(in.Int64())
+			}
+		case "clientOrderId":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ClientOrderID = string(in.String())
+			}
+		case "price":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Price = string(in.String())
+			}
+		case "avgPrice":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.AvgPrice = string(in.String())
+			}
+		case "origQty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.OrigQuantity = string(in.String())
+			}
+		case "executedQty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ExecutedQuantity = string(in.String())
+			}
+		case "cumQty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.CumQuantity = string(in.String())
+			}
+		case "cumBase":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.CumBase = string(in.String())
+			}
+		case "reduceOnly":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ReduceOnly = bool(in.Bool())
+			}
+		case "status":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Status = OrderStatusType(in.String())
+			}
+		case "timeInForce":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TimeInForce = TimeInForceType(in.String())
+			}
+		case "type":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Type = OrderType(in.String())
+			}
+		case "side":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Side = SideType(in.String())
+			}
+		case "positionSide":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.PositionSide = PositionSideType(in.String())
+			}
+		case "updateTime":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.UpdateTime = int64(in.Int64())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinancePortfoliomargin7(out *jwriter.Writer, in CMOrder) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"symbol\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Symbol))
+	}
+	{
+		const prefix string = ",\"pair\":"
+		out.RawString(prefix)
+		out.String(string(in.Pair))
+	}
+	{
+		const prefix string = ",\"orderId\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.OrderID))
+	}
+	{
+		const prefix string = ",\"clientOrderId\":"
+		out.RawString(prefix)
+		out.String(string(in.ClientOrderID))
+	}
+	{
+		const prefix string = ",\"price\":"
+		out.RawString(prefix)
+		out.String(string(in.Price))
+	}
+	{
+		const prefix string = ",\"avgPrice\":"
+		out.RawString(prefix)
+		out.String(string(in.AvgPrice))
+	}
+	{
+		const prefix string = ",\"origQty\":"
+		out.RawString(prefix)
+		out.String(string(in.OrigQuantity))
+	}
+	{
+		const prefix string = ",\"executedQty\":"
+		out.RawString(prefix)
+		out.String(string(in.ExecutedQuantity))
+	}
+	{
+		const prefix string = ",\"cumQty\":"
+		out.RawString(prefix)
+		out.String(string(in.CumQuantity))
+	}
+	{
+		const prefix string = ",\"cumBase\":"
+		out.RawString(prefix)
+		out.String(string(in.CumBase))
+	}
+	{
+		const prefix string = ",\"reduceOnly\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.ReduceOnly))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	{
+		const prefix string = ",\"timeInForce\":"
+		out.RawString(prefix)
+		out.String(string(in.TimeInForce))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"side\":"
+		out.RawString(prefix)
+		out.String(string(in.Side))
+	}
+	{
+		const prefix string = ",\"positionSide\":"
+		out.RawString(prefix)
+		out.String(string(in.PositionSide))
+	}
+	{
+		const prefix string = ",\"updateTime\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.UpdateTime))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CMOrder) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinancePortfoliomargin7(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CMOrder) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinancePortfoliomargin7(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CMOrder) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinancePortfoliomargin7(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CMOrder) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinancePortfoliomargin7(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinancePortfoliomargin8(in *jlexer.Lexer, out *Balance) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "asset":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Asset = string(in.String())
+			}
+		case "totalWalletBalance":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.TotalWalletBalance).UnmarshalJSON(data))
+				}
+			}
+		case "crossMarginAsset":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.CrossMarginAsset).UnmarshalJSON(data))
+				}
+			}
+		case "crossMarginBorrowed":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.CrossMarginBorrowed).UnmarshalJSON(data))
+				}
+			}
+		case "crossMarginFree":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.CrossMarginFree).UnmarshalJSON(data))
+				}
+			}
+		case "crossMarginInterest":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.CrossMarginInterest).UnmarshalJSON(data))
+				}
+			}
+		case "crossMarginLocked":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.CrossMarginLocked).UnmarshalJSON(data))
+				}
+			}
+		case "umWalletBalance":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.UMWalletBalance).UnmarshalJSON(data))
+				}
+			}
+		case "umUnrealizedPNL":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.UMUnrealizedPNL).UnmarshalJSON(data))
+				}
+			}
+		case "cmWalletBalance":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.CMWalletBalance).UnmarshalJSON(data))
+				}
+			}
+		case "cmUnrealizedPNL":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.CMUnrealizedPNL).UnmarshalJSON(data))
+				}
+			}
+		case "negativeBalance":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.NegativeBalance).UnmarshalJSON(data))
+				}
+			}
+		case "updateTime":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.UpdateTime = int64(in.Int64())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinancePortfoliomargin8(out *jwriter.Writer, in Balance) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"asset\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Asset))
+	}
+	{
+		const prefix string = ",\"totalWalletBalance\":"
+		out.RawString(prefix)
+		out.Raw((in.TotalWalletBalance).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"crossMarginAsset\":"
+		out.RawString(prefix)
+		out.Raw((in.CrossMarginAsset).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"crossMarginBorrowed\":"
+		out.RawString(prefix)
+		out.Raw((in.CrossMarginBorrowed).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"crossMarginFree\":"
+		out.RawString(prefix)
+		out.Raw((in.CrossMarginFree).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"crossMarginInterest\":"
+		out.RawString(prefix)
+		out.Raw((in.CrossMarginInterest).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"crossMarginLocked\":"
+		out.RawString(prefix)
+		out.Raw((in.CrossMarginLocked).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"umWalletBalance\":"
+		out.RawString(prefix)
+		out.Raw((in.UMWalletBalance).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"umUnrealizedPNL\":"
+		out.RawString(prefix)
+		out.Raw((in.UMUnrealizedPNL).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"cmWalletBalance\":"
+		out.RawString(prefix)
+		out.Raw((in.CMWalletBalance).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"cmUnrealizedPNL\":"
+		out.RawString(prefix)
+		out.Raw((in.CMUnrealizedPNL).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"negativeBalance\":"
+		out.RawString(prefix)
+		out.Raw((in.NegativeBalance).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"updateTime\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.UpdateTime))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Balance) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinancePortfoliomargin8(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Balance) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinancePortfoliomargin8(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Balance) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinancePortfoliomargin8(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Balance) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinancePortfoliomargin8(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinancePortfoliomargin9(in *jlexer.Lexer, out *Account) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "uniMMR":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.UniMMR = string(in.String())
+			}
+		case "accountEquity":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.AccountEquity = string(in.String())
+			}
+		case "actualEquity":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ActualEquity = string(in.String())
+			}
+		case "accountInitialMargin":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.AccountInitialMargin = string(in.String())
+			}
+		case "accountMaintMargin":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.AccountMaintMargin = string(in.String())
+			}
+		case "accountStatus":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.AccountStatus = string(in.String())
+			}
+		case "virtualMaxWithdrawAmount":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.VirtualMaxWithdrawAmount = string(in.String())
+			}
+		case "totalAvailableBalance":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TotalAvailableBalance = string(in.String())
+			}
+		case "totalMarginOpenLoss":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TotalMarginOpenLoss = string(in.String())
+			}
+		case "updateTime":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.UpdateTime = int64(in.Int64())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinancePortfoliomargin9(out *jwriter.Writer, in Account) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"uniMMR\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.UniMMR))
+	}
+	{
+		const prefix string = ",\"accountEquity\":"
+		out.RawString(prefix)
+		out.String(string(in.AccountEquity))
+	}
+	{
+		const prefix string = ",\"actualEquity\":"
+		out.RawString(prefix)
+		out.String(string(in.ActualEquity))
+	}
+	{
+		const prefix string = ",\"accountInitialMargin\":"
+		out.RawString(prefix)
+		out.String(string(in.AccountInitialMargin))
+	}
+	{
+		const prefix string = ",\"accountMaintMargin\":"
+		out.RawString(prefix)
+		out.String(string(in.AccountMaintMargin))
+	}
+	{
+		const prefix string = ",\"accountStatus\":"
+		out.RawString(prefix)
+		out.String(string(in.AccountStatus))
+	}
+	{
+		const prefix string = ",\"virtualMaxWithdrawAmount\":"
+		out.RawString(prefix)
+		out.String(string(in.VirtualMaxWithdrawAmount))
+	}
+	{
+		const prefix string = ",\"totalAvailableBalance\":"
+		out.RawString(prefix)
+		out.String(string(in.TotalAvailableBalance))
+	}
+	{
+		const prefix string = ",\"totalMarginOpenLoss\":"
+		out.RawString(prefix)
+		out.String(string(in.TotalMarginOpenLoss))
+	}
+	{
+		const prefix string = ",\"updateTime\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.UpdateTime))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Account) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinancePortfoliomargin9(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Account) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinancePortfoliomargin9(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Account) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinancePortfoliomargin9(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Account) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinancePortfoliomargin9(l, v)
+}
@@ -0,0 +1,87 @@
+package portfoliomargin
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// GetUMPositionRiskService get UM position risk
+type GetUMPositionRiskService struct {
+	c      *Client
+	symbol string
+}
+
+// Symbol set symbol
+func (s *GetUMPositionRiskService) Symbol(symbol string) *GetUMPositionRiskService {
+	s.symbol = symbol
+	return s
+}
+
+// Do send request
+func (s *GetUMPositionRiskService) Do(ctx context.Context, opts ...RequestOption) (res []*UMPositionRisk, err error) {
+	r := &request{
+		Service:  "GetUMPositionRiskService",
+		Method:   http.MethodGet,
+		Endpoint: "/papi/v1/um/positionRisk",
+		SecType:  secTypeSigned,
+	}
+	if s.symbol != "" {
+		r.SetParam("symbol", s.symbol)
+	}
+	data, _, err := s.c.CallAPI(ctx, r, opts...)
+	if err != nil {
+		return []*UMPositionRisk{}, err
+	}
+	res = make([]*UMPositionRisk, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*UMPositionRisk{}, err
+	}
+	return res, nil
+}
+
+// GetCMPositionRiskService get CM position risk
+type GetCMPositionRiskService struct {
+	c           *Client
+	marginAsset string
+	pair        string
+}
+
+// MarginAsset set marginAsset
+func (s *GetCMPositionRiskService) MarginAsset(marginAsset string) *GetCMPositionRiskService {
+	s.marginAsset = marginAsset
+	return s
+}
+
+// Pair set pair
+func (s *GetCMPositionRiskService) Pair(pair string) *GetCMPositionRiskService {
+	s.pair = pair
+	return s
+}
+
+// Do send request
+func (s *GetCMPositionRiskService) Do(ctx context.Context, opts ...RequestOption) (res []*CMPositionRisk, err error) {
+	r := &request{
+		Service:  "GetCMPositionRiskService",
+		Method:   http.MethodGet,
+		Endpoint: "/papi/v1/cm/positionRisk",
+		SecType:  secTypeSigned,
+	}
+	if s.marginAsset != "" {
+		r.SetParam("marginAsset", s.marginAsset)
+	}
+	if s.pair != "" {
+		r.SetParam("pair", s.pair)
+	}
+	data, _, err := s.c.CallAPI(ctx, r, opts...)
+	if err != nil {
+		return []*CMPositionRisk{}, err
+	}
+	res = make([]*CMPositionRisk, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*CMPositionRisk{}, err
+	}
+	return res, nil
+}
@@ -0,0 +1,56 @@
+package portfoliomargin
+
+import (
+	"strings"
+
+	"github.com/ward-cap/go-binance/common"
+)
+
+// DefaultRateLimits are the Portfolio Margin limits, which have no
+// exchangeInfo to be fetched from
+var DefaultRateLimits = []common.RateLimit{
+	{RateLimitType: common.RateLimitTypeRequestWeight, Interval: "MINUTE", IntervalNum: 1, Limit: 6000},
+	{RateLimitType: common.RateLimitTypeOrders, Interval: "MINUTE", IntervalNum: 1, Limit: 1200},
+}
+
+// endpointWeights lists the request weight of /papi endpoints, keyed by
+// method and endpoint. Endpoints missing here weigh 1.
+var endpointWeights = map[string]int64{
+	"GET /papi/v1/balance":           20,
+	"GET /papi/v1/account":           20,
+	"GET /papi/v1/um/positionRisk":   5,
+	"GET /papi/v1/cm/positionRisk":   1,
+	"POST /papi/v1/auto-collection":  750,
+	"POST /papi/v1/asset-collection": 30,
+	"POST /papi/v1/bnb-transfer":     750,
+	"POST /papi/v1/margin/order":     1,
+	"DELETE /papi/v1/margin/order":   2,
+}
+
+// endpointOrders lists the endpoints counting against the order rate limits
+var endpointOrders = map[string]int64{
+	"POST /papi/v1/um/order":     1,
+	"POST /papi/v1/cm/order":     1,
+	"POST /papi/v1/margin/order": 1,
+}
+
+// requestCost return the weight and order count of r
+func requestCost(r *request) common.RequestCost {
+	if !strings.HasPrefix(r.Endpoint, "/papi/") {
+		return common.RequestCost{}
+	}
+	key := r.Method + " " + r.Endpoint
+	cost := common.RequestCost{Requests: 1, Weight: 1, Orders: endpointOrders[key]}
+	if weight, ok := endpointWeights[key]; ok {
+		cost.Weight = weight
+	}
+	return cost
+}
+
+// EnableRateLimiter throttle every later request with DefaultRateLimits. In
+// fail fast mode requests that would exceed a limit return a
+// *common.RateLimitError instead of waiting. Call it before the client is
+// shared between goroutines.
+func (c *Client) EnableRateLimiter(failFast bool) {
+	c.RateLimiter = common.NewRateLimiter(DefaultRateLimits, failFast)
+}
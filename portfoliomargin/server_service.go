@@ -0,0 +1,22 @@
+package portfoliomargin
+
+import (
+	"context"
+	"net/http"
+)
+
+// PingService ping server
+type PingService struct {
+	c *Client
+}
+
+// Do send request
+func (s *PingService) Do(ctx context.Context, opts ...RequestOption) (err error) {
+	r := &request{
+		Service:  "PingService",
+		Method:   http.MethodGet,
+		Endpoint: "/papi/v1/ping",
+	}
+	_, _, err = s.c.CallAPI(ctx, r, opts...)
+	return err
+}
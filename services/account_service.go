@@ -10,15 +10,18 @@ type GetAccountService struct {
 	c *Client
 }
 
-// Do send request
-func (s *GetAccountService) Do(ctx context.Context, opts ...RequestOption) (res *Account, err error) {
-	r := &request{
+func (s *GetAccountService) newRequest() *request {
+	return &request{
 		service:  "GetAccountService",
 		method:   http.MethodGet,
 		endpoint: "/api/v3/account",
 		secType:  secTypeSigned,
 	}
-	data, err := s.c.callAPI(ctx, r, opts...)
+}
+
+// Do send request
+func (s *GetAccountService) Do(ctx context.Context, opts ...RequestOption) (res *Account, err error) {
+	data, err := s.c.callAPI(ctx, s.newRequest(), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -30,6 +33,20 @@ func (s *GetAccountService) Do(ctx context.Context, opts ...RequestOption) (res
 	return res, nil
 }
 
+// DoV2 send request and return the decimal typed AccountV2
+func (s *GetAccountService) DoV2(ctx context.Context, opts ...RequestOption) (res *AccountV2, err error) {
+	data, err := s.c.callAPI(ctx, s.newRequest(), opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(AccountV2)
+	err = jsonCodec.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
 // GetAccountSnapshotService all account orders; active, canceled, or filled
 type GetAccountSnapshotService struct {
 	c           *Client
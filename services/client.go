@@ -3,13 +3,11 @@ package binance
 import (
 	"bytes"
 	"context"
-	"crypto/hmac"
-	"crypto/sha256"
-	"encoding/hex"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"sync/atomic"
 	"time"
 
 	jsoniter "github.com/json-iterator/go"
@@ -33,6 +31,13 @@ type NewOrderRespType string
 // OrderStatusType define order status type
 type OrderStatusType string
 
+// CancelReplaceModeType define whether a cancel-replace places the new order
+// when the cancel fails
+type CancelReplaceModeType string
+
+// CancelReplaceResultType define the result of each step of a cancel-replace
+type CancelReplaceResultType string
+
 // SymbolType define symbol type
 type SymbolType string
 
@@ -45,6 +50,12 @@ type SymbolFilterType string
 // UserDataEventType define spot user data event type
 type UserDataEventType string
 
+// ExecutionType define execution type of an execution report
+type ExecutionType string
+
+// UserStreamAccountType define the account a user data stream belongs to
+type UserStreamAccountType string
+
 // MarginTransferType define margin transfer type
 type MarginTransferType int
 
@@ -99,12 +110,56 @@ type AccountType string
 
 // Endpoints
 const (
-	baseAPIMainURL = "https://api.binance.com"
+	baseAPIMainURL      = "https://api.binance.com"
+	baseWsMainURL       = "wss://stream.binance.com:9443"
+	baseWsAPIMainURL    = "wss://ws-api.binance.com:443/ws-api/v3"
+	baseAPITestnetURL   = "https://testnet.binance.vision"
+	baseWsTestnetURL    = "wss://stream.testnet.binance.vision"
+	baseWsAPITestnetURL = "wss://ws-api.testnet.binance.vision/ws-api/v3"
+	baseAPIDemoURL      = "https://demo-api.binance.com"
+	baseWsDemoURL       = "wss://demo-stream.binance.com:9443"
+	baseWsAPIDemoURL    = "wss://demo-ws-api.binance.com/ws-api/v3"
 )
 
 // UseTestnet switch all the API endpoints from production to the testnet
 var UseTestnet = false
 
+// Environment define the REST, websocket and WebSocket API endpoints of a
+// deployment. They are always set together so a client never mixes hosts.
+type Environment struct {
+	BaseURL      string
+	BaseWsURL    string
+	BaseWsAPIURL string
+}
+
+// MainnetEnvironment return the endpoints of the production deployment
+func MainnetEnvironment() Environment {
+	return Environment{
+		BaseURL:      baseAPIMainURL,
+		BaseWsURL:    baseWsMainURL,
+		BaseWsAPIURL: baseWsAPIMainURL,
+	}
+}
+
+// TestnetEnvironment return the endpoints of the spot testnet
+func TestnetEnvironment() Environment {
+	return Environment{
+		BaseURL:      baseAPITestnetURL,
+		BaseWsURL:    baseWsTestnetURL,
+		BaseWsAPIURL: baseWsAPITestnetURL,
+	}
+}
+
+// DemoEnvironment return the endpoints of demo trading, which mirrors the
+// production market data with simulated balances
+func DemoEnvironment() Environment {
+	return Environment{
+		BaseURL:      baseAPIDemoURL,
+		BaseWsURL:    baseWsDemoURL,
+		BaseWsAPIURL: baseWsAPIDemoURL,
+	}
+}
+
 // Redefining the standard package
 var jsonCodec = jsoniter.ConfigCompatibleWithStandardLibrary
 
@@ -129,6 +184,13 @@ const (
 	NewOrderRespTypeRESULT NewOrderRespType = "RESULT"
 	NewOrderRespTypeFULL   NewOrderRespType = "FULL"
 
+	CancelReplaceModeTypeStopOnFailure CancelReplaceModeType = "STOP_ON_FAILURE"
+	CancelReplaceModeTypeAllowFailure  CancelReplaceModeType = "ALLOW_FAILURE"
+
+	CancelReplaceResultTypeSuccess      CancelReplaceResultType = "SUCCESS"
+	CancelReplaceResultTypeFailure      CancelReplaceResultType = "FAILURE"
+	CancelReplaceResultTypeNotAttempted CancelReplaceResultType = "NOT_ATTEMPTED"
+
 	OrderStatusTypeNew             OrderStatusType = "NEW"
 	OrderStatusTypePartiallyFilled OrderStatusType = "PARTIALLY_FILLED"
 	OrderStatusTypeFilled          OrderStatusType = "FILLED"
@@ -163,6 +225,19 @@ const (
 	UserDataEventTypeBalanceUpdate           UserDataEventType = "balanceUpdate"
 	UserDataEventTypeExecutionReport         UserDataEventType = "executionReport"
 	UserDataEventTypeListStatus              UserDataEventType = "ListStatus"
+	UserDataEventTypeListenKeyExpired        UserDataEventType = "listenKeyExpired"
+
+	ExecutionTypeNew             ExecutionType = "NEW"
+	ExecutionTypeCanceled        ExecutionType = "CANCELED"
+	ExecutionTypeReplaced        ExecutionType = "REPLACED"
+	ExecutionTypeRejected        ExecutionType = "REJECTED"
+	ExecutionTypeTrade           ExecutionType = "TRADE"
+	ExecutionTypeExpired         ExecutionType = "EXPIRED"
+	ExecutionTypeTradePrevention ExecutionType = "TRADE_PREVENTION"
+
+	UserStreamAccountTypeSpot           UserStreamAccountType = "SPOT"
+	UserStreamAccountTypeMargin         UserStreamAccountType = "MARGIN"
+	UserStreamAccountTypeIsolatedMargin UserStreamAccountType = "ISOLATED_MARGIN"
 
 	MarginTransferTypeToMargin MarginTransferType = 1
 	MarginTransferTypeToMain   MarginTransferType = 2
@@ -244,9 +319,27 @@ func FormatTimestamp(t time.Time) int64 {
 	return t.UnixNano() / int64(time.Millisecond)
 }
 
+// getEnvironment return the environment according the UseTestnet flag
+func getEnvironment() Environment {
+	if UseTestnet {
+		return TestnetEnvironment()
+	}
+	return MainnetEnvironment()
+}
+
 // getAPIEndpoint return the base endpoint of the Rest API according the UseTestnet flag
 func getAPIEndpoint() string {
-	return baseAPIMainURL
+	return getEnvironment().BaseURL
+}
+
+// getWsEndpoint return the base endpoint of the market data websocket streams
+func getWsEndpoint() string {
+	return getEnvironment().BaseWsURL
+}
+
+// getWsAPIEndpoint return the endpoint of the WebSocket API
+func getWsAPIEndpoint() string {
+	return getEnvironment().BaseWsAPIURL
 }
 
 // NewClient initialize an API client instance with API key and secret key.
@@ -257,23 +350,31 @@ func NewClient(apiKey, secretKey string, client *http.Client) *Client {
 		client = http.DefaultClient
 	}
 	return &Client{
-		APIKey:     apiKey,
-		SecretKey:  secretKey,
-		BaseURL:    getAPIEndpoint(),
-		UserAgent:  "Binance/golang",
-		HTTPClient: client,
+		APIKey:       apiKey,
+		SecretKey:    secretKey,
+		BaseURL:      getAPIEndpoint(),
+		BaseWsURL:    getWsEndpoint(),
+		BaseWsAPIURL: getWsAPIEndpoint(),
+		UserAgent:    "Binance/golang",
+		HTTPClient:   client,
 	}
 }
 
-// Sign returns an HMAC SHA256 signature for the provided payload.
+// Sign returns the signature of the provided payload, made with Signer or,
+// when no signer is set, with HMAC SHA256 over SecretKey.
 func (c *Client) Sign(payload string) string {
-	h := hmac.New(sha256.New, []byte(c.SecretKey))
-	_, err := io.WriteString(h, payload)
+	signature, err := c.sign(payload)
 	if err != nil {
 		panic(err)
 	}
+	return signature
+}
 
-	return hex.EncodeToString(h.Sum(nil))
+func (c *Client) sign(payload string) (string, error) {
+	if c.Signer != nil {
+		return c.Signer.Sign(payload)
+	}
+	return common.NewHMACSigner(c.SecretKey).Sign(payload)
 }
 
 //func NewProxiedClient(apiKey, secretKey, proxyUrl string) *Client {
@@ -299,19 +400,29 @@ func (c *Client) Sign(payload string) string {
 
 // NewFuturesClient initialize client for futures API
 func NewFuturesClient(apiKey, secretKey string, client *http.Client) *futures.Client {
-	return futures.NewClient(apiKey, secretKey, client)
+	c := futures.NewClient(apiKey, secretKey, client)
+	if UseTestnet {
+		c.SetEnvironment(futures.TestnetEnvironment())
+	}
+	return c
 }
 
 // Client define API client
 type Client struct {
-	APIKey     string
-	SecretKey  string
-	BaseURL    string
-	UserAgent  string
-	HTTPClient *http.Client
-	Debug      bool
-	TimeOffset int64
-	Logger     *zap.SugaredLogger
+	APIKey       string
+	SecretKey    string
+	Signer       common.Signer
+	BaseURL      string
+	BaseWsURL    string
+	BaseWsAPIURL string
+	UserAgent    string
+	HTTPClient   *http.Client
+	Debug        bool
+	TimeOffset   int64
+	Logger       *zap.SugaredLogger
+	RateLimiter  *common.RateLimiter
+	RetryPolicy  common.RetryPolicy
+	ClockSync    *common.ClockSync
 }
 
 func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
@@ -329,7 +440,7 @@ func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 		r.setParam(recvWindowKey, r.recvWindow)
 	}
 	if r.secType == secTypeSigned {
-		r.setParam(timestampKey, currentTimestamp()-c.TimeOffset)
+		r.setParam(timestampKey, currentTimestamp()-atomic.LoadInt64(&c.TimeOffset))
 	}
 	queryString := r.query.Encode()
 	body := &bytes.Buffer{}
@@ -348,7 +459,10 @@ func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 
 	if r.secType == secTypeSigned {
 		raw := fmt.Sprintf("%s%s", queryString, bodyString)
-		signature := c.Sign(raw)
+		signature, err := c.sign(raw)
+		if err != nil {
+			return err
+		}
 		v := url.Values{}
 		v.Set(signatureKey, signature)
 		if queryString == "" {
@@ -368,31 +482,92 @@ func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 }
 
 func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption) (data []byte, err error) {
-	startedAt := time.Now()
 	service := r.service
 	ctx, span := common.StartRequestSpan(ctx, "go-binance/services", service)
-	defer span.End()
+	defer func() { common.EndRequestSpan(span, err) }()
 
 	err = c.parseRequest(r, opts...)
 	if err != nil {
-		c.logAPIError(ctx, service, r, nil, startedAt, err)
+		c.logAPIError(ctx, service, r, nil, time.Now(), err)
 		return []byte{}, err
 	}
 
-	req, err := http.NewRequestWithContext(ctx, r.method, r.fullURL, r.body)
+	for attempt := 1; ; attempt++ {
+		var statusCode int
+		data, statusCode, err = c.doRequest(ctx, r)
+		if c.ClockSync != nil && isTimestampError(err) {
+			_ = c.ClockSync.Resync(ctx)
+		}
+		if err == nil || c.RetryPolicy == nil || !retrySafe(r, err) {
+			return data, err
+		}
+		delay, ok := c.RetryPolicy.Retry(common.RetryRequest{
+			Service:    service,
+			Method:     r.method,
+			Endpoint:   r.endpoint,
+			Attempt:    attempt,
+			StatusCode: statusCode,
+			Err:        err,
+		})
+		if !ok {
+			return data, err
+		}
+		if serr := common.SleepContext(ctx, delay); serr != nil {
+			return data, err
+		}
+		if r.method != http.MethodGet && !isTimestampError(err) {
+			existing, found, lerr := c.lookupOrder(ctx, r)
+			if lerr != nil {
+				return data, err
+			}
+			if found {
+				return existing, nil
+			}
+		}
+		// parse again for a fresh timestamp and signature
+		if perr := c.parseRequest(r); perr != nil {
+			return data, err
+		}
+	}
+}
+
+// doRequest send r once, r must have been parsed
+func (c *Client) doRequest(ctx context.Context, r *request) (data []byte, statusCode int, err error) {
+	startedAt := time.Now()
+	var req *http.Request
+	var res *http.Response
+	defer func() { c.recordRequest(ctx, r, req, res, startedAt, err) }()
+	service := r.service
+
+	var cost common.RequestCost
+	if c.RateLimiter != nil {
+		cost = requestCost(r)
+	}
+	limited := cost != common.RequestCost{}
+	if limited {
+		if err = c.RateLimiter.Wait(ctx, cost); err != nil {
+			c.logAPIError(ctx, service, r, nil, startedAt, err)
+			return []byte{}, 0, err
+		}
+	}
+
+	req, err = http.NewRequestWithContext(ctx, r.method, r.fullURL, r.body)
 	if err != nil {
 		c.logAPIError(ctx, service, r, nil, startedAt, err)
-		return []byte{}, err
+		return []byte{}, 0, err
 	}
 	req = req.WithContext(common.WithHTTPConnTrace(req.Context()))
 	req.Header = r.header
 
 	c.logAPIRequest(ctx, service, r, req)
 
-	res, err := c.HTTPClient.Do(req)
+	res, err = c.HTTPClient.Do(req)
 	if err != nil {
 		c.logAPIError(ctx, service, r, req, startedAt, err)
-		return []byte{}, err
+		return []byte{}, 0, err
+	}
+	if limited {
+		c.RateLimiter.Update(res.StatusCode, res.Header)
 	}
 	defer func() {
 		cerr := res.Body.Close()
@@ -404,7 +579,7 @@ func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption)
 	data, err = io.ReadAll(res.Body)
 	if err != nil {
 		c.logAPIError(ctx, service, r, req, startedAt, err)
-		return []byte{}, err
+		return []byte{}, res.StatusCode, err
 	}
 
 	c.logAPIResponse(ctx, service, r, req, res, data, startedAt)
@@ -412,11 +587,34 @@ func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption)
 	if res.StatusCode >= http.StatusBadRequest {
 		apiErr := new(common.APIError)
 		_ = jsonCodec.Unmarshal(data, apiErr)
+		apiErr.StatusCode = res.StatusCode
+		apiErr.Endpoint = r.endpoint
+		apiErr.Service = service
 		c.logAPIError(ctx, service, r, req, startedAt, apiErr)
-		return nil, apiErr
+		return nil, res.StatusCode, apiErr
 	}
 
-	return data, nil
+	return data, res.StatusCode, nil
+}
+
+// recordRequest record one attempt of r to the request span and metrics
+func (c *Client) recordRequest(ctx context.Context, r *request, req *http.Request, res *http.Response, startedAt time.Time, err error) {
+	result := common.RequestResult{
+		Service:  r.service,
+		Method:   r.method,
+		Endpoint: r.endpoint,
+		Duration: time.Since(startedAt),
+		Err:      err,
+	}
+	if req != nil {
+		ctx = req.Context()
+		result.Host = req.URL.Host
+	}
+	if res != nil {
+		result.StatusCode = res.StatusCode
+		result.Header = res.Header
+	}
+	common.RecordRequest(ctx, "go-binance/services", result)
 }
 
 func (c *Client) logAPIRequest(ctx context.Context, service string, r *request, req *http.Request) {
@@ -456,7 +654,7 @@ func (c *Client) logAPIResponse(ctx context.Context, service string, r *request,
 		"event.duration", time.Since(startedAt),
 	}
 	if reused, ok := common.HTTPConnReused(req.Context()); ok {
-		fields = append(fields, "http.connection.reused", reused)
+		fields = append(fields, "binance.http.connection_reused", reused)
 	}
 	fields = common.AppendContextField(ctx, fields)
 
@@ -488,12 +686,38 @@ func (c *Client) logAPIError(ctx context.Context, service string, r *request, re
 	c.Logger.Errorw("binance api error", fields...)
 }
 
-// SetApiEndpoint set api Endpoint
+// SetApiEndpoint set api Endpoint. The endpoints of the environment whose
+// REST endpoint is url are all set; any other url only changes BaseURL.
+//
+// Deprecated: use SetEnvironment, which sets the websocket endpoints too.
 func (c *Client) SetApiEndpoint(url string) *Client {
+	for _, env := range []Environment{MainnetEnvironment(), TestnetEnvironment(), DemoEnvironment()} {
+		if env.BaseURL == url {
+			return c.SetEnvironment(env)
+		}
+	}
 	c.BaseURL = url
 	return c
 }
 
+// SetEnvironment set the REST, websocket and WebSocket API endpoints of env.
+// Streams and WebSocket API clients created before keep their endpoints.
+func (c *Client) SetEnvironment(env Environment) *Client {
+	c.BaseURL = env.BaseURL
+	c.BaseWsURL = env.BaseWsURL
+	c.BaseWsAPIURL = env.BaseWsAPIURL
+	return c
+}
+
+// Environment return the endpoints the client uses
+func (c *Client) Environment() Environment {
+	return Environment{
+		BaseURL:      c.BaseURL,
+		BaseWsURL:    c.BaseWsURL,
+		BaseWsAPIURL: c.BaseWsAPIURL,
+	}
+}
+
 // NewPingService init ping service
 func (c *Client) NewPingService() *PingService {
 	return &PingService{c: c}
@@ -574,6 +798,21 @@ func (c *Client) NewCancelOrderService() *CancelOrderService {
 	return &CancelOrderService{c: c}
 }
 
+// NewCancelReplaceOrderService init cancel-replace order service
+func (c *Client) NewCancelReplaceOrderService() *CancelReplaceOrderService {
+	return &CancelReplaceOrderService{c: c}
+}
+
+// NewAmendOrderKeepPriorityService init amend order keep priority service
+func (c *Client) NewAmendOrderKeepPriorityService() *AmendOrderKeepPriorityService {
+	return &AmendOrderKeepPriorityService{c: c}
+}
+
+// NewTestOrderService init test order service
+func (c *Client) NewTestOrderService() *TestOrderService {
+	return &TestOrderService{c: c}
+}
+
 // NewCancelOpenOrdersService init cancel open orders service
 func (c *Client) NewCancelOpenOrdersService() *CancelOpenOrdersService {
 	return &CancelOpenOrdersService{c: c}
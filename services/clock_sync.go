@@ -0,0 +1,34 @@
+package binance
+
+import (
+	"context"
+	"time"
+
+	"github.com/ward-cap/go-binance/common"
+)
+
+// EnableClockSync sync TimeOffset with the server time, then keep syncing it
+// every interval until ctx is done. A -1021 error (timestamp outside
+// recvWindow) triggers an immediate resync. Call it before the client is
+// shared between goroutines.
+func (c *Client) EnableClockSync(ctx context.Context, interval time.Duration) (*common.ClockSync, error) {
+	clock := common.NewClockSync(c.serverTime, &c.TimeOffset)
+	if interval > 0 {
+		clock.Interval = interval
+	}
+	clock.OnError = func(err error) {
+		if c.Logger != nil {
+			c.Logger.Errorw("binance clock sync", "binance.package", "services", "error", err)
+		}
+	}
+	if err := clock.Sync(ctx); err != nil {
+		return nil, err
+	}
+	c.ClockSync = clock
+	go clock.Run(ctx)
+	return clock, nil
+}
+
+func (c *Client) serverTime(ctx context.Context) (int64, error) {
+	return c.NewServerTimeService().Do(ctx)
+}
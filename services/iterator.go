@@ -0,0 +1,210 @@
+package binance
+
+import (
+	"context"
+	"iter"
+	"sync/atomic"
+	"time"
+
+	"github.com/ward-cap/go-binance/common"
+)
+
+// timeRange resolve the range an iterator walks, endTime default to the
+// server time and startTime to one window before endTime, or to 0 when the
+// endpoint has no window
+func (c *Client) timeRange(startTime, endTime *int64, window time.Duration) (int64, int64) {
+	end := currentTimestamp() - atomic.LoadInt64(&c.TimeOffset)
+	if endTime != nil {
+		end = *endTime
+	}
+	var start int64
+	if startTime != nil {
+		start = *startTime
+	} else if window > 0 {
+		start = end - window.Milliseconds() + 1
+	}
+	return start, end
+}
+
+// All iterate over the orders between startTime and endTime in 24 hour
+// windows, endTime default to now and startTime to 24 hours before endTime.
+// orderID and limit are not used.
+func (s *ListOrdersService) All(ctx context.Context, opts ...RequestOption) iter.Seq2[*Order, error] {
+	base := *s
+	start, end := s.c.timeRange(s.startTime, s.endTime, 24*time.Hour)
+	p := &common.Paginator[*Order, int64]{
+		StartTime: start,
+		EndTime:   end,
+		Window:    24 * time.Hour,
+		Limit:     1000,
+		Fetch: func(ctx context.Context, page common.Page) ([]*Order, error) {
+			svc, limit := base, 1000
+			svc.orderID, svc.limit = nil, &limit
+			svc.startTime, svc.endTime = &page.StartTime, &page.EndTime
+			return svc.Do(ctx, opts...)
+		},
+		Key:  func(o *Order) int64 { return o.OrderID },
+		Time: func(o *Order) int64 { return o.Time },
+	}
+	return p.All(ctx)
+}
+
+// All iterate over the trades between startTime and endTime in 24 hour
+// windows, endTime default to now and startTime to 24 hours before endTime.
+// fromID, orderId and limit are not used.
+func (s *ListTradesService) All(ctx context.Context, opts ...RequestOption) iter.Seq2[*TradeV3, error] {
+	base := *s
+	start, end := s.c.timeRange(s.startTime, s.endTime, 24*time.Hour)
+	p := &common.Paginator[*TradeV3, int64]{
+		StartTime: start,
+		EndTime:   end,
+		Window:    24 * time.Hour,
+		Limit:     1000,
+		Fetch: func(ctx context.Context, page common.Page) ([]*TradeV3, error) {
+			svc, limit := base, 1000
+			svc.fromID, svc.orderId, svc.limit = nil, nil, &limit
+			svc.startTime, svc.endTime = &page.StartTime, &page.EndTime
+			return svc.Do(ctx, opts...)
+		},
+		Key:  func(t *TradeV3) int64 { return t.ID },
+		Time: func(t *TradeV3) int64 { return t.Time },
+	}
+	return p.All(ctx)
+}
+
+// All iterate over the aggregate trades between startTime and endTime in
+// 1 hour windows, endTime default to now and startTime to 1 hour before
+// endTime. fromID and limit are not used.
+func (s *AggTradesService) All(ctx context.Context, opts ...RequestOption) iter.Seq2[*AggTrade, error] {
+	base := *s
+	start, end := s.c.timeRange(s.startTime, s.endTime, time.Hour)
+	p := &common.Paginator[*AggTrade, int64]{
+		StartTime: start,
+		EndTime:   end,
+		Window:    time.Hour,
+		Limit:     1000,
+		Fetch: func(ctx context.Context, page common.Page) ([]*AggTrade, error) {
+			svc, limit := base, 1000
+			svc.fromID, svc.limit = nil, &limit
+			svc.startTime, svc.endTime = &page.StartTime, &page.EndTime
+			return svc.Do(ctx, opts...)
+		},
+		Key:  func(t *AggTrade) int64 { return t.AggTradeID },
+		Time: func(t *AggTrade) int64 { return t.Timestamp },
+	}
+	return p.All(ctx)
+}
+
+// All iterate over the deposits between startTime and endTime in 90 day
+// windows, endTime default to now and startTime to 90 days before endTime.
+// offset and limit are not used.
+func (s *ListDepositsService) All(ctx context.Context) iter.Seq2[*Deposit, error] {
+	const window = 90 * 24 * time.Hour
+	base := *s
+	start, end := s.c.timeRange(s.startTime, s.endTime, window)
+	p := &common.Paginator[*Deposit, string]{
+		StartTime: start,
+		EndTime:   end,
+		Window:    window,
+		Limit:     1000,
+		Mode:      common.PageByOffset,
+		Fetch: func(ctx context.Context, page common.Page) ([]*Deposit, error) {
+			svc, offset, limit := base, page.Offset, 1000
+			svc.offset, svc.limit = &offset, &limit
+			svc.startTime, svc.endTime = &page.StartTime, &page.EndTime
+			return svc.Do(ctx)
+		},
+		Key: func(d *Deposit) string { return d.ID },
+	}
+	return p.All(ctx)
+}
+
+// All iterate over the withdrawals between startTime and endTime in 90 day
+// windows, endTime default to now and startTime to 90 days before endTime.
+// offset and limit are not used.
+func (s *ListWithdrawsService) All(ctx context.Context) iter.Seq2[*Withdraw, error] {
+	const window = 90 * 24 * time.Hour
+	base := *s
+	start, end := s.c.timeRange(s.startTime, s.endTime, window)
+	p := &common.Paginator[*Withdraw, string]{
+		StartTime: start,
+		EndTime:   end,
+		Window:    window,
+		Limit:     1000,
+		Mode:      common.PageByOffset,
+		Fetch: func(ctx context.Context, page common.Page) ([]*Withdraw, error) {
+			svc, offset, limit := base, page.Offset, 1000
+			svc.offset, svc.limit = &offset, &limit
+			svc.startTime, svc.endTime = &page.StartTime, &page.EndTime
+			return svc.Do(ctx)
+		},
+		Key: func(w *Withdraw) string { return w.ID },
+	}
+	return p.All(ctx)
+}
+
+// All iterate over the interest history between startTime and endTime in
+// 30 day windows, endTime default to now and startTime to 30 days before
+// endTime. current and size are not used.
+func (s *InterestHistoryService) All(ctx context.Context) iter.Seq2[InterestHistoryElement, error] {
+	const (
+		window = 30 * 24 * time.Hour
+		size   = 100
+	)
+	base := *s
+	start, end := s.c.timeRange(s.startTime, s.endTime, window)
+	p := &common.Paginator[InterestHistoryElement, InterestHistoryElement]{
+		StartTime: start,
+		EndTime:   end,
+		Window:    window,
+		Limit:     size,
+		Mode:      common.PageByOffset,
+		Fetch: func(ctx context.Context, page common.Page) ([]InterestHistoryElement, error) {
+			svc, current, pageSize := base, int32(page.Offset/size+1), int32(size)
+			svc.current, svc.size = &current, &pageSize
+			svc.startTime, svc.endTime = &page.StartTime, &page.EndTime
+			res, err := svc.Do(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return *res, nil
+		},
+		Key: func(e InterestHistoryElement) InterestHistoryElement { return e },
+	}
+	return p.All(ctx)
+}
+
+// All iterate over the convert trades between startTime and endTime in 30
+// day windows, endTime default to now and startTime to 30 days before
+// endTime. limit is not used.
+func (s *ConvertTradeHistoryService) All(ctx context.Context, opts ...RequestOption) iter.Seq2[ConvertTradeHistoryItem, error] {
+	const window = 30 * 24 * time.Hour
+	var startTime, endTime *int64
+	if s.startTime != 0 {
+		startTime = &s.startTime
+	}
+	if s.endTime != 0 {
+		endTime = &s.endTime
+	}
+	base := *s
+	start, end := s.c.timeRange(startTime, endTime, window)
+	p := &common.Paginator[ConvertTradeHistoryItem, int64]{
+		StartTime: start,
+		EndTime:   end,
+		Window:    window,
+		Limit:     1000,
+		Fetch: func(ctx context.Context, page common.Page) ([]ConvertTradeHistoryItem, error) {
+			svc, limit := base, int32(1000)
+			svc.limit = &limit
+			svc.startTime, svc.endTime = page.StartTime, page.EndTime
+			res, err := svc.Do(ctx, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return res.List, nil
+		},
+		Key:  func(t ConvertTradeHistoryItem) int64 { return t.OrderId },
+		Time: func(t ConvertTradeHistoryItem) int64 { return t.CreateTime },
+	}
+	return p.All(ctx)
+}
@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+
+	"github.com/ward-cap/go-binance/common"
 )
 
 type listenKeyResponse struct {
@@ -20,6 +22,29 @@ type depthPayload struct {
 	Asks         []priceLevelTuple `json:"asks"`
 }
 
+type wsDepthPayload struct {
+	Event         string            `json:"e"`
+	Time          int64             `json:"E"`
+	Symbol        string            `json:"s"`
+	FirstUpdateID int64             `json:"U"`
+	LastUpdateID  int64             `json:"u"`
+	Bids          []priceLevelTuple `json:"b"`
+	Asks          []priceLevelTuple `json:"a"`
+}
+
+type wsUserDataEventHeader struct {
+	Event     UserDataEventType `json:"e"`
+	Time      json.RawMessage   `json:"E"`
+	ListenKey string            `json:"listenKey"`
+}
+
+type cancelReplaceOrderPayload struct {
+	CancelResult     CancelReplaceResultType `json:"cancelResult"`
+	NewOrderResult   CancelReplaceResultType `json:"newOrderResult"`
+	CancelResponse   json.RawMessage         `json:"cancelResponse"`
+	NewOrderResponse json.RawMessage         `json:"newOrderResponse"`
+}
+
 type priceLevelTuple [2]string
 
 type klineTuple []json.RawMessage
@@ -57,6 +82,136 @@ func parseDepth(data []byte) (*DepthResponse, error) {
 	return res, nil
 }
 
+func parseWsDepthEvent(data []byte) (*WsDepthEvent, error) {
+	var payload wsDepthPayload
+	if err := jsonCodec.Unmarshal(data, &payload); err != nil {
+		return nil, err
+	}
+	return &WsDepthEvent{
+		Event:         payload.Event,
+		Time:          payload.Time,
+		Symbol:        payload.Symbol,
+		FirstUpdateID: payload.FirstUpdateID,
+		LastUpdateID:  payload.LastUpdateID,
+		Bids:          toPriceLevels(payload.Bids),
+		Asks:          toPriceLevels(payload.Asks),
+	}, nil
+}
+
+func parseWsPartialDepthEvent(symbol string, data []byte) (*WsPartialDepthEvent, error) {
+	var payload depthPayload
+	if err := jsonCodec.Unmarshal(data, &payload); err != nil {
+		return nil, err
+	}
+	return &WsPartialDepthEvent{
+		Symbol:       symbol,
+		LastUpdateID: payload.LastUpdateID,
+		Bids:         toPriceLevels(payload.Bids),
+		Asks:         toPriceLevels(payload.Asks),
+	}, nil
+}
+
+func parseWsUserDataEvent(data []byte) (*WsUserDataEvent, error) {
+	var header wsUserDataEventHeader
+	if err := jsonCodec.Unmarshal(data, &header); err != nil {
+		return nil, err
+	}
+	res := &WsUserDataEvent{Event: header.Event}
+	if len(header.Time) > 0 {
+		t, err := rawInt64(header.Time)
+		if err != nil {
+			return nil, err
+		}
+		res.Time = t
+	}
+
+	var err error
+	switch header.Event {
+	case UserDataEventTypeOutboundAccountPosition:
+		res.AccountUpdate = new(WsAccountUpdateEvent)
+		err = res.AccountUpdate.UnmarshalJSON(data)
+	case UserDataEventTypeBalanceUpdate:
+		res.BalanceUpdate = new(WsBalanceUpdateEvent)
+		err = res.BalanceUpdate.UnmarshalJSON(data)
+	case UserDataEventTypeExecutionReport:
+		res.ExecutionReport = new(WsExecutionReportEvent)
+		err = res.ExecutionReport.UnmarshalJSON(data)
+	case UserDataEventTypeListStatus:
+		res.ListStatus = new(WsListStatusEvent)
+		err = res.ListStatus.UnmarshalJSON(data)
+	case UserDataEventTypeListenKeyExpired:
+		// the event time may be sent as a string here, so build it from the header
+		res.ListenKeyExpired = &WsListenKeyExpiredEvent{
+			Event:     header.Event,
+			Time:      res.Time,
+			ListenKey: header.ListenKey,
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func toPriceLevels(levels []priceLevelTuple) []common.PriceLevel {
+	res := make([]common.PriceLevel, len(levels))
+	for i, level := range levels {
+		res[i] = common.PriceLevel{Price: level[0], Quantity: level[1]}
+	}
+	return res
+}
+
+// parseCancelReplaceOrderResponse decode a cancel-replace response, a step
+// which failed carries an error in place of its response
+func parseCancelReplaceOrderResponse(data []byte) (*CancelReplaceOrderResponse, error) {
+	var payload cancelReplaceOrderPayload
+	if err := jsonCodec.Unmarshal(data, &payload); err != nil {
+		return nil, err
+	}
+	res := &CancelReplaceOrderResponse{
+		CancelResult:   payload.CancelResult,
+		NewOrderResult: payload.NewOrderResult,
+	}
+	var err error
+	res.CancelError, err = parseStepError(payload.CancelResponse)
+	if err != nil {
+		return nil, err
+	}
+	if res.CancelError == nil && len(payload.CancelResponse) > 0 && string(payload.CancelResponse) != "null" {
+		res.CancelResponse = new(CancelOrderResponse)
+		if err := jsonCodec.Unmarshal(payload.CancelResponse, res.CancelResponse); err != nil {
+			return nil, err
+		}
+	}
+	res.NewOrderError, err = parseStepError(payload.NewOrderResponse)
+	if err != nil {
+		return nil, err
+	}
+	if res.NewOrderError == nil && len(payload.NewOrderResponse) > 0 && string(payload.NewOrderResponse) != "null" {
+		res.NewOrderResponse = new(CreateOrderResponse)
+		if err := jsonCodec.Unmarshal(payload.NewOrderResponse, res.NewOrderResponse); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+// parseStepError return the error of a cancel-replace step, nil when the
+// step succeeded or was not attempted
+func parseStepError(data json.RawMessage) (*common.APIError, error) {
+	if len(data) == 0 || string(data) == "null" {
+		return nil, nil
+	}
+	apiErr := new(common.APIError)
+	if err := jsonCodec.Unmarshal(data, apiErr); err != nil {
+		return nil, err
+	}
+	if apiErr.Code == 0 {
+		return nil, nil
+	}
+	return apiErr, nil
+}
+
 func parseKlines(data []byte) ([]*Kline, error) {
 	var rows []klineTuple
 	if err := jsonCodec.Unmarshal(data, &rows); err != nil {
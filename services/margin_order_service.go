@@ -3,6 +3,8 @@ package binance
 import (
 	"context"
 	"net/http"
+
+	"github.com/shopspring/decimal"
 )
 
 // CreateMarginOrderService create order
@@ -59,18 +61,33 @@ func (s *CreateMarginOrderService) Quantity(quantity string) *CreateMarginOrderS
 	return s
 }
 
+// QuantityDecimal set quantity from a decimal
+func (s *CreateMarginOrderService) QuantityDecimal(quantity decimal.Decimal) *CreateMarginOrderService {
+	return s.Quantity(quantity.String())
+}
+
 // QuoteOrderQty set quoteOrderQty
 func (s *CreateMarginOrderService) QuoteOrderQty(quoteOrderQty string) *CreateMarginOrderService {
 	s.quoteOrderQty = &quoteOrderQty
 	return s
 }
 
+// QuoteOrderQtyDecimal set quoteOrderQty from a decimal
+func (s *CreateMarginOrderService) QuoteOrderQtyDecimal(quoteOrderQty decimal.Decimal) *CreateMarginOrderService {
+	return s.QuoteOrderQty(quoteOrderQty.String())
+}
+
 // Price set price
 func (s *CreateMarginOrderService) Price(price string) *CreateMarginOrderService {
 	s.price = &price
 	return s
 }
 
+// PriceDecimal set price from a decimal
+func (s *CreateMarginOrderService) PriceDecimal(price decimal.Decimal) *CreateMarginOrderService {
+	return s.Price(price.String())
+}
+
 // NewClientOrderID set newClientOrderID
 func (s *CreateMarginOrderService) NewClientOrderID(newClientOrderID string) *CreateMarginOrderService {
 	s.newClientOrderID = &newClientOrderID
@@ -83,12 +100,22 @@ func (s *CreateMarginOrderService) StopPrice(stopPrice string) *CreateMarginOrde
 	return s
 }
 
+// StopPriceDecimal set stopPrice from a decimal
+func (s *CreateMarginOrderService) StopPriceDecimal(stopPrice decimal.Decimal) *CreateMarginOrderService {
+	return s.StopPrice(stopPrice.String())
+}
+
 // IcebergQuantity set icebergQuantity
 func (s *CreateMarginOrderService) IcebergQuantity(icebergQuantity string) *CreateMarginOrderService {
 	s.icebergQuantity = &icebergQuantity
 	return s
 }
 
+// IcebergQuantityDecimal set icebergQuantity from a decimal
+func (s *CreateMarginOrderService) IcebergQuantityDecimal(icebergQuantity decimal.Decimal) *CreateMarginOrderService {
+	return s.IcebergQuantity(icebergQuantity.String())
+}
+
 // NewOrderRespType set icebergQuantity
 func (s *CreateMarginOrderService) NewOrderRespType(newOrderRespType NewOrderRespType) *CreateMarginOrderService {
 	s.newOrderRespType = &newOrderRespType
@@ -477,6 +504,11 @@ func (s *CreateMarginOCOService) Quantity(quantity string) *CreateMarginOCOServi
 	return s
 }
 
+// QuantityDecimal set quantity from a decimal
+func (s *CreateMarginOCOService) QuantityDecimal(quantity decimal.Decimal) *CreateMarginOCOService {
+	return s.Quantity(quantity.String())
+}
+
 // ListClientOrderID set listClientOrderID
 func (s *CreateMarginOCOService) ListClientOrderID(listClientOrderID string) *CreateMarginOCOService {
 	s.listClientOrderID = &listClientOrderID
@@ -495,12 +527,22 @@ func (s *CreateMarginOCOService) Price(price string) *CreateMarginOCOService {
 	return s
 }
 
+// PriceDecimal set price from a decimal
+func (s *CreateMarginOCOService) PriceDecimal(price decimal.Decimal) *CreateMarginOCOService {
+	return s.Price(price.String())
+}
+
 // LimitIcebergQuantity set limitIcebergQuantity
 func (s *CreateMarginOCOService) LimitIcebergQuantity(limitIcebergQty string) *CreateMarginOCOService {
 	s.limitIcebergQty = &limitIcebergQty
 	return s
 }
 
+// LimitIcebergQuantityDecimal set limitIcebergQty from a decimal
+func (s *CreateMarginOCOService) LimitIcebergQuantityDecimal(limitIcebergQty decimal.Decimal) *CreateMarginOCOService {
+	return s.LimitIcebergQuantity(limitIcebergQty.String())
+}
+
 // StopClientOrderID set stopClientOrderID
 func (s *CreateMarginOCOService) StopClientOrderID(stopClientOrderID string) *CreateMarginOCOService {
 	s.stopClientOrderID = &stopClientOrderID
@@ -513,18 +555,33 @@ func (s *CreateMarginOCOService) StopPrice(stopPrice string) *CreateMarginOCOSer
 	return s
 }
 
+// StopPriceDecimal set stopPrice from a decimal
+func (s *CreateMarginOCOService) StopPriceDecimal(stopPrice decimal.Decimal) *CreateMarginOCOService {
+	return s.StopPrice(stopPrice.String())
+}
+
 // StopLimitPrice set stop limit price
 func (s *CreateMarginOCOService) StopLimitPrice(stopLimitPrice string) *CreateMarginOCOService {
 	s.stopLimitPrice = &stopLimitPrice
 	return s
 }
 
+// StopLimitPriceDecimal set stopLimitPrice from a decimal
+func (s *CreateMarginOCOService) StopLimitPriceDecimal(stopLimitPrice decimal.Decimal) *CreateMarginOCOService {
+	return s.StopLimitPrice(stopLimitPrice.String())
+}
+
 // StopIcebergQty set stop limit price
 func (s *CreateMarginOCOService) StopIcebergQty(stopIcebergQty string) *CreateMarginOCOService {
 	s.stopIcebergQty = &stopIcebergQty
 	return s
 }
 
+// StopIcebergQtyDecimal set stopIcebergQty from a decimal
+func (s *CreateMarginOCOService) StopIcebergQtyDecimal(stopIcebergQty decimal.Decimal) *CreateMarginOCOService {
+	return s.StopIcebergQty(stopIcebergQty.String())
+}
+
 // StopLimitTimeInForce set stopLimitTimeInForce
 func (s *CreateMarginOCOService) StopLimitTimeInForce(stopLimitTimeInForce TimeInForceType) *CreateMarginOCOService {
 	s.stopLimitTimeInForce = &stopLimitTimeInForce
@@ -108,6 +108,52 @@ type AggTrade struct {
 	IsBestPriceMatch bool   `json:"M"`
 }
 
+// AmendOrderListStatus define the order list of an amended order
+//
+//easyjson:json
+type AmendOrderListStatus struct {
+	OrderListID       int64       `json:"orderListId"`
+	ContingencyType   string      `json:"contingencyType"`
+	ListOrderStatus   string      `json:"listOrderStatus"`
+	ListClientOrderID string      `json:"listClientOrderId"`
+	Symbol            string      `json:"symbol"`
+	Orders            []*OCOOrder `json:"orders"`
+}
+
+// AmendOrderResponse define amend order keep priority response, ListStatus
+// is only set for an order of an order list
+//
+//easyjson:json
+type AmendOrderResponse struct {
+	TransactTime int64                 `json:"transactTime"`
+	ExecutionID  int64                 `json:"executionId"`
+	AmendedOrder *AmendedOrder         `json:"amendedOrder"`
+	ListStatus   *AmendOrderListStatus `json:"listStatus,omitempty"`
+}
+
+// AmendedOrder define an order after its quantity was amended
+//
+//easyjson:json
+type AmendedOrder struct {
+	Symbol                  string          `json:"symbol"`
+	OrderID                 int64           `json:"orderId"`
+	OrderListID             int64           `json:"orderListId"`
+	OrigClientOrderID       string          `json:"origClientOrderId"`
+	ClientOrderID           string          `json:"clientOrderId"`
+	Price                   string          `json:"price"`
+	Quantity                string          `json:"qty"`
+	ExecutedQuantity        string          `json:"executedQty"`
+	PreventedQuantity       string          `json:"preventedQty"`
+	QuoteOrderQuantity      string          `json:"quoteOrderQty"`
+	CumulativeQuoteQuantity string          `json:"cumulativeQuoteQty"`
+	Status                  OrderStatusType `json:"status"`
+	TimeInForce             TimeInForceType `json:"timeInForce"`
+	Type                    OrderType       `json:"type"`
+	Side                    SideType        `json:"side"`
+	WorkingTime             int64           `json:"workingTime"`
+	SelfTradePreventionMode string          `json:"selfTradePreventionMode"`
+}
+
 //easyjson:json
 type AssetBalance struct {
 	Asset  string  `json:"asset"`
@@ -292,6 +338,20 @@ type CancelOrderResponse struct {
 	Side                     SideType        `json:"side"`
 }
 
+// CancelReplaceOrderResponse define cancel-replace order response. The
+// response of a step is nil when it failed or was not attempted, the error
+// of a failed step is in CancelError or NewOrderError.
+//
+//easyjson:json
+type CancelReplaceOrderResponse struct {
+	CancelResult     CancelReplaceResultType `json:"cancelResult"`
+	NewOrderResult   CancelReplaceResultType `json:"newOrderResult"`
+	CancelResponse   *CancelOrderResponse    `json:"cancelResponse"`
+	NewOrderResponse *CreateOrderResponse    `json:"newOrderResponse"`
+	CancelError      *common.APIError        `json:"-"`
+	NewOrderError    *common.APIError        `json:"-"`
+}
+
 //easyjson:json
 type ClaimRewardResponse struct {
 	Success bool `json:"success"`
@@ -325,6 +385,17 @@ type CoinInfo struct {
 	Withdrawing       string    `json:"withdrawing"`
 }
 
+// CommissionDiscount define the commission discount of an order paid with
+// the discount asset
+//
+//easyjson:json
+type CommissionDiscount struct {
+	EnabledForAccount bool   `json:"enabledForAccount"`
+	EnabledForSymbol  bool   `json:"enabledForSymbol"`
+	DiscountAsset     string `json:"discountAsset"`
+	Discount          string `json:"discount"`
+}
+
 //easyjson:json
 type CommissionRateSubAccount struct {
 	SubAccountId    int64  `json:"subAccountId"`
@@ -426,6 +497,12 @@ type CreateOrderResponse struct {
 	Fills                 []*Fill `json:"fills"`
 	MarginBuyBorrowAmount string  `json:"marginBuyBorrowAmount"` // for margin
 	MarginBuyBorrowAsset  string  `json:"marginBuyBorrowAsset"`
+
+	// Recovered is set when a retried placement found the order already
+	// placed; the response is then built from the queried order, so Fills
+	// and the margin borrow fields are empty and TransactTime is the order
+	// creation time.
+	Recovered bool `json:"recovered,omitempty"`
 }
 
 // CreateUserUniversalTransferResponse represents a response from CreateUserUniversalTransferResponse.
@@ -446,6 +523,7 @@ type CreateWithdrawResponse struct {
 //
 //easyjson:json
 type Deposit struct {
+	ID            string          `json:"id"`
 	Amount        decimal.Decimal `json:"amount"`
 	Coin          string          `json:"coin"`
 	Network       string          `json:"network"`
@@ -1725,6 +1803,16 @@ type SymbolTicker struct {
 	Count              int64  `json:"count"`
 }
 
+// TestOrderResponse define test order response, it is only filled in when
+// computeCommissionRates is set
+//
+//easyjson:json
+type TestOrderResponse struct {
+	StandardCommissionForOrder *CommissionRates    `json:"standardCommissionForOrder,omitempty"`
+	TaxCommissionForOrder      *CommissionRates    `json:"taxCommissionForOrder,omitempty"`
+	Discount                   *CommissionDiscount `json:"discount,omitempty"`
+}
+
 // Trade define trade info
 //
 //easyjson:json
@@ -1881,3 +1969,259 @@ type Withdraw struct {
 	Info            string `json:"info"`
 	TxID            string `json:"txId"`
 }
+
+// WsAccountUpdate define balance of an account update event
+//
+//easyjson:json
+type WsAccountUpdate struct {
+	Asset  string          `json:"a"`
+	Free   decimal.Decimal `json:"f"`
+	Locked decimal.Decimal `json:"l"`
+}
+
+// WsAccountUpdateEvent define websocket outboundAccountPosition event
+//
+//easyjson:json
+type WsAccountUpdateEvent struct {
+	Event          UserDataEventType `json:"e"`
+	Time           int64             `json:"E"`
+	LastUpdateTime int64             `json:"u"`
+	Balances       []WsAccountUpdate `json:"B"`
+}
+
+// WsAggTradeEvent define websocket aggregate trade event
+//
+//easyjson:json
+type WsAggTradeEvent struct {
+	Event            string `json:"e"`
+	Time             int64  `json:"E"`
+	Symbol           string `json:"s"`
+	AggTradeID       int64  `json:"a"`
+	Price            string `json:"p"`
+	Quantity         string `json:"q"`
+	FirstTradeID     int64  `json:"f"`
+	LastTradeID      int64  `json:"l"`
+	TradeTime        int64  `json:"T"`
+	IsBuyerMaker     bool   `json:"m"`
+	IsBestPriceMatch bool   `json:"M"`
+}
+
+// WsAllMarketStatEvent define array of websocket market statistics events
+//
+//easyjson:json
+type WsAllMarketStatEvent []*WsMarketStatEvent
+
+// WsAllMiniMarketStatEvent define array of websocket mini market statistics events
+//
+//easyjson:json
+type WsAllMiniMarketStatEvent []*WsMiniMarketStatEvent
+
+// WsBalanceUpdateEvent define websocket balanceUpdate event
+//
+//easyjson:json
+type WsBalanceUpdateEvent struct {
+	Event        UserDataEventType `json:"e"`
+	Time         int64             `json:"E"`
+	Asset        string            `json:"a"`
+	Change       decimal.Decimal   `json:"d"`
+	TransactTime int64             `json:"T"`
+}
+
+// WsBookTickerEvent define websocket best bid/ask event
+//
+//easyjson:json
+type WsBookTickerEvent struct {
+	UpdateID     int64  `json:"u"`
+	Symbol       string `json:"s"`
+	BestBidPrice string `json:"b"`
+	BestBidQty   string `json:"B"`
+	BestAskPrice string `json:"a"`
+	BestAskQty   string `json:"A"`
+}
+
+// WsDepthEvent define websocket diff depth event
+//
+//easyjson:json
+type WsDepthEvent struct {
+	Event         string `json:"e"`
+	Time          int64  `json:"E"`
+	Symbol        string `json:"s"`
+	FirstUpdateID int64  `json:"U"`
+	LastUpdateID  int64  `json:"u"`
+	Bids          []Bid  `json:"b"`
+	Asks          []Ask  `json:"a"`
+}
+
+// WsExecutionReportEvent define websocket executionReport event
+//
+//easyjson:json
+type WsExecutionReportEvent struct {
+	Event                   UserDataEventType `json:"e"`
+	Time                    int64             `json:"E"`
+	Symbol                  string            `json:"s"`
+	ClientOrderID           string            `json:"c"`
+	Side                    SideType          `json:"S"`
+	Type                    OrderType         `json:"o"`
+	TimeInForce             TimeInForceType   `json:"f"`
+	Quantity                decimal.Decimal   `json:"q"`
+	Price                   decimal.Decimal   `json:"p"`
+	StopPrice               decimal.Decimal   `json:"P"`
+	IcebergQuantity         decimal.Decimal   `json:"F"`
+	OrderListID             int64             `json:"g"`
+	OrigClientOrderID       string            `json:"C"`
+	ExecutionType           ExecutionType     `json:"x"`
+	Status                  OrderStatusType   `json:"X"`
+	RejectReason            string            `json:"r"`
+	OrderID                 int64             `json:"i"`
+	LastExecutedQuantity    decimal.Decimal   `json:"l"`
+	ExecutedQuantity        decimal.Decimal   `json:"z"`
+	LastExecutedPrice       decimal.Decimal   `json:"L"`
+	Commission              decimal.Decimal   `json:"n"`
+	CommissionAsset         string            `json:"N"`
+	TransactionTime         int64             `json:"T"`
+	TradeID                 int64             `json:"t"`
+	IsInOrderBook           bool              `json:"w"`
+	IsMaker                 bool              `json:"m"`
+	CreateTime              int64             `json:"O"`
+	CummulativeQuoteQty     decimal.Decimal   `json:"Z"`
+	LastQuoteQty            decimal.Decimal   `json:"Y"`
+	QuoteOrderQty           decimal.Decimal   `json:"Q"`
+	WorkingTime             int64             `json:"W"`
+	SelfTradePreventionMode string            `json:"V"`
+}
+
+// WsKline define websocket kline
+//
+//easyjson:json
+type WsKline struct {
+	StartTime            int64  `json:"t"`
+	EndTime              int64  `json:"T"`
+	Symbol               string `json:"s"`
+	Interval             string `json:"i"`
+	FirstTradeID         int64  `json:"f"`
+	LastTradeID          int64  `json:"L"`
+	Open                 string `json:"o"`
+	Close                string `json:"c"`
+	High                 string `json:"h"`
+	Low                  string `json:"l"`
+	Volume               string `json:"v"`
+	TradeNum             int64  `json:"n"`
+	IsFinal              bool   `json:"x"`
+	QuoteVolume          string `json:"q"`
+	ActiveBuyVolume      string `json:"V"`
+	ActiveBuyQuoteVolume string `json:"Q"`
+}
+
+// WsKlineEvent define websocket kline event
+//
+//easyjson:json
+type WsKlineEvent struct {
+	Event  string  `json:"e"`
+	Time   int64   `json:"E"`
+	Symbol string  `json:"s"`
+	Kline  WsKline `json:"k"`
+}
+
+// WsListOrder define order of a list status event
+//
+//easyjson:json
+type WsListOrder struct {
+	Symbol        string `json:"s"`
+	OrderID       int64  `json:"i"`
+	ClientOrderID string `json:"c"`
+}
+
+// WsListStatusEvent define websocket ListStatus event
+//
+//easyjson:json
+type WsListStatusEvent struct {
+	Event             UserDataEventType `json:"e"`
+	Time              int64             `json:"E"`
+	Symbol            string            `json:"s"`
+	OrderListID       int64             `json:"g"`
+	ContingencyType   string            `json:"c"`
+	ListStatusType    string            `json:"l"`
+	ListOrderStatus   string            `json:"L"`
+	ListRejectReason  string            `json:"r"`
+	ListClientOrderID string            `json:"C"`
+	TransactionTime   int64             `json:"T"`
+	Orders            []WsListOrder     `json:"O"`
+}
+
+// WsListenKeyExpiredEvent define websocket listenKeyExpired event
+//
+//easyjson:json
+type WsListenKeyExpiredEvent struct {
+	Event     UserDataEventType `json:"e"`
+	Time      int64             `json:"E"`
+	ListenKey string            `json:"listenKey"`
+}
+
+// WsMarketStatEvent define websocket 24hr rolling window ticker event
+//
+//easyjson:json
+type WsMarketStatEvent struct {
+	Event              string `json:"e"`
+	Time               int64  `json:"E"`
+	Symbol             string `json:"s"`
+	PriceChange        string `json:"p"`
+	PriceChangePercent string `json:"P"`
+	WeightedAvgPrice   string `json:"w"`
+	PrevClosePrice     string `json:"x"`
+	LastPrice          string `json:"c"`
+	CloseQty           string `json:"Q"`
+	BidPrice           string `json:"b"`
+	BidQty             string `json:"B"`
+	AskPrice           string `json:"a"`
+	AskQty             string `json:"A"`
+	OpenPrice          string `json:"o"`
+	HighPrice          string `json:"h"`
+	LowPrice           string `json:"l"`
+	BaseVolume         string `json:"v"`
+	QuoteVolume        string `json:"q"`
+	OpenTime           int64  `json:"O"`
+	CloseTime          int64  `json:"C"`
+	FirstID            int64  `json:"F"`
+	LastID             int64  `json:"L"`
+	Count              int64  `json:"n"`
+}
+
+// WsMiniMarketStatEvent define websocket 24hr rolling window mini ticker event
+//
+//easyjson:json
+type WsMiniMarketStatEvent struct {
+	Event       string `json:"e"`
+	Time        int64  `json:"E"`
+	Symbol      string `json:"s"`
+	LastPrice   string `json:"c"`
+	OpenPrice   string `json:"o"`
+	HighPrice   string `json:"h"`
+	LowPrice    string `json:"l"`
+	BaseVolume  string `json:"v"`
+	QuoteVolume string `json:"q"`
+}
+
+// WsPartialDepthEvent define websocket partial book depth event
+//
+//easyjson:json
+type WsPartialDepthEvent struct {
+	Symbol       string `json:"symbol"`
+	LastUpdateID int64  `json:"lastUpdateId"`
+	Bids         []Bid  `json:"bids"`
+	Asks         []Ask  `json:"asks"`
+}
+
+// WsTradeEvent define websocket trade event
+//
+//easyjson:json
+type WsTradeEvent struct {
+	Event        string `json:"e"`
+	Time         int64  `json:"E"`
+	Symbol       string `json:"s"`
+	TradeID      int64  `json:"t"`
+	Price        string `json:"p"`
+	Quantity     string `json:"q"`
+	TradeTime    int64  `json:"T"`
+	IsBuyerMaker bool   `json:"m"`
+	Placeholder  bool   `json:"M"` // add this field to avoid case insensitive unmarshaling
+}
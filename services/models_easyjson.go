@@ -18,7 +18,7 @@ var (
 	_ easyjson.Marshaler
 )
 
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices(in *jlexer.Lexer, out *Withdraw) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices(in *jlexer.Lexer, out *WsTradeEvent) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -32,83 +32,59 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices(in *jlexer.Lexer, o
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "address":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.Address = string(in.String())
-			}
-		case "amount":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.Amount = string(in.String())
-			}
-		case "applyTime":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.ApplyTime = string(in.String())
-			}
-		case "coin":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.Coin = string(in.String())
-			}
-		case "id":
+		case "e":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.ID = string(in.String())
+				out.Event = string(in.String())
 			}
-		case "withdrawOrderId":
+		case "E":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.WithdrawOrderID = string(in.String())
+				out.Time = int64(in.Int64())
 			}
-		case "network":
+		case "s":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Network = string(in.String())
+				out.Symbol = string(in.String())
 			}
-		case "transferType":
+		case "t":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.TransferType = int(in.Int())
+				out.TradeID = int64(in.Int64())
 			}
-		case "status":
+		case "p":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Status = int(in.Int())
+				out.Price = string(in.String())
 			}
-		case "transactionFee":
+		case "q":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.TransactionFee = string(in.String())
+				out.Quantity = string(in.String())
 			}
-		case "confirmNo":
+		case "T":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.ConfirmNo = int32(in.Int32())
+				out.TradeTime = int64(in.Int64())
 			}
-		case "info":
+		case "m":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Info = string(in.String())
+				out.IsBuyerMaker = bool(in.Bool())
 			}
-		case "txId":
+		case "M":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.TxID = string(in.String())
+				out.Placeholder = bool(in.Bool())
 			}
 		default:
 			in.SkipRecursive()
@@ -120,102 +96,82 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices(in *jlexer.Lexer, o
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices(out *jwriter.Writer, in Withdraw) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices(out *jwriter.Writer, in WsTradeEvent) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"address\":"
+		const prefix string = ",\"e\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Address))
-	}
-	{
-		const prefix string = ",\"amount\":"
-		out.RawString(prefix)
-		out.String(string(in.Amount))
-	}
-	{
-		const prefix string = ",\"applyTime\":"
-		out.RawString(prefix)
-		out.String(string(in.ApplyTime))
-	}
-	{
-		const prefix string = ",\"coin\":"
-		out.RawString(prefix)
-		out.String(string(in.Coin))
-	}
-	{
-		const prefix string = ",\"id\":"
-		out.RawString(prefix)
-		out.String(string(in.ID))
+		out.String(string(in.Event))
 	}
 	{
-		const prefix string = ",\"withdrawOrderId\":"
+		const prefix string = ",\"E\":"
 		out.RawString(prefix)
-		out.String(string(in.WithdrawOrderID))
+		out.Int64(int64(in.Time))
 	}
 	{
-		const prefix string = ",\"network\":"
+		const prefix string = ",\"s\":"
 		out.RawString(prefix)
-		out.String(string(in.Network))
+		out.String(string(in.Symbol))
 	}
 	{
-		const prefix string = ",\"transferType\":"
+		const prefix string = ",\"t\":"
 		out.RawString(prefix)
-		out.Int(int(in.TransferType))
+		out.Int64(int64(in.TradeID))
 	}
 	{
-		const prefix string = ",\"status\":"
+		const prefix string = ",\"p\":"
 		out.RawString(prefix)
-		out.Int(int(in.Status))
+		out.String(string(in.Price))
 	}
 	{
-		const prefix string = ",\"transactionFee\":"
+		const prefix string = ",\"q\":"
 		out.RawString(prefix)
-		out.String(string(in.TransactionFee))
+		out.String(string(in.Quantity))
 	}
 	{
-		const prefix string = ",\"confirmNo\":"
+		const prefix string = ",\"T\":"
 		out.RawString(prefix)
-		out.Int32(int32(in.ConfirmNo))
+		out.Int64(int64(in.TradeTime))
 	}
 	{
-		const prefix string = ",\"info\":"
+		const prefix string = ",\"m\":"
 		out.RawString(prefix)
-		out.String(string(in.Info))
+		out.Bool(bool(in.IsBuyerMaker))
 	}
 	{
-		const prefix string = ",\"txId\":"
+		const prefix string = ",\"M\":"
 		out.RawString(prefix)
-		out.String(string(in.TxID))
+		out.Bool(bool(in.Placeholder))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Withdraw) MarshalJSON() ([]byte, error) {
+func (v WsTradeEvent) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Withdraw) MarshalEasyJSON(w *jwriter.Writer) {
+func (v WsTradeEvent) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Withdraw) UnmarshalJSON(data []byte) error {
+func (v *WsTradeEvent) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Withdraw) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *WsTradeEvent) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices1(in *jlexer.Lexer, out *UserAssetRecord) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices1(in *jlexer.Lexer, out *WsPartialDepthEvent) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -229,53 +185,71 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices1(in *jlexer.Lexer,
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "asset":
+		case "symbol":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Asset = string(in.String())
+				out.Symbol = string(in.String())
 			}
-		case "free":
+		case "lastUpdateId":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				if data := in.Raw(); in.Ok() {
-					in.AddError((out.Free).UnmarshalJSON(data))
-				}
+				out.LastUpdateID = int64(in.Int64())
 			}
-		case "locked":
+		case "bids":
 			if in.IsNull() {
 				in.Skip()
+				out.Bids = nil
 			} else {
-				if data := in.Raw(); in.Ok() {
-					in.AddError((out.Locked).UnmarshalJSON(data))
+				in.Delim('[')
+				if out.Bids == nil {
+					if !in.IsDelim(']') {
+						out.Bids = make([]common.PriceLevel, 0, 2)
+					} else {
+						out.Bids = []common.PriceLevel{}
+					}
+				} else {
+					out.Bids = (out.Bids)[:0]
 				}
-			}
-		case "freeze":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				if data := in.Raw(); in.Ok() {
-					in.AddError((out.Freeze).UnmarshalJSON(data))
+				for !in.IsDelim(']') {
+					var v1 common.PriceLevel
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v1).UnmarshalEasyJSON(in)
+					}
+					out.Bids = append(out.Bids, v1)
+					in.WantComma()
 				}
+				in.Delim(']')
 			}
-		case "withdrawing":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.Withdrawing = string(in.String())
-			}
-		case "ipoable":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.Ipoable = string(in.String())
-			}
-		case "btcValuation":
+		case "asks":
 			if in.IsNull() {
 				in.Skip()
+				out.Asks = nil
 			} else {
-				out.BtcValuation = string(in.String())
+				in.Delim('[')
+				if out.Asks == nil {
+					if !in.IsDelim(']') {
+						out.Asks = make([]common.PriceLevel, 0, 2)
+					} else {
+						out.Asks = []common.PriceLevel{}
+					}
+				} else {
+					out.Asks = (out.Asks)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v2 common.PriceLevel
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v2).UnmarshalEasyJSON(in)
+					}
+					out.Asks = append(out.Asks, v2)
+					in.WantComma()
+				}
+				in.Delim(']')
 			}
 		default:
 			in.SkipRecursive()
@@ -287,72 +261,79 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices1(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices1(out *jwriter.Writer, in UserAssetRecord) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices1(out *jwriter.Writer, in WsPartialDepthEvent) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"asset\":"
+		const prefix string = ",\"symbol\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Asset))
-	}
-	{
-		const prefix string = ",\"free\":"
-		out.RawString(prefix)
-		out.Raw((in.Free).MarshalJSON())
-	}
-	{
-		const prefix string = ",\"locked\":"
-		out.RawString(prefix)
-		out.Raw((in.Locked).MarshalJSON())
-	}
-	{
-		const prefix string = ",\"freeze\":"
-		out.RawString(prefix)
-		out.Raw((in.Freeze).MarshalJSON())
+		out.String(string(in.Symbol))
 	}
 	{
-		const prefix string = ",\"withdrawing\":"
+		const prefix string = ",\"lastUpdateId\":"
 		out.RawString(prefix)
-		out.String(string(in.Withdrawing))
+		out.Int64(int64(in.LastUpdateID))
 	}
 	{
-		const prefix string = ",\"ipoable\":"
+		const prefix string = ",\"bids\":"
 		out.RawString(prefix)
-		out.String(string(in.Ipoable))
+		if in.Bids == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v3, v4 := range in.Bids {
+				if v3 > 0 {
+					out.RawByte(',')
+				}
+				(v4).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
 	}
 	{
-		const prefix string = ",\"btcValuation\":"
+		const prefix string = ",\"asks\":"
 		out.RawString(prefix)
-		out.String(string(in.BtcValuation))
+		if in.Asks == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v5, v6 := range in.Asks {
+				if v5 > 0 {
+					out.RawByte(',')
+				}
+				(v6).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v UserAssetRecord) MarshalJSON() ([]byte, error) {
+func (v WsPartialDepthEvent) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices1(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v UserAssetRecord) MarshalEasyJSON(w *jwriter.Writer) {
+func (v WsPartialDepthEvent) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices1(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *UserAssetRecord) UnmarshalJSON(data []byte) error {
+func (v *WsPartialDepthEvent) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices1(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *UserAssetRecord) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *WsPartialDepthEvent) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices1(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices2(in *jlexer.Lexer, out *UserAssetDribbletDetail) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices2(in *jlexer.Lexer, out *WsMiniMarketStatEvent) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -366,53 +347,2970 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices2(in *jlexer.Lexer,
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "transId":
+		case "e":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.TransID = int(in.Int())
+				out.Event = string(in.String())
 			}
-		case "serviceChargeAmount":
+		case "E":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.ServiceChargeAmount = string(in.String())
+				out.Time = int64(in.Int64())
 			}
-		case "amount":
+		case "s":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Amount = string(in.String())
+				out.Symbol = string(in.String())
 			}
-		case "operateTime":
+		case "c":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.OperateTime = int64(in.Int64())
+				out.LastPrice = string(in.String())
 			}
-		case "transferedAmount":
+		case "o":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.TransferedAmount = string(in.String())
+				out.OpenPrice = string(in.String())
 			}
-		case "fromAsset":
+		case "h":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.FromAsset = string(in.String())
+				out.HighPrice = string(in.String())
 			}
-		default:
-			in.SkipRecursive()
-		}
-		in.WantComma()
-	}
-	in.Delim('}')
-	if isTopLevel {
-		in.Consumed()
+		case "l":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.LowPrice = string(in.String())
+			}
+		case "v":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.BaseVolume = string(in.String())
+			}
+		case "q":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.QuoteVolume = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices2(out *jwriter.Writer, in WsMiniMarketStatEvent) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"e\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Event))
+	}
+	{
+		const prefix string = ",\"E\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Time))
+	}
+	{
+		const prefix string = ",\"s\":"
+		out.RawString(prefix)
+		out.String(string(in.Symbol))
+	}
+	{
+		const prefix string = ",\"c\":"
+		out.RawString(prefix)
+		out.String(string(in.LastPrice))
+	}
+	{
+		const prefix string = ",\"o\":"
+		out.RawString(prefix)
+		out.String(string(in.OpenPrice))
+	}
+	{
+		const prefix string = ",\"h\":"
+		out.RawString(prefix)
+		out.String(string(in.HighPrice))
+	}
+	{
+		const prefix string = ",\"l\":"
+		out.RawString(prefix)
+		out.String(string(in.LowPrice))
+	}
+	{
+		const prefix string = ",\"v\":"
+		out.RawString(prefix)
+		out.String(string(in.BaseVolume))
+	}
+	{
+		const prefix string = ",\"q\":"
+		out.RawString(prefix)
+		out.String(string(in.QuoteVolume))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v WsMiniMarketStatEvent) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v WsMiniMarketStatEvent) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *WsMiniMarketStatEvent) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *WsMiniMarketStatEvent) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices2(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices3(in *jlexer.Lexer, out *WsMarketStatEvent) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "e":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Event = string(in.String())
+			}
+		case "E":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Time = int64(in.Int64())
+			}
+		case "s":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Symbol = string(in.String())
+			}
+		case "p":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.PriceChange = string(in.String())
+			}
+		case "P":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.PriceChangePercent = string(in.String())
+			}
+		case "w":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.WeightedAvgPrice = string(in.String())
+			}
+		case "x":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.PrevClosePrice = string(in.String())
+			}
+		case "c":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.LastPrice = string(in.String())
+			}
+		case "Q":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.CloseQty = string(in.String())
+			}
+		case "b":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.BidPrice = string(in.String())
+			}
+		case "B":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.BidQty = string(in.String())
+			}
+		case "a":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.AskPrice = string(in.String())
+			}
+		case "A":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.AskQty = string(in.String())
+			}
+		case "o":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.OpenPrice = string(in.String())
+			}
+		case "h":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.HighPrice = string(in.String())
+			}
+		case "l":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.LowPrice = string(in.String())
+			}
+		case "v":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.BaseVolume = string(in.String())
+			}
+		case "q":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.QuoteVolume = string(in.String())
+			}
+		case "O":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.OpenTime = int64(in.Int64())
+			}
+		case "C":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.CloseTime = int64(in.Int64())
+			}
+		case "F":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.FirstID = int64(in.Int64())
+			}
+		case "L":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.LastID = int64(in.Int64())
+			}
+		case "n":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Count = int64(in.Int64())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices3(out *jwriter.Writer, in WsMarketStatEvent) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"e\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Event))
+	}
+	{
+		const prefix string = ",\"E\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Time))
+	}
+	{
+		const prefix string = ",\"s\":"
+		out.RawString(prefix)
+		out.String(string(in.Symbol))
+	}
+	{
+		const prefix string = ",\"p\":"
+		out.RawString(prefix)
+		out.String(string(in.PriceChange))
+	}
+	{
+		const prefix string = ",\"P\":"
+		out.RawString(prefix)
+		out.String(string(in.PriceChangePercent))
+	}
+	{
+		const prefix string = ",\"w\":"
+		out.RawString(prefix)
+		out.String(string(in.WeightedAvgPrice))
+	}
+	{
+		const prefix string = ",\"x\":"
+		out.RawString(prefix)
+		out.String(string(in.PrevClosePrice))
+	}
+	{
+		const prefix string = ",\"c\":"
+		out.RawString(prefix)
+		out.String(string(in.LastPrice))
+	}
+	{
+		const prefix string = ",\"Q\":"
+		out.RawString(prefix)
+		out.String(string(in.CloseQty))
+	}
+	{
+		const prefix string = ",\"b\":"
+		out.RawString(prefix)
+		out.String(string(in.BidPrice))
+	}
+	{
+		const prefix string = ",\"B\":"
+		out.RawString(prefix)
+		out.String(string(in.BidQty))
+	}
+	{
+		const prefix string = ",\"a\":"
+		out.RawString(prefix)
+		out.String(string(in.AskPrice))
+	}
+	{
+		const prefix string = ",\"A\":"
+		out.RawString(prefix)
+		out.String(string(in.AskQty))
+	}
+	{
+		const prefix string = ",\"o\":"
+		out.RawString(prefix)
+		out.String(string(in.OpenPrice))
+	}
+	{
+		const prefix string = ",\"h\":"
+		out.RawString(prefix)
+		out.String(string(in.HighPrice))
+	}
+	{
+		const prefix string = ",\"l\":"
+		out.RawString(prefix)
+		out.String(string(in.LowPrice))
+	}
+	{
+		const prefix string = ",\"v\":"
+		out.RawString(prefix)
+		out.String(string(in.BaseVolume))
+	}
+	{
+		const prefix string = ",\"q\":"
+		out.RawString(prefix)
+		out.String(string(in.QuoteVolume))
+	}
+	{
+		const prefix string = ",\"O\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.OpenTime))
+	}
+	{
+		const prefix string = ",\"C\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.CloseTime))
+	}
+	{
+		const prefix string = ",\"F\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.FirstID))
+	}
+	{
+		const prefix string = ",\"L\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.LastID))
+	}
+	{
+		const prefix string = ",\"n\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Count))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v WsMarketStatEvent) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v WsMarketStatEvent) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *WsMarketStatEvent) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *WsMarketStatEvent) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices3(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices4(in *jlexer.Lexer, out *WsListenKeyExpiredEvent) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "e":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Event = UserDataEventType(in.String())
+			}
+		case "E":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Time = int64(in.Int64())
+			}
+		case "listenKey":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ListenKey = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices4(out *jwriter.Writer, in WsListenKeyExpiredEvent) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"e\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Event))
+	}
+	{
+		const prefix string = ",\"E\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Time))
+	}
+	{
+		const prefix string = ",\"listenKey\":"
+		out.RawString(prefix)
+		out.String(string(in.ListenKey))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v WsListenKeyExpiredEvent) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices4(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v WsListenKeyExpiredEvent) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices4(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *WsListenKeyExpiredEvent) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices4(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *WsListenKeyExpiredEvent) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices4(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices5(in *jlexer.Lexer, out *WsListStatusEvent) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "e":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Event = UserDataEventType(in.String())
+			}
+		case "E":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Time = int64(in.Int64())
+			}
+		case "s":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Symbol = string(in.String())
+			}
+		case "g":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.OrderListID = int64(in.Int64())
+			}
+		case "c":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ContingencyType = string(in.String())
+			}
+		case "l":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ListStatusType = string(in.String())
+			}
+		case "L":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ListOrderStatus = string(in.String())
+			}
+		case "r":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ListRejectReason = string(in.String())
+			}
+		case "C":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ListClientOrderID = string(in.String())
+			}
+		case "T":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TransactionTime = int64(in.Int64())
+			}
+		case "O":
+			if in.IsNull() {
+				in.Skip()
+				out.Orders = nil
+			} else {
+				in.Delim('[')
+				if out.Orders == nil {
+					if !in.IsDelim(']') {
+						out.Orders = make([]WsListOrder, 0, 1)
+					} else {
+						out.Orders = []WsListOrder{}
+					}
+				} else {
+					out.Orders = (out.Orders)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v7 WsListOrder
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v7).UnmarshalEasyJSON(in)
+					}
+					out.Orders = append(out.Orders, v7)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices5(out *jwriter.Writer, in WsListStatusEvent) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"e\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Event))
+	}
+	{
+		const prefix string = ",\"E\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Time))
+	}
+	{
+		const prefix string = ",\"s\":"
+		out.RawString(prefix)
+		out.String(string(in.Symbol))
+	}
+	{
+		const prefix string = ",\"g\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.OrderListID))
+	}
+	{
+		const prefix string = ",\"c\":"
+		out.RawString(prefix)
+		out.String(string(in.ContingencyType))
+	}
+	{
+		const prefix string = ",\"l\":"
+		out.RawString(prefix)
+		out.String(string(in.ListStatusType))
+	}
+	{
+		const prefix string = ",\"L\":"
+		out.RawString(prefix)
+		out.String(string(in.ListOrderStatus))
+	}
+	{
+		const prefix string = ",\"r\":"
+		out.RawString(prefix)
+		out.String(string(in.ListRejectReason))
+	}
+	{
+		const prefix string = ",\"C\":"
+		out.RawString(prefix)
+		out.String(string(in.ListClientOrderID))
+	}
+	{
+		const prefix string = ",\"T\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.TransactionTime))
+	}
+	{
+		const prefix string = ",\"O\":"
+		out.RawString(prefix)
+		if in.Orders == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v8, v9 := range in.Orders {
+				if v8 > 0 {
+					out.RawByte(',')
+				}
+				(v9).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v WsListStatusEvent) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices5(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v WsListStatusEvent) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices5(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *WsListStatusEvent) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices5(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *WsListStatusEvent) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices5(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices6(in *jlexer.Lexer, out *WsListOrder) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "s":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Symbol = string(in.String())
+			}
+		case "i":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.OrderID = int64(in.Int64())
+			}
+		case "c":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ClientOrderID = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices6(out *jwriter.Writer, in WsListOrder) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"s\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Symbol))
+	}
+	{
+		const prefix string = ",\"i\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.OrderID))
+	}
+	{
+		const prefix string = ",\"c\":"
+		out.RawString(prefix)
+		out.String(string(in.ClientOrderID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v WsListOrder) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices6(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v WsListOrder) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices6(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *WsListOrder) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices6(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *WsListOrder) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices6(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices7(in *jlexer.Lexer, out *WsKlineEvent) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "e":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Event = string(in.String())
+			}
+		case "E":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Time = int64(in.Int64())
+			}
+		case "s":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Symbol = string(in.String())
+			}
+		case "k":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Kline).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices7(out *jwriter.Writer, in WsKlineEvent) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"e\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Event))
+	}
+	{
+		const prefix string = ",\"E\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Time))
+	}
+	{
+		const prefix string = ",\"s\":"
+		out.RawString(prefix)
+		out.String(string(in.Symbol))
+	}
+	{
+		const prefix string = ",\"k\":"
+		out.RawString(prefix)
+		(in.Kline).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v WsKlineEvent) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices7(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v WsKlineEvent) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices7(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *WsKlineEvent) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices7(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *WsKlineEvent) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices7(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices8(in *jlexer.Lexer, out *WsKline) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "t":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.StartTime = int64(in.Int64())
+			}
+		case "T":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.EndTime = int64(in.Int64())
+			}
+		case "s":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Symbol = string(in.String())
+			}
+		case "i":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Interval = string(in.String())
+			}
+		case "f":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.FirstTradeID = int64(in.Int64())
+			}
+		case "L":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.LastTradeID = int64(in.Int64())
+			}
+		case "o":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Open = string(in.String())
+			}
+		case "c":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Close = string(in.String())
+			}
+		case "h":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.High = string(in.String())
+			}
+		case "l":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Low = string(in.String())
+			}
+		case "v":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Volume = string(in.String())
+			}
+		case "n":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TradeNum = int64(in.Int64())
+			}
+		case "x":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.IsFinal = bool(in.Bool())
+			}
+		case "q":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.QuoteVolume = string(in.String())
+			}
+		case "V":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ActiveBuyVolume = string(in.String())
+			}
+		case "Q":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ActiveBuyQuoteVolume = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices8(out *jwriter.Writer, in WsKline) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"t\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.StartTime))
+	}
+	{
+		const prefix string = ",\"T\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.EndTime))
+	}
+	{
+		const prefix string = ",\"s\":"
+		out.RawString(prefix)
+		out.String(string(in.Symbol))
+	}
+	{
+		const prefix string = ",\"i\":"
+		out.RawString(prefix)
+		out.String(string(in.Interval))
+	}
+	{
+		const prefix string = ",\"f\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.FirstTradeID))
+	}
+	{
+		const prefix string = ",\"L\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.LastTradeID))
+	}
+	{
+		const prefix string = ",\"o\":"
+		out.RawString(prefix)
+		out.String(string(in.Open))
+	}
+	{
+		const prefix string = ",\"c\":"
+		out.RawString(prefix)
+		out.String(string(in.Close))
+	}
+	{
+		const prefix string = ",\"h\":"
+		out.RawString(prefix)
+		out.String(string(in.High))
+	}
+	{
+		const prefix string = ",\"l\":"
+		out.RawString(prefix)
+		out.String(string(in.Low))
+	}
+	{
+		const prefix string = ",\"v\":"
+		out.RawString(prefix)
+		out.String(string(in.Volume))
+	}
+	{
+		const prefix string = ",\"n\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.TradeNum))
+	}
+	{
+		const prefix string = ",\"x\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsFinal))
+	}
+	{
+		const prefix string = ",\"q\":"
+		out.RawString(prefix)
+		out.String(string(in.QuoteVolume))
+	}
+	{
+		const prefix string = ",\"V\":"
+		out.RawString(prefix)
+		out.String(string(in.ActiveBuyVolume))
+	}
+	{
+		const prefix string = ",\"Q\":"
+		out.RawString(prefix)
+		out.String(string(in.ActiveBuyQuoteVolume))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v WsKline) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices8(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v WsKline) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices8(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *WsKline) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices8(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *WsKline) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices8(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices9(in *jlexer.Lexer, out *WsExecutionReportEvent) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "e":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Event = UserDataEventType(in.String())
+			}
+		case "E":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Time = int64(in.Int64())
+			}
+		case "s":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Symbol = string(in.String())
+			}
+		case "c":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ClientOrderID = string(in.String())
+			}
+		case "S":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Side = SideType(in.String())
+			}
+		case "o":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Type = OrderType(in.String())
+			}
+		case "f":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TimeInForce = TimeInForceType(in.String())
+			}
+		case "q":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.Quantity).UnmarshalJSON(data))
+				}
+			}
+		case "p":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.Price).UnmarshalJSON(data))
+				}
+			}
+		case "P":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.StopPrice).UnmarshalJSON(data))
+				}
+			}
+		case "F":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.IcebergQuantity).UnmarshalJSON(data))
+				}
+			}
+		case "g":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.OrderListID = int64(in.Int64())
+			}
+		case "C":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.OrigClientOrderID = string(in.String())
+			}
+		case "x":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ExecutionType = ExecutionType(in.String())
+			}
+		case "X":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Status = OrderStatusType(in.String())
+			}
+		case "r":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.RejectReason = string(in.String())
+			}
+		case "i":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.OrderID = int64(in.Int64())
+			}
+		case "l":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.LastExecutedQuantity).UnmarshalJSON(data))
+				}
+			}
+		case "z":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.ExecutedQuantity).UnmarshalJSON(data))
+				}
+			}
+		case "L":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.LastExecutedPrice).UnmarshalJSON(data))
+				}
+			}
+		case "n":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.Commission).UnmarshalJSON(data))
+				}
+			}
+		case "N":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.CommissionAsset = string(in.String())
+			}
+		case "T":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TransactionTime = int64(in.Int64())
+			}
+		case "t":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TradeID = int64(in.Int64())
+			}
+		case "w":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.IsInOrderBook = bool(in.Bool())
+			}
+		case "m":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.IsMaker = bool(in.Bool())
+			}
+		case "O":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.CreateTime = int64(in.Int64())
+			}
+		case "Z":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.CummulativeQuoteQty).UnmarshalJSON(data))
+				}
+			}
+		case "Y":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.LastQuoteQty).UnmarshalJSON(data))
+				}
+			}
+		case "Q":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.QuoteOrderQty).UnmarshalJSON(data))
+				}
+			}
+		case "W":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.WorkingTime = int64(in.Int64())
+			}
+		case "V":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.SelfTradePreventionMode = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices9(out *jwriter.Writer, in WsExecutionReportEvent) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"e\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Event))
+	}
+	{
+		const prefix string = ",\"E\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Time))
+	}
+	{
+		const prefix string = ",\"s\":"
+		out.RawString(prefix)
+		out.String(string(in.Symbol))
+	}
+	{
+		const prefix string = ",\"c\":"
+		out.RawString(prefix)
+		out.String(string(in.ClientOrderID))
+	}
+	{
+		const prefix string = ",\"S\":"
+		out.RawString(prefix)
+		out.String(string(in.Side))
+	}
+	{
+		const prefix string = ",\"o\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"f\":"
+		out.RawString(prefix)
+		out.String(string(in.TimeInForce))
+	}
+	{
+		const prefix string = ",\"q\":"
+		out.RawString(prefix)
+		out.Raw((in.Quantity).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"p\":"
+		out.RawString(prefix)
+		out.Raw((in.Price).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"P\":"
+		out.RawString(prefix)
+		out.Raw((in.StopPrice).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"F\":"
+		out.RawString(prefix)
+		out.Raw((in.IcebergQuantity).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"g\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.OrderListID))
+	}
+	{
+		const prefix string = ",\"C\":"
+		out.RawString(prefix)
+		out.String(string(in.OrigClientOrderID))
+	}
+	{
+		const prefix string = ",\"x\":"
+		out.RawString(prefix)
+		out.String(string(in.ExecutionType))
+	}
+	{
+		const prefix string = ",\"X\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	{
+		const prefix string = ",\"r\":"
+		out.RawString(prefix)
+		out.String(string(in.RejectReason))
+	}
+	{
+		const prefix string = ",\"i\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.OrderID))
+	}
+	{
+		const prefix string = ",\"l\":"
+		out.RawString(prefix)
+		out.Raw((in.LastExecutedQuantity).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"z\":"
+		out.RawString(prefix)
+		out.Raw((in.ExecutedQuantity).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"L\":"
+		out.RawString(prefix)
+		out.Raw((in.LastExecutedPrice).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"n\":"
+		out.RawString(prefix)
+		out.Raw((in.Commission).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"N\":"
+		out.RawString(prefix)
+		out.String(string(in.CommissionAsset))
+	}
+	{
+		const prefix string = ",\"T\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.TransactionTime))
+	}
+	{
+		const prefix string = ",\"t\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.TradeID))
+	}
+	{
+		const prefix string = ",\"w\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsInOrderBook))
+	}
+	{
+		const prefix string = ",\"m\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsMaker))
+	}
+	{
+		const prefix string = ",\"O\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.CreateTime))
+	}
+	{
+		const prefix string = ",\"Z\":"
+		out.RawString(prefix)
+		out.Raw((in.CummulativeQuoteQty).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"Y\":"
+		out.RawString(prefix)
+		out.Raw((in.LastQuoteQty).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"Q\":"
+		out.RawString(prefix)
+		out.Raw((in.QuoteOrderQty).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"W\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.WorkingTime))
+	}
+	{
+		const prefix string = ",\"V\":"
+		out.RawString(prefix)
+		out.String(string(in.SelfTradePreventionMode))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v WsExecutionReportEvent) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices9(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v WsExecutionReportEvent) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices9(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *WsExecutionReportEvent) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices9(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *WsExecutionReportEvent) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices9(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices10(in *jlexer.Lexer, out *WsDepthEvent) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "e":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Event = string(in.String())
+			}
+		case "E":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Time = int64(in.Int64())
+			}
+		case "s":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Symbol = string(in.String())
+			}
+		case "U":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.FirstUpdateID = int64(in.Int64())
+			}
+		case "u":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.LastUpdateID = int64(in.Int64())
+			}
+		case "b":
+			if in.IsNull() {
+				in.Skip()
+				out.Bids = nil
+			} else {
+				in.Delim('[')
+				if out.Bids == nil {
+					if !in.IsDelim(']') {
+						out.Bids = make([]common.PriceLevel, 0, 2)
+					} else {
+						out.Bids = []common.PriceLevel{}
+					}
+				} else {
+					out.Bids = (out.Bids)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v10 common.PriceLevel
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v10).UnmarshalEasyJSON(in)
+					}
+					out.Bids = append(out.Bids, v10)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "a":
+			if in.IsNull() {
+				in.Skip()
+				out.Asks = nil
+			} else {
+				in.Delim('[')
+				if out.Asks == nil {
+					if !in.IsDelim(']') {
+						out.Asks = make([]common.PriceLevel, 0, 2)
+					} else {
+						out.Asks = []common.PriceLevel{}
+					}
+				} else {
+					out.Asks = (out.Asks)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v11 common.PriceLevel
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v11).UnmarshalEasyJSON(in)
+					}
+					out.Asks = append(out.Asks, v11)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices10(out *jwriter.Writer, in WsDepthEvent) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"e\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Event))
+	}
+	{
+		const prefix string = ",\"E\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Time))
+	}
+	{
+		const prefix string = ",\"s\":"
+		out.RawString(prefix)
+		out.String(string(in.Symbol))
+	}
+	{
+		const prefix string = ",\"U\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.FirstUpdateID))
+	}
+	{
+		const prefix string = ",\"u\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.LastUpdateID))
+	}
+	{
+		const prefix string = ",\"b\":"
+		out.RawString(prefix)
+		if in.Bids == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v12, v13 := range in.Bids {
+				if v12 > 0 {
+					out.RawByte(',')
+				}
+				(v13).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"a\":"
+		out.RawString(prefix)
+		if in.Asks == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v14, v15 := range in.Asks {
+				if v14 > 0 {
+					out.RawByte(',')
+				}
+				(v15).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v WsDepthEvent) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices10(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v WsDepthEvent) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices10(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *WsDepthEvent) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices10(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *WsDepthEvent) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices10(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices11(in *jlexer.Lexer, out *WsBookTickerEvent) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "u":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.UpdateID = int64(in.Int64())
+			}
+		case "s":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Symbol = string(in.String())
+			}
+		case "b":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.BestBidPrice = string(in.String())
+			}
+		case "B":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.BestBidQty = string(in.String())
+			}
+		case "a":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.BestAskPrice = string(in.String())
+			}
+		case "A":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.BestAskQty = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices11(out *jwriter.Writer, in WsBookTickerEvent) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"u\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.UpdateID))
+	}
+	{
+		const prefix string = ",\"s\":"
+		out.RawString(prefix)
+		out.String(string(in.Symbol))
+	}
+	{
+		const prefix string = ",\"b\":"
+		out.RawString(prefix)
+		out.String(string(in.BestBidPrice))
+	}
+	{
+		const prefix string = ",\"B\":"
+		out.RawString(prefix)
+		out.String(string(in.BestBidQty))
+	}
+	{
+		const prefix string = ",\"a\":"
+		out.RawString(prefix)
+		out.String(string(in.BestAskPrice))
+	}
+	{
+		const prefix string = ",\"A\":"
+		out.RawString(prefix)
+		out.String(string(in.BestAskQty))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v WsBookTickerEvent) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices11(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v WsBookTickerEvent) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices11(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *WsBookTickerEvent) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices11(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *WsBookTickerEvent) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices11(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices12(in *jlexer.Lexer, out *WsBalanceUpdateEvent) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "e":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Event = UserDataEventType(in.String())
+			}
+		case "E":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Time = int64(in.Int64())
+			}
+		case "a":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Asset = string(in.String())
+			}
+		case "d":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.Change).UnmarshalJSON(data))
+				}
+			}
+		case "T":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TransactTime = int64(in.Int64())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices12(out *jwriter.Writer, in WsBalanceUpdateEvent) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"e\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Event))
+	}
+	{
+		const prefix string = ",\"E\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Time))
+	}
+	{
+		const prefix string = ",\"a\":"
+		out.RawString(prefix)
+		out.String(string(in.Asset))
+	}
+	{
+		const prefix string = ",\"d\":"
+		out.RawString(prefix)
+		out.Raw((in.Change).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"T\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.TransactTime))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v WsBalanceUpdateEvent) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices12(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v WsBalanceUpdateEvent) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices12(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *WsBalanceUpdateEvent) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices12(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *WsBalanceUpdateEvent) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices12(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices13(in *jlexer.Lexer, out *WsAllMiniMarketStatEvent) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+		*out = nil
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(WsAllMiniMarketStatEvent, 0, 8)
+			} else {
+				*out = WsAllMiniMarketStatEvent{}
+			}
+		} else {
+			*out = (*out)[:0]
+		}
+		for !in.IsDelim(']') {
+			var v16 *WsMiniMarketStatEvent
+			if in.IsNull() {
+				in.Skip()
+				v16 = nil
+			} else {
+				if v16 == nil {
+					v16 = new(WsMiniMarketStatEvent)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*v16).UnmarshalEasyJSON(in)
+				}
+			}
+			*out = append(*out, v16)
+			in.WantComma()
+		}
+		in.Delim(']')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices13(out *jwriter.Writer, in WsAllMiniMarketStatEvent) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v17, v18 := range in {
+			if v17 > 0 {
+				out.RawByte(',')
+			}
+			if v18 == nil {
+				out.RawString("null")
+			} else {
+				(*v18).MarshalEasyJSON(out)
+			}
+		}
+		out.RawByte(']')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v WsAllMiniMarketStatEvent) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices13(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v WsAllMiniMarketStatEvent) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices13(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *WsAllMiniMarketStatEvent) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices13(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *WsAllMiniMarketStatEvent) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices13(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices14(in *jlexer.Lexer, out *WsAllMarketStatEvent) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+		*out = nil
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(WsAllMarketStatEvent, 0, 8)
+			} else {
+				*out = WsAllMarketStatEvent{}
+			}
+		} else {
+			*out = (*out)[:0]
+		}
+		for !in.IsDelim(']') {
+			var v19 *WsMarketStatEvent
+			if in.IsNull() {
+				in.Skip()
+				v19 = nil
+			} else {
+				if v19 == nil {
+					v19 = new(WsMarketStatEvent)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*v19).UnmarshalEasyJSON(in)
+				}
+			}
+			*out = append(*out, v19)
+			in.WantComma()
+		}
+		in.Delim(']')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices14(out *jwriter.Writer, in WsAllMarketStatEvent) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v20, v21 := range in {
+			if v20 > 0 {
+				out.RawByte(',')
+			}
+			if v21 == nil {
+				out.RawString("null")
+			} else {
+				(*v21).MarshalEasyJSON(out)
+			}
+		}
+		out.RawByte(']')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v WsAllMarketStatEvent) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices14(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v WsAllMarketStatEvent) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices14(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *WsAllMarketStatEvent) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices14(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *WsAllMarketStatEvent) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices14(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices15(in *jlexer.Lexer, out *WsAggTradeEvent) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "e":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Event = string(in.String())
+			}
+		case "E":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Time = int64(in.Int64())
+			}
+		case "s":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Symbol = string(in.String())
+			}
+		case "a":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.AggTradeID = int64(in.Int64())
+			}
+		case "p":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Price = string(in.String())
+			}
+		case "q":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Quantity = string(in.String())
+			}
+		case "f":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.FirstTradeID = int64(in.Int64())
+			}
+		case "l":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.LastTradeID = int64(in.Int64())
+			}
+		case "T":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TradeTime = int64(in.Int64())
+			}
+		case "m":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.IsBuyerMaker = bool(in.Bool())
+			}
+		case "M":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.IsBestPriceMatch = bool(in.Bool())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices15(out *jwriter.Writer, in WsAggTradeEvent) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"e\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Event))
+	}
+	{
+		const prefix string = ",\"E\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Time))
+	}
+	{
+		const prefix string = ",\"s\":"
+		out.RawString(prefix)
+		out.String(string(in.Symbol))
+	}
+	{
+		const prefix string = ",\"a\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.AggTradeID))
+	}
+	{
+		const prefix string = ",\"p\":"
+		out.RawString(prefix)
+		out.String(string(in.Price))
+	}
+	{
+		const prefix string = ",\"q\":"
+		out.RawString(prefix)
+		out.String(string(in.Quantity))
+	}
+	{
+		const prefix string = ",\"f\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.FirstTradeID))
+	}
+	{
+		const prefix string = ",\"l\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.LastTradeID))
+	}
+	{
+		const prefix string = ",\"T\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.TradeTime))
+	}
+	{
+		const prefix string = ",\"m\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsBuyerMaker))
+	}
+	{
+		const prefix string = ",\"M\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsBestPriceMatch))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v WsAggTradeEvent) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices15(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v WsAggTradeEvent) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices15(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *WsAggTradeEvent) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices15(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *WsAggTradeEvent) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices15(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices16(in *jlexer.Lexer, out *WsAccountUpdateEvent) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "e":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Event = UserDataEventType(in.String())
+			}
+		case "E":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Time = int64(in.Int64())
+			}
+		case "u":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.LastUpdateTime = int64(in.Int64())
+			}
+		case "B":
+			if in.IsNull() {
+				in.Skip()
+				out.Balances = nil
+			} else {
+				in.Delim('[')
+				if out.Balances == nil {
+					if !in.IsDelim(']') {
+						out.Balances = make([]WsAccountUpdate, 0, 1)
+					} else {
+						out.Balances = []WsAccountUpdate{}
+					}
+				} else {
+					out.Balances = (out.Balances)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v22 WsAccountUpdate
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v22).UnmarshalEasyJSON(in)
+					}
+					out.Balances = append(out.Balances, v22)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices16(out *jwriter.Writer, in WsAccountUpdateEvent) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"e\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Event))
+	}
+	{
+		const prefix string = ",\"E\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Time))
+	}
+	{
+		const prefix string = ",\"u\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.LastUpdateTime))
+	}
+	{
+		const prefix string = ",\"B\":"
+		out.RawString(prefix)
+		if in.Balances == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v23, v24 := range in.Balances {
+				if v23 > 0 {
+					out.RawByte(',')
+				}
+				(v24).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v WsAccountUpdateEvent) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices16(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v WsAccountUpdateEvent) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices16(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *WsAccountUpdateEvent) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices16(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *WsAccountUpdateEvent) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices16(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices17(in *jlexer.Lexer, out *WsAccountUpdate) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "a":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Asset = string(in.String())
+			}
+		case "f":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.Free).UnmarshalJSON(data))
+				}
+			}
+		case "l":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.Locked).UnmarshalJSON(data))
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices17(out *jwriter.Writer, in WsAccountUpdate) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"a\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Asset))
+	}
+	{
+		const prefix string = ",\"f\":"
+		out.RawString(prefix)
+		out.Raw((in.Free).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"l\":"
+		out.RawString(prefix)
+		out.Raw((in.Locked).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v WsAccountUpdate) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices17(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v WsAccountUpdate) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices17(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *WsAccountUpdate) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices17(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *WsAccountUpdate) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices17(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices18(in *jlexer.Lexer, out *Withdraw) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "address":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Address = string(in.String())
+			}
+		case "amount":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Amount = string(in.String())
+			}
+		case "applyTime":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ApplyTime = string(in.String())
+			}
+		case "coin":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Coin = string(in.String())
+			}
+		case "id":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ID = string(in.String())
+			}
+		case "withdrawOrderId":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.WithdrawOrderID = string(in.String())
+			}
+		case "network":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Network = string(in.String())
+			}
+		case "transferType":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TransferType = int(in.Int())
+			}
+		case "status":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Status = int(in.Int())
+			}
+		case "transactionFee":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TransactionFee = string(in.String())
+			}
+		case "confirmNo":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ConfirmNo = int32(in.Int32())
+			}
+		case "info":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Info = string(in.String())
+			}
+		case "txId":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TxID = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices18(out *jwriter.Writer, in Withdraw) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"address\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Address))
+	}
+	{
+		const prefix string = ",\"amount\":"
+		out.RawString(prefix)
+		out.String(string(in.Amount))
+	}
+	{
+		const prefix string = ",\"applyTime\":"
+		out.RawString(prefix)
+		out.String(string(in.ApplyTime))
+	}
+	{
+		const prefix string = ",\"coin\":"
+		out.RawString(prefix)
+		out.String(string(in.Coin))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.String(string(in.ID))
+	}
+	{
+		const prefix string = ",\"withdrawOrderId\":"
+		out.RawString(prefix)
+		out.String(string(in.WithdrawOrderID))
+	}
+	{
+		const prefix string = ",\"network\":"
+		out.RawString(prefix)
+		out.String(string(in.Network))
+	}
+	{
+		const prefix string = ",\"transferType\":"
+		out.RawString(prefix)
+		out.Int(int(in.TransferType))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.Int(int(in.Status))
+	}
+	{
+		const prefix string = ",\"transactionFee\":"
+		out.RawString(prefix)
+		out.String(string(in.TransactionFee))
+	}
+	{
+		const prefix string = ",\"confirmNo\":"
+		out.RawString(prefix)
+		out.Int32(int32(in.ConfirmNo))
+	}
+	{
+		const prefix string = ",\"info\":"
+		out.RawString(prefix)
+		out.String(string(in.Info))
+	}
+	{
+		const prefix string = ",\"txId\":"
+		out.RawString(prefix)
+		out.String(string(in.TxID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Withdraw) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices18(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Withdraw) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices18(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Withdraw) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices18(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Withdraw) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices18(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices19(in *jlexer.Lexer, out *UserAssetRecord) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "asset":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Asset = string(in.String())
+			}
+		case "free":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.Free).UnmarshalJSON(data))
+				}
+			}
+		case "locked":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.Locked).UnmarshalJSON(data))
+				}
+			}
+		case "freeze":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.Freeze).UnmarshalJSON(data))
+				}
+			}
+		case "withdrawing":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Withdrawing = string(in.String())
+			}
+		case "ipoable":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Ipoable = string(in.String())
+			}
+		case "btcValuation":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.BtcValuation = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices19(out *jwriter.Writer, in UserAssetRecord) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"asset\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Asset))
+	}
+	{
+		const prefix string = ",\"free\":"
+		out.RawString(prefix)
+		out.Raw((in.Free).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"locked\":"
+		out.RawString(prefix)
+		out.Raw((in.Locked).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"freeze\":"
+		out.RawString(prefix)
+		out.Raw((in.Freeze).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"withdrawing\":"
+		out.RawString(prefix)
+		out.String(string(in.Withdrawing))
+	}
+	{
+		const prefix string = ",\"ipoable\":"
+		out.RawString(prefix)
+		out.String(string(in.Ipoable))
+	}
+	{
+		const prefix string = ",\"btcValuation\":"
+		out.RawString(prefix)
+		out.String(string(in.BtcValuation))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v UserAssetRecord) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices19(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v UserAssetRecord) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices19(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *UserAssetRecord) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices19(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *UserAssetRecord) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices19(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices20(in *jlexer.Lexer, out *UserAssetDribbletDetail) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "transId":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TransID = int(in.Int())
+			}
+		case "serviceChargeAmount":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ServiceChargeAmount = string(in.String())
+			}
+		case "amount":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Amount = string(in.String())
+			}
+		case "operateTime":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.OperateTime = int64(in.Int64())
+			}
+		case "transferedAmount":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TransferedAmount = string(in.String())
+			}
+		case "fromAsset":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.FromAsset = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices2(out *jwriter.Writer, in UserAssetDribbletDetail) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices20(out *jwriter.Writer, in UserAssetDribbletDetail) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -452,27 +3350,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices2(out *jwriter.Write
 // MarshalJSON supports json.Marshaler interface
 func (v UserAssetDribbletDetail) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices2(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices20(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v UserAssetDribbletDetail) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices2(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices20(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *UserAssetDribbletDetail) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices2(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices20(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *UserAssetDribbletDetail) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices2(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices20(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices3(in *jlexer.Lexer, out *UserAssetDribblet) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices21(in *jlexer.Lexer, out *UserAssetDribblet) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -526,13 +3424,13 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices3(in *jlexer.Lexer,
 					out.UserAssetDribbletDetails = (out.UserAssetDribbletDetails)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v1 UserAssetDribbletDetail
+					var v25 UserAssetDribbletDetail
 					if in.IsNull() {
 						in.Skip()
 					} else {
-						(v1).UnmarshalEasyJSON(in)
+						(v25).UnmarshalEasyJSON(in)
 					}
-					out.UserAssetDribbletDetails = append(out.UserAssetDribbletDetails, v1)
+					out.UserAssetDribbletDetails = append(out.UserAssetDribbletDetails, v25)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -547,7 +3445,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices3(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices3(out *jwriter.Writer, in UserAssetDribblet) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices21(out *jwriter.Writer, in UserAssetDribblet) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -578,11 +3476,11 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices3(out *jwriter.Write
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v2, v3 := range in.UserAssetDribbletDetails {
-				if v2 > 0 {
+			for v26, v27 := range in.UserAssetDribbletDetails {
+				if v26 > 0 {
 					out.RawByte(',')
 				}
-				(v3).MarshalEasyJSON(out)
+				(v27).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -593,27 +3491,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices3(out *jwriter.Write
 // MarshalJSON supports json.Marshaler interface
 func (v UserAssetDribblet) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices3(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices21(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v UserAssetDribblet) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices3(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices21(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *UserAssetDribblet) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices3(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices21(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *UserAssetDribblet) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices3(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices21(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices4(in *jlexer.Lexer, out *UserAsset) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices22(in *jlexer.Lexer, out *UserAsset) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -673,7 +3571,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices4(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices4(out *jwriter.Writer, in UserAsset) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices22(out *jwriter.Writer, in UserAsset) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -713,27 +3611,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices4(out *jwriter.Write
 // MarshalJSON supports json.Marshaler interface
 func (v UserAsset) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices4(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices22(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v UserAsset) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices4(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices22(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *UserAsset) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices4(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices22(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *UserAsset) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices4(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices22(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices5(in *jlexer.Lexer, out *UpdateIPRestrictionSubAccountAPIKeyResponse) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices23(in *jlexer.Lexer, out *UpdateIPRestrictionSubAccountAPIKeyResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -769,13 +3667,13 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices5(in *jlexer.Lexer,
 					out.IpList = (out.IpList)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v4 string
+					var v28 string
 					if in.IsNull() {
 						in.Skip()
 					} else {
-						v4 = string(in.String())
+						v28 = string(in.String())
 					}
-					out.IpList = append(out.IpList, v4)
+					out.IpList = append(out.IpList, v28)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -802,7 +3700,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices5(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices5(out *jwriter.Writer, in UpdateIPRestrictionSubAccountAPIKeyResponse) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices23(out *jwriter.Writer, in UpdateIPRestrictionSubAccountAPIKeyResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -818,11 +3716,11 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices5(out *jwriter.Write
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v5, v6 := range in.IpList {
-				if v5 > 0 {
+			for v29, v30 := range in.IpList {
+				if v29 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v6))
+				out.String(string(v30))
 			}
 			out.RawByte(']')
 		}
@@ -843,27 +3741,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices5(out *jwriter.Write
 // MarshalJSON supports json.Marshaler interface
 func (v UpdateIPRestrictionSubAccountAPIKeyResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices5(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices23(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v UpdateIPRestrictionSubAccountAPIKeyResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices5(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices23(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *UpdateIPRestrictionSubAccountAPIKeyResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices5(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices23(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *UpdateIPRestrictionSubAccountAPIKeyResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices5(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices23(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices6(in *jlexer.Lexer, out *UniversalTransferServiceResponse) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices24(in *jlexer.Lexer, out *UniversalTransferServiceResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -893,7 +3791,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices6(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices6(out *jwriter.Writer, in UniversalTransferServiceResponse) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices24(out *jwriter.Writer, in UniversalTransferServiceResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -908,27 +3806,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices6(out *jwriter.Write
 // MarshalJSON supports json.Marshaler interface
 func (v UniversalTransferServiceResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices6(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices24(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v UniversalTransferServiceResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices6(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices24(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *UniversalTransferServiceResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices6(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices24(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *UniversalTransferServiceResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices6(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices24(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices7(in *jlexer.Lexer, out *TransferToSubAccountResponse) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices25(in *jlexer.Lexer, out *TransferToSubAccountResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -958,7 +3856,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices7(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices7(out *jwriter.Writer, in TransferToSubAccountResponse) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices25(out *jwriter.Writer, in TransferToSubAccountResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -973,27 +3871,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices7(out *jwriter.Write
 // MarshalJSON supports json.Marshaler interface
 func (v TransferToSubAccountResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices7(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices25(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v TransferToSubAccountResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices7(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices25(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *TransferToSubAccountResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices7(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices25(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *TransferToSubAccountResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices7(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices25(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices8(in *jlexer.Lexer, out *Transfer) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices26(in *jlexer.Lexer, out *Transfer) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1055,7 +3953,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices8(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices8(out *jwriter.Writer, in Transfer) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices26(out *jwriter.Writer, in Transfer) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1095,27 +3993,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices8(out *jwriter.Write
 // MarshalJSON supports json.Marshaler interface
 func (v Transfer) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices8(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices26(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Transfer) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices8(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices26(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Transfer) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices8(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices26(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Transfer) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices8(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices26(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices9(in *jlexer.Lexer, out *TransactionResponse) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices27(in *jlexer.Lexer, out *TransactionResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1145,7 +4043,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices9(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices9(out *jwriter.Writer, in TransactionResponse) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices27(out *jwriter.Writer, in TransactionResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1160,27 +4058,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices9(out *jwriter.Write
 // MarshalJSON supports json.Marshaler interface
 func (v TransactionResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices9(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices27(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v TransactionResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices9(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices27(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *TransactionResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices9(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices27(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *TransactionResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices9(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices27(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices10(in *jlexer.Lexer, out *TrailingDeltaFilter) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices28(in *jlexer.Lexer, out *TrailingDeltaFilter) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1228,7 +4126,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices10(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices10(out *jwriter.Writer, in TrailingDeltaFilter) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices28(out *jwriter.Writer, in TrailingDeltaFilter) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1258,27 +4156,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices10(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v TrailingDeltaFilter) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices10(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices28(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v TrailingDeltaFilter) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices10(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices28(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *TrailingDeltaFilter) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices10(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices28(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *TrailingDeltaFilter) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices10(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices28(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices11(in *jlexer.Lexer, out *TradeV3) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices29(in *jlexer.Lexer, out *TradeV3) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1392,7 +4290,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices11(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices11(out *jwriter.Writer, in TradeV3) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices29(out *jwriter.Writer, in TradeV3) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1472,27 +4370,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices11(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v TradeV3) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices11(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices29(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v TradeV3) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices11(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices29(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *TradeV3) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices11(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices29(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *TradeV3) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices11(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices29(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices12(in *jlexer.Lexer, out *TradeFeeDetails) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices30(in *jlexer.Lexer, out *TradeFeeDetails) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1534,7 +4432,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices12(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices12(out *jwriter.Writer, in TradeFeeDetails) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices30(out *jwriter.Writer, in TradeFeeDetails) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1559,27 +4457,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices12(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v TradeFeeDetails) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices12(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices30(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v TradeFeeDetails) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices12(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices30(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *TradeFeeDetails) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices12(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices30(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *TradeFeeDetails) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices12(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices30(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices13(in *jlexer.Lexer, out *Trade) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices31(in *jlexer.Lexer, out *Trade) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1627,19 +4525,155 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices13(in *jlexer.Lexer,
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.IsBuyerMaker = bool(in.Bool())
+				out.IsBuyerMaker = bool(in.Bool())
+			}
+		case "isBestMatch":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.IsBestMatch = bool(in.Bool())
+			}
+		case "isIsolated":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.IsIsolated = bool(in.Bool())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices31(out *jwriter.Writer, in Trade) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.ID))
+	}
+	{
+		const prefix string = ",\"price\":"
+		out.RawString(prefix)
+		out.String(string(in.Price))
+	}
+	{
+		const prefix string = ",\"qty\":"
+		out.RawString(prefix)
+		out.String(string(in.Quantity))
+	}
+	{
+		const prefix string = ",\"quoteQty\":"
+		out.RawString(prefix)
+		out.String(string(in.QuoteQuantity))
+	}
+	{
+		const prefix string = ",\"time\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Time))
+	}
+	{
+		const prefix string = ",\"isBuyerMaker\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsBuyerMaker))
+	}
+	{
+		const prefix string = ",\"isBestMatch\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsBestMatch))
+	}
+	{
+		const prefix string = ",\"isIsolated\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsIsolated))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Trade) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices31(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Trade) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices31(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Trade) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices31(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Trade) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices31(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices32(in *jlexer.Lexer, out *TestOrderResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "standardCommissionForOrder":
+			if in.IsNull() {
+				in.Skip()
+				out.StandardCommissionForOrder = nil
+			} else {
+				if out.StandardCommissionForOrder == nil {
+					out.StandardCommissionForOrder = new(CommissionRates)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.StandardCommissionForOrder).UnmarshalEasyJSON(in)
+				}
 			}
-		case "isBestMatch":
+		case "taxCommissionForOrder":
 			if in.IsNull() {
 				in.Skip()
+				out.TaxCommissionForOrder = nil
 			} else {
-				out.IsBestMatch = bool(in.Bool())
+				if out.TaxCommissionForOrder == nil {
+					out.TaxCommissionForOrder = new(CommissionRates)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.TaxCommissionForOrder).UnmarshalEasyJSON(in)
+				}
 			}
-		case "isIsolated":
+		case "discount":
 			if in.IsNull() {
 				in.Skip()
+				out.Discount = nil
 			} else {
-				out.IsIsolated = bool(in.Bool())
+				if out.Discount == nil {
+					out.Discount = new(CommissionDiscount)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.Discount).UnmarshalEasyJSON(in)
+				}
 			}
 		default:
 			in.SkipRecursive()
@@ -1651,77 +4685,63 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices13(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices13(out *jwriter.Writer, in Trade) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices32(out *jwriter.Writer, in TestOrderResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
-	{
-		const prefix string = ",\"id\":"
+	if in.StandardCommissionForOrder != nil {
+		const prefix string = ",\"standardCommissionForOrder\":"
+		first = false
 		out.RawString(prefix[1:])
-		out.Int64(int64(in.ID))
-	}
-	{
-		const prefix string = ",\"price\":"
-		out.RawString(prefix)
-		out.String(string(in.Price))
-	}
-	{
-		const prefix string = ",\"qty\":"
-		out.RawString(prefix)
-		out.String(string(in.Quantity))
-	}
-	{
-		const prefix string = ",\"quoteQty\":"
-		out.RawString(prefix)
-		out.String(string(in.QuoteQuantity))
+		(*in.StandardCommissionForOrder).MarshalEasyJSON(out)
 	}
-	{
-		const prefix string = ",\"time\":"
-		out.RawString(prefix)
-		out.Int64(int64(in.Time))
-	}
-	{
-		const prefix string = ",\"isBuyerMaker\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.IsBuyerMaker))
-	}
-	{
-		const prefix string = ",\"isBestMatch\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.IsBestMatch))
+	if in.TaxCommissionForOrder != nil {
+		const prefix string = ",\"taxCommissionForOrder\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.TaxCommissionForOrder).MarshalEasyJSON(out)
 	}
-	{
-		const prefix string = ",\"isIsolated\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.IsIsolated))
+	if in.Discount != nil {
+		const prefix string = ",\"discount\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.Discount).MarshalEasyJSON(out)
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Trade) MarshalJSON() ([]byte, error) {
+func (v TestOrderResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices13(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices32(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Trade) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices13(w, v)
+func (v TestOrderResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices32(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Trade) UnmarshalJSON(data []byte) error {
+func (v *TestOrderResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices13(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices32(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Trade) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices13(l, v)
+func (v *TestOrderResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices32(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices14(in *jlexer.Lexer, out *SymbolTicker) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices33(in *jlexer.Lexer, out *SymbolTicker) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1835,7 +4855,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices14(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices14(out *jwriter.Writer, in SymbolTicker) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices33(out *jwriter.Writer, in SymbolTicker) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1920,27 +4940,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices14(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v SymbolTicker) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices14(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices33(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v SymbolTicker) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices14(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices33(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *SymbolTicker) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices14(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices33(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *SymbolTicker) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices14(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices33(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices15(in *jlexer.Lexer, out *SymbolPrice) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices34(in *jlexer.Lexer, out *SymbolPrice) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1976,7 +4996,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices15(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices15(out *jwriter.Writer, in SymbolPrice) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices34(out *jwriter.Writer, in SymbolPrice) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1996,27 +5016,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices15(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v SymbolPrice) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices15(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices34(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v SymbolPrice) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices15(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices34(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *SymbolPrice) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices15(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices34(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *SymbolPrice) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices15(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices34(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices16(in *jlexer.Lexer, out *Symbol) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices35(in *jlexer.Lexer, out *Symbol) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2100,13 +5120,13 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices16(in *jlexer.Lexer,
 					out.OrderTypes = (out.OrderTypes)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v7 string
+					var v31 string
 					if in.IsNull() {
 						in.Skip()
 					} else {
-						v7 = string(in.String())
+						v31 = string(in.String())
 					}
-					out.OrderTypes = append(out.OrderTypes, v7)
+					out.OrderTypes = append(out.OrderTypes, v31)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -2157,29 +5177,29 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices16(in *jlexer.Lexer,
 					out.Filters = (out.Filters)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v8 map[string]interface{}
+					var v32 map[string]interface{}
 					if in.IsNull() {
 						in.Skip()
 					} else {
 						in.Delim('{')
-						v8 = make(map[string]interface{})
+						v32 = make(map[string]interface{})
 						for !in.IsDelim('}') {
 							key := string(in.String())
 							in.WantColon()
-							var v9 interface{}
-							if m, ok := v9.(easyjson.Unmarshaler); ok {
+							var v33 interface{}
+							if m, ok := v33.(easyjson.Unmarshaler); ok {
 								m.UnmarshalEasyJSON(in)
-							} else if m, ok := v9.(json.Unmarshaler); ok {
+							} else if m, ok := v33.(json.Unmarshaler); ok {
 								_ = m.UnmarshalJSON(in.Raw())
 							} else {
-								v9 = in.Interface()
+								v33 = in.Interface()
 							}
-							(v8)[key] = v9
+							(v32)[key] = v33
 							in.WantComma()
 						}
 						in.Delim('}')
 					}
-					out.Filters = append(out.Filters, v8)
+					out.Filters = append(out.Filters, v32)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -2200,13 +5220,13 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices16(in *jlexer.Lexer,
 					out.Permissions = (out.Permissions)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v10 string
+					var v34 string
 					if in.IsNull() {
 						in.Skip()
 					} else {
-						v10 = string(in.String())
+						v34 = string(in.String())
 					}
-					out.Permissions = append(out.Permissions, v10)
+					out.Permissions = append(out.Permissions, v34)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -2221,7 +5241,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices16(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices16(out *jwriter.Writer, in Symbol) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices35(out *jwriter.Writer, in Symbol) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -2277,11 +5297,11 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices16(out *jwriter.Writ
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v11, v12 := range in.OrderTypes {
-				if v11 > 0 {
+			for v35, v36 := range in.OrderTypes {
+				if v35 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v12))
+				out.String(string(v36))
 			}
 			out.RawByte(']')
 		}
@@ -2318,29 +5338,29 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices16(out *jwriter.Writ
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v13, v14 := range in.Filters {
-				if v13 > 0 {
+			for v37, v38 := range in.Filters {
+				if v37 > 0 {
 					out.RawByte(',')
 				}
-				if v14 == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+				if v38 == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
 					out.RawString(`null`)
 				} else {
 					out.RawByte('{')
-					v15First := true
-					for v15Name, v15Value := range v14 {
-						if v15First {
-							v15First = false
+					v39First := true
+					for v39Name, v39Value := range v38 {
+						if v39First {
+							v39First = false
 						} else {
 							out.RawByte(',')
 						}
-						out.String(string(v15Name))
+						out.String(string(v39Name))
 						out.RawByte(':')
-						if m, ok := v15Value.(easyjson.Marshaler); ok {
+						if m, ok := v39Value.(easyjson.Marshaler); ok {
 							m.MarshalEasyJSON(out)
-						} else if m, ok := v15Value.(json.Marshaler); ok {
+						} else if m, ok := v39Value.(json.Marshaler); ok {
 							out.Raw(m.MarshalJSON())
 						} else {
-							out.Raw(json.Marshal(v15Value))
+							out.Raw(json.Marshal(v39Value))
 						}
 					}
 					out.RawByte('}')
@@ -2356,11 +5376,11 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices16(out *jwriter.Writ
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v16, v17 := range in.Permissions {
-				if v16 > 0 {
+			for v40, v41 := range in.Permissions {
+				if v40 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v17))
+				out.String(string(v41))
 			}
 			out.RawByte(']')
 		}
@@ -2371,27 +5391,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices16(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v Symbol) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices16(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices35(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Symbol) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices16(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices35(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Symbol) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices16(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices35(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Symbol) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices16(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices35(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices17(in *jlexer.Lexer, out *SwapResponse) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices36(in *jlexer.Lexer, out *SwapResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2421,7 +5441,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices17(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices17(out *jwriter.Writer, in SwapResponse) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices36(out *jwriter.Writer, in SwapResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -2436,27 +5456,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices17(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v SwapResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices17(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices36(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v SwapResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices17(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices36(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *SwapResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices17(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices36(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *SwapResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices17(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices36(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices18(in *jlexer.Lexer, out *SwapRecord) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices37(in *jlexer.Lexer, out *SwapRecord) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2534,7 +5554,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices18(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices18(out *jwriter.Writer, in SwapRecord) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices37(out *jwriter.Writer, in SwapRecord) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -2589,27 +5609,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices18(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v SwapRecord) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices18(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices37(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v SwapRecord) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices18(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices37(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *SwapRecord) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices18(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices37(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *SwapRecord) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices18(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices37(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices19(in *jlexer.Lexer, out *SubaccountSpotSummaryResponse) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices38(in *jlexer.Lexer, out *SubaccountSpotSummaryResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2651,13 +5671,13 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices19(in *jlexer.Lexer,
 					out.SpotSubUserAssetBtcVoList = (out.SpotSubUserAssetBtcVoList)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v18 SpotSubUserAssetBtcVoList
+					var v42 SpotSubUserAssetBtcVoList
 					if in.IsNull() {
 						in.Skip()
 					} else {
-						(v18).UnmarshalEasyJSON(in)
+						(v42).UnmarshalEasyJSON(in)
 					}
-					out.SpotSubUserAssetBtcVoList = append(out.SpotSubUserAssetBtcVoList, v18)
+					out.SpotSubUserAssetBtcVoList = append(out.SpotSubUserAssetBtcVoList, v42)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -2672,7 +5692,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices19(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices19(out *jwriter.Writer, in SubaccountSpotSummaryResponse) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices38(out *jwriter.Writer, in SubaccountSpotSummaryResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -2693,11 +5713,11 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices19(out *jwriter.Writ
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v19, v20 := range in.SpotSubUserAssetBtcVoList {
-				if v19 > 0 {
+			for v43, v44 := range in.SpotSubUserAssetBtcVoList {
+				if v43 > 0 {
 					out.RawByte(',')
 				}
-				(v20).MarshalEasyJSON(out)
+				(v44).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -2708,27 +5728,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices19(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v SubaccountSpotSummaryResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices19(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices38(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v SubaccountSpotSummaryResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices19(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices38(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *SubaccountSpotSummaryResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices19(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices38(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *SubaccountSpotSummaryResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices19(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices38(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices20(in *jlexer.Lexer, out *SubaccountDepositAddressResponse) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices39(in *jlexer.Lexer, out *SubaccountDepositAddressResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2776,7 +5796,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices20(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices20(out *jwriter.Writer, in SubaccountDepositAddressResponse) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices39(out *jwriter.Writer, in SubaccountDepositAddressResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -2806,27 +5826,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices20(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v SubaccountDepositAddressResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices20(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices39(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v SubaccountDepositAddressResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices20(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices39(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *SubaccountDepositAddressResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices20(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices39(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *SubaccountDepositAddressResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices20(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices39(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices21(in *jlexer.Lexer, out *SubaccountAssetsResponse) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices40(in *jlexer.Lexer, out *SubaccountAssetsResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2856,13 +5876,13 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices21(in *jlexer.Lexer,
 					out.Balances = (out.Balances)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v21 AssetBalance
+					var v45 AssetBalance
 					if in.IsNull() {
 						in.Skip()
 					} else {
-						(v21).UnmarshalEasyJSON(in)
+						(v45).UnmarshalEasyJSON(in)
 					}
-					out.Balances = append(out.Balances, v21)
+					out.Balances = append(out.Balances, v45)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -2877,7 +5897,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices21(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices21(out *jwriter.Writer, in SubaccountAssetsResponse) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices40(out *jwriter.Writer, in SubaccountAssetsResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -2888,11 +5908,11 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices21(out *jwriter.Writ
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v22, v23 := range in.Balances {
-				if v22 > 0 {
+			for v46, v47 := range in.Balances {
+				if v46 > 0 {
 					out.RawByte(',')
 				}
-				(v23).MarshalEasyJSON(out)
+				(v47).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -2903,27 +5923,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices21(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v SubaccountAssetsResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices21(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices40(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v SubaccountAssetsResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices21(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices40(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *SubaccountAssetsResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices21(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices40(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *SubaccountAssetsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices21(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices40(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices22(in *jlexer.Lexer, out *SubAccountResponse) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices41(in *jlexer.Lexer, out *SubAccountResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2965,7 +5985,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices22(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices22(out *jwriter.Writer, in SubAccountResponse) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices41(out *jwriter.Writer, in SubAccountResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -2990,27 +6010,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices22(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v SubAccountResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices22(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices41(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v SubAccountResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices22(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices41(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *SubAccountResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices22(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices41(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *SubAccountResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices22(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices41(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices23(in *jlexer.Lexer, out *SubAccountList) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices42(in *jlexer.Lexer, out *SubAccountList) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3040,13 +6060,13 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices23(in *jlexer.Lexer,
 					out.SubAccounts = (out.SubAccounts)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v24 SubAccount
+					var v48 SubAccount
 					if in.IsNull() {
 						in.Skip()
 					} else {
-						(v24).UnmarshalEasyJSON(in)
+						(v48).UnmarshalEasyJSON(in)
 					}
-					out.SubAccounts = append(out.SubAccounts, v24)
+					out.SubAccounts = append(out.SubAccounts, v48)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -3061,7 +6081,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices23(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices23(out *jwriter.Writer, in SubAccountList) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices42(out *jwriter.Writer, in SubAccountList) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -3072,11 +6092,11 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices23(out *jwriter.Writ
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v25, v26 := range in.SubAccounts {
-				if v25 > 0 {
+			for v49, v50 := range in.SubAccounts {
+				if v49 > 0 {
 					out.RawByte(',')
 				}
-				(v26).MarshalEasyJSON(out)
+				(v50).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -3087,27 +6107,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices23(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v SubAccountList) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices23(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices42(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v SubAccountList) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices23(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices42(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *SubAccountList) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices23(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices42(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *SubAccountList) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices23(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices42(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices24(in *jlexer.Lexer, out *SubAccountFuturesSummaryV1SubAccountList) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices43(in *jlexer.Lexer, out *SubAccountFuturesSummaryV1SubAccountList) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3185,7 +6205,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices24(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices24(out *jwriter.Writer, in SubAccountFuturesSummaryV1SubAccountList) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices43(out *jwriter.Writer, in SubAccountFuturesSummaryV1SubAccountList) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -3240,27 +6260,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices24(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v SubAccountFuturesSummaryV1SubAccountList) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices24(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices43(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v SubAccountFuturesSummaryV1SubAccountList) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices24(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices43(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *SubAccountFuturesSummaryV1SubAccountList) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices24(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices43(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *SubAccountFuturesSummaryV1SubAccountList) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices24(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices43(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices25(in *jlexer.Lexer, out *SubAccountFuturesSummaryV1) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices44(in *jlexer.Lexer, out *SubAccountFuturesSummaryV1) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3290,13 +6310,13 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices25(in *jlexer.Lexer,
 					out.SubAccountList = (out.SubAccountList)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v27 SubAccountFuturesSummaryV1SubAccountList
+					var v51 SubAccountFuturesSummaryV1SubAccountList
 					if in.IsNull() {
 						in.Skip()
 					} else {
-						(v27).UnmarshalEasyJSON(in)
+						(v51).UnmarshalEasyJSON(in)
 					}
-					out.SubAccountList = append(out.SubAccountList, v27)
+					out.SubAccountList = append(out.SubAccountList, v51)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -3359,7 +6379,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices25(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices25(out *jwriter.Writer, in SubAccountFuturesSummaryV1) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices44(out *jwriter.Writer, in SubAccountFuturesSummaryV1) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -3370,11 +6390,11 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices25(out *jwriter.Writ
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v28, v29 := range in.SubAccountList {
-				if v28 > 0 {
+			for v52, v53 := range in.SubAccountList {
+				if v52 > 0 {
 					out.RawByte(',')
 				}
-				(v29).MarshalEasyJSON(out)
+				(v53).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -3425,27 +6445,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices25(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v SubAccountFuturesSummaryV1) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices25(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices44(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v SubAccountFuturesSummaryV1) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices25(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices44(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *SubAccountFuturesSummaryV1) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices25(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices44(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *SubAccountFuturesSummaryV1) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices25(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices44(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices26(in *jlexer.Lexer, out *SubAccountFuturesSummaryCommon) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices45(in *jlexer.Lexer, out *SubAccountFuturesSummaryCommon) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3517,7 +6537,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices26(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices26(out *jwriter.Writer, in SubAccountFuturesSummaryCommon) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices45(out *jwriter.Writer, in SubAccountFuturesSummaryCommon) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -3567,27 +6587,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices26(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v SubAccountFuturesSummaryCommon) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices26(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices45(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v SubAccountFuturesSummaryCommon) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices26(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices45(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *SubAccountFuturesSummaryCommon) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices26(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices45(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *SubAccountFuturesSummaryCommon) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices26(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices45(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices27(in *jlexer.Lexer, out *SubAccountFuturesAccountAsset) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices46(in *jlexer.Lexer, out *SubAccountFuturesAccountAsset) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3665,7 +6685,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices27(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices27(out *jwriter.Writer, in SubAccountFuturesAccountAsset) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices46(out *jwriter.Writer, in SubAccountFuturesAccountAsset) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -3720,27 +6740,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices27(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v SubAccountFuturesAccountAsset) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices27(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices46(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v SubAccountFuturesAccountAsset) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices27(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices46(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *SubAccountFuturesAccountAsset) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices27(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices46(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *SubAccountFuturesAccountAsset) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices27(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices46(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices28(in *jlexer.Lexer, out *SubAccountFuturesAccount) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices47(in *jlexer.Lexer, out *SubAccountFuturesAccount) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3782,13 +6802,13 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices28(in *jlexer.Lexer,
 					out.Assets = (out.Assets)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v30 SubAccountFuturesAccountAsset
+					var v54 SubAccountFuturesAccountAsset
 					if in.IsNull() {
 						in.Skip()
 					} else {
-						(v30).UnmarshalEasyJSON(in)
+						(v54).UnmarshalEasyJSON(in)
 					}
-					out.Assets = append(out.Assets, v30)
+					out.Assets = append(out.Assets, v54)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -3881,7 +6901,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices28(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices28(out *jwriter.Writer, in SubAccountFuturesAccount) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices47(out *jwriter.Writer, in SubAccountFuturesAccount) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -3902,11 +6922,11 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices28(out *jwriter.Writ
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v31, v32 := range in.Assets {
-				if v31 > 0 {
+			for v55, v56 := range in.Assets {
+				if v55 > 0 {
 					out.RawByte(',')
 				}
-				(v32).MarshalEasyJSON(out)
+				(v56).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -3982,27 +7002,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices28(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v SubAccountFuturesAccount) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices28(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices47(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v SubAccountFuturesAccount) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices28(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices47(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *SubAccountFuturesAccount) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices28(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices47(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *SubAccountFuturesAccount) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices28(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices47(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices29(in *jlexer.Lexer, out *SubAccountAPIKeyResponse) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices48(in *jlexer.Lexer, out *SubAccountAPIKeyResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4038,7 +7058,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices29(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices29(out *jwriter.Writer, in SubAccountAPIKeyResponse) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices48(out *jwriter.Writer, in SubAccountAPIKeyResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -4058,27 +7078,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices29(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v SubAccountAPIKeyResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices29(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices48(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v SubAccountAPIKeyResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices29(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices48(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *SubAccountAPIKeyResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices29(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices48(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *SubAccountAPIKeyResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices29(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices48(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices30(in *jlexer.Lexer, out *SubAccount) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices49(in *jlexer.Lexer, out *SubAccount) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4132,7 +7152,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices30(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices30(out *jwriter.Writer, in SubAccount) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices49(out *jwriter.Writer, in SubAccount) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -4167,27 +7187,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices30(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v SubAccount) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices30(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices49(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v SubAccount) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices30(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices49(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *SubAccount) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices30(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices49(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *SubAccount) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices30(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices49(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices31(in *jlexer.Lexer, out *StakingProductPositions) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices50(in *jlexer.Lexer, out *StakingProductPositions) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		in.Skip()
@@ -4204,13 +7224,13 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices31(in *jlexer.Lexer,
 			*out = (*out)[:0]
 		}
 		for !in.IsDelim(']') {
-			var v33 StakingProductPosition
+			var v57 StakingProductPosition
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				(v33).UnmarshalEasyJSON(in)
+				(v57).UnmarshalEasyJSON(in)
 			}
-			*out = append(*out, v33)
+			*out = append(*out, v57)
 			in.WantComma()
 		}
 		in.Delim(']')
@@ -4219,16 +7239,16 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices31(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices31(out *jwriter.Writer, in StakingProductPositions) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices50(out *jwriter.Writer, in StakingProductPositions) {
 	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 		out.RawString("null")
 	} else {
 		out.RawByte('[')
-		for v34, v35 := range in {
-			if v34 > 0 {
+		for v58, v59 := range in {
+			if v58 > 0 {
 				out.RawByte(',')
 			}
-			(v35).MarshalEasyJSON(out)
+			(v59).MarshalEasyJSON(out)
 		}
 		out.RawByte(']')
 	}
@@ -4237,27 +7257,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices31(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v StakingProductPositions) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices31(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices50(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v StakingProductPositions) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices31(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices50(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *StakingProductPositions) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices31(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices50(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *StakingProductPositions) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices31(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices50(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices32(in *jlexer.Lexer, out *StakingProductPosition) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices51(in *jlexer.Lexer, out *StakingProductPosition) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4437,7 +7457,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices32(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices32(out *jwriter.Writer, in StakingProductPosition) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices51(out *jwriter.Writer, in StakingProductPosition) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -4577,27 +7597,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices32(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v StakingProductPosition) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices32(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices51(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v StakingProductPosition) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices32(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices51(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *StakingProductPosition) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices32(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices51(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *StakingProductPosition) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices32(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices51(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices33(in *jlexer.Lexer, out *StakingHistoryTransaction) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices52(in *jlexer.Lexer, out *StakingHistoryTransaction) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4675,7 +7695,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices33(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices33(out *jwriter.Writer, in StakingHistoryTransaction) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices52(out *jwriter.Writer, in StakingHistoryTransaction) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -4730,27 +7750,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices33(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v StakingHistoryTransaction) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices33(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices52(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v StakingHistoryTransaction) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices33(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices52(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *StakingHistoryTransaction) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices33(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices52(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *StakingHistoryTransaction) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices33(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices52(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices34(in *jlexer.Lexer, out *StakingHistory) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices53(in *jlexer.Lexer, out *StakingHistory) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		in.Skip()
@@ -4767,13 +7787,13 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices34(in *jlexer.Lexer,
 			*out = (*out)[:0]
 		}
 		for !in.IsDelim(']') {
-			var v36 StakingHistoryTransaction
+			var v60 StakingHistoryTransaction
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				(v36).UnmarshalEasyJSON(in)
+				(v60).UnmarshalEasyJSON(in)
 			}
-			*out = append(*out, v36)
+			*out = append(*out, v60)
 			in.WantComma()
 		}
 		in.Delim(']')
@@ -4782,16 +7802,16 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices34(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices34(out *jwriter.Writer, in StakingHistory) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices53(out *jwriter.Writer, in StakingHistory) {
 	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 		out.RawString("null")
 	} else {
 		out.RawByte('[')
-		for v37, v38 := range in {
-			if v37 > 0 {
+		for v61, v62 := range in {
+			if v61 > 0 {
 				out.RawByte(',')
 			}
-			(v38).MarshalEasyJSON(out)
+			(v62).MarshalEasyJSON(out)
 		}
 		out.RawByte(']')
 	}
@@ -4800,27 +7820,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices34(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v StakingHistory) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices34(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices53(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v StakingHistory) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices34(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices53(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *StakingHistory) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices34(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices53(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *StakingHistory) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices34(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices53(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices35(in *jlexer.Lexer, out *SpotSubUserAssetBtcVoList) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices54(in *jlexer.Lexer, out *SpotSubUserAssetBtcVoList) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4856,7 +7876,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices35(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices35(out *jwriter.Writer, in SpotSubUserAssetBtcVoList) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices54(out *jwriter.Writer, in SpotSubUserAssetBtcVoList) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -4876,27 +7896,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices35(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v SpotSubUserAssetBtcVoList) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices35(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices54(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v SpotSubUserAssetBtcVoList) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices35(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices54(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *SpotSubUserAssetBtcVoList) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices35(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices54(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *SpotSubUserAssetBtcVoList) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices35(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices54(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices36(in *jlexer.Lexer, out *SpotRebateHistoryDataItem) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices55(in *jlexer.Lexer, out *SpotRebateHistoryDataItem) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4944,7 +7964,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices36(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices36(out *jwriter.Writer, in SpotRebateHistoryDataItem) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices55(out *jwriter.Writer, in SpotRebateHistoryDataItem) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -4974,27 +7994,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices36(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v SpotRebateHistoryDataItem) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices36(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices55(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v SpotRebateHistoryDataItem) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices36(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices55(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *SpotRebateHistoryDataItem) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices36(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices55(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *SpotRebateHistoryDataItem) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices36(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices55(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices37(in *jlexer.Lexer, out *SpotRebateHistoryData) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices56(in *jlexer.Lexer, out *SpotRebateHistoryData) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5042,13 +8062,13 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices37(in *jlexer.Lexer,
 					out.Data = (out.Data)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v39 SpotRebateHistoryDataItem
+					var v63 SpotRebateHistoryDataItem
 					if in.IsNull() {
 						in.Skip()
 					} else {
-						(v39).UnmarshalEasyJSON(in)
+						(v63).UnmarshalEasyJSON(in)
 					}
-					out.Data = append(out.Data, v39)
+					out.Data = append(out.Data, v63)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -5063,7 +8083,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices37(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices37(out *jwriter.Writer, in SpotRebateHistoryData) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices56(out *jwriter.Writer, in SpotRebateHistoryData) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -5089,11 +8109,11 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices37(out *jwriter.Writ
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v40, v41 := range in.Data {
-				if v40 > 0 {
+			for v64, v65 := range in.Data {
+				if v64 > 0 {
 					out.RawByte(',')
 				}
-				(v41).MarshalEasyJSON(out)
+				(v65).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -5104,27 +8124,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices37(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v SpotRebateHistoryData) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices37(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices56(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v SpotRebateHistoryData) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices37(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices56(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *SpotRebateHistoryData) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices37(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices56(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *SpotRebateHistoryData) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices37(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices56(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices38(in *jlexer.Lexer, out *SpotRebateHistory) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices57(in *jlexer.Lexer, out *SpotRebateHistory) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5172,7 +8192,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices38(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices38(out *jwriter.Writer, in SpotRebateHistory) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices57(out *jwriter.Writer, in SpotRebateHistory) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -5202,27 +8222,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices38(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v SpotRebateHistory) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices38(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices57(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v SpotRebateHistory) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices38(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices57(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *SpotRebateHistory) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices38(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices57(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *SpotRebateHistory) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices38(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices57(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices39(in *jlexer.Lexer, out *SnapshotVos) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices58(in *jlexer.Lexer, out *SnapshotVos) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5272,7 +8292,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices39(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices39(out *jwriter.Writer, in SnapshotVos) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices58(out *jwriter.Writer, in SnapshotVos) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -5301,27 +8321,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices39(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v SnapshotVos) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices39(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices58(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v SnapshotVos) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices39(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices58(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *SnapshotVos) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices39(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices58(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *SnapshotVos) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices39(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices58(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices40(in *jlexer.Lexer, out *SnapshotUserAssets) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices59(in *jlexer.Lexer, out *SnapshotUserAssets) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5381,7 +8401,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices40(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices40(out *jwriter.Writer, in SnapshotUserAssets) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices59(out *jwriter.Writer, in SnapshotUserAssets) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -5421,27 +8441,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices40(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v SnapshotUserAssets) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices40(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices59(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v SnapshotUserAssets) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices40(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices59(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *SnapshotUserAssets) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices40(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices59(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *SnapshotUserAssets) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices40(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices59(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices41(in *jlexer.Lexer, out *SnapshotPositions) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices60(in *jlexer.Lexer, out *SnapshotPositions) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5495,7 +8515,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices41(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices41(out *jwriter.Writer, in SnapshotPositions) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices60(out *jwriter.Writer, in SnapshotPositions) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -5530,27 +8550,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices41(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v SnapshotPositions) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices41(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices60(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v SnapshotPositions) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices41(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices60(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *SnapshotPositions) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices41(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices60(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *SnapshotPositions) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices41(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices60(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices42(in *jlexer.Lexer, out *SnapshotData) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices61(in *jlexer.Lexer, out *SnapshotData) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5604,21 +8624,21 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices42(in *jlexer.Lexer,
 					out.Balances = (out.Balances)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v42 *SnapshotBalances
+					var v66 *SnapshotBalances
 					if in.IsNull() {
 						in.Skip()
-						v42 = nil
+						v66 = nil
 					} else {
-						if v42 == nil {
-							v42 = new(SnapshotBalances)
+						if v66 == nil {
+							v66 = new(SnapshotBalances)
 						}
 						if in.IsNull() {
 							in.Skip()
 						} else {
-							(*v42).UnmarshalEasyJSON(in)
+							(*v66).UnmarshalEasyJSON(in)
 						}
 					}
-					out.Balances = append(out.Balances, v42)
+					out.Balances = append(out.Balances, v66)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -5639,21 +8659,21 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices42(in *jlexer.Lexer,
 					out.UserAssets = (out.UserAssets)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v43 *SnapshotUserAssets
+					var v67 *SnapshotUserAssets
 					if in.IsNull() {
 						in.Skip()
-						v43 = nil
+						v67 = nil
 					} else {
-						if v43 == nil {
-							v43 = new(SnapshotUserAssets)
+						if v67 == nil {
+							v67 = new(SnapshotUserAssets)
 						}
 						if in.IsNull() {
 							in.Skip()
 						} else {
-							(*v43).UnmarshalEasyJSON(in)
+							(*v67).UnmarshalEasyJSON(in)
 						}
 					}
-					out.UserAssets = append(out.UserAssets, v43)
+					out.UserAssets = append(out.UserAssets, v67)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -5674,21 +8694,21 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices42(in *jlexer.Lexer,
 					out.Assets = (out.Assets)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v44 *SnapshotAssets
+					var v68 *SnapshotAssets
 					if in.IsNull() {
 						in.Skip()
-						v44 = nil
+						v68 = nil
 					} else {
-						if v44 == nil {
-							v44 = new(SnapshotAssets)
+						if v68 == nil {
+							v68 = new(SnapshotAssets)
 						}
 						if in.IsNull() {
 							in.Skip()
 						} else {
-							(*v44).UnmarshalEasyJSON(in)
+							(*v68).UnmarshalEasyJSON(in)
 						}
 					}
-					out.Assets = append(out.Assets, v44)
+					out.Assets = append(out.Assets, v68)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -5709,21 +8729,21 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices42(in *jlexer.Lexer,
 					out.Positions = (out.Positions)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v45 *SnapshotPositions
+					var v69 *SnapshotPositions
 					if in.IsNull() {
 						in.Skip()
-						v45 = nil
+						v69 = nil
 					} else {
-						if v45 == nil {
-							v45 = new(SnapshotPositions)
+						if v69 == nil {
+							v69 = new(SnapshotPositions)
 						}
 						if in.IsNull() {
 							in.Skip()
 						} else {
-							(*v45).UnmarshalEasyJSON(in)
+							(*v69).UnmarshalEasyJSON(in)
 						}
 					}
-					out.Positions = append(out.Positions, v45)
+					out.Positions = append(out.Positions, v69)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -5738,7 +8758,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices42(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices42(out *jwriter.Writer, in SnapshotData) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices61(out *jwriter.Writer, in SnapshotData) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -5769,14 +8789,14 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices42(out *jwriter.Writ
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v46, v47 := range in.Balances {
-				if v46 > 0 {
+			for v70, v71 := range in.Balances {
+				if v70 > 0 {
 					out.RawByte(',')
 				}
-				if v47 == nil {
+				if v71 == nil {
 					out.RawString("null")
 				} else {
-					(*v47).MarshalEasyJSON(out)
+					(*v71).MarshalEasyJSON(out)
 				}
 			}
 			out.RawByte(']')
@@ -5789,14 +8809,14 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices42(out *jwriter.Writ
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v48, v49 := range in.UserAssets {
-				if v48 > 0 {
+			for v72, v73 := range in.UserAssets {
+				if v72 > 0 {
 					out.RawByte(',')
 				}
-				if v49 == nil {
+				if v73 == nil {
 					out.RawString("null")
 				} else {
-					(*v49).MarshalEasyJSON(out)
+					(*v73).MarshalEasyJSON(out)
 				}
 			}
 			out.RawByte(']')
@@ -5809,14 +8829,14 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices42(out *jwriter.Writ
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v50, v51 := range in.Assets {
-				if v50 > 0 {
+			for v74, v75 := range in.Assets {
+				if v74 > 0 {
 					out.RawByte(',')
 				}
-				if v51 == nil {
+				if v75 == nil {
 					out.RawString("null")
 				} else {
-					(*v51).MarshalEasyJSON(out)
+					(*v75).MarshalEasyJSON(out)
 				}
 			}
 			out.RawByte(']')
@@ -5829,14 +8849,14 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices42(out *jwriter.Writ
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v52, v53 := range in.Positions {
-				if v52 > 0 {
+			for v76, v77 := range in.Positions {
+				if v76 > 0 {
 					out.RawByte(',')
 				}
-				if v53 == nil {
+				if v77 == nil {
 					out.RawString("null")
 				} else {
-					(*v53).MarshalEasyJSON(out)
+					(*v77).MarshalEasyJSON(out)
 				}
 			}
 			out.RawByte(']')
@@ -5848,27 +8868,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices42(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v SnapshotData) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices42(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices61(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v SnapshotData) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices42(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices61(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *SnapshotData) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices42(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices61(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *SnapshotData) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices42(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices61(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices43(in *jlexer.Lexer, out *SnapshotBalances) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices62(in *jlexer.Lexer, out *SnapshotBalances) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5910,7 +8930,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices43(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices43(out *jwriter.Writer, in SnapshotBalances) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices62(out *jwriter.Writer, in SnapshotBalances) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -5935,27 +8955,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices43(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v SnapshotBalances) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices43(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices62(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v SnapshotBalances) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices43(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices62(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *SnapshotBalances) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices43(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices62(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *SnapshotBalances) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices43(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices62(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices44(in *jlexer.Lexer, out *SnapshotAssets) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices63(in *jlexer.Lexer, out *SnapshotAssets) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5997,7 +9017,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices44(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices44(out *jwriter.Writer, in SnapshotAssets) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices63(out *jwriter.Writer, in SnapshotAssets) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -6022,27 +9042,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices44(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v SnapshotAssets) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices44(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices63(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v SnapshotAssets) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices44(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices63(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *SnapshotAssets) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices44(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices63(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *SnapshotAssets) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices44(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices63(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices45(in *jlexer.Lexer, out *Snapshot) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices64(in *jlexer.Lexer, out *Snapshot) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -6084,21 +9104,21 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices45(in *jlexer.Lexer,
 					out.Snapshot = (out.Snapshot)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v54 *SnapshotVos
+					var v78 *SnapshotVos
 					if in.IsNull() {
 						in.Skip()
-						v54 = nil
+						v78 = nil
 					} else {
-						if v54 == nil {
-							v54 = new(SnapshotVos)
+						if v78 == nil {
+							v78 = new(SnapshotVos)
 						}
 						if in.IsNull() {
 							in.Skip()
 						} else {
-							(*v54).UnmarshalEasyJSON(in)
+							(*v78).UnmarshalEasyJSON(in)
 						}
 					}
-					out.Snapshot = append(out.Snapshot, v54)
+					out.Snapshot = append(out.Snapshot, v78)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -6113,7 +9133,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices45(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices45(out *jwriter.Writer, in Snapshot) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices64(out *jwriter.Writer, in Snapshot) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -6134,14 +9154,14 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices45(out *jwriter.Writ
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v55, v56 := range in.Snapshot {
-				if v55 > 0 {
+			for v79, v80 := range in.Snapshot {
+				if v79 > 0 {
 					out.RawByte(',')
 				}
-				if v56 == nil {
+				if v80 == nil {
 					out.RawString("null")
 				} else {
-					(*v56).MarshalEasyJSON(out)
+					(*v80).MarshalEasyJSON(out)
 				}
 			}
 			out.RawByte(']')
@@ -6153,27 +9173,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices45(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v Snapshot) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices45(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices64(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Snapshot) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices45(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices64(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Snapshot) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices45(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices64(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Snapshot) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices45(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices64(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices46(in *jlexer.Lexer, out *SavingsFlexibleProduct) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices65(in *jlexer.Lexer, out *SavingsFlexibleProduct) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -6269,7 +9289,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices46(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices46(out *jwriter.Writer, in SavingsFlexibleProduct) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices65(out *jwriter.Writer, in SavingsFlexibleProduct) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -6339,27 +9359,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices46(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v SavingsFlexibleProduct) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices46(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices65(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v SavingsFlexibleProduct) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices46(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices65(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *SavingsFlexibleProduct) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices46(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices65(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *SavingsFlexibleProduct) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices46(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices65(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices47(in *jlexer.Lexer, out *SavingsFixedProduct) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices66(in *jlexer.Lexer, out *SavingsFixedProduct) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -6479,7 +9499,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices47(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices47(out *jwriter.Writer, in SavingsFixedProduct) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices66(out *jwriter.Writer, in SavingsFixedProduct) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -6569,27 +9589,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices47(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v SavingsFixedProduct) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices47(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices66(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v SavingsFixedProduct) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices47(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices66(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *SavingsFixedProduct) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices47(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices66(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *SavingsFixedProduct) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices47(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices66(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices48(in *jlexer.Lexer, out *SavingFlexibleProductPosition) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices67(in *jlexer.Lexer, out *SavingFlexibleProductPosition) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -6697,7 +9717,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices48(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices48(out *jwriter.Writer, in SavingFlexibleProductPosition) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices67(out *jwriter.Writer, in SavingFlexibleProductPosition) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -6777,27 +9797,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices48(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v SavingFlexibleProductPosition) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices48(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices67(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v SavingFlexibleProductPosition) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices48(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices67(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *SavingFlexibleProductPosition) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices48(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices67(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *SavingFlexibleProductPosition) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices48(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices67(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices49(in *jlexer.Lexer, out *SavingFixedProjectPosition) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices68(in *jlexer.Lexer, out *SavingFixedProjectPosition) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -6923,7 +9943,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices49(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices49(out *jwriter.Writer, in SavingFixedProjectPosition) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices68(out *jwriter.Writer, in SavingFixedProjectPosition) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -7018,27 +10038,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices49(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v SavingFixedProjectPosition) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices49(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices68(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v SavingFixedProjectPosition) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices49(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices68(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *SavingFixedProjectPosition) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices49(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices68(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *SavingFixedProjectPosition) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices49(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices68(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices50(in *jlexer.Lexer, out *RemoveLiquidityResponse) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices69(in *jlexer.Lexer, out *RemoveLiquidityResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -7068,7 +10088,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices50(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices50(out *jwriter.Writer, in RemoveLiquidityResponse) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices69(out *jwriter.Writer, in RemoveLiquidityResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -7083,27 +10103,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices50(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v RemoveLiquidityResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices50(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices69(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v RemoveLiquidityResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices50(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices69(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *RemoveLiquidityResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices50(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices69(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *RemoveLiquidityResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices50(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices69(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices51(in *jlexer.Lexer, out *ReferralRebateRecordResponse) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices70(in *jlexer.Lexer, out *ReferralRebateRecordResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -7199,7 +10219,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices51(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices51(out *jwriter.Writer, in ReferralRebateRecordResponse) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices70(out *jwriter.Writer, in ReferralRebateRecordResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -7269,27 +10289,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices51(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v ReferralRebateRecordResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices51(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices70(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v ReferralRebateRecordResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices51(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices70(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *ReferralRebateRecordResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices51(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices70(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *ReferralRebateRecordResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices51(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices70(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices52(in *jlexer.Lexer, out *ReceiverInfo) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices71(in *jlexer.Lexer, out *ReceiverInfo) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -7369,7 +10389,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices52(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices52(out *jwriter.Writer, in ReceiverInfo) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices71(out *jwriter.Writer, in ReceiverInfo) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -7429,25 +10449,25 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices52(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v ReceiverInfo) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices52(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices71(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v ReceiverInfo) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices52(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices71(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *ReceiverInfo) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices52(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices71(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *ReceiverInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices52(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices71(l, v)
 }
 func easyjsonD2b7633eDecode(in *jlexer.Lexer, out *struct {
 	PhoneOrEmailChanged bool `json:"phoneOrEmailChanged"`
@@ -7494,7 +10514,7 @@ func easyjsonD2b7633eEncode(out *jwriter.Writer, in struct {
 	}
 	out.RawByte('}')
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices53(in *jlexer.Lexer, out *RateLimitFull) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices72(in *jlexer.Lexer, out *RateLimitFull) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -7548,7 +10568,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices53(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices53(out *jwriter.Writer, in RateLimitFull) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices72(out *jwriter.Writer, in RateLimitFull) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -7583,27 +10603,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices53(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v RateLimitFull) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices53(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices72(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v RateLimitFull) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices53(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices72(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *RateLimitFull) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices53(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices72(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *RateLimitFull) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices53(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices72(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices54(in *jlexer.Lexer, out *RateLimit) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices73(in *jlexer.Lexer, out *RateLimit) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -7651,7 +10671,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices54(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices54(out *jwriter.Writer, in RateLimit) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices73(out *jwriter.Writer, in RateLimit) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -7681,27 +10701,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices54(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v RateLimit) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices54(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices73(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v RateLimit) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices54(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices73(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *RateLimit) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices54(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices73(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *RateLimit) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices54(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices73(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices55(in *jlexer.Lexer, out *QuerySubAccountResponse) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices74(in *jlexer.Lexer, out *QuerySubAccountResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -7781,7 +10801,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices55(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices55(out *jwriter.Writer, in QuerySubAccountResponse) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices74(out *jwriter.Writer, in QuerySubAccountResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -7831,27 +10851,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices55(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v QuerySubAccountResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices55(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices74(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v QuerySubAccountResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices55(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices74(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *QuerySubAccountResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices55(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices74(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *QuerySubAccountResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices55(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices74(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices56(in *jlexer.Lexer, out *PurchaseSavingsFlexibleProductResponse) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices75(in *jlexer.Lexer, out *PurchaseSavingsFlexibleProductResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -7881,7 +10901,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices56(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices56(out *jwriter.Writer, in PurchaseSavingsFlexibleProductResponse) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices75(out *jwriter.Writer, in PurchaseSavingsFlexibleProductResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -7896,27 +10916,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices56(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v PurchaseSavingsFlexibleProductResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices56(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices75(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v PurchaseSavingsFlexibleProductResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices56(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices75(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *PurchaseSavingsFlexibleProductResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices56(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices75(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *PurchaseSavingsFlexibleProductResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices56(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices75(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices57(in *jlexer.Lexer, out *PriceFilter) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices76(in *jlexer.Lexer, out *PriceFilter) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -7958,7 +10978,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices57(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices57(out *jwriter.Writer, in PriceFilter) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices76(out *jwriter.Writer, in PriceFilter) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -7983,27 +11003,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices57(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v PriceFilter) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices57(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices76(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v PriceFilter) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices57(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices76(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *PriceFilter) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices57(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices76(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *PriceFilter) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices57(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices76(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices58(in *jlexer.Lexer, out *PriceChangeStats) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices77(in *jlexer.Lexer, out *PriceChangeStats) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -8155,7 +11175,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices58(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices58(out *jwriter.Writer, in PriceChangeStats) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices77(out *jwriter.Writer, in PriceChangeStats) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -8270,27 +11290,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices58(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v PriceChangeStats) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices58(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices77(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v PriceChangeStats) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices58(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices77(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *PriceChangeStats) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices58(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices77(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *PriceChangeStats) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices58(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices77(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices59(in *jlexer.Lexer, out *PoolShareInformation) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices78(in *jlexer.Lexer, out *PoolShareInformation) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -8325,13 +11345,13 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices59(in *jlexer.Lexer,
 				for !in.IsDelim('}') {
 					key := string(in.String())
 					in.WantColon()
-					var v57 string
+					var v81 string
 					if in.IsNull() {
 						in.Skip()
 					} else {
-						v57 = string(in.String())
+						v81 = string(in.String())
 					}
-					(out.Assets)[key] = v57
+					(out.Assets)[key] = v81
 					in.WantComma()
 				}
 				in.Delim('}')
@@ -8346,7 +11366,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices59(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices59(out *jwriter.Writer, in PoolShareInformation) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices78(out *jwriter.Writer, in PoolShareInformation) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -8367,16 +11387,16 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices59(out *jwriter.Writ
 			out.RawString(`null`)
 		} else {
 			out.RawByte('{')
-			v58First := true
-			for v58Name, v58Value := range in.Assets {
-				if v58First {
-					v58First = false
+			v82First := true
+			for v82Name, v82Value := range in.Assets {
+				if v82First {
+					v82First = false
 				} else {
 					out.RawByte(',')
 				}
-				out.String(string(v58Name))
+				out.String(string(v82Name))
 				out.RawByte(':')
-				out.String(string(v58Value))
+				out.String(string(v82Value))
 			}
 			out.RawByte('}')
 		}
@@ -8387,27 +11407,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices59(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v PoolShareInformation) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices59(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices78(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v PoolShareInformation) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices59(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices78(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *PoolShareInformation) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices59(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices78(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *PoolShareInformation) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices59(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices78(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices60(in *jlexer.Lexer, out *PercentPriceBySideFilter) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices79(in *jlexer.Lexer, out *PercentPriceBySideFilter) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -8461,7 +11481,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices60(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices60(out *jwriter.Writer, in PercentPriceBySideFilter) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices79(out *jwriter.Writer, in PercentPriceBySideFilter) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -8496,27 +11516,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices60(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v PercentPriceBySideFilter) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices60(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices79(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v PercentPriceBySideFilter) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices60(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices79(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *PercentPriceBySideFilter) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices60(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices79(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *PercentPriceBySideFilter) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices60(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices79(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices61(in *jlexer.Lexer, out *PayerInfo) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices80(in *jlexer.Lexer, out *PayerInfo) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -8594,7 +11614,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices61(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices61(out *jwriter.Writer, in PayerInfo) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices80(out *jwriter.Writer, in PayerInfo) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -8649,27 +11669,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices61(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v PayerInfo) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices61(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices80(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v PayerInfo) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices61(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices80(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *PayerInfo) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices61(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices80(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *PayerInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices61(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices80(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices62(in *jlexer.Lexer, out *PayTradeItem) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices81(in *jlexer.Lexer, out *PayTradeItem) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -8757,13 +11777,13 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices62(in *jlexer.Lexer,
 					out.FundsDetail = (out.FundsDetail)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v59 FundsDetail
+					var v83 FundsDetail
 					if in.IsNull() {
 						in.Skip()
 					} else {
-						(v59).UnmarshalEasyJSON(in)
+						(v83).UnmarshalEasyJSON(in)
 					}
-					out.FundsDetail = append(out.FundsDetail, v59)
+					out.FundsDetail = append(out.FundsDetail, v83)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -8778,7 +11798,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices62(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices62(out *jwriter.Writer, in PayTradeItem) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices81(out *jwriter.Writer, in PayTradeItem) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -8832,11 +11852,11 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices62(out *jwriter.Writ
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v60, v61 := range in.FundsDetail {
-				if v60 > 0 {
+			for v84, v85 := range in.FundsDetail {
+				if v84 > 0 {
 					out.RawByte(',')
 				}
-				(v61).MarshalEasyJSON(out)
+				(v85).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -8847,27 +11867,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices62(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v PayTradeItem) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices62(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices81(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v PayTradeItem) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices62(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices81(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *PayTradeItem) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices62(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices81(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *PayTradeItem) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices62(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices81(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices63(in *jlexer.Lexer, out *PayTradeHistory) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices82(in *jlexer.Lexer, out *PayTradeHistory) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -8909,13 +11929,13 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices63(in *jlexer.Lexer,
 					out.Data = (out.Data)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v62 PayTradeItem
+					var v86 PayTradeItem
 					if in.IsNull() {
 						in.Skip()
 					} else {
-						(v62).UnmarshalEasyJSON(in)
+						(v86).UnmarshalEasyJSON(in)
 					}
-					out.Data = append(out.Data, v62)
+					out.Data = append(out.Data, v86)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -8936,7 +11956,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices63(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices63(out *jwriter.Writer, in PayTradeHistory) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices82(out *jwriter.Writer, in PayTradeHistory) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -8957,11 +11977,11 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices63(out *jwriter.Writ
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v63, v64 := range in.Data {
-				if v63 > 0 {
+			for v87, v88 := range in.Data {
+				if v87 > 0 {
 					out.RawByte(',')
 				}
-				(v64).MarshalEasyJSON(out)
+				(v88).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -8977,27 +11997,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices63(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v PayTradeHistory) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices63(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices82(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v PayTradeHistory) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices63(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices82(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *PayTradeHistory) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices63(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices82(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *PayTradeHistory) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices63(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices82(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices64(in *jlexer.Lexer, out *Order) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices83(in *jlexer.Lexer, out *Order) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -9143,7 +12163,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices64(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices64(out *jwriter.Writer, in Order) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices83(out *jwriter.Writer, in Order) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -9248,27 +12268,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices64(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v Order) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices64(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices83(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Order) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices64(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices83(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Order) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices64(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices83(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Order) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices64(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices83(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices65(in *jlexer.Lexer, out *Oco) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices84(in *jlexer.Lexer, out *Oco) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -9340,21 +12360,21 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices65(in *jlexer.Lexer,
 					out.Orders = (out.Orders)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v65 *Order
+					var v89 *Order
 					if in.IsNull() {
 						in.Skip()
-						v65 = nil
+						v89 = nil
 					} else {
-						if v65 == nil {
-							v65 = new(Order)
+						if v89 == nil {
+							v89 = new(Order)
 						}
 						if in.IsNull() {
 							in.Skip()
 						} else {
-							(*v65).UnmarshalEasyJSON(in)
+							(*v89).UnmarshalEasyJSON(in)
 						}
 					}
-					out.Orders = append(out.Orders, v65)
+					out.Orders = append(out.Orders, v89)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -9369,7 +12389,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices65(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices65(out *jwriter.Writer, in Oco) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices84(out *jwriter.Writer, in Oco) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -9415,14 +12435,14 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices65(out *jwriter.Writ
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v66, v67 := range in.Orders {
-				if v66 > 0 {
+			for v90, v91 := range in.Orders {
+				if v90 > 0 {
 					out.RawByte(',')
 				}
-				if v67 == nil {
+				if v91 == nil {
 					out.RawString("null")
 				} else {
-					(*v67).MarshalEasyJSON(out)
+					(*v91).MarshalEasyJSON(out)
 				}
 			}
 			out.RawByte(']')
@@ -9434,27 +12454,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices65(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v Oco) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices65(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices84(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Oco) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices65(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices84(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Oco) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices65(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices84(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Oco) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices65(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices84(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices66(in *jlexer.Lexer, out *OCOOrderReport) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices85(in *jlexer.Lexer, out *OCOOrderReport) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -9574,7 +12594,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices66(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices66(out *jwriter.Writer, in OCOOrderReport) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices85(out *jwriter.Writer, in OCOOrderReport) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -9664,27 +12684,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices66(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v OCOOrderReport) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices66(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices85(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v OCOOrderReport) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices66(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices85(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *OCOOrderReport) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices66(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices85(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *OCOOrderReport) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices66(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices85(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices67(in *jlexer.Lexer, out *OCOOrder) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices86(in *jlexer.Lexer, out *OCOOrder) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -9726,7 +12746,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices67(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices67(out *jwriter.Writer, in OCOOrder) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices86(out *jwriter.Writer, in OCOOrder) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -9751,27 +12771,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices67(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v OCOOrder) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices67(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices86(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v OCOOrder) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices67(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices86(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *OCOOrder) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices67(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices86(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *OCOOrder) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices67(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices86(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices68(in *jlexer.Lexer, out *NotionalFilter) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices87(in *jlexer.Lexer, out *NotionalFilter) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -9825,7 +12845,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices68(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices68(out *jwriter.Writer, in NotionalFilter) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices87(out *jwriter.Writer, in NotionalFilter) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -9860,27 +12880,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices68(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v NotionalFilter) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices68(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices87(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v NotionalFilter) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices68(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices87(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *NotionalFilter) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices68(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices87(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *NotionalFilter) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices68(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices87(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices69(in *jlexer.Lexer, out *Network) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices88(in *jlexer.Lexer, out *Network) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -10024,7 +13044,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices69(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices69(out *jwriter.Writer, in Network) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices88(out *jwriter.Writer, in Network) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -10134,27 +13154,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices69(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v Network) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices69(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices88(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Network) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices69(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices88(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Network) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices69(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices88(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Network) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices69(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices88(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices70(in *jlexer.Lexer, out *MaxTransferable) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices89(in *jlexer.Lexer, out *MaxTransferable) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -10184,7 +13204,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices70(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices70(out *jwriter.Writer, in MaxTransferable) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices89(out *jwriter.Writer, in MaxTransferable) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -10199,27 +13219,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices70(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v MaxTransferable) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices70(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices89(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v MaxTransferable) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices70(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices89(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *MaxTransferable) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices70(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices89(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *MaxTransferable) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices70(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices89(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices71(in *jlexer.Lexer, out *MaxNumOrdersFilter) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices90(in *jlexer.Lexer, out *MaxNumOrdersFilter) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -10249,7 +13269,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices71(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices71(out *jwriter.Writer, in MaxNumOrdersFilter) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices90(out *jwriter.Writer, in MaxNumOrdersFilter) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -10264,27 +13284,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices71(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v MaxNumOrdersFilter) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices71(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices90(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v MaxNumOrdersFilter) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices71(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices90(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *MaxNumOrdersFilter) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices71(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices90(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *MaxNumOrdersFilter) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices71(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices90(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices72(in *jlexer.Lexer, out *MaxNumAlgoOrdersFilter) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices91(in *jlexer.Lexer, out *MaxNumAlgoOrdersFilter) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -10314,7 +13334,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices72(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices72(out *jwriter.Writer, in MaxNumAlgoOrdersFilter) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices91(out *jwriter.Writer, in MaxNumAlgoOrdersFilter) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -10329,27 +13349,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices72(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v MaxNumAlgoOrdersFilter) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices72(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices91(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v MaxNumAlgoOrdersFilter) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices72(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices91(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *MaxNumAlgoOrdersFilter) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices72(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices91(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *MaxNumAlgoOrdersFilter) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices72(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices91(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices73(in *jlexer.Lexer, out *MaxBorrowable) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices92(in *jlexer.Lexer, out *MaxBorrowable) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -10379,7 +13399,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices73(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices73(out *jwriter.Writer, in MaxBorrowable) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices92(out *jwriter.Writer, in MaxBorrowable) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -10394,27 +13414,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices73(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v MaxBorrowable) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices73(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices92(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v MaxBorrowable) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices73(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices92(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *MaxBorrowable) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices73(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices92(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *MaxBorrowable) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices73(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices92(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices74(in *jlexer.Lexer, out *MarketLotSizeFilter) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices93(in *jlexer.Lexer, out *MarketLotSizeFilter) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -10456,7 +13476,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices74(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices74(out *jwriter.Writer, in MarketLotSizeFilter) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices93(out *jwriter.Writer, in MarketLotSizeFilter) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -10481,27 +13501,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices74(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v MarketLotSizeFilter) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices74(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices93(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v MarketLotSizeFilter) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices74(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices93(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *MarketLotSizeFilter) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices74(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices93(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *MarketLotSizeFilter) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices74(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices93(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices75(in *jlexer.Lexer, out *MarginRepayResponse) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices94(in *jlexer.Lexer, out *MarginRepayResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -10531,13 +13551,13 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices75(in *jlexer.Lexer,
 					out.Rows = (out.Rows)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v68 MarginRepay
+					var v92 MarginRepay
 					if in.IsNull() {
 						in.Skip()
 					} else {
-						(v68).UnmarshalEasyJSON(in)
+						(v92).UnmarshalEasyJSON(in)
 					}
-					out.Rows = append(out.Rows, v68)
+					out.Rows = append(out.Rows, v92)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -10558,7 +13578,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices75(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices75(out *jwriter.Writer, in MarginRepayResponse) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices94(out *jwriter.Writer, in MarginRepayResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -10569,11 +13589,11 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices75(out *jwriter.Writ
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v69, v70 := range in.Rows {
-				if v69 > 0 {
+			for v93, v94 := range in.Rows {
+				if v93 > 0 {
 					out.RawByte(',')
 				}
-				(v70).MarshalEasyJSON(out)
+				(v94).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -10589,27 +13609,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices75(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v MarginRepayResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices75(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices94(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v MarginRepayResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices75(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices94(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *MarginRepayResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices75(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices94(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *MarginRepayResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices75(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices94(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices76(in *jlexer.Lexer, out *MarginRepay) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices95(in *jlexer.Lexer, out *MarginRepay) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -10675,7 +13695,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices76(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices76(out *jwriter.Writer, in MarginRepay) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices95(out *jwriter.Writer, in MarginRepay) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -10720,27 +13740,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices76(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v MarginRepay) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices76(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices95(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v MarginRepay) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices76(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices95(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *MarginRepay) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices76(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices95(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *MarginRepay) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices76(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices95(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices77(in *jlexer.Lexer, out *MarginPriceIndex) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices96(in *jlexer.Lexer, out *MarginPriceIndex) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -10782,7 +13802,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices77(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices77(out *jwriter.Writer, in MarginPriceIndex) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices96(out *jwriter.Writer, in MarginPriceIndex) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -10807,27 +13827,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices77(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v MarginPriceIndex) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices77(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices96(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v MarginPriceIndex) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices77(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices96(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *MarginPriceIndex) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices77(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices96(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *MarginPriceIndex) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices77(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices96(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices78(in *jlexer.Lexer, out *MarginPair) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices97(in *jlexer.Lexer, out *MarginPair) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -10893,7 +13913,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices78(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices78(out *jwriter.Writer, in MarginPair) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices97(out *jwriter.Writer, in MarginPair) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -10938,27 +13958,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices78(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v MarginPair) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices78(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices97(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v MarginPair) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices78(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices97(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *MarginPair) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices78(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices97(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *MarginPair) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices78(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices97(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices79(in *jlexer.Lexer, out *MarginOCOOrderReport) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices98(in *jlexer.Lexer, out *MarginOCOOrderReport) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -11066,7 +14086,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices79(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices79(out *jwriter.Writer, in MarginOCOOrderReport) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices98(out *jwriter.Writer, in MarginOCOOrderReport) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -11146,27 +14166,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices79(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v MarginOCOOrderReport) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices79(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices98(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v MarginOCOOrderReport) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices79(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices98(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *MarginOCOOrderReport) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices79(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices98(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *MarginOCOOrderReport) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices79(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices98(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices80(in *jlexer.Lexer, out *MarginOCOOrder) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices99(in *jlexer.Lexer, out *MarginOCOOrder) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -11208,7 +14228,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices80(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices80(out *jwriter.Writer, in MarginOCOOrder) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices99(out *jwriter.Writer, in MarginOCOOrder) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -11233,27 +14253,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices80(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v MarginOCOOrder) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices80(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices99(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v MarginOCOOrder) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices80(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices99(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *MarginOCOOrder) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices80(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices99(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *MarginOCOOrder) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices80(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices99(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices81(in *jlexer.Lexer, out *MarginLoanResponse) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices100(in *jlexer.Lexer, out *MarginLoanResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -11283,13 +14303,13 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices81(in *jlexer.Lexer,
 					out.Rows = (out.Rows)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v71 MarginLoan
+					var v95 MarginLoan
 					if in.IsNull() {
 						in.Skip()
 					} else {
-						(v71).UnmarshalEasyJSON(in)
+						(v95).UnmarshalEasyJSON(in)
 					}
-					out.Rows = append(out.Rows, v71)
+					out.Rows = append(out.Rows, v95)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -11310,7 +14330,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices81(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices81(out *jwriter.Writer, in MarginLoanResponse) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices100(out *jwriter.Writer, in MarginLoanResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -11321,11 +14341,11 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices81(out *jwriter.Writ
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v72, v73 := range in.Rows {
-				if v72 > 0 {
+			for v96, v97 := range in.Rows {
+				if v96 > 0 {
 					out.RawByte(',')
 				}
-				(v73).MarshalEasyJSON(out)
+				(v97).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -11341,27 +14361,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices81(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v MarginLoanResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices81(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices100(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v MarginLoanResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices81(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices100(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *MarginLoanResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices81(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices100(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *MarginLoanResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices81(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices100(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices82(in *jlexer.Lexer, out *MarginLoan) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices101(in *jlexer.Lexer, out *MarginLoan) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -11409,7 +14429,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices82(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices82(out *jwriter.Writer, in MarginLoan) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices101(out *jwriter.Writer, in MarginLoan) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -11439,27 +14459,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices82(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v MarginLoan) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices82(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices101(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v MarginLoan) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices82(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices101(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *MarginLoan) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices82(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices101(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *MarginLoan) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices82(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices101(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices83(in *jlexer.Lexer, out *MarginAsset) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices102(in *jlexer.Lexer, out *MarginAsset) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -11519,7 +14539,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices83(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices83(out *jwriter.Writer, in MarginAsset) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices102(out *jwriter.Writer, in MarginAsset) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -11559,27 +14579,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices83(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v MarginAsset) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices83(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices102(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v MarginAsset) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices83(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices102(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *MarginAsset) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices83(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices102(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *MarginAsset) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices83(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices102(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices84(in *jlexer.Lexer, out *MarginAllPair) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices103(in *jlexer.Lexer, out *MarginAllPair) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -11645,7 +14665,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices84(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices84(out *jwriter.Writer, in MarginAllPair) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices103(out *jwriter.Writer, in MarginAllPair) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -11690,27 +14710,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices84(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v MarginAllPair) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices84(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices103(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v MarginAllPair) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices84(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices103(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *MarginAllPair) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices84(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices103(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *MarginAllPair) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices84(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices103(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices85(in *jlexer.Lexer, out *MarginAccount) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices104(in *jlexer.Lexer, out *MarginAccount) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -11782,13 +14802,13 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices85(in *jlexer.Lexer,
 					out.UserAssets = (out.UserAssets)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v74 UserAsset
+					var v98 UserAsset
 					if in.IsNull() {
 						in.Skip()
 					} else {
-						(v74).UnmarshalEasyJSON(in)
+						(v98).UnmarshalEasyJSON(in)
 					}
-					out.UserAssets = append(out.UserAssets, v74)
+					out.UserAssets = append(out.UserAssets, v98)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -11803,7 +14823,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices85(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices85(out *jwriter.Writer, in MarginAccount) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices104(out *jwriter.Writer, in MarginAccount) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -11849,11 +14869,11 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices85(out *jwriter.Writ
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v75, v76 := range in.UserAssets {
-				if v75 > 0 {
+			for v99, v100 := range in.UserAssets {
+				if v99 > 0 {
 					out.RawByte(',')
 				}
-				(v76).MarshalEasyJSON(out)
+				(v100).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -11864,27 +14884,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices85(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v MarginAccount) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices85(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices104(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v MarginAccount) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices85(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices104(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *MarginAccount) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices85(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices104(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *MarginAccount) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices85(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices104(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices86(in *jlexer.Lexer, out *ManagedSubAccountWithdrawalResponse) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices105(in *jlexer.Lexer, out *ManagedSubAccountWithdrawalResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -11914,7 +14934,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices86(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices86(out *jwriter.Writer, in ManagedSubAccountWithdrawalResponse) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices105(out *jwriter.Writer, in ManagedSubAccountWithdrawalResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -11929,27 +14949,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices86(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v ManagedSubAccountWithdrawalResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices86(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices105(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v ManagedSubAccountWithdrawalResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices86(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices105(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *ManagedSubAccountWithdrawalResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices86(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices105(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *ManagedSubAccountWithdrawalResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices86(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices105(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices87(in *jlexer.Lexer, out *ManagedSubAccountDepositResponse) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices106(in *jlexer.Lexer, out *ManagedSubAccountDepositResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -11979,7 +14999,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices87(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices87(out *jwriter.Writer, in ManagedSubAccountDepositResponse) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices106(out *jwriter.Writer, in ManagedSubAccountDepositResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -11994,27 +15014,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices87(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v ManagedSubAccountDepositResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices87(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices106(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v ManagedSubAccountDepositResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices87(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices106(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *ManagedSubAccountDepositResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices87(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices106(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *ManagedSubAccountDepositResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices87(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices106(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices88(in *jlexer.Lexer, out *ManagedSubAccountAsset) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices107(in *jlexer.Lexer, out *ManagedSubAccountAsset) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -12074,7 +15094,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices88(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices88(out *jwriter.Writer, in ManagedSubAccountAsset) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices107(out *jwriter.Writer, in ManagedSubAccountAsset) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -12114,27 +15134,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices88(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v ManagedSubAccountAsset) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices88(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices107(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v ManagedSubAccountAsset) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices88(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices107(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *ManagedSubAccountAsset) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices88(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices107(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *ManagedSubAccountAsset) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices88(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices107(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices89(in *jlexer.Lexer, out *LotSizeFilter) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices108(in *jlexer.Lexer, out *LotSizeFilter) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -12176,7 +15196,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices89(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices89(out *jwriter.Writer, in LotSizeFilter) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices108(out *jwriter.Writer, in LotSizeFilter) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -12201,27 +15221,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices89(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v LotSizeFilter) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices89(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices108(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v LotSizeFilter) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices89(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices108(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *LotSizeFilter) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices89(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices108(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *LotSizeFilter) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices89(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices108(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices90(in *jlexer.Lexer, out *ListDustResponse) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices109(in *jlexer.Lexer, out *ListDustResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -12251,13 +15271,13 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices90(in *jlexer.Lexer,
 					out.Details = (out.Details)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v77 ListDustDetail
+					var v101 ListDustDetail
 					if in.IsNull() {
 						in.Skip()
 					} else {
-						(v77).UnmarshalEasyJSON(in)
+						(v101).UnmarshalEasyJSON(in)
 					}
-					out.Details = append(out.Details, v77)
+					out.Details = append(out.Details, v101)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -12290,7 +15310,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices90(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices90(out *jwriter.Writer, in ListDustResponse) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices109(out *jwriter.Writer, in ListDustResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -12301,11 +15321,11 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices90(out *jwriter.Writ
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v78, v79 := range in.Details {
-				if v78 > 0 {
+			for v102, v103 := range in.Details {
+				if v102 > 0 {
 					out.RawByte(',')
 				}
-				(v79).MarshalEasyJSON(out)
+				(v103).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -12331,27 +15351,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices90(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v ListDustResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices90(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices109(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v ListDustResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices90(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices109(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *ListDustResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices90(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices109(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *ListDustResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices90(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices109(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices91(in *jlexer.Lexer, out *ListDustDetail) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices110(in *jlexer.Lexer, out *ListDustDetail) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -12417,7 +15437,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices91(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices91(out *jwriter.Writer, in ListDustDetail) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices110(out *jwriter.Writer, in ListDustDetail) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -12462,27 +15482,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices91(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v ListDustDetail) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices91(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices110(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v ListDustDetail) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices91(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices110(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *ListDustDetail) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices91(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices110(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *ListDustDetail) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices91(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices110(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices92(in *jlexer.Lexer, out *LiquidityPoolDetail) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices111(in *jlexer.Lexer, out *LiquidityPoolDetail) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -12523,13 +15543,13 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices92(in *jlexer.Lexer,
 				for !in.IsDelim('}') {
 					key := string(in.String())
 					in.WantColon()
-					var v80 string
+					var v104 string
 					if in.IsNull() {
 						in.Skip()
 					} else {
-						v80 = string(in.String())
+						v104 = string(in.String())
 					}
-					(out.Liquidity)[key] = v80
+					(out.Liquidity)[key] = v104
 					in.WantComma()
 				}
 				in.Delim('}')
@@ -12558,7 +15578,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices92(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices92(out *jwriter.Writer, in LiquidityPoolDetail) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices111(out *jwriter.Writer, in LiquidityPoolDetail) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -12584,16 +15604,16 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices92(out *jwriter.Writ
 			out.RawString(`null`)
 		} else {
 			out.RawByte('{')
-			v81First := true
-			for v81Name, v81Value := range in.Liquidity {
-				if v81First {
-					v81First = false
+			v105First := true
+			for v105Name, v105Value := range in.Liquidity {
+				if v105First {
+					v105First = false
 				} else {
 					out.RawByte(',')
 				}
-				out.String(string(v81Name))
+				out.String(string(v105Name))
 				out.RawByte(':')
-				out.String(string(v81Value))
+				out.String(string(v105Value))
 			}
 			out.RawByte('}')
 		}
@@ -12613,27 +15633,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices92(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v LiquidityPoolDetail) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices92(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices111(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v LiquidityPoolDetail) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices92(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices111(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *LiquidityPoolDetail) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices92(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices111(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *LiquidityPoolDetail) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices92(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices111(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices93(in *jlexer.Lexer, out *LiquidityPool) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices112(in *jlexer.Lexer, out *LiquidityPool) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -12675,13 +15695,13 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices93(in *jlexer.Lexer,
 					out.Assets = (out.Assets)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v82 string
+					var v106 string
 					if in.IsNull() {
 						in.Skip()
 					} else {
-						v82 = string(in.String())
+						v106 = string(in.String())
 					}
-					out.Assets = append(out.Assets, v82)
+					out.Assets = append(out.Assets, v106)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -12696,7 +15716,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices93(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices93(out *jwriter.Writer, in LiquidityPool) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices112(out *jwriter.Writer, in LiquidityPool) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -12717,11 +15737,11 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices93(out *jwriter.Writ
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v83, v84 := range in.Assets {
-				if v83 > 0 {
+			for v107, v108 := range in.Assets {
+				if v107 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v84))
+				out.String(string(v108))
 			}
 			out.RawByte(']')
 		}
@@ -12732,27 +15752,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices93(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v LiquidityPool) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices93(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices112(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v LiquidityPool) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices93(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices112(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *LiquidityPool) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices93(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices112(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *LiquidityPool) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices93(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices112(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices94(in *jlexer.Lexer, out *Kline) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices113(in *jlexer.Lexer, out *Kline) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -12842,7 +15862,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices94(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices94(out *jwriter.Writer, in Kline) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices113(out *jwriter.Writer, in Kline) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -12907,27 +15927,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices94(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v Kline) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices94(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices113(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Kline) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices94(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices113(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Kline) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices94(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices113(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Kline) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices94(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices113(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices95(in *jlexer.Lexer, out *IsolatedUserAsset) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices114(in *jlexer.Lexer, out *IsolatedUserAsset) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -13011,7 +16031,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices95(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices95(out *jwriter.Writer, in IsolatedUserAsset) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices114(out *jwriter.Writer, in IsolatedUserAsset) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -13071,27 +16091,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices95(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v IsolatedUserAsset) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices95(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices114(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v IsolatedUserAsset) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices95(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices114(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *IsolatedUserAsset) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices95(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices114(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *IsolatedUserAsset) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices95(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices114(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices96(in *jlexer.Lexer, out *IsolatedMarginAsset) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices115(in *jlexer.Lexer, out *IsolatedMarginAsset) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -13187,7 +16207,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices96(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices96(out *jwriter.Writer, in IsolatedMarginAsset) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices115(out *jwriter.Writer, in IsolatedMarginAsset) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -13257,27 +16277,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices96(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v IsolatedMarginAsset) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices96(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices115(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v IsolatedMarginAsset) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices96(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices115(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *IsolatedMarginAsset) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices96(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices115(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *IsolatedMarginAsset) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices96(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices115(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices97(in *jlexer.Lexer, out *IsolatedMarginAllPair) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices116(in *jlexer.Lexer, out *IsolatedMarginAllPair) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -13337,7 +16357,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices97(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices97(out *jwriter.Writer, in IsolatedMarginAllPair) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices116(out *jwriter.Writer, in IsolatedMarginAllPair) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -13377,27 +16397,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices97(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v IsolatedMarginAllPair) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices97(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices116(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v IsolatedMarginAllPair) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices97(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices116(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *IsolatedMarginAllPair) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices97(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices116(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *IsolatedMarginAllPair) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices97(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices116(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices98(in *jlexer.Lexer, out *IsolatedMarginAccount) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices117(in *jlexer.Lexer, out *IsolatedMarginAccount) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -13445,13 +16465,13 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices98(in *jlexer.Lexer,
 					out.Assets = (out.Assets)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v85 IsolatedMarginAsset
+					var v109 IsolatedMarginAsset
 					if in.IsNull() {
 						in.Skip()
 					} else {
-						(v85).UnmarshalEasyJSON(in)
+						(v109).UnmarshalEasyJSON(in)
 					}
-					out.Assets = append(out.Assets, v85)
+					out.Assets = append(out.Assets, v109)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -13466,7 +16486,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices98(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices98(out *jwriter.Writer, in IsolatedMarginAccount) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices117(out *jwriter.Writer, in IsolatedMarginAccount) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -13492,11 +16512,11 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices98(out *jwriter.Writ
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v86, v87 := range in.Assets {
-				if v86 > 0 {
+			for v110, v111 := range in.Assets {
+				if v110 > 0 {
 					out.RawByte(',')
 				}
-				(v87).MarshalEasyJSON(out)
+				(v111).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -13507,27 +16527,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices98(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v IsolatedMarginAccount) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices98(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices117(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v IsolatedMarginAccount) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices98(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices117(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *IsolatedMarginAccount) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices98(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices117(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *IsolatedMarginAccount) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices98(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices117(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices99(in *jlexer.Lexer, out *InternalUniversalTransferResponse) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices118(in *jlexer.Lexer, out *InternalUniversalTransferResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -13563,7 +16583,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices99(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices99(out *jwriter.Writer, in InternalUniversalTransferResponse) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices118(out *jwriter.Writer, in InternalUniversalTransferResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -13583,27 +16603,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices99(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v InternalUniversalTransferResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices99(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices118(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v InternalUniversalTransferResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices99(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices118(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *InternalUniversalTransferResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices99(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices118(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *InternalUniversalTransferResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices99(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices118(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices100(in *jlexer.Lexer, out *InternalUniversalTransferHistoryResponse) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices119(in *jlexer.Lexer, out *InternalUniversalTransferHistoryResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -13633,21 +16653,21 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices100(in *jlexer.Lexer
 					out.Result = (out.Result)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v88 *InternalUniversalTransfer
+					var v112 *InternalUniversalTransfer
 					if in.IsNull() {
 						in.Skip()
-						v88 = nil
+						v112 = nil
 					} else {
-						if v88 == nil {
-							v88 = new(InternalUniversalTransfer)
+						if v112 == nil {
+							v112 = new(InternalUniversalTransfer)
 						}
 						if in.IsNull() {
 							in.Skip()
 						} else {
-							(*v88).UnmarshalEasyJSON(in)
+							(*v112).UnmarshalEasyJSON(in)
 						}
 					}
-					out.Result = append(out.Result, v88)
+					out.Result = append(out.Result, v112)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -13668,7 +16688,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices100(in *jlexer.Lexer
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices100(out *jwriter.Writer, in InternalUniversalTransferHistoryResponse) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices119(out *jwriter.Writer, in InternalUniversalTransferHistoryResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -13679,14 +16699,14 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices100(out *jwriter.Wri
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v89, v90 := range in.Result {
-				if v89 > 0 {
+			for v113, v114 := range in.Result {
+				if v113 > 0 {
 					out.RawByte(',')
 				}
-				if v90 == nil {
+				if v114 == nil {
 					out.RawString("null")
 				} else {
-					(*v90).MarshalEasyJSON(out)
+					(*v114).MarshalEasyJSON(out)
 				}
 			}
 			out.RawByte(']')
@@ -13703,27 +16723,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices100(out *jwriter.Wri
 // MarshalJSON supports json.Marshaler interface
 func (v InternalUniversalTransferHistoryResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices100(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices119(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v InternalUniversalTransferHistoryResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices100(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices119(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *InternalUniversalTransferHistoryResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices100(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices119(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *InternalUniversalTransferHistoryResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices100(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices119(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices101(in *jlexer.Lexer, out *InternalUniversalTransfer) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices120(in *jlexer.Lexer, out *InternalUniversalTransfer) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -13807,7 +16827,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices101(in *jlexer.Lexer
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices101(out *jwriter.Writer, in InternalUniversalTransfer) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices120(out *jwriter.Writer, in InternalUniversalTransfer) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -13867,27 +16887,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices101(out *jwriter.Wri
 // MarshalJSON supports json.Marshaler interface
 func (v InternalUniversalTransfer) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices101(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices120(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v InternalUniversalTransfer) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices101(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices120(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *InternalUniversalTransfer) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices101(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices120(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *InternalUniversalTransfer) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices101(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices120(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices102(in *jlexer.Lexer, out *InterestHistoryElement) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices121(in *jlexer.Lexer, out *InterestHistoryElement) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -13941,7 +16961,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices102(in *jlexer.Lexer
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices102(out *jwriter.Writer, in InterestHistoryElement) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices121(out *jwriter.Writer, in InterestHistoryElement) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -13976,27 +16996,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices102(out *jwriter.Wri
 // MarshalJSON supports json.Marshaler interface
 func (v InterestHistoryElement) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices102(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices121(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v InterestHistoryElement) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices102(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices121(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *InterestHistoryElement) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices102(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices121(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *InterestHistoryElement) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices102(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices121(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices103(in *jlexer.Lexer, out *InterestHistory) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices122(in *jlexer.Lexer, out *InterestHistory) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		in.Skip()
@@ -14013,13 +17033,13 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices103(in *jlexer.Lexer
 			*out = (*out)[:0]
 		}
 		for !in.IsDelim(']') {
-			var v91 InterestHistoryElement
+			var v115 InterestHistoryElement
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				(v91).UnmarshalEasyJSON(in)
+				(v115).UnmarshalEasyJSON(in)
 			}
-			*out = append(*out, v91)
+			*out = append(*out, v115)
 			in.WantComma()
 		}
 		in.Delim(']')
@@ -14028,16 +17048,16 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices103(in *jlexer.Lexer
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices103(out *jwriter.Writer, in InterestHistory) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices122(out *jwriter.Writer, in InterestHistory) {
 	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 		out.RawString("null")
 	} else {
 		out.RawByte('[')
-		for v92, v93 := range in {
-			if v92 > 0 {
+		for v116, v117 := range in {
+			if v116 > 0 {
 				out.RawByte(',')
 			}
-			(v93).MarshalEasyJSON(out)
+			(v117).MarshalEasyJSON(out)
 		}
 		out.RawByte(']')
 	}
@@ -14046,27 +17066,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices103(out *jwriter.Wri
 // MarshalJSON supports json.Marshaler interface
 func (v InterestHistory) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices103(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices122(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v InterestHistory) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices103(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices122(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *InterestHistory) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices103(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices122(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *InterestHistory) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices103(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices122(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices104(in *jlexer.Lexer, out *IcebergPartsFilter) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices123(in *jlexer.Lexer, out *IcebergPartsFilter) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -14096,7 +17116,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices104(in *jlexer.Lexer
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices104(out *jwriter.Writer, in IcebergPartsFilter) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices123(out *jwriter.Writer, in IcebergPartsFilter) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -14111,27 +17131,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices104(out *jwriter.Wri
 // MarshalJSON supports json.Marshaler interface
 func (v IcebergPartsFilter) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices104(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices123(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v IcebergPartsFilter) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices104(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices123(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *IcebergPartsFilter) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices104(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices123(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *IcebergPartsFilter) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices104(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices123(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices105(in *jlexer.Lexer, out *GetSwapQuoteResponse) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices124(in *jlexer.Lexer, out *GetSwapQuoteResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -14197,7 +17217,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices105(in *jlexer.Lexer
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices105(out *jwriter.Writer, in GetSwapQuoteResponse) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices124(out *jwriter.Writer, in GetSwapQuoteResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -14242,27 +17262,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices105(out *jwriter.Wri
 // MarshalJSON supports json.Marshaler interface
 func (v GetSwapQuoteResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices105(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices124(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v GetSwapQuoteResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices105(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices124(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *GetSwapQuoteResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices105(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices124(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *GetSwapQuoteResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices105(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices124(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices106(in *jlexer.Lexer, out *GetReferralRebateRecord) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices125(in *jlexer.Lexer, out *GetReferralRebateRecord) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -14286,7 +17306,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices106(in *jlexer.Lexer
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices106(out *jwriter.Writer, in GetReferralRebateRecord) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices125(out *jwriter.Writer, in GetReferralRebateRecord) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -14296,27 +17316,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices106(out *jwriter.Wri
 // MarshalJSON supports json.Marshaler interface
 func (v GetReferralRebateRecord) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices106(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices125(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v GetReferralRebateRecord) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices106(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices125(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *GetReferralRebateRecord) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices106(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices125(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *GetReferralRebateRecord) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices106(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices125(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices107(in *jlexer.Lexer, out *GetListingResponse) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices126(in *jlexer.Lexer, out *GetListingResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -14352,13 +17372,13 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices107(in *jlexer.Lexer
 					out.Symbols = (out.Symbols)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v94 string
+					var v118 string
 					if in.IsNull() {
 						in.Skip()
 					} else {
-						v94 = string(in.String())
+						v118 = string(in.String())
 					}
-					out.Symbols = append(out.Symbols, v94)
+					out.Symbols = append(out.Symbols, v118)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -14373,7 +17393,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices107(in *jlexer.Lexer
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices107(out *jwriter.Writer, in GetListingResponse) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices126(out *jwriter.Writer, in GetListingResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -14389,11 +17409,11 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices107(out *jwriter.Wri
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v95, v96 := range in.Symbols {
-				if v95 > 0 {
+			for v119, v120 := range in.Symbols {
+				if v119 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v96))
+				out.String(string(v120))
 			}
 			out.RawByte(']')
 		}
@@ -14404,27 +17424,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices107(out *jwriter.Wri
 // MarshalJSON supports json.Marshaler interface
 func (v GetListingResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices107(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices126(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v GetListingResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices107(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices126(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *GetListingResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices107(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices126(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *GetListingResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices107(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices126(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices108(in *jlexer.Lexer, out *GetIPRestrictionSubAccountAPIKeyResponse) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices127(in *jlexer.Lexer, out *GetIPRestrictionSubAccountAPIKeyResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -14472,13 +17492,13 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices108(in *jlexer.Lexer
 					out.IpList = (out.IpList)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v97 string
+					var v121 string
 					if in.IsNull() {
 						in.Skip()
 					} else {
-						v97 = string(in.String())
+						v121 = string(in.String())
 					}
-					out.IpList = append(out.IpList, v97)
+					out.IpList = append(out.IpList, v121)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -14499,7 +17519,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices108(in *jlexer.Lexer
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices108(out *jwriter.Writer, in GetIPRestrictionSubAccountAPIKeyResponse) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices127(out *jwriter.Writer, in GetIPRestrictionSubAccountAPIKeyResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -14525,11 +17545,11 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices108(out *jwriter.Wri
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v98, v99 := range in.IpList {
-				if v98 > 0 {
+			for v122, v123 := range in.IpList {
+				if v122 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v99))
+				out.String(string(v123))
 			}
 			out.RawByte(']')
 		}
@@ -14545,27 +17565,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices108(out *jwriter.Wri
 // MarshalJSON supports json.Marshaler interface
 func (v GetIPRestrictionSubAccountAPIKeyResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices108(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices127(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v GetIPRestrictionSubAccountAPIKeyResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices108(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices127(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *GetIPRestrictionSubAccountAPIKeyResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices108(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices127(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *GetIPRestrictionSubAccountAPIKeyResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices108(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices127(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices109(in *jlexer.Lexer, out *GetDepositAddressResponse) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices128(in *jlexer.Lexer, out *GetDepositAddressResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -14613,7 +17633,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices109(in *jlexer.Lexer
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices109(out *jwriter.Writer, in GetDepositAddressResponse) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices128(out *jwriter.Writer, in GetDepositAddressResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -14643,27 +17663,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices109(out *jwriter.Wri
 // MarshalJSON supports json.Marshaler interface
 func (v GetDepositAddressResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices109(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices128(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v GetDepositAddressResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices109(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices128(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *GetDepositAddressResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices109(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices128(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *GetDepositAddressResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices109(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices128(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices110(in *jlexer.Lexer, out *GetBrokerInfoResponse) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices129(in *jlexer.Lexer, out *GetBrokerInfoResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -14731,7 +17751,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices110(in *jlexer.Lexer
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices110(out *jwriter.Writer, in GetBrokerInfoResponse) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices129(out *jwriter.Writer, in GetBrokerInfoResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -14771,27 +17791,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices110(out *jwriter.Wri
 // MarshalJSON supports json.Marshaler interface
 func (v GetBrokerInfoResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices110(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices129(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v GetBrokerInfoResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices110(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices129(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *GetBrokerInfoResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices110(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices129(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *GetBrokerInfoResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices110(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices129(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices111(in *jlexer.Lexer, out *GetAPIKeyPermission) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices130(in *jlexer.Lexer, out *GetAPIKeyPermission) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -14815,7 +17835,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices111(in *jlexer.Lexer
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices111(out *jwriter.Writer, in GetAPIKeyPermission) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices130(out *jwriter.Writer, in GetAPIKeyPermission) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -14825,27 +17845,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices111(out *jwriter.Wri
 // MarshalJSON supports json.Marshaler interface
 func (v GetAPIKeyPermission) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices111(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices130(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v GetAPIKeyPermission) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices111(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices130(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *GetAPIKeyPermission) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices111(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices130(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *GetAPIKeyPermission) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices111(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices130(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices112(in *jlexer.Lexer, out *FuturesTransferHistory) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices131(in *jlexer.Lexer, out *FuturesTransferHistory) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -14875,13 +17895,13 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices112(in *jlexer.Lexer
 					out.Rows = (out.Rows)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v100 FuturesTransfer
+					var v124 FuturesTransfer
 					if in.IsNull() {
 						in.Skip()
 					} else {
-						(v100).UnmarshalEasyJSON(in)
+						(v124).UnmarshalEasyJSON(in)
 					}
-					out.Rows = append(out.Rows, v100)
+					out.Rows = append(out.Rows, v124)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -14902,7 +17922,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices112(in *jlexer.Lexer
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices112(out *jwriter.Writer, in FuturesTransferHistory) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices131(out *jwriter.Writer, in FuturesTransferHistory) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -14913,11 +17933,11 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices112(out *jwriter.Wri
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v101, v102 := range in.Rows {
-				if v101 > 0 {
+			for v125, v126 := range in.Rows {
+				if v125 > 0 {
 					out.RawByte(',')
 				}
-				(v102).MarshalEasyJSON(out)
+				(v126).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -14933,27 +17953,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices112(out *jwriter.Wri
 // MarshalJSON supports json.Marshaler interface
 func (v FuturesTransferHistory) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices112(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices131(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v FuturesTransferHistory) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices112(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices131(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *FuturesTransferHistory) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices112(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices131(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *FuturesTransferHistory) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices112(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices131(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices113(in *jlexer.Lexer, out *FuturesTransfer) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices132(in *jlexer.Lexer, out *FuturesTransfer) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -15013,7 +18033,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices113(in *jlexer.Lexer
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices113(out *jwriter.Writer, in FuturesTransfer) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices132(out *jwriter.Writer, in FuturesTransfer) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -15053,27 +18073,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices113(out *jwriter.Wri
 // MarshalJSON supports json.Marshaler interface
 func (v FuturesTransfer) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices113(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices132(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v FuturesTransfer) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices113(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices132(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *FuturesTransfer) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices113(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices132(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *FuturesTransfer) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices113(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices132(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices114(in *jlexer.Lexer, out *FundsDetail) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices133(in *jlexer.Lexer, out *FundsDetail) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -15109,7 +18129,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices114(in *jlexer.Lexer
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices114(out *jwriter.Writer, in FundsDetail) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices133(out *jwriter.Writer, in FundsDetail) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -15129,27 +18149,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices114(out *jwriter.Wri
 // MarshalJSON supports json.Marshaler interface
 func (v FundsDetail) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices114(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices133(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v FundsDetail) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices114(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices133(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *FundsDetail) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices114(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices133(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *FundsDetail) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices114(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices133(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices115(in *jlexer.Lexer, out *Fill) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices134(in *jlexer.Lexer, out *Fill) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -15203,7 +18223,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices115(in *jlexer.Lexer
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices115(out *jwriter.Writer, in Fill) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices134(out *jwriter.Writer, in Fill) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -15238,27 +18258,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices115(out *jwriter.Wri
 // MarshalJSON supports json.Marshaler interface
 func (v Fill) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices115(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices134(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Fill) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices115(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices134(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Fill) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices115(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices134(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Fill) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices115(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices134(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices116(in *jlexer.Lexer, out *FiatPaymentsHistoryItem) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices135(in *jlexer.Lexer, out *FiatPaymentsHistoryItem) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -15342,7 +18362,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices116(in *jlexer.Lexer
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices116(out *jwriter.Writer, in FiatPaymentsHistoryItem) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices135(out *jwriter.Writer, in FiatPaymentsHistoryItem) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -15402,27 +18422,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices116(out *jwriter.Wri
 // MarshalJSON supports json.Marshaler interface
 func (v FiatPaymentsHistoryItem) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices116(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices135(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v FiatPaymentsHistoryItem) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices116(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices135(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *FiatPaymentsHistoryItem) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices116(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices135(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *FiatPaymentsHistoryItem) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices116(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices135(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices117(in *jlexer.Lexer, out *FiatPaymentsHistory) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices136(in *jlexer.Lexer, out *FiatPaymentsHistory) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -15464,13 +18484,13 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices117(in *jlexer.Lexer
 					out.Data = (out.Data)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v103 FiatPaymentsHistoryItem
+					var v127 FiatPaymentsHistoryItem
 					if in.IsNull() {
 						in.Skip()
 					} else {
-						(v103).UnmarshalEasyJSON(in)
+						(v127).UnmarshalEasyJSON(in)
 					}
-					out.Data = append(out.Data, v103)
+					out.Data = append(out.Data, v127)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -15497,7 +18517,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices117(in *jlexer.Lexer
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices117(out *jwriter.Writer, in FiatPaymentsHistory) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices136(out *jwriter.Writer, in FiatPaymentsHistory) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -15518,11 +18538,11 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices117(out *jwriter.Wri
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v104, v105 := range in.Data {
-				if v104 > 0 {
+			for v128, v129 := range in.Data {
+				if v128 > 0 {
 					out.RawByte(',')
 				}
-				(v105).MarshalEasyJSON(out)
+				(v129).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -15543,27 +18563,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices117(out *jwriter.Wri
 // MarshalJSON supports json.Marshaler interface
 func (v FiatPaymentsHistory) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices117(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices136(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v FiatPaymentsHistory) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices117(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices136(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *FiatPaymentsHistory) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices117(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices136(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *FiatPaymentsHistory) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices117(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices136(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices118(in *jlexer.Lexer, out *FiatDepositWithdrawHistoryItem) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices137(in *jlexer.Lexer, out *FiatDepositWithdrawHistoryItem) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -15641,7 +18661,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices118(in *jlexer.Lexer
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices118(out *jwriter.Writer, in FiatDepositWithdrawHistoryItem) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices137(out *jwriter.Writer, in FiatDepositWithdrawHistoryItem) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -15696,27 +18716,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices118(out *jwriter.Wri
 // MarshalJSON supports json.Marshaler interface
 func (v FiatDepositWithdrawHistoryItem) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices118(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices137(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v FiatDepositWithdrawHistoryItem) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices118(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices137(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *FiatDepositWithdrawHistoryItem) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices118(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices137(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *FiatDepositWithdrawHistoryItem) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices118(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices137(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices119(in *jlexer.Lexer, out *FiatDepositWithdrawHistory) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices138(in *jlexer.Lexer, out *FiatDepositWithdrawHistory) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -15758,13 +18778,13 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices119(in *jlexer.Lexer
 					out.Data = (out.Data)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v106 FiatDepositWithdrawHistoryItem
+					var v130 FiatDepositWithdrawHistoryItem
 					if in.IsNull() {
 						in.Skip()
 					} else {
-						(v106).UnmarshalEasyJSON(in)
+						(v130).UnmarshalEasyJSON(in)
 					}
-					out.Data = append(out.Data, v106)
+					out.Data = append(out.Data, v130)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -15791,7 +18811,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices119(in *jlexer.Lexer
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices119(out *jwriter.Writer, in FiatDepositWithdrawHistory) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices138(out *jwriter.Writer, in FiatDepositWithdrawHistory) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -15812,11 +18832,11 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices119(out *jwriter.Wri
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v107, v108 := range in.Data {
-				if v107 > 0 {
+			for v131, v132 := range in.Data {
+				if v131 > 0 {
 					out.RawByte(',')
 				}
-				(v108).MarshalEasyJSON(out)
+				(v132).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -15837,27 +18857,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices119(out *jwriter.Wri
 // MarshalJSON supports json.Marshaler interface
 func (v FiatDepositWithdrawHistory) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices119(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices138(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v FiatDepositWithdrawHistory) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices119(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices138(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *FiatDepositWithdrawHistory) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices119(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices138(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *FiatDepositWithdrawHistory) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices119(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices138(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices120(in *jlexer.Lexer, out *ExchangeInfo) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices139(in *jlexer.Lexer, out *ExchangeInfo) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -15899,13 +18919,13 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices120(in *jlexer.Lexer
 					out.RateLimits = (out.RateLimits)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v109 RateLimit
+					var v133 RateLimit
 					if in.IsNull() {
 						in.Skip()
 					} else {
-						(v109).UnmarshalEasyJSON(in)
+						(v133).UnmarshalEasyJSON(in)
 					}
-					out.RateLimits = append(out.RateLimits, v109)
+					out.RateLimits = append(out.RateLimits, v133)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -15926,15 +18946,15 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices120(in *jlexer.Lexer
 					out.ExchangeFilters = (out.ExchangeFilters)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v110 interface{}
-					if m, ok := v110.(easyjson.Unmarshaler); ok {
+					var v134 interface{}
+					if m, ok := v134.(easyjson.Unmarshaler); ok {
 						m.UnmarshalEasyJSON(in)
-					} else if m, ok := v110.(json.Unmarshaler); ok {
+					} else if m, ok := v134.(json.Unmarshaler); ok {
 						_ = m.UnmarshalJSON(in.Raw())
 					} else {
-						v110 = in.Interface()
+						v134 = in.Interface()
 					}
-					out.ExchangeFilters = append(out.ExchangeFilters, v110)
+					out.ExchangeFilters = append(out.ExchangeFilters, v134)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -15955,13 +18975,13 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices120(in *jlexer.Lexer
 					out.Symbols = (out.Symbols)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v111 Symbol
+					var v135 Symbol
 					if in.IsNull() {
 						in.Skip()
 					} else {
-						(v111).UnmarshalEasyJSON(in)
+						(v135).UnmarshalEasyJSON(in)
 					}
-					out.Symbols = append(out.Symbols, v111)
+					out.Symbols = append(out.Symbols, v135)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -15976,7 +18996,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices120(in *jlexer.Lexer
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices120(out *jwriter.Writer, in ExchangeInfo) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices139(out *jwriter.Writer, in ExchangeInfo) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -15997,11 +19017,11 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices120(out *jwriter.Wri
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v112, v113 := range in.RateLimits {
-				if v112 > 0 {
+			for v136, v137 := range in.RateLimits {
+				if v136 > 0 {
 					out.RawByte(',')
 				}
-				(v113).MarshalEasyJSON(out)
+				(v137).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -16013,16 +19033,16 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices120(out *jwriter.Wri
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v114, v115 := range in.ExchangeFilters {
-				if v114 > 0 {
+			for v138, v139 := range in.ExchangeFilters {
+				if v138 > 0 {
 					out.RawByte(',')
 				}
-				if m, ok := v115.(easyjson.Marshaler); ok {
+				if m, ok := v139.(easyjson.Marshaler); ok {
 					m.MarshalEasyJSON(out)
-				} else if m, ok := v115.(json.Marshaler); ok {
+				} else if m, ok := v139.(json.Marshaler); ok {
 					out.Raw(m.MarshalJSON())
 				} else {
-					out.Raw(json.Marshal(v115))
+					out.Raw(json.Marshal(v139))
 				}
 			}
 			out.RawByte(']')
@@ -16035,11 +19055,11 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices120(out *jwriter.Wri
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v116, v117 := range in.Symbols {
-				if v116 > 0 {
+			for v140, v141 := range in.Symbols {
+				if v140 > 0 {
 					out.RawByte(',')
 				}
-				(v117).MarshalEasyJSON(out)
+				(v141).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -16050,27 +19070,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices120(out *jwriter.Wri
 // MarshalJSON supports json.Marshaler interface
 func (v ExchangeInfo) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices120(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices139(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v ExchangeInfo) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices120(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices139(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *ExchangeInfo) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices120(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices139(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *ExchangeInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices120(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices139(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices121(in *jlexer.Lexer, out *DustTransferResult) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices140(in *jlexer.Lexer, out *DustTransferResult) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -16130,7 +19150,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices121(in *jlexer.Lexer
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices121(out *jwriter.Writer, in DustTransferResult) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices140(out *jwriter.Writer, in DustTransferResult) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -16170,27 +19190,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices121(out *jwriter.Wri
 // MarshalJSON supports json.Marshaler interface
 func (v DustTransferResult) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices121(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices140(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v DustTransferResult) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices121(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices140(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *DustTransferResult) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices121(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices140(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *DustTransferResult) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices121(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices140(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices122(in *jlexer.Lexer, out *DustTransferResponse) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices141(in *jlexer.Lexer, out *DustTransferResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -16232,21 +19252,21 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices122(in *jlexer.Lexer
 					out.TransferResult = (out.TransferResult)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v118 *DustTransferResult
+					var v142 *DustTransferResult
 					if in.IsNull() {
 						in.Skip()
-						v118 = nil
+						v142 = nil
 					} else {
-						if v118 == nil {
-							v118 = new(DustTransferResult)
+						if v142 == nil {
+							v142 = new(DustTransferResult)
 						}
 						if in.IsNull() {
 							in.Skip()
 						} else {
-							(*v118).UnmarshalEasyJSON(in)
+							(*v142).UnmarshalEasyJSON(in)
 						}
 					}
-					out.TransferResult = append(out.TransferResult, v118)
+					out.TransferResult = append(out.TransferResult, v142)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -16261,7 +19281,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices122(in *jlexer.Lexer
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices122(out *jwriter.Writer, in DustTransferResponse) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices141(out *jwriter.Writer, in DustTransferResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -16282,14 +19302,14 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices122(out *jwriter.Wri
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v119, v120 := range in.TransferResult {
-				if v119 > 0 {
+			for v143, v144 := range in.TransferResult {
+				if v143 > 0 {
 					out.RawByte(',')
 				}
-				if v120 == nil {
+				if v144 == nil {
 					out.RawString("null")
 				} else {
-					(*v120).MarshalEasyJSON(out)
+					(*v144).MarshalEasyJSON(out)
 				}
 			}
 			out.RawByte(']')
@@ -16301,27 +19321,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices122(out *jwriter.Wri
 // MarshalJSON supports json.Marshaler interface
 func (v DustTransferResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices122(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices141(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v DustTransferResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices122(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices141(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *DustTransferResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices122(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices141(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *DustTransferResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices122(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices141(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices123(in *jlexer.Lexer, out *DustResult) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices142(in *jlexer.Lexer, out *DustResult) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -16357,13 +19377,13 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices123(in *jlexer.Lexer
 					out.UserAssetDribblets = (out.UserAssetDribblets)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v121 UserAssetDribblet
+					var v145 UserAssetDribblet
 					if in.IsNull() {
 						in.Skip()
 					} else {
-						(v121).UnmarshalEasyJSON(in)
+						(v145).UnmarshalEasyJSON(in)
 					}
-					out.UserAssetDribblets = append(out.UserAssetDribblets, v121)
+					out.UserAssetDribblets = append(out.UserAssetDribblets, v145)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -16378,7 +19398,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices123(in *jlexer.Lexer
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices123(out *jwriter.Writer, in DustResult) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices142(out *jwriter.Writer, in DustResult) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -16394,11 +19414,11 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices123(out *jwriter.Wri
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v122, v123 := range in.UserAssetDribblets {
-				if v122 > 0 {
+			for v146, v147 := range in.UserAssetDribblets {
+				if v146 > 0 {
 					out.RawByte(',')
 				}
-				(v123).MarshalEasyJSON(out)
+				(v147).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -16409,27 +19429,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices123(out *jwriter.Wri
 // MarshalJSON supports json.Marshaler interface
 func (v DustResult) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices123(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices142(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v DustResult) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices123(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices142(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *DustResult) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices123(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices142(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *DustResult) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices123(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices142(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices124(in *jlexer.Lexer, out *DividendResponseWrapper) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices143(in *jlexer.Lexer, out *DividendResponseWrapper) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -16466,13 +19486,13 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices124(in *jlexer.Lexer
 						*out.Rows = (*out.Rows)[:0]
 					}
 					for !in.IsDelim(']') {
-						var v124 DividendResponse
+						var v148 DividendResponse
 						if in.IsNull() {
 							in.Skip()
 						} else {
-							(v124).UnmarshalEasyJSON(in)
+							(v148).UnmarshalEasyJSON(in)
 						}
-						*out.Rows = append(*out.Rows, v124)
+						*out.Rows = append(*out.Rows, v148)
 						in.WantComma()
 					}
 					in.Delim(']')
@@ -16494,7 +19514,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices124(in *jlexer.Lexer
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices124(out *jwriter.Writer, in DividendResponseWrapper) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices143(out *jwriter.Writer, in DividendResponseWrapper) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -16508,11 +19528,11 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices124(out *jwriter.Wri
 				out.RawString("null")
 			} else {
 				out.RawByte('[')
-				for v125, v126 := range *in.Rows {
-					if v125 > 0 {
+				for v149, v150 := range *in.Rows {
+					if v149 > 0 {
 						out.RawByte(',')
 					}
-					(v126).MarshalEasyJSON(out)
+					(v150).MarshalEasyJSON(out)
 				}
 				out.RawByte(']')
 			}
@@ -16529,27 +19549,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices124(out *jwriter.Wri
 // MarshalJSON supports json.Marshaler interface
 func (v DividendResponseWrapper) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices124(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices143(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v DividendResponseWrapper) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices124(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices143(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *DividendResponseWrapper) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices124(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices143(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *DividendResponseWrapper) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices124(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices143(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices125(in *jlexer.Lexer, out *DividendResponse) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices144(in *jlexer.Lexer, out *DividendResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -16609,7 +19629,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices125(in *jlexer.Lexer
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices125(out *jwriter.Writer, in DividendResponse) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices144(out *jwriter.Writer, in DividendResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -16649,27 +19669,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices125(out *jwriter.Wri
 // MarshalJSON supports json.Marshaler interface
 func (v DividendResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices125(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices144(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v DividendResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices125(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices144(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *DividendResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices125(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices144(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *DividendResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices125(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices144(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices126(in *jlexer.Lexer, out *DepthResponse) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices145(in *jlexer.Lexer, out *DepthResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -16705,13 +19725,13 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices126(in *jlexer.Lexer
 					out.Bids = (out.Bids)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v127 common.PriceLevel
+					var v151 common.PriceLevel
 					if in.IsNull() {
 						in.Skip()
 					} else {
-						(v127).UnmarshalEasyJSON(in)
+						(v151).UnmarshalEasyJSON(in)
 					}
-					out.Bids = append(out.Bids, v127)
+					out.Bids = append(out.Bids, v151)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -16732,13 +19752,13 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices126(in *jlexer.Lexer
 					out.Asks = (out.Asks)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v128 common.PriceLevel
+					var v152 common.PriceLevel
 					if in.IsNull() {
 						in.Skip()
 					} else {
-						(v128).UnmarshalEasyJSON(in)
+						(v152).UnmarshalEasyJSON(in)
 					}
-					out.Asks = append(out.Asks, v128)
+					out.Asks = append(out.Asks, v152)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -16753,7 +19773,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices126(in *jlexer.Lexer
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices126(out *jwriter.Writer, in DepthResponse) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices145(out *jwriter.Writer, in DepthResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -16769,11 +19789,11 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices126(out *jwriter.Wri
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v129, v130 := range in.Bids {
-				if v129 > 0 {
+			for v153, v154 := range in.Bids {
+				if v153 > 0 {
 					out.RawByte(',')
 				}
-				(v130).MarshalEasyJSON(out)
+				(v154).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -16785,11 +19805,11 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices126(out *jwriter.Wri
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v131, v132 := range in.Asks {
-				if v131 > 0 {
+			for v155, v156 := range in.Asks {
+				if v155 > 0 {
 					out.RawByte(',')
 				}
-				(v132).MarshalEasyJSON(out)
+				(v156).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -16800,27 +19820,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices126(out *jwriter.Wri
 // MarshalJSON supports json.Marshaler interface
 func (v DepthResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices126(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices145(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v DepthResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices126(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices145(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *DepthResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices126(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices145(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *DepthResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices126(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices145(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices127(in *jlexer.Lexer, out *Deposit) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices146(in *jlexer.Lexer, out *Deposit) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -16834,6 +19854,12 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices127(in *jlexer.Lexer
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
+		case "id":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ID = string(in.String())
+			}
 		case "amount":
 			if in.IsNull() {
 				in.Skip()
@@ -16912,13 +19938,18 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices127(in *jlexer.Lexer
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices127(out *jwriter.Writer, in Deposit) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices146(out *jwriter.Writer, in Deposit) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"amount\":"
+		const prefix string = ",\"id\":"
 		out.RawString(prefix[1:])
+		out.String(string(in.ID))
+	}
+	{
+		const prefix string = ",\"amount\":"
+		out.RawString(prefix)
 		out.Raw((in.Amount).MarshalJSON())
 	}
 	{
@@ -16977,27 +20008,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices127(out *jwriter.Wri
 // MarshalJSON supports json.Marshaler interface
 func (v Deposit) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices127(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices146(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Deposit) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices127(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices146(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Deposit) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices127(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices146(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Deposit) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices127(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices146(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices128(in *jlexer.Lexer, out *CreateWithdrawResponse) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices147(in *jlexer.Lexer, out *CreateWithdrawResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -17027,7 +20058,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices128(in *jlexer.Lexer
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices128(out *jwriter.Writer, in CreateWithdrawResponse) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices147(out *jwriter.Writer, in CreateWithdrawResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -17042,27 +20073,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices128(out *jwriter.Wri
 // MarshalJSON supports json.Marshaler interface
 func (v CreateWithdrawResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices128(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices147(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v CreateWithdrawResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices128(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices147(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *CreateWithdrawResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices128(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices147(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *CreateWithdrawResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices128(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices147(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices129(in *jlexer.Lexer, out *CreateUserUniversalTransferResponse) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices148(in *jlexer.Lexer, out *CreateUserUniversalTransferResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -17092,7 +20123,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices129(in *jlexer.Lexer
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices129(out *jwriter.Writer, in CreateUserUniversalTransferResponse) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices148(out *jwriter.Writer, in CreateUserUniversalTransferResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -17107,27 +20138,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices129(out *jwriter.Wri
 // MarshalJSON supports json.Marshaler interface
 func (v CreateUserUniversalTransferResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices129(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices148(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v CreateUserUniversalTransferResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices129(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices148(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *CreateUserUniversalTransferResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices129(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices148(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *CreateUserUniversalTransferResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices129(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices148(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices130(in *jlexer.Lexer, out *CreateOrderResponse) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices149(in *jlexer.Lexer, out *CreateOrderResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -17235,21 +20266,21 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices130(in *jlexer.Lexer
 					out.Fills = (out.Fills)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v133 *Fill
+					var v157 *Fill
 					if in.IsNull() {
 						in.Skip()
-						v133 = nil
+						v157 = nil
 					} else {
-						if v133 == nil {
-							v133 = new(Fill)
+						if v157 == nil {
+							v157 = new(Fill)
 						}
 						if in.IsNull() {
 							in.Skip()
 						} else {
-							(*v133).UnmarshalEasyJSON(in)
+							(*v157).UnmarshalEasyJSON(in)
 						}
 					}
-					out.Fills = append(out.Fills, v133)
+					out.Fills = append(out.Fills, v157)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -17266,6 +20297,12 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices130(in *jlexer.Lexer
 			} else {
 				out.MarginBuyBorrowAsset = string(in.String())
 			}
+		case "recovered":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Recovered = bool(in.Bool())
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -17276,7 +20313,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices130(in *jlexer.Lexer
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices130(out *jwriter.Writer, in CreateOrderResponse) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices149(out *jwriter.Writer, in CreateOrderResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -17352,14 +20389,14 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices130(out *jwriter.Wri
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v134, v135 := range in.Fills {
-				if v134 > 0 {
+			for v158, v159 := range in.Fills {
+				if v158 > 0 {
 					out.RawByte(',')
 				}
-				if v135 == nil {
+				if v159 == nil {
 					out.RawString("null")
 				} else {
-					(*v135).MarshalEasyJSON(out)
+					(*v159).MarshalEasyJSON(out)
 				}
 			}
 			out.RawByte(']')
@@ -17375,33 +20412,38 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices130(out *jwriter.Wri
 		out.RawString(prefix)
 		out.String(string(in.MarginBuyBorrowAsset))
 	}
+	if in.Recovered {
+		const prefix string = ",\"recovered\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Recovered))
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v CreateOrderResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices130(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices149(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v CreateOrderResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices130(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices149(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *CreateOrderResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices130(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices149(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *CreateOrderResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices130(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices149(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices131(in *jlexer.Lexer, out *CreateOCOResponse) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices150(in *jlexer.Lexer, out *CreateOCOResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -17473,21 +20515,21 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices131(in *jlexer.Lexer
 					out.Orders = (out.Orders)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v136 *OCOOrder
+					var v160 *OCOOrder
 					if in.IsNull() {
 						in.Skip()
-						v136 = nil
+						v160 = nil
 					} else {
-						if v136 == nil {
-							v136 = new(OCOOrder)
+						if v160 == nil {
+							v160 = new(OCOOrder)
 						}
 						if in.IsNull() {
 							in.Skip()
 						} else {
-							(*v136).UnmarshalEasyJSON(in)
+							(*v160).UnmarshalEasyJSON(in)
 						}
 					}
-					out.Orders = append(out.Orders, v136)
+					out.Orders = append(out.Orders, v160)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -17508,21 +20550,21 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices131(in *jlexer.Lexer
 					out.OrderReports = (out.OrderReports)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v137 *OCOOrderReport
+					var v161 *OCOOrderReport
 					if in.IsNull() {
 						in.Skip()
-						v137 = nil
+						v161 = nil
 					} else {
-						if v137 == nil {
-							v137 = new(OCOOrderReport)
+						if v161 == nil {
+							v161 = new(OCOOrderReport)
 						}
 						if in.IsNull() {
 							in.Skip()
 						} else {
-							(*v137).UnmarshalEasyJSON(in)
+							(*v161).UnmarshalEasyJSON(in)
 						}
 					}
-					out.OrderReports = append(out.OrderReports, v137)
+					out.OrderReports = append(out.OrderReports, v161)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -17537,7 +20579,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices131(in *jlexer.Lexer
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices131(out *jwriter.Writer, in CreateOCOResponse) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices150(out *jwriter.Writer, in CreateOCOResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -17583,14 +20625,14 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices131(out *jwriter.Wri
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v138, v139 := range in.Orders {
-				if v138 > 0 {
+			for v162, v163 := range in.Orders {
+				if v162 > 0 {
 					out.RawByte(',')
 				}
-				if v139 == nil {
+				if v163 == nil {
 					out.RawString("null")
 				} else {
-					(*v139).MarshalEasyJSON(out)
+					(*v163).MarshalEasyJSON(out)
 				}
 			}
 			out.RawByte(']')
@@ -17603,14 +20645,14 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices131(out *jwriter.Wri
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v140, v141 := range in.OrderReports {
-				if v140 > 0 {
+			for v164, v165 := range in.OrderReports {
+				if v164 > 0 {
 					out.RawByte(',')
 				}
-				if v141 == nil {
+				if v165 == nil {
 					out.RawString("null")
 				} else {
-					(*v141).MarshalEasyJSON(out)
+					(*v165).MarshalEasyJSON(out)
 				}
 			}
 			out.RawByte(']')
@@ -17622,27 +20664,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices131(out *jwriter.Wri
 // MarshalJSON supports json.Marshaler interface
 func (v CreateOCOResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices131(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices150(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v CreateOCOResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices131(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices150(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *CreateOCOResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices131(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices150(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *CreateOCOResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices131(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices150(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices132(in *jlexer.Lexer, out *CreateMarginOCOResponse) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices151(in *jlexer.Lexer, out *CreateMarginOCOResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -17732,21 +20774,21 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices132(in *jlexer.Lexer
 					out.Orders = (out.Orders)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v142 *MarginOCOOrder
+					var v166 *MarginOCOOrder
 					if in.IsNull() {
 						in.Skip()
-						v142 = nil
+						v166 = nil
 					} else {
-						if v142 == nil {
-							v142 = new(MarginOCOOrder)
+						if v166 == nil {
+							v166 = new(MarginOCOOrder)
 						}
 						if in.IsNull() {
 							in.Skip()
 						} else {
-							(*v142).UnmarshalEasyJSON(in)
+							(*v166).UnmarshalEasyJSON(in)
 						}
 					}
-					out.Orders = append(out.Orders, v142)
+					out.Orders = append(out.Orders, v166)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -17767,21 +20809,21 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices132(in *jlexer.Lexer
 					out.OrderReports = (out.OrderReports)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v143 *MarginOCOOrderReport
+					var v167 *MarginOCOOrderReport
 					if in.IsNull() {
 						in.Skip()
-						v143 = nil
+						v167 = nil
 					} else {
-						if v143 == nil {
-							v143 = new(MarginOCOOrderReport)
+						if v167 == nil {
+							v167 = new(MarginOCOOrderReport)
 						}
 						if in.IsNull() {
 							in.Skip()
 						} else {
-							(*v143).UnmarshalEasyJSON(in)
+							(*v167).UnmarshalEasyJSON(in)
 						}
 					}
-					out.OrderReports = append(out.OrderReports, v143)
+					out.OrderReports = append(out.OrderReports, v167)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -17796,7 +20838,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices132(in *jlexer.Lexer
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices132(out *jwriter.Writer, in CreateMarginOCOResponse) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices151(out *jwriter.Writer, in CreateMarginOCOResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -17857,14 +20899,14 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices132(out *jwriter.Wri
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v144, v145 := range in.Orders {
-				if v144 > 0 {
+			for v168, v169 := range in.Orders {
+				if v168 > 0 {
 					out.RawByte(',')
 				}
-				if v145 == nil {
+				if v169 == nil {
 					out.RawString("null")
 				} else {
-					(*v145).MarshalEasyJSON(out)
+					(*v169).MarshalEasyJSON(out)
 				}
 			}
 			out.RawByte(']')
@@ -17877,14 +20919,14 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices132(out *jwriter.Wri
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v146, v147 := range in.OrderReports {
-				if v146 > 0 {
+			for v170, v171 := range in.OrderReports {
+				if v170 > 0 {
 					out.RawByte(',')
 				}
-				if v147 == nil {
+				if v171 == nil {
 					out.RawString("null")
 				} else {
-					(*v147).MarshalEasyJSON(out)
+					(*v171).MarshalEasyJSON(out)
 				}
 			}
 			out.RawByte(']')
@@ -17896,27 +20938,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices132(out *jwriter.Wri
 // MarshalJSON supports json.Marshaler interface
 func (v CreateMarginOCOResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices132(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices151(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v CreateMarginOCOResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices132(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices151(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *CreateMarginOCOResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices132(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices151(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *CreateMarginOCOResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices132(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices151(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices133(in *jlexer.Lexer, out *ConvertTradeHistoryItem) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices152(in *jlexer.Lexer, out *ConvertTradeHistoryItem) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -18000,7 +21042,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices133(in *jlexer.Lexer
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices133(out *jwriter.Writer, in ConvertTradeHistoryItem) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices152(out *jwriter.Writer, in ConvertTradeHistoryItem) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -18060,27 +21102,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices133(out *jwriter.Wri
 // MarshalJSON supports json.Marshaler interface
 func (v ConvertTradeHistoryItem) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices133(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices152(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v ConvertTradeHistoryItem) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices133(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices152(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *ConvertTradeHistoryItem) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices133(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices152(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *ConvertTradeHistoryItem) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices133(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices152(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices134(in *jlexer.Lexer, out *ConvertTradeHistory) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices153(in *jlexer.Lexer, out *ConvertTradeHistory) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -18110,13 +21152,13 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices134(in *jlexer.Lexer
 					out.List = (out.List)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v148 ConvertTradeHistoryItem
+					var v172 ConvertTradeHistoryItem
 					if in.IsNull() {
 						in.Skip()
 					} else {
-						(v148).UnmarshalEasyJSON(in)
+						(v172).UnmarshalEasyJSON(in)
 					}
-					out.List = append(out.List, v148)
+					out.List = append(out.List, v172)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -18155,7 +21197,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices134(in *jlexer.Lexer
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices134(out *jwriter.Writer, in ConvertTradeHistory) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices153(out *jwriter.Writer, in ConvertTradeHistory) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -18166,11 +21208,11 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices134(out *jwriter.Wri
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v149, v150 := range in.List {
-				if v149 > 0 {
+			for v173, v174 := range in.List {
+				if v173 > 0 {
 					out.RawByte(',')
 				}
-				(v150).MarshalEasyJSON(out)
+				(v174).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -18201,27 +21243,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices134(out *jwriter.Wri
 // MarshalJSON supports json.Marshaler interface
 func (v ConvertTradeHistory) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices134(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices153(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v ConvertTradeHistory) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices134(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices153(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *ConvertTradeHistory) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices134(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices153(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *ConvertTradeHistory) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices134(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices153(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices135(in *jlexer.Lexer, out *CommissionRates) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices154(in *jlexer.Lexer, out *CommissionRates) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -18269,7 +21311,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices135(in *jlexer.Lexer
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices135(out *jwriter.Writer, in CommissionRates) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices154(out *jwriter.Writer, in CommissionRates) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -18299,27 +21341,147 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices135(out *jwriter.Wri
 // MarshalJSON supports json.Marshaler interface
 func (v CommissionRates) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices135(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices154(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v CommissionRates) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices135(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices154(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *CommissionRates) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices135(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices154(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *CommissionRates) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices135(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices154(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices155(in *jlexer.Lexer, out *CommissionRateSubAccount) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "subAccountId":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.SubAccountId = int64(in.Int64())
+			}
+		case "symbol":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Symbol = string(in.String())
+			}
+		case "makerAdjustment":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.MakerAdjustment = int(in.Int())
+			}
+		case "takerAdjustment":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TakerAdjustment = int(in.Int())
+			}
+		case "makerCommission":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.MakerCommission = int(in.Int())
+			}
+		case "takerCommission":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TakerCommission = int(in.Int())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices155(out *jwriter.Writer, in CommissionRateSubAccount) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"subAccountId\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.SubAccountId))
+	}
+	{
+		const prefix string = ",\"symbol\":"
+		out.RawString(prefix)
+		out.String(string(in.Symbol))
+	}
+	{
+		const prefix string = ",\"makerAdjustment\":"
+		out.RawString(prefix)
+		out.Int(int(in.MakerAdjustment))
+	}
+	{
+		const prefix string = ",\"takerAdjustment\":"
+		out.RawString(prefix)
+		out.Int(int(in.TakerAdjustment))
+	}
+	{
+		const prefix string = ",\"makerCommission\":"
+		out.RawString(prefix)
+		out.Int(int(in.MakerCommission))
+	}
+	{
+		const prefix string = ",\"takerCommission\":"
+		out.RawString(prefix)
+		out.Int(int(in.TakerCommission))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CommissionRateSubAccount) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices155(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CommissionRateSubAccount) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices155(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CommissionRateSubAccount) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices155(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CommissionRateSubAccount) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices155(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices136(in *jlexer.Lexer, out *CommissionRateSubAccount) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices156(in *jlexer.Lexer, out *CommissionDiscount) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -18333,41 +21495,29 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices136(in *jlexer.Lexer
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "subAccountId":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.SubAccountId = int64(in.Int64())
-			}
-		case "symbol":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.Symbol = string(in.String())
-			}
-		case "makerAdjustment":
+		case "enabledForAccount":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.MakerAdjustment = int(in.Int())
+				out.EnabledForAccount = bool(in.Bool())
 			}
-		case "takerAdjustment":
+		case "enabledForSymbol":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.TakerAdjustment = int(in.Int())
+				out.EnabledForSymbol = bool(in.Bool())
 			}
-		case "makerCommission":
+		case "discountAsset":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.MakerCommission = int(in.Int())
+				out.DiscountAsset = string(in.String())
 			}
-		case "takerCommission":
+		case "discount":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.TakerCommission = int(in.Int())
+				out.Discount = string(in.String())
 			}
 		default:
 			in.SkipRecursive()
@@ -18379,67 +21529,57 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices136(in *jlexer.Lexer
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices136(out *jwriter.Writer, in CommissionRateSubAccount) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices156(out *jwriter.Writer, in CommissionDiscount) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"subAccountId\":"
+		const prefix string = ",\"enabledForAccount\":"
 		out.RawString(prefix[1:])
-		out.Int64(int64(in.SubAccountId))
-	}
-	{
-		const prefix string = ",\"symbol\":"
-		out.RawString(prefix)
-		out.String(string(in.Symbol))
-	}
-	{
-		const prefix string = ",\"makerAdjustment\":"
-		out.RawString(prefix)
-		out.Int(int(in.MakerAdjustment))
+		out.Bool(bool(in.EnabledForAccount))
 	}
 	{
-		const prefix string = ",\"takerAdjustment\":"
+		const prefix string = ",\"enabledForSymbol\":"
 		out.RawString(prefix)
-		out.Int(int(in.TakerAdjustment))
+		out.Bool(bool(in.EnabledForSymbol))
 	}
 	{
-		const prefix string = ",\"makerCommission\":"
+		const prefix string = ",\"discountAsset\":"
 		out.RawString(prefix)
-		out.Int(int(in.MakerCommission))
+		out.String(string(in.DiscountAsset))
 	}
 	{
-		const prefix string = ",\"takerCommission\":"
+		const prefix string = ",\"discount\":"
 		out.RawString(prefix)
-		out.Int(int(in.TakerCommission))
+		out.String(string(in.Discount))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v CommissionRateSubAccount) MarshalJSON() ([]byte, error) {
+func (v CommissionDiscount) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices136(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices156(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v CommissionRateSubAccount) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices136(w, v)
+func (v CommissionDiscount) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices156(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *CommissionRateSubAccount) UnmarshalJSON(data []byte) error {
+func (v *CommissionDiscount) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices136(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices156(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *CommissionRateSubAccount) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices136(l, v)
+func (v *CommissionDiscount) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices156(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices137(in *jlexer.Lexer, out *CoinInfo) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices157(in *jlexer.Lexer, out *CoinInfo) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -18523,13 +21663,13 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices137(in *jlexer.Lexer
 					out.NetworkList = (out.NetworkList)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v151 Network
+					var v175 Network
 					if in.IsNull() {
 						in.Skip()
 					} else {
-						(v151).UnmarshalEasyJSON(in)
+						(v175).UnmarshalEasyJSON(in)
 					}
-					out.NetworkList = append(out.NetworkList, v151)
+					out.NetworkList = append(out.NetworkList, v175)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -18568,7 +21708,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices137(in *jlexer.Lexer
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices137(out *jwriter.Writer, in CoinInfo) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices157(out *jwriter.Writer, in CoinInfo) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -18624,11 +21764,11 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices137(out *jwriter.Wri
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v152, v153 := range in.NetworkList {
-				if v152 > 0 {
+			for v176, v177 := range in.NetworkList {
+				if v176 > 0 {
 					out.RawByte(',')
 				}
-				(v153).MarshalEasyJSON(out)
+				(v177).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -18659,27 +21799,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices137(out *jwriter.Wri
 // MarshalJSON supports json.Marshaler interface
 func (v CoinInfo) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices137(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices157(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v CoinInfo) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices137(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices157(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *CoinInfo) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices137(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices157(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *CoinInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices137(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices157(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices138(in *jlexer.Lexer, out *ClaimedRewardHistory) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices158(in *jlexer.Lexer, out *ClaimedRewardHistory) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -18739,7 +21879,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices138(in *jlexer.Lexer
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices138(out *jwriter.Writer, in ClaimedRewardHistory) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices158(out *jwriter.Writer, in ClaimedRewardHistory) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -18779,27 +21919,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices138(out *jwriter.Wri
 // MarshalJSON supports json.Marshaler interface
 func (v ClaimedRewardHistory) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices138(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices158(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v ClaimedRewardHistory) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices138(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices158(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *ClaimedRewardHistory) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices138(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices158(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *ClaimedRewardHistory) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices138(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices158(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices139(in *jlexer.Lexer, out *ClaimRewardResponse) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices159(in *jlexer.Lexer, out *ClaimRewardResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -18829,7 +21969,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices139(in *jlexer.Lexer
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices139(out *jwriter.Writer, in ClaimRewardResponse) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices159(out *jwriter.Writer, in ClaimRewardResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -18844,27 +21984,149 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices139(out *jwriter.Wri
 // MarshalJSON supports json.Marshaler interface
 func (v ClaimRewardResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices139(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices159(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v ClaimRewardResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices139(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices159(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *ClaimRewardResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices139(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices159(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *ClaimRewardResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices139(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices159(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices160(in *jlexer.Lexer, out *CancelReplaceOrderResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "cancelResult":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.CancelResult = CancelReplaceResultType(in.String())
+			}
+		case "newOrderResult":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.NewOrderResult = CancelReplaceResultType(in.String())
+			}
+		case "cancelResponse":
+			if in.IsNull() {
+				in.Skip()
+				out.CancelResponse = nil
+			} else {
+				if out.CancelResponse == nil {
+					out.CancelResponse = new(CancelOrderResponse)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.CancelResponse).UnmarshalEasyJSON(in)
+				}
+			}
+		case "newOrderResponse":
+			if in.IsNull() {
+				in.Skip()
+				out.NewOrderResponse = nil
+			} else {
+				if out.NewOrderResponse == nil {
+					out.NewOrderResponse = new(CreateOrderResponse)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.NewOrderResponse).UnmarshalEasyJSON(in)
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices160(out *jwriter.Writer, in CancelReplaceOrderResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"cancelResult\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.CancelResult))
+	}
+	{
+		const prefix string = ",\"newOrderResult\":"
+		out.RawString(prefix)
+		out.String(string(in.NewOrderResult))
+	}
+	{
+		const prefix string = ",\"cancelResponse\":"
+		out.RawString(prefix)
+		if in.CancelResponse == nil {
+			out.RawString("null")
+		} else {
+			(*in.CancelResponse).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"newOrderResponse\":"
+		out.RawString(prefix)
+		if in.NewOrderResponse == nil {
+			out.RawString("null")
+		} else {
+			(*in.NewOrderResponse).MarshalEasyJSON(out)
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CancelReplaceOrderResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices160(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CancelReplaceOrderResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices160(w, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices140(in *jlexer.Lexer, out *CancelOrderResponse) {
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CancelReplaceOrderResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices160(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CancelReplaceOrderResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices160(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices161(in *jlexer.Lexer, out *CancelOrderResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -18972,7 +22234,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices140(in *jlexer.Lexer
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices140(out *jwriter.Writer, in CancelOrderResponse) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices161(out *jwriter.Writer, in CancelOrderResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -19052,27 +22314,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices140(out *jwriter.Wri
 // MarshalJSON supports json.Marshaler interface
 func (v CancelOrderResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices140(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices161(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v CancelOrderResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices140(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices161(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *CancelOrderResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices140(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices161(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *CancelOrderResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices140(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices161(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices141(in *jlexer.Lexer, out *CancelOpenOrdersResponse) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices162(in *jlexer.Lexer, out *CancelOpenOrdersResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -19102,21 +22364,21 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices141(in *jlexer.Lexer
 					out.Orders = (out.Orders)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v154 *CancelOrderResponse
+					var v178 *CancelOrderResponse
 					if in.IsNull() {
 						in.Skip()
-						v154 = nil
+						v178 = nil
 					} else {
-						if v154 == nil {
-							v154 = new(CancelOrderResponse)
+						if v178 == nil {
+							v178 = new(CancelOrderResponse)
 						}
 						if in.IsNull() {
 							in.Skip()
 						} else {
-							(*v154).UnmarshalEasyJSON(in)
+							(*v178).UnmarshalEasyJSON(in)
 						}
 					}
-					out.Orders = append(out.Orders, v154)
+					out.Orders = append(out.Orders, v178)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -19137,21 +22399,21 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices141(in *jlexer.Lexer
 					out.OCOOrders = (out.OCOOrders)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v155 *CancelOCOResponse
+					var v179 *CancelOCOResponse
 					if in.IsNull() {
 						in.Skip()
-						v155 = nil
+						v179 = nil
 					} else {
-						if v155 == nil {
-							v155 = new(CancelOCOResponse)
+						if v179 == nil {
+							v179 = new(CancelOCOResponse)
 						}
 						if in.IsNull() {
 							in.Skip()
 						} else {
-							(*v155).UnmarshalEasyJSON(in)
+							(*v179).UnmarshalEasyJSON(in)
 						}
 					}
-					out.OCOOrders = append(out.OCOOrders, v155)
+					out.OCOOrders = append(out.OCOOrders, v179)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -19166,7 +22428,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices141(in *jlexer.Lexer
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices141(out *jwriter.Writer, in CancelOpenOrdersResponse) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices162(out *jwriter.Writer, in CancelOpenOrdersResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -19177,14 +22439,14 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices141(out *jwriter.Wri
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v156, v157 := range in.Orders {
-				if v156 > 0 {
+			for v180, v181 := range in.Orders {
+				if v180 > 0 {
 					out.RawByte(',')
 				}
-				if v157 == nil {
+				if v181 == nil {
 					out.RawString("null")
 				} else {
-					(*v157).MarshalEasyJSON(out)
+					(*v181).MarshalEasyJSON(out)
 				}
 			}
 			out.RawByte(']')
@@ -19197,14 +22459,14 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices141(out *jwriter.Wri
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v158, v159 := range in.OCOOrders {
-				if v158 > 0 {
+			for v182, v183 := range in.OCOOrders {
+				if v182 > 0 {
 					out.RawByte(',')
 				}
-				if v159 == nil {
+				if v183 == nil {
 					out.RawString("null")
 				} else {
-					(*v159).MarshalEasyJSON(out)
+					(*v183).MarshalEasyJSON(out)
 				}
 			}
 			out.RawByte(']')
@@ -19216,27 +22478,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices141(out *jwriter.Wri
 // MarshalJSON supports json.Marshaler interface
 func (v CancelOpenOrdersResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices141(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices162(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v CancelOpenOrdersResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices141(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices162(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *CancelOpenOrdersResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices141(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices162(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *CancelOpenOrdersResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices141(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices162(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices142(in *jlexer.Lexer, out *CancelOCOResponse) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices163(in *jlexer.Lexer, out *CancelOCOResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -19308,21 +22570,21 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices142(in *jlexer.Lexer
 					out.Orders = (out.Orders)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v160 *OCOOrder
+					var v184 *OCOOrder
 					if in.IsNull() {
 						in.Skip()
-						v160 = nil
+						v184 = nil
 					} else {
-						if v160 == nil {
-							v160 = new(OCOOrder)
+						if v184 == nil {
+							v184 = new(OCOOrder)
 						}
 						if in.IsNull() {
 							in.Skip()
 						} else {
-							(*v160).UnmarshalEasyJSON(in)
+							(*v184).UnmarshalEasyJSON(in)
 						}
 					}
-					out.Orders = append(out.Orders, v160)
+					out.Orders = append(out.Orders, v184)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -19343,21 +22605,21 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices142(in *jlexer.Lexer
 					out.OrderReports = (out.OrderReports)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v161 *OCOOrderReport
+					var v185 *OCOOrderReport
 					if in.IsNull() {
 						in.Skip()
-						v161 = nil
+						v185 = nil
 					} else {
-						if v161 == nil {
-							v161 = new(OCOOrderReport)
+						if v185 == nil {
+							v185 = new(OCOOrderReport)
 						}
 						if in.IsNull() {
 							in.Skip()
 						} else {
-							(*v161).UnmarshalEasyJSON(in)
+							(*v185).UnmarshalEasyJSON(in)
 						}
 					}
-					out.OrderReports = append(out.OrderReports, v161)
+					out.OrderReports = append(out.OrderReports, v185)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -19372,7 +22634,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices142(in *jlexer.Lexer
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices142(out *jwriter.Writer, in CancelOCOResponse) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices163(out *jwriter.Writer, in CancelOCOResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -19418,14 +22680,14 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices142(out *jwriter.Wri
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v162, v163 := range in.Orders {
-				if v162 > 0 {
+			for v186, v187 := range in.Orders {
+				if v186 > 0 {
 					out.RawByte(',')
 				}
-				if v163 == nil {
+				if v187 == nil {
 					out.RawString("null")
 				} else {
-					(*v163).MarshalEasyJSON(out)
+					(*v187).MarshalEasyJSON(out)
 				}
 			}
 			out.RawByte(']')
@@ -19438,14 +22700,14 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices142(out *jwriter.Wri
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v164, v165 := range in.OrderReports {
-				if v164 > 0 {
+			for v188, v189 := range in.OrderReports {
+				if v188 > 0 {
 					out.RawByte(',')
 				}
-				if v165 == nil {
+				if v189 == nil {
 					out.RawString("null")
 				} else {
-					(*v165).MarshalEasyJSON(out)
+					(*v189).MarshalEasyJSON(out)
 				}
 			}
 			out.RawByte(']')
@@ -19457,27 +22719,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices142(out *jwriter.Wri
 // MarshalJSON supports json.Marshaler interface
 func (v CancelOCOResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices142(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices163(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v CancelOCOResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices142(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices163(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *CancelOCOResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices142(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices163(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *CancelOCOResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices142(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices163(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices143(in *jlexer.Lexer, out *CancelMarginOrderResponse) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices164(in *jlexer.Lexer, out *CancelMarginOrderResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -19579,7 +22841,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices143(in *jlexer.Lexer
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices143(out *jwriter.Writer, in CancelMarginOrderResponse) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices164(out *jwriter.Writer, in CancelMarginOrderResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -19654,27 +22916,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices143(out *jwriter.Wri
 // MarshalJSON supports json.Marshaler interface
 func (v CancelMarginOrderResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices143(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices164(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v CancelMarginOrderResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices143(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices164(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *CancelMarginOrderResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices143(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices164(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *CancelMarginOrderResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices143(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices164(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices144(in *jlexer.Lexer, out *CancelMarginOCOResponse) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices165(in *jlexer.Lexer, out *CancelMarginOCOResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -19752,21 +23014,21 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices144(in *jlexer.Lexer
 					out.Orders = (out.Orders)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v166 *MarginOCOOrder
+					var v190 *MarginOCOOrder
 					if in.IsNull() {
 						in.Skip()
-						v166 = nil
+						v190 = nil
 					} else {
-						if v166 == nil {
-							v166 = new(MarginOCOOrder)
+						if v190 == nil {
+							v190 = new(MarginOCOOrder)
 						}
 						if in.IsNull() {
 							in.Skip()
 						} else {
-							(*v166).UnmarshalEasyJSON(in)
+							(*v190).UnmarshalEasyJSON(in)
 						}
 					}
-					out.Orders = append(out.Orders, v166)
+					out.Orders = append(out.Orders, v190)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -19787,21 +23049,21 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices144(in *jlexer.Lexer
 					out.OrderReports = (out.OrderReports)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v167 *MarginOCOOrderReport
+					var v191 *MarginOCOOrderReport
 					if in.IsNull() {
 						in.Skip()
-						v167 = nil
+						v191 = nil
 					} else {
-						if v167 == nil {
-							v167 = new(MarginOCOOrderReport)
+						if v191 == nil {
+							v191 = new(MarginOCOOrderReport)
 						}
 						if in.IsNull() {
 							in.Skip()
 						} else {
-							(*v167).UnmarshalEasyJSON(in)
+							(*v191).UnmarshalEasyJSON(in)
 						}
 					}
-					out.OrderReports = append(out.OrderReports, v167)
+					out.OrderReports = append(out.OrderReports, v191)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -19816,7 +23078,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices144(in *jlexer.Lexer
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices144(out *jwriter.Writer, in CancelMarginOCOResponse) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices165(out *jwriter.Writer, in CancelMarginOCOResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -19867,14 +23129,14 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices144(out *jwriter.Wri
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v168, v169 := range in.Orders {
-				if v168 > 0 {
+			for v192, v193 := range in.Orders {
+				if v192 > 0 {
 					out.RawByte(',')
 				}
-				if v169 == nil {
+				if v193 == nil {
 					out.RawString("null")
 				} else {
-					(*v169).MarshalEasyJSON(out)
+					(*v193).MarshalEasyJSON(out)
 				}
 			}
 			out.RawByte(']')
@@ -19887,14 +23149,14 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices144(out *jwriter.Wri
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v170, v171 := range in.OrderReports {
-				if v170 > 0 {
+			for v194, v195 := range in.OrderReports {
+				if v194 > 0 {
 					out.RawByte(',')
 				}
-				if v171 == nil {
+				if v195 == nil {
 					out.RawString("null")
 				} else {
-					(*v171).MarshalEasyJSON(out)
+					(*v195).MarshalEasyJSON(out)
 				}
 			}
 			out.RawByte(']')
@@ -19906,27 +23168,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices144(out *jwriter.Wri
 // MarshalJSON supports json.Marshaler interface
 func (v CancelMarginOCOResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices144(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices165(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v CancelMarginOCOResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices144(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices165(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *CancelMarginOCOResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices144(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices165(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *CancelMarginOCOResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices144(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices165(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices145(in *jlexer.Lexer, out *C2CTradeHistory) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices166(in *jlexer.Lexer, out *C2CTradeHistory) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -19968,13 +23230,13 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices145(in *jlexer.Lexer
 					out.Data = (out.Data)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v172 C2CRecord
+					var v196 C2CRecord
 					if in.IsNull() {
 						in.Skip()
 					} else {
-						(v172).UnmarshalEasyJSON(in)
+						(v196).UnmarshalEasyJSON(in)
 					}
-					out.Data = append(out.Data, v172)
+					out.Data = append(out.Data, v196)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -20001,7 +23263,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices145(in *jlexer.Lexer
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices145(out *jwriter.Writer, in C2CTradeHistory) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices166(out *jwriter.Writer, in C2CTradeHistory) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -20022,11 +23284,11 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices145(out *jwriter.Wri
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v173, v174 := range in.Data {
-				if v173 > 0 {
+			for v197, v198 := range in.Data {
+				if v197 > 0 {
 					out.RawByte(',')
 				}
-				(v174).MarshalEasyJSON(out)
+				(v198).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -20047,27 +23309,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices145(out *jwriter.Wri
 // MarshalJSON supports json.Marshaler interface
 func (v C2CTradeHistory) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices145(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices166(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v C2CTradeHistory) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices145(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices166(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *C2CTradeHistory) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices145(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices166(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *C2CTradeHistory) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices145(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices166(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices146(in *jlexer.Lexer, out *C2CRecord) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices167(in *jlexer.Lexer, out *C2CRecord) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -20175,7 +23437,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices146(in *jlexer.Lexer
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices146(out *jwriter.Writer, in C2CRecord) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices167(out *jwriter.Writer, in C2CRecord) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -20255,27 +23517,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices146(out *jwriter.Wri
 // MarshalJSON supports json.Marshaler interface
 func (v C2CRecord) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices146(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices167(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v C2CRecord) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices146(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices167(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *C2CRecord) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices146(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices167(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *C2CRecord) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices146(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices167(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices147(in *jlexer.Lexer, out *BrokerCommissionRebateResponse) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices168(in *jlexer.Lexer, out *BrokerCommissionRebateResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -20343,7 +23605,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices147(in *jlexer.Lexer
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices147(out *jwriter.Writer, in BrokerCommissionRebateResponse) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices168(out *jwriter.Writer, in BrokerCommissionRebateResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -20388,27 +23650,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices147(out *jwriter.Wri
 // MarshalJSON supports json.Marshaler interface
 func (v BrokerCommissionRebateResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices147(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices168(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v BrokerCommissionRebateResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices147(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices168(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *BrokerCommissionRebateResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices147(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices168(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *BrokerCommissionRebateResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices147(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices168(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices148(in *jlexer.Lexer, out *BookTicker) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices169(in *jlexer.Lexer, out *BookTicker) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -20462,7 +23724,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices148(in *jlexer.Lexer
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices148(out *jwriter.Writer, in BookTicker) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices169(out *jwriter.Writer, in BookTicker) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -20497,27 +23759,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices148(out *jwriter.Wri
 // MarshalJSON supports json.Marshaler interface
 func (v BookTicker) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices148(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices169(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v BookTicker) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices148(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices169(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *BookTicker) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices148(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices169(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *BookTicker) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices148(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices169(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices149(in *jlexer.Lexer, out *Balance) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices170(in *jlexer.Lexer, out *Balance) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -20547,7 +23809,274 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices149(in *jlexer.Lexer
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Locked = string(in.String())
+				out.Locked = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices170(out *jwriter.Writer, in Balance) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"asset\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Asset))
+	}
+	{
+		const prefix string = ",\"free\":"
+		out.RawString(prefix)
+		out.String(string(in.Free))
+	}
+	{
+		const prefix string = ",\"locked\":"
+		out.RawString(prefix)
+		out.String(string(in.Locked))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Balance) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices170(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Balance) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices170(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Balance) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices170(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Balance) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices170(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices171(in *jlexer.Lexer, out *BNBBurn) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "spotBNBBurn":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.SpotBNBBurn = bool(in.Bool())
+			}
+		case "interestBNBBurn":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.InterestBNBBurn = bool(in.Bool())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices171(out *jwriter.Writer, in BNBBurn) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"spotBNBBurn\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.SpotBNBBurn))
+	}
+	{
+		const prefix string = ",\"interestBNBBurn\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.InterestBNBBurn))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v BNBBurn) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices171(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v BNBBurn) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices171(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *BNBBurn) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices171(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *BNBBurn) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices171(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices172(in *jlexer.Lexer, out *AvgPrice) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "mins":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Mins = int64(in.Int64())
+			}
+		case "price":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Price = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices172(out *jwriter.Writer, in AvgPrice) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"mins\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.Mins))
+	}
+	{
+		const prefix string = ",\"price\":"
+		out.RawString(prefix)
+		out.String(string(in.Price))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AvgPrice) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices172(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v AvgPrice) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices172(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AvgPrice) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices172(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *AvgPrice) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices172(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices173(in *jlexer.Lexer, out *AssetFundingResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "asset":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Asset = string(in.String())
+			}
+		case "free":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.Free).UnmarshalJSON(data))
+				}
+			}
+		case "locked":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.Locked).UnmarshalJSON(data))
+				}
+			}
+		case "freeze":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.Freeze).UnmarshalJSON(data))
+				}
+			}
+		case "withdrawing":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.Withdrawing).UnmarshalJSON(data))
+				}
+			}
+		case "btcValuation":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.BtcValuation).UnmarshalJSON(data))
+				}
 			}
 		default:
 			in.SkipRecursive()
@@ -20559,7 +24088,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices149(in *jlexer.Lexer
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices149(out *jwriter.Writer, in Balance) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices173(out *jwriter.Writer, in AssetFundingResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -20571,40 +24100,55 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices149(out *jwriter.Wri
 	{
 		const prefix string = ",\"free\":"
 		out.RawString(prefix)
-		out.String(string(in.Free))
+		out.Raw((in.Free).MarshalJSON())
 	}
 	{
 		const prefix string = ",\"locked\":"
 		out.RawString(prefix)
-		out.String(string(in.Locked))
+		out.Raw((in.Locked).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"freeze\":"
+		out.RawString(prefix)
+		out.Raw((in.Freeze).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"withdrawing\":"
+		out.RawString(prefix)
+		out.Raw((in.Withdrawing).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"btcValuation\":"
+		out.RawString(prefix)
+		out.Raw((in.BtcValuation).MarshalJSON())
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Balance) MarshalJSON() ([]byte, error) {
+func (v AssetFundingResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices149(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices173(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Balance) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices149(w, v)
+func (v AssetFundingResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices173(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Balance) UnmarshalJSON(data []byte) error {
+func (v *AssetFundingResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices149(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices173(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Balance) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices149(l, v)
+func (v *AssetFundingResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices173(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices150(in *jlexer.Lexer, out *BNBBurn) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices174(in *jlexer.Lexer, out *AssetDetail) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -20618,17 +24162,35 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices150(in *jlexer.Lexer
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "spotBNBBurn":
+		case "minWithdrawAmount":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.SpotBNBBurn = bool(in.Bool())
+				out.MinWithdrawAmount = string(in.String())
 			}
-		case "interestBNBBurn":
+		case "depositStatus":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.InterestBNBBurn = bool(in.Bool())
+				out.DepositStatus = bool(in.Bool())
+			}
+		case "withdrawFee":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.WithdrawFee = string(in.String())
+			}
+		case "withdrawStatus":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.WithdrawStatus = bool(in.Bool())
+			}
+		case "depositTip":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.DepositTip = string(in.String())
 			}
 		default:
 			in.SkipRecursive()
@@ -20640,47 +24202,62 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices150(in *jlexer.Lexer
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices150(out *jwriter.Writer, in BNBBurn) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices174(out *jwriter.Writer, in AssetDetail) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"spotBNBBurn\":"
+		const prefix string = ",\"minWithdrawAmount\":"
 		out.RawString(prefix[1:])
-		out.Bool(bool(in.SpotBNBBurn))
+		out.String(string(in.MinWithdrawAmount))
 	}
 	{
-		const prefix string = ",\"interestBNBBurn\":"
+		const prefix string = ",\"depositStatus\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.InterestBNBBurn))
+		out.Bool(bool(in.DepositStatus))
+	}
+	{
+		const prefix string = ",\"withdrawFee\":"
+		out.RawString(prefix)
+		out.String(string(in.WithdrawFee))
+	}
+	{
+		const prefix string = ",\"withdrawStatus\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.WithdrawStatus))
+	}
+	{
+		const prefix string = ",\"depositTip\":"
+		out.RawString(prefix)
+		out.String(string(in.DepositTip))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v BNBBurn) MarshalJSON() ([]byte, error) {
+func (v AssetDetail) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices150(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices174(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v BNBBurn) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices150(w, v)
+func (v AssetDetail) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices174(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *BNBBurn) UnmarshalJSON(data []byte) error {
+func (v *AssetDetail) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices150(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices174(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *BNBBurn) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices150(l, v)
+func (v *AssetDetail) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices174(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices151(in *jlexer.Lexer, out *AvgPrice) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices175(in *jlexer.Lexer, out *AssetBalance) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -20694,17 +24271,23 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices151(in *jlexer.Lexer
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "mins":
+		case "asset":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Mins = int64(in.Int64())
+				out.Asset = string(in.String())
 			}
-		case "price":
+		case "free":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Price = string(in.String())
+				out.Free = float64(in.Float64())
+			}
+		case "locked":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Locked = float64(in.Float64())
 			}
 		default:
 			in.SkipRecursive()
@@ -20716,47 +24299,52 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices151(in *jlexer.Lexer
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices151(out *jwriter.Writer, in AvgPrice) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices175(out *jwriter.Writer, in AssetBalance) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"mins\":"
+		const prefix string = ",\"asset\":"
 		out.RawString(prefix[1:])
-		out.Int64(int64(in.Mins))
+		out.String(string(in.Asset))
 	}
 	{
-		const prefix string = ",\"price\":"
+		const prefix string = ",\"free\":"
 		out.RawString(prefix)
-		out.String(string(in.Price))
+		out.Float64(float64(in.Free))
+	}
+	{
+		const prefix string = ",\"locked\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Locked))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v AvgPrice) MarshalJSON() ([]byte, error) {
+func (v AssetBalance) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices151(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices175(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v AvgPrice) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices151(w, v)
+func (v AssetBalance) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices175(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *AvgPrice) UnmarshalJSON(data []byte) error {
+func (v *AssetBalance) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices151(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices175(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *AvgPrice) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices151(l, v)
+func (v *AssetBalance) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices175(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices152(in *jlexer.Lexer, out *AssetFundingResponse) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices176(in *jlexer.Lexer, out *AmendedOrder) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -20770,123 +24358,234 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices152(in *jlexer.Lexer
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "asset":
+		case "symbol":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Asset = string(in.String())
+				out.Symbol = string(in.String())
 			}
-		case "free":
+		case "orderId":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				if data := in.Raw(); in.Ok() {
-					in.AddError((out.Free).UnmarshalJSON(data))
-				}
+				out.OrderID = int64(in.Int64())
 			}
-		case "locked":
+		case "orderListId":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				if data := in.Raw(); in.Ok() {
-					in.AddError((out.Locked).UnmarshalJSON(data))
-				}
+				out.OrderListID = int64(in.Int64())
 			}
-		case "freeze":
+		case "origClientOrderId":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				if data := in.Raw(); in.Ok() {
-					in.AddError((out.Freeze).UnmarshalJSON(data))
-				}
+				out.OrigClientOrderID = string(in.String())
 			}
-		case "withdrawing":
+		case "clientOrderId":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				if data := in.Raw(); in.Ok() {
-					in.AddError((out.Withdrawing).UnmarshalJSON(data))
-				}
+				out.ClientOrderID = string(in.String())
 			}
-		case "btcValuation":
+		case "price":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				if data := in.Raw(); in.Ok() {
-					in.AddError((out.BtcValuation).UnmarshalJSON(data))
-				}
+				out.Price = string(in.String())
+			}
+		case "qty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Quantity = string(in.String())
+			}
+		case "executedQty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ExecutedQuantity = string(in.String())
+			}
+		case "preventedQty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.PreventedQuantity = string(in.String())
+			}
+		case "quoteOrderQty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.QuoteOrderQuantity = string(in.String())
+			}
+		case "cumulativeQuoteQty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.CumulativeQuoteQuantity = string(in.String())
+			}
+		case "status":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Status = OrderStatusType(in.String())
+			}
+		case "timeInForce":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TimeInForce = TimeInForceType(in.String())
+			}
+		case "type":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Type = OrderType(in.String())
+			}
+		case "side":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Side = SideType(in.String())
+			}
+		case "workingTime":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.WorkingTime = int64(in.Int64())
+			}
+		case "selfTradePreventionMode":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.SelfTradePreventionMode = string(in.String())
 			}
 		default:
 			in.SkipRecursive()
 		}
 		in.WantComma()
 	}
-	in.Delim('}')
-	if isTopLevel {
-		in.Consumed()
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices176(out *jwriter.Writer, in AmendedOrder) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"symbol\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Symbol))
+	}
+	{
+		const prefix string = ",\"orderId\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.OrderID))
+	}
+	{
+		const prefix string = ",\"orderListId\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.OrderListID))
+	}
+	{
+		const prefix string = ",\"origClientOrderId\":"
+		out.RawString(prefix)
+		out.String(string(in.OrigClientOrderID))
+	}
+	{
+		const prefix string = ",\"clientOrderId\":"
+		out.RawString(prefix)
+		out.String(string(in.ClientOrderID))
+	}
+	{
+		const prefix string = ",\"price\":"
+		out.RawString(prefix)
+		out.String(string(in.Price))
 	}
-}
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices152(out *jwriter.Writer, in AssetFundingResponse) {
-	out.RawByte('{')
-	first := true
-	_ = first
 	{
-		const prefix string = ",\"asset\":"
-		out.RawString(prefix[1:])
-		out.String(string(in.Asset))
+		const prefix string = ",\"qty\":"
+		out.RawString(prefix)
+		out.String(string(in.Quantity))
 	}
 	{
-		const prefix string = ",\"free\":"
+		const prefix string = ",\"executedQty\":"
 		out.RawString(prefix)
-		out.Raw((in.Free).MarshalJSON())
+		out.String(string(in.ExecutedQuantity))
 	}
 	{
-		const prefix string = ",\"locked\":"
+		const prefix string = ",\"preventedQty\":"
 		out.RawString(prefix)
-		out.Raw((in.Locked).MarshalJSON())
+		out.String(string(in.PreventedQuantity))
 	}
 	{
-		const prefix string = ",\"freeze\":"
+		const prefix string = ",\"quoteOrderQty\":"
 		out.RawString(prefix)
-		out.Raw((in.Freeze).MarshalJSON())
+		out.String(string(in.QuoteOrderQuantity))
 	}
 	{
-		const prefix string = ",\"withdrawing\":"
+		const prefix string = ",\"cumulativeQuoteQty\":"
 		out.RawString(prefix)
-		out.Raw((in.Withdrawing).MarshalJSON())
+		out.String(string(in.CumulativeQuoteQuantity))
 	}
 	{
-		const prefix string = ",\"btcValuation\":"
+		const prefix string = ",\"status\":"
 		out.RawString(prefix)
-		out.Raw((in.BtcValuation).MarshalJSON())
+		out.String(string(in.Status))
+	}
+	{
+		const prefix string = ",\"timeInForce\":"
+		out.RawString(prefix)
+		out.String(string(in.TimeInForce))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"side\":"
+		out.RawString(prefix)
+		out.String(string(in.Side))
+	}
+	{
+		const prefix string = ",\"workingTime\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.WorkingTime))
+	}
+	{
+		const prefix string = ",\"selfTradePreventionMode\":"
+		out.RawString(prefix)
+		out.String(string(in.SelfTradePreventionMode))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v AssetFundingResponse) MarshalJSON() ([]byte, error) {
+func (v AmendedOrder) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices152(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices176(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v AssetFundingResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices152(w, v)
+func (v AmendedOrder) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices176(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *AssetFundingResponse) UnmarshalJSON(data []byte) error {
+func (v *AmendedOrder) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices152(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices176(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *AssetFundingResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices152(l, v)
+func (v *AmendedOrder) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices176(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices153(in *jlexer.Lexer, out *AssetDetail) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices177(in *jlexer.Lexer, out *AmendOrderResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -20900,35 +24599,45 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices153(in *jlexer.Lexer
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "minWithdrawAmount":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.MinWithdrawAmount = string(in.String())
-			}
-		case "depositStatus":
+		case "transactTime":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.DepositStatus = bool(in.Bool())
+				out.TransactTime = int64(in.Int64())
 			}
-		case "withdrawFee":
+		case "executionId":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.WithdrawFee = string(in.String())
+				out.ExecutionID = int64(in.Int64())
 			}
-		case "withdrawStatus":
+		case "amendedOrder":
 			if in.IsNull() {
 				in.Skip()
+				out.AmendedOrder = nil
 			} else {
-				out.WithdrawStatus = bool(in.Bool())
+				if out.AmendedOrder == nil {
+					out.AmendedOrder = new(AmendedOrder)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.AmendedOrder).UnmarshalEasyJSON(in)
+				}
 			}
-		case "depositTip":
+		case "listStatus":
 			if in.IsNull() {
 				in.Skip()
+				out.ListStatus = nil
 			} else {
-				out.DepositTip = string(in.String())
+				if out.ListStatus == nil {
+					out.ListStatus = new(AmendOrderListStatus)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.ListStatus).UnmarshalEasyJSON(in)
+				}
 			}
 		default:
 			in.SkipRecursive()
@@ -20940,62 +24649,61 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices153(in *jlexer.Lexer
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices153(out *jwriter.Writer, in AssetDetail) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices177(out *jwriter.Writer, in AmendOrderResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"minWithdrawAmount\":"
+		const prefix string = ",\"transactTime\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.MinWithdrawAmount))
-	}
-	{
-		const prefix string = ",\"depositStatus\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.DepositStatus))
+		out.Int64(int64(in.TransactTime))
 	}
 	{
-		const prefix string = ",\"withdrawFee\":"
+		const prefix string = ",\"executionId\":"
 		out.RawString(prefix)
-		out.String(string(in.WithdrawFee))
+		out.Int64(int64(in.ExecutionID))
 	}
 	{
-		const prefix string = ",\"withdrawStatus\":"
+		const prefix string = ",\"amendedOrder\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.WithdrawStatus))
+		if in.AmendedOrder == nil {
+			out.RawString("null")
+		} else {
+			(*in.AmendedOrder).MarshalEasyJSON(out)
+		}
 	}
-	{
-		const prefix string = ",\"depositTip\":"
+	if in.ListStatus != nil {
+		const prefix string = ",\"listStatus\":"
 		out.RawString(prefix)
-		out.String(string(in.DepositTip))
+		(*in.ListStatus).MarshalEasyJSON(out)
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v AssetDetail) MarshalJSON() ([]byte, error) {
+func (v AmendOrderResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices153(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices177(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v AssetDetail) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices153(w, v)
+func (v AmendOrderResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices177(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *AssetDetail) UnmarshalJSON(data []byte) error {
+func (v *AmendOrderResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices153(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices177(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *AssetDetail) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices153(l, v)
+func (v *AmendOrderResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices177(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices154(in *jlexer.Lexer, out *AssetBalance) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices178(in *jlexer.Lexer, out *AmendOrderListStatus) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -21009,23 +24717,70 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices154(in *jlexer.Lexer
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "asset":
+		case "orderListId":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Asset = string(in.String())
+				out.OrderListID = int64(in.Int64())
 			}
-		case "free":
+		case "contingencyType":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Free = float64(in.Float64())
+				out.ContingencyType = string(in.String())
 			}
-		case "locked":
+		case "listOrderStatus":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Locked = float64(in.Float64())
+				out.ListOrderStatus = string(in.String())
+			}
+		case "listClientOrderId":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ListClientOrderID = string(in.String())
+			}
+		case "symbol":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Symbol = string(in.String())
+			}
+		case "orders":
+			if in.IsNull() {
+				in.Skip()
+				out.Orders = nil
+			} else {
+				in.Delim('[')
+				if out.Orders == nil {
+					if !in.IsDelim(']') {
+						out.Orders = make([]*OCOOrder, 0, 8)
+					} else {
+						out.Orders = []*OCOOrder{}
+					}
+				} else {
+					out.Orders = (out.Orders)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v199 *OCOOrder
+					if in.IsNull() {
+						in.Skip()
+						v199 = nil
+					} else {
+						if v199 == nil {
+							v199 = new(OCOOrder)
+						}
+						if in.IsNull() {
+							in.Skip()
+						} else {
+							(*v199).UnmarshalEasyJSON(in)
+						}
+					}
+					out.Orders = append(out.Orders, v199)
+					in.WantComma()
+				}
+				in.Delim(']')
 			}
 		default:
 			in.SkipRecursive()
@@ -21037,52 +24792,82 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices154(in *jlexer.Lexer
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices154(out *jwriter.Writer, in AssetBalance) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices178(out *jwriter.Writer, in AmendOrderListStatus) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"asset\":"
+		const prefix string = ",\"orderListId\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Asset))
+		out.Int64(int64(in.OrderListID))
 	}
 	{
-		const prefix string = ",\"free\":"
+		const prefix string = ",\"contingencyType\":"
 		out.RawString(prefix)
-		out.Float64(float64(in.Free))
+		out.String(string(in.ContingencyType))
 	}
 	{
-		const prefix string = ",\"locked\":"
+		const prefix string = ",\"listOrderStatus\":"
 		out.RawString(prefix)
-		out.Float64(float64(in.Locked))
+		out.String(string(in.ListOrderStatus))
+	}
+	{
+		const prefix string = ",\"listClientOrderId\":"
+		out.RawString(prefix)
+		out.String(string(in.ListClientOrderID))
+	}
+	{
+		const prefix string = ",\"symbol\":"
+		out.RawString(prefix)
+		out.String(string(in.Symbol))
+	}
+	{
+		const prefix string = ",\"orders\":"
+		out.RawString(prefix)
+		if in.Orders == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v200, v201 := range in.Orders {
+				if v200 > 0 {
+					out.RawByte(',')
+				}
+				if v201 == nil {
+					out.RawString("null")
+				} else {
+					(*v201).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v AssetBalance) MarshalJSON() ([]byte, error) {
+func (v AmendOrderListStatus) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices154(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices178(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v AssetBalance) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices154(w, v)
+func (v AmendOrderListStatus) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices178(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *AssetBalance) UnmarshalJSON(data []byte) error {
+func (v *AmendOrderListStatus) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices154(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices178(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *AssetBalance) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices154(l, v)
+func (v *AmendOrderListStatus) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices178(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices155(in *jlexer.Lexer, out *AggTrade) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices179(in *jlexer.Lexer, out *AggTrade) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -21154,7 +24939,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices155(in *jlexer.Lexer
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices155(out *jwriter.Writer, in AggTrade) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices179(out *jwriter.Writer, in AggTrade) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -21204,27 +24989,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices155(out *jwriter.Wri
 // MarshalJSON supports json.Marshaler interface
 func (v AggTrade) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices155(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices179(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v AggTrade) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices155(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices179(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *AggTrade) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices155(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices179(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *AggTrade) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices155(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices179(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices156(in *jlexer.Lexer, out *AddLiquidityResponse) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices180(in *jlexer.Lexer, out *AddLiquidityResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -21254,7 +25039,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices156(in *jlexer.Lexer
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices156(out *jwriter.Writer, in AddLiquidityResponse) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices180(out *jwriter.Writer, in AddLiquidityResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -21269,27 +25054,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices156(out *jwriter.Wri
 // MarshalJSON supports json.Marshaler interface
 func (v AddLiquidityResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices156(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices180(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v AddLiquidityResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices156(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices180(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *AddLiquidityResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices156(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices180(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *AddLiquidityResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices156(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices180(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices157(in *jlexer.Lexer, out *AddLiquidityPreviewResponse) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices181(in *jlexer.Lexer, out *AddLiquidityPreviewResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -21361,7 +25146,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices157(in *jlexer.Lexer
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices157(out *jwriter.Writer, in AddLiquidityPreviewResponse) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices181(out *jwriter.Writer, in AddLiquidityPreviewResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -21411,27 +25196,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices157(out *jwriter.Wri
 // MarshalJSON supports json.Marshaler interface
 func (v AddLiquidityPreviewResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices157(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices181(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v AddLiquidityPreviewResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices157(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices181(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *AddLiquidityPreviewResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices157(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices181(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *AddLiquidityPreviewResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices157(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices181(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices158(in *jlexer.Lexer, out *AccountTransferSpotResponse) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices182(in *jlexer.Lexer, out *AccountTransferSpotResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -21467,7 +25252,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices158(in *jlexer.Lexer
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices158(out *jwriter.Writer, in AccountTransferSpotResponse) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices182(out *jwriter.Writer, in AccountTransferSpotResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -21487,27 +25272,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices158(out *jwriter.Wri
 // MarshalJSON supports json.Marshaler interface
 func (v AccountTransferSpotResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices158(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices182(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v AccountTransferSpotResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices158(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices182(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *AccountTransferSpotResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices158(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices182(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *AccountTransferSpotResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices158(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices182(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices159(in *jlexer.Lexer, out *AccountTransferHistorySpotResponse) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices183(in *jlexer.Lexer, out *AccountTransferHistorySpotResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -21569,7 +25354,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices159(in *jlexer.Lexer
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices159(out *jwriter.Writer, in AccountTransferHistorySpotResponse) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices183(out *jwriter.Writer, in AccountTransferHistorySpotResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -21609,27 +25394,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices159(out *jwriter.Wri
 // MarshalJSON supports json.Marshaler interface
 func (v AccountTransferHistorySpotResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices159(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices183(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v AccountTransferHistorySpotResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices159(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices183(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *AccountTransferHistorySpotResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices159(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices183(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *AccountTransferHistorySpotResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices159(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices183(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices160(in *jlexer.Lexer, out *AccountTransferHistoryFuturesResponse) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices184(in *jlexer.Lexer, out *AccountTransferHistoryFuturesResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -21671,13 +25456,13 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices160(in *jlexer.Lexer
 					out.Transfers = (out.Transfers)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v175 Transfer
+					var v202 Transfer
 					if in.IsNull() {
 						in.Skip()
 					} else {
-						(v175).UnmarshalEasyJSON(in)
+						(v202).UnmarshalEasyJSON(in)
 					}
-					out.Transfers = append(out.Transfers, v175)
+					out.Transfers = append(out.Transfers, v202)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -21692,7 +25477,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices160(in *jlexer.Lexer
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices160(out *jwriter.Writer, in AccountTransferHistoryFuturesResponse) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices184(out *jwriter.Writer, in AccountTransferHistoryFuturesResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -21713,11 +25498,11 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices160(out *jwriter.Wri
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v176, v177 := range in.Transfers {
-				if v176 > 0 {
+			for v203, v204 := range in.Transfers {
+				if v203 > 0 {
 					out.RawByte(',')
 				}
-				(v177).MarshalEasyJSON(out)
+				(v204).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -21728,27 +25513,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices160(out *jwriter.Wri
 // MarshalJSON supports json.Marshaler interface
 func (v AccountTransferHistoryFuturesResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices160(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices184(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v AccountTransferHistoryFuturesResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices160(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices184(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *AccountTransferHistoryFuturesResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices160(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices184(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *AccountTransferHistoryFuturesResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices160(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices184(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices161(in *jlexer.Lexer, out *AccountTransferFuturesResponse) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices185(in *jlexer.Lexer, out *AccountTransferFuturesResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -21784,7 +25569,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices161(in *jlexer.Lexer
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices161(out *jwriter.Writer, in AccountTransferFuturesResponse) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices185(out *jwriter.Writer, in AccountTransferFuturesResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -21804,27 +25589,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices161(out *jwriter.Wri
 // MarshalJSON supports json.Marshaler interface
 func (v AccountTransferFuturesResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices161(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices185(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v AccountTransferFuturesResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices161(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices185(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *AccountTransferFuturesResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices161(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices185(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *AccountTransferFuturesResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices161(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices185(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices162(in *jlexer.Lexer, out *Account) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices186(in *jlexer.Lexer, out *Account) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -21914,13 +25699,13 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices162(in *jlexer.Lexer
 					out.Balances = (out.Balances)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v178 Balance
+					var v205 Balance
 					if in.IsNull() {
 						in.Skip()
 					} else {
-						(v178).UnmarshalEasyJSON(in)
+						(v205).UnmarshalEasyJSON(in)
 					}
-					out.Balances = append(out.Balances, v178)
+					out.Balances = append(out.Balances, v205)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -21941,13 +25726,13 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices162(in *jlexer.Lexer
 					out.Permissions = (out.Permissions)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v179 string
+					var v206 string
 					if in.IsNull() {
 						in.Skip()
 					} else {
-						v179 = string(in.String())
+						v206 = string(in.String())
 					}
-					out.Permissions = append(out.Permissions, v179)
+					out.Permissions = append(out.Permissions, v206)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -21962,7 +25747,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices162(in *jlexer.Lexer
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices162(out *jwriter.Writer, in Account) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices186(out *jwriter.Writer, in Account) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -22023,11 +25808,11 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices162(out *jwriter.Wri
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v180, v181 := range in.Balances {
-				if v180 > 0 {
+			for v207, v208 := range in.Balances {
+				if v207 > 0 {
 					out.RawByte(',')
 				}
-				(v181).MarshalEasyJSON(out)
+				(v208).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -22039,11 +25824,11 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices162(out *jwriter.Wri
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v182, v183 := range in.Permissions {
-				if v182 > 0 {
+			for v209, v210 := range in.Permissions {
+				if v209 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v183))
+				out.String(string(v210))
 			}
 			out.RawByte(']')
 		}
@@ -22054,27 +25839,27 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices162(out *jwriter.Wri
 // MarshalJSON supports json.Marshaler interface
 func (v Account) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices162(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices186(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Account) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices162(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices186(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Account) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices162(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices186(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Account) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices162(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices186(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices163(in *jlexer.Lexer, out *APIKeyPermission) {
+func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices187(in *jlexer.Lexer, out *APIKeyPermission) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -22164,7 +25949,7 @@ func easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices163(in *jlexer.Lexer
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices163(out *jwriter.Writer, in APIKeyPermission) {
+func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices187(out *jwriter.Writer, in APIKeyPermission) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -22229,23 +26014,23 @@ func easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices163(out *jwriter.Wri
 // MarshalJSON supports json.Marshaler interface
 func (v APIKeyPermission) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices163(&w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices187(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v APIKeyPermission) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices163(w, v)
+	easyjsonD2b7633eEncodeGithubComWardCapGoBinanceServices187(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *APIKeyPermission) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices163(&r, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices187(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *APIKeyPermission) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices163(l, v)
+	easyjsonD2b7633eDecodeGithubComWardCapGoBinanceServices187(l, v)
 }
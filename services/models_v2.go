@@ -0,0 +1,261 @@
+package binance
+
+import (
+	"github.com/ward-cap/go-binance/decimal"
+)
+
+//go:generate easyjson -all models_v2.go
+
+// The V2 models mirror the models of the same name with every price,
+// quantity, balance and rate decimal typed. Optional numbers that Binance may
+// send empty or null are decimal.NullDecimalV2.
+
+// AccountTradeV2 define an account trade, the decimal typed TradeV3
+//
+//easyjson:json
+type AccountTradeV2 struct {
+	ID              int64           `json:"id"`
+	Symbol          string          `json:"symbol"`
+	OrderID         int64           `json:"orderId"`
+	OrderListId     int64           `json:"orderListId"`
+	Price           decimal.Decimal `json:"price"`
+	Quantity        decimal.Decimal `json:"qty"`
+	QuoteQuantity   decimal.Decimal `json:"quoteQty"`
+	Commission      decimal.Decimal `json:"commission"`
+	CommissionAsset string          `json:"commissionAsset"`
+	Time            int64           `json:"time"`
+	IsBuyer         bool            `json:"isBuyer"`
+	IsMaker         bool            `json:"isMaker"`
+	IsBestMatch     bool            `json:"isBestMatch"`
+	IsIsolated      bool            `json:"isIsolated"`
+}
+
+// AccountV2 define account info
+//
+//easyjson:json
+type AccountV2 struct {
+	MakerCommission  int64             `json:"makerCommission"`
+	TakerCommission  int64             `json:"takerCommission"`
+	BuyerCommission  int64             `json:"buyerCommission"`
+	SellerCommission int64             `json:"sellerCommission"`
+	CommissionRates  CommissionRatesV2 `json:"commissionRates"`
+	CanTrade         bool              `json:"canTrade"`
+	CanWithdraw      bool              `json:"canWithdraw"`
+	CanDeposit       bool              `json:"canDeposit"`
+	UpdateTime       uint64            `json:"updateTime"`
+	AccountType      string            `json:"accountType"`
+	Balances         []BalanceV2       `json:"balances"`
+	Permissions      []string          `json:"permissions"`
+}
+
+// AggTradeV2 define aggregate trade info
+//
+//easyjson:json
+type AggTradeV2 struct {
+	AggTradeID       int64           `json:"a"`
+	Price            decimal.Decimal `json:"p"`
+	Quantity         decimal.Decimal `json:"q"`
+	FirstTradeID     int64           `json:"f"`
+	LastTradeID      int64           `json:"l"`
+	Timestamp        int64           `json:"T"`
+	IsBuyerMaker     bool            `json:"m"`
+	IsBestPriceMatch bool            `json:"M"`
+}
+
+// AmendOrderResponseV2 define the response of an order amended keeping its
+// priority, the decimal typed AmendOrderResponse
+//
+//easyjson:json
+type AmendOrderResponseV2 struct {
+	TransactTime int64                 `json:"transactTime"`
+	ExecutionID  int64                 `json:"executionId"`
+	AmendedOrder *AmendedOrderV2       `json:"amendedOrder"`
+	ListStatus   *AmendOrderListStatus `json:"listStatus,omitempty"`
+}
+
+// AmendedOrderV2 define an order after its amendment
+//
+//easyjson:json
+type AmendedOrderV2 struct {
+	Symbol                  string                `json:"symbol"`
+	OrderID                 int64                 `json:"orderId"`
+	OrderListID             int64                 `json:"orderListId"`
+	OrigClientOrderID       string                `json:"origClientOrderId"`
+	ClientOrderID           string                `json:"clientOrderId"`
+	Price                   decimal.Decimal       `json:"price"`
+	Quantity                decimal.Decimal       `json:"qty"`
+	ExecutedQuantity        decimal.Decimal       `json:"executedQty"`
+	PreventedQuantity       decimal.NullDecimalV2 `json:"preventedQty"`
+	QuoteOrderQuantity      decimal.NullDecimalV2 `json:"quoteOrderQty"`
+	CumulativeQuoteQuantity decimal.Decimal       `json:"cumulativeQuoteQty"`
+	Status                  OrderStatusType       `json:"status"`
+	TimeInForce             TimeInForceType       `json:"timeInForce"`
+	Type                    OrderType             `json:"type"`
+	Side                    SideType              `json:"side"`
+	WorkingTime             int64                 `json:"workingTime"`
+	SelfTradePreventionMode string                `json:"selfTradePreventionMode"`
+}
+
+// BalanceV2 define user balance of your account
+//
+//easyjson:json
+type BalanceV2 struct {
+	Asset  string          `json:"asset"`
+	Free   decimal.Decimal `json:"free"`
+	Locked decimal.Decimal `json:"locked"`
+}
+
+// BookTickerV2 define book ticker info
+//
+//easyjson:json
+type BookTickerV2 struct {
+	Symbol      string          `json:"symbol"`
+	BidPrice    decimal.Decimal `json:"bidPrice"`
+	BidQuantity decimal.Decimal `json:"bidQty"`
+	AskPrice    decimal.Decimal `json:"askPrice"`
+	AskQuantity decimal.Decimal `json:"askQty"`
+}
+
+// CancelOrderResponseV2 define response of canceling order
+//
+//easyjson:json
+type CancelOrderResponseV2 struct {
+	Symbol                   string          `json:"symbol"`
+	OrigClientOrderID        string          `json:"origClientOrderId"`
+	OrderID                  int64           `json:"orderId"`
+	OrderListID              int64           `json:"orderListId"`
+	ClientOrderID            string          `json:"clientOrderId"`
+	TransactTime             int64           `json:"transactTime"`
+	Price                    decimal.Decimal `json:"price"`
+	OrigQuantity             decimal.Decimal `json:"origQty"`
+	ExecutedQuantity         decimal.Decimal `json:"executedQty"`
+	CummulativeQuoteQuantity decimal.Decimal `json:"cummulativeQuoteQty"`
+	Status                   OrderStatusType `json:"status"`
+	TimeInForce              TimeInForceType `json:"timeInForce"`
+	Type                     OrderType       `json:"type"`
+	Side                     SideType        `json:"side"`
+}
+
+// CommissionRatesV2 define the commission rates of an account
+//
+//easyjson:json
+type CommissionRatesV2 struct {
+	Maker  decimal.Decimal `json:"maker"`
+	Taker  decimal.Decimal `json:"taker"`
+	Buyer  decimal.Decimal `json:"buyer"`
+	Seller decimal.Decimal `json:"seller"`
+}
+
+// CreateOrderResponseV2 define create order response
+//
+//easyjson:json
+type CreateOrderResponseV2 struct {
+	Symbol                   string          `json:"symbol"`
+	OrderID                  int64           `json:"orderId"`
+	ClientOrderID            string          `json:"clientOrderId"`
+	TransactTime             int64           `json:"transactTime"`
+	Price                    decimal.Decimal `json:"price"`
+	OrigQuantity             decimal.Decimal `json:"origQty"`
+	ExecutedQuantity         decimal.Decimal `json:"executedQty"`
+	CummulativeQuoteQuantity decimal.Decimal `json:"cummulativeQuoteQty"`
+	IsIsolated               bool            `json:"isIsolated"` // for isolated margin
+
+	Status      OrderStatusType `json:"status"`
+	TimeInForce TimeInForceType `json:"timeInForce"`
+	Type        OrderType       `json:"type"`
+	Side        SideType        `json:"side"`
+
+	// for order response is set to FULL
+	Fills                 []*FillV2             `json:"fills"`
+	MarginBuyBorrowAmount decimal.NullDecimalV2 `json:"marginBuyBorrowAmount"` // for margin
+	MarginBuyBorrowAsset  string                `json:"marginBuyBorrowAsset"`
+
+	// Recovered is set when a retried placement found the order already
+	// placed, see CreateOrderResponse
+	Recovered bool `json:"recovered,omitempty"`
+}
+
+// FillV2 may be returned in an array of fills in a CreateOrderResponseV2.
+//
+//easyjson:json
+type FillV2 struct {
+	TradeID         int64           `json:"tradeId"`
+	Price           decimal.Decimal `json:"price"`
+	Quantity        decimal.Decimal `json:"qty"`
+	Commission      decimal.Decimal `json:"commission"`
+	CommissionAsset string          `json:"commissionAsset"`
+}
+
+// OrderV2 define order info
+//
+//easyjson:json
+type OrderV2 struct {
+	Symbol                   string                `json:"symbol"`
+	OrderID                  int64                 `json:"orderId"`
+	OrderListId              int64                 `json:"orderListId"`
+	ClientOrderID            string                `json:"clientOrderId"`
+	Price                    decimal.Decimal       `json:"price"`
+	OrigQuantity             decimal.Decimal       `json:"origQty"`
+	ExecutedQuantity         decimal.Decimal       `json:"executedQty"`
+	CummulativeQuoteQuantity decimal.Decimal       `json:"cummulativeQuoteQty"`
+	Status                   OrderStatusType       `json:"status"`
+	TimeInForce              TimeInForceType       `json:"timeInForce"`
+	Type                     OrderType             `json:"type"`
+	Side                     SideType              `json:"side"`
+	StopPrice                decimal.NullDecimalV2 `json:"stopPrice"`
+	IcebergQuantity          decimal.NullDecimalV2 `json:"icebergQty"`
+	Time                     int64                 `json:"time"`
+	UpdateTime               int64                 `json:"updateTime"`
+	IsWorking                bool                  `json:"isWorking"`
+	IsIsolated               bool                  `json:"isIsolated"`
+	OrigQuoteOrderQuantity   decimal.NullDecimalV2 `json:"origQuoteOrderQty"`
+}
+
+// PriceChangeStatsV2 define price change stats
+//
+//easyjson:json
+type PriceChangeStatsV2 struct {
+	Symbol             string                `json:"symbol"`
+	PriceChange        decimal.Decimal       `json:"priceChange"`
+	PriceChangePercent decimal.Decimal       `json:"priceChangePercent"`
+	WeightedAvgPrice   decimal.Decimal       `json:"weightedAvgPrice"`
+	PrevClosePrice     decimal.Decimal       `json:"prevClosePrice"`
+	LastPrice          decimal.Decimal       `json:"lastPrice"`
+	LastQty            decimal.Decimal       `json:"lastQty"`
+	BidPrice           decimal.Decimal       `json:"bidPrice"`
+	BidQty             decimal.Decimal       `json:"bidQty"`
+	AskPrice           decimal.Decimal       `json:"askPrice"`
+	AskQty             decimal.Decimal       `json:"askQty"`
+	OpenPrice          decimal.NullDecimalV2 `json:"openPrice"`
+	HighPrice          decimal.Decimal       `json:"highPrice"`
+	LowPrice           decimal.Decimal       `json:"lowPrice"`
+	Volume             decimal.Decimal       `json:"volume"`
+	QuoteVolume        decimal.Decimal       `json:"quoteVolume"`
+	OpenTime           int64                 `json:"openTime"`
+	CloseTime          int64                 `json:"closeTime"`
+	FirstID            int64                 `json:"firstId"`
+	LastID             int64                 `json:"lastId"`
+	Count              int64                 `json:"count"`
+}
+
+// SymbolPriceV2 define symbol and price pair
+//
+//easyjson:json
+type SymbolPriceV2 struct {
+	Symbol string          `json:"symbol"`
+	Price  decimal.Decimal `json:"price"`
+}
+
+// TradeV2 define trade info
+//
+//easyjson:json
+type TradeV2 struct {
+	ID            int64           `json:"id"`
+	Price         decimal.Decimal `json:"price"`
+	Quantity      decimal.Decimal `json:"qty"`
+	QuoteQuantity decimal.Decimal `json:"quoteQty"`
+	Time          int64           `json:"time"`
+	IsBuyerMaker  bool            `json:"isBuyerMaker"`
+	IsBestMatch   bool            `json:"isBestMatch"`
+	IsIsolated    bool            `json:"isIsolated"`
+}
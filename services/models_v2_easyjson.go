@@ -0,0 +1,2760 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package binance
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjsonE769a587DecodeGithubComWardCapGoBinanceServices(in *jlexer.Lexer, out *TradeV2) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "id":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ID = int64(in.Int64())
+			}
+		case "price":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.Price).UnmarshalJSON(data))
+				}
+			}
+		case "qty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.Quantity).UnmarshalJSON(data))
+				}
+			}
+		case "quoteQty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.QuoteQuantity).UnmarshalJSON(data))
+				}
+			}
+		case "time":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Time = int64(in.Int64())
+			}
+		case "isBuyerMaker":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.IsBuyerMaker = bool(in.Bool())
+			}
+		case "isBestMatch":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.IsBestMatch = bool(in.Bool())
+			}
+		case "isIsolated":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.IsIsolated = bool(in.Bool())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonE769a587EncodeGithubComWardCapGoBinanceServices(out *jwriter.Writer, in TradeV2) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.ID))
+	}
+	{
+		const prefix string = ",\"price\":"
+		out.RawString(prefix)
+		out.Raw((in.Price).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"qty\":"
+		out.RawString(prefix)
+		out.Raw((in.Quantity).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"quoteQty\":"
+		out.RawString(prefix)
+		out.Raw((in.QuoteQuantity).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"time\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Time))
+	}
+	{
+		const prefix string = ",\"isBuyerMaker\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsBuyerMaker))
+	}
+	{
+		const prefix string = ",\"isBestMatch\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsBestMatch))
+	}
+	{
+		const prefix string = ",\"isIsolated\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsIsolated))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TradeV2) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonE769a587EncodeGithubComWardCapGoBinanceServices(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TradeV2) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonE769a587EncodeGithubComWardCapGoBinanceServices(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TradeV2) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonE769a587DecodeGithubComWardCapGoBinanceServices(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TradeV2) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonE769a587DecodeGithubComWardCapGoBinanceServices(l, v)
+}
+func easyjsonE769a587DecodeGithubComWardCapGoBinanceServices1(in *jlexer.Lexer, out *SymbolPriceV2) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "symbol":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Symbol = string(in.String())
+			}
+		case "price":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.Price).UnmarshalJSON(data))
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonE769a587EncodeGithubComWardCapGoBinanceServices1(out *jwriter.Writer, in SymbolPriceV2) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"symbol\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Symbol))
+	}
+	{
+		const prefix string = ",\"price\":"
+		out.RawString(prefix)
+		out.Raw((in.Price).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SymbolPriceV2) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonE769a587EncodeGithubComWardCapGoBinanceServices1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SymbolPriceV2) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonE769a587EncodeGithubComWardCapGoBinanceServices1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SymbolPriceV2) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonE769a587DecodeGithubComWardCapGoBinanceServices1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SymbolPriceV2) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonE769a587DecodeGithubComWardCapGoBinanceServices1(l, v)
+}
+func easyjsonE769a587DecodeGithubComWardCapGoBinanceServices2(in *jlexer.Lexer, out *PriceChangeStatsV2) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "symbol":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Symbol = string(in.String())
+			}
+		case "priceChange":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.PriceChange).UnmarshalJSON(data))
+				}
+			}
+		case "priceChangePercent":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.PriceChangePercent).UnmarshalJSON(data))
+				}
+			}
+		case "weightedAvgPrice":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.WeightedAvgPrice).UnmarshalJSON(data))
+				}
+			}
+		case "prevClosePrice":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.PrevClosePrice).UnmarshalJSON(data))
+				}
+			}
+		case "lastPrice":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.LastPrice).UnmarshalJSON(data))
+				}
+			}
+		case "lastQty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.LastQty).UnmarshalJSON(data))
+				}
+			}
+		case "bidPrice":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.BidPrice).UnmarshalJSON(data))
+				}
+			}
+		case "bidQty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.BidQty).UnmarshalJSON(data))
+				}
+			}
+		case "askPrice":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.AskPrice).UnmarshalJSON(data))
+				}
+			}
+		case "askQty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.AskQty).UnmarshalJSON(data))
+				}
+			}
+		case "openPrice":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.OpenPrice).UnmarshalJSON(data))
+				}
+			}
+		case "highPrice":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.HighPrice).UnmarshalJSON(data))
+				}
+			}
+		case "lowPrice":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.LowPrice).UnmarshalJSON(data))
+				}
+			}
+		case "volume":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.Volume).UnmarshalJSON(data))
+				}
+			}
+		case "quoteVolume":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.QuoteVolume).UnmarshalJSON(data))
+				}
+			}
+		case "openTime":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.OpenTime = int64(in.Int64())
+			}
+		case "closeTime":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.CloseTime = int64(in.Int64())
+			}
+		case "firstId":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.FirstID = int64(in.Int64())
+			}
+		case "lastId":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.LastID = int64(in.Int64())
+			}
+		case "count":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Count = int64(in.Int64())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonE769a587EncodeGithubComWardCapGoBinanceServices2(out *jwriter.Writer, in PriceChangeStatsV2) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"symbol\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Symbol))
+	}
+	{
+		const prefix string = ",\"priceChange\":"
+		out.RawString(prefix)
+		out.Raw((in.PriceChange).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"priceChangePercent\":"
+		out.RawString(prefix)
+		out.Raw((in.PriceChangePercent).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"weightedAvgPrice\":"
+		out.RawString(prefix)
+		out.Raw((in.WeightedAvgPrice).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"prevClosePrice\":"
+		out.RawString(prefix)
+		out.Raw((in.PrevClosePrice).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"lastPrice\":"
+		out.RawString(prefix)
+		out.Raw((in.LastPrice).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"lastQty\":"
+		out.RawString(prefix)
+		out.Raw((in.LastQty).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"bidPrice\":"
+		out.RawString(prefix)
+		out.Raw((in.BidPrice).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"bidQty\":"
+		out.RawString(prefix)
+		out.Raw((in.BidQty).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"askPrice\":"
+		out.RawString(prefix)
+		out.Raw((in.AskPrice).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"askQty\":"
+		out.RawString(prefix)
+		out.Raw((in.AskQty).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"openPrice\":"
+		out.RawString(prefix)
+		out.Raw((in.OpenPrice).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"highPrice\":"
+		out.RawString(prefix)
+		out.Raw((in.HighPrice).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"lowPrice\":"
+		out.RawString(prefix)
+		out.Raw((in.LowPrice).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"volume\":"
+		out.RawString(prefix)
+		out.Raw((in.Volume).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"quoteVolume\":"
+		out.RawString(prefix)
+		out.Raw((in.QuoteVolume).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"openTime\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.OpenTime))
+	}
+	{
+		const prefix string = ",\"closeTime\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.CloseTime))
+	}
+	{
+		const prefix string = ",\"firstId\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.FirstID))
+	}
+	{
+		const prefix string = ",\"lastId\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.LastID))
+	}
+	{
+		const prefix string = ",\"count\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Count))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PriceChangeStatsV2) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonE769a587EncodeGithubComWardCapGoBinanceServices2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PriceChangeStatsV2) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonE769a587EncodeGithubComWardCapGoBinanceServices2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PriceChangeStatsV2) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonE769a587DecodeGithubComWardCapGoBinanceServices2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PriceChangeStatsV2) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonE769a587DecodeGithubComWardCapGoBinanceServices2(l, v)
+}
+func easyjsonE769a587DecodeGithubComWardCapGoBinanceServices3(in *jlexer.Lexer, out *OrderV2) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "symbol":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Symbol = string(in.String())
+			}
+		case "orderId":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.OrderID = int64(in.Int64())
+			}
+		case "orderListId":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.OrderListId = int64(in.Int64())
+			}
+		case "clientOrderId":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ClientOrderID = string(in.String())
+			}
+		case "price":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.Price).UnmarshalJSON(data))
+				}
+			}
+		case "origQty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.OrigQuantity).UnmarshalJSON(data))
+				}
+			}
+		case "executedQty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.ExecutedQuantity).UnmarshalJSON(data))
+				}
+			}
+		case "cummulativeQuoteQty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.CummulativeQuoteQuantity).UnmarshalJSON(data))
+				}
+			}
+		case "status":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Status = OrderStatusType(in.String())
+			}
+		case "timeInForce":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TimeInForce = TimeInForceType(in.String())
+			}
+		case "type":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Type = OrderType(in.String())
+			}
+		case "side":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Side = SideType(in.String())
+			}
+		case "stopPrice":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.StopPrice).UnmarshalJSON(data))
+				}
+			}
+		case "icebergQty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.IcebergQuantity).UnmarshalJSON(data))
+				}
+			}
+		case "time":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Time = int64(in.Int64())
+			}
+		case "updateTime":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.UpdateTime = int64(in.Int64())
+			}
+		case "isWorking":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.IsWorking = bool(in.Bool())
+			}
+		case "isIsolated":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.IsIsolated = bool(in.Bool())
+			}
+		case "origQuoteOrderQty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.OrigQuoteOrderQuantity).UnmarshalJSON(data))
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonE769a587EncodeGithubComWardCapGoBinanceServices3(out *jwriter.Writer, in OrderV2) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"symbol\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Symbol))
+	}
+	{
+		const prefix string = ",\"orderId\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.OrderID))
+	}
+	{
+		const prefix string = ",\"orderListId\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.OrderListId))
+	}
+	{
+		const prefix string = ",\"clientOrderId\":"
+		out.RawString(prefix)
+		out.String(string(in.ClientOrderID))
+	}
+	{
+		const prefix string = ",\"price\":"
+		out.RawString(prefix)
+		out.Raw((in.Price).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"origQty\":"
+		out.RawString(prefix)
+		out.Raw((in.OrigQuantity).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"executedQty\":"
+		out.RawString(prefix)
+		out.Raw((in.ExecutedQuantity).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"cummulativeQuoteQty\":"
+		out.RawString(prefix)
+		out.Raw((in.CummulativeQuoteQuantity).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	{
+		const prefix string = ",\"timeInForce\":"
+		out.RawString(prefix)
+		out.String(string(in.TimeInForce))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"side\":"
+		out.RawString(prefix)
+		out.String(string(in.Side))
+	}
+	{
+		const prefix string = ",\"stopPrice\":"
+		out.RawString(prefix)
+		out.Raw((in.StopPrice).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"icebergQty\":"
+		out.RawString(prefix)
+		out.Raw((in.IcebergQuantity).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"time\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Time))
+	}
+	{
+		const prefix string = ",\"updateTime\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.UpdateTime))
+	}
+	{
+		const prefix string = ",\"isWorking\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsWorking))
+	}
+	{
+		const prefix string = ",\"isIsolated\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsIsolated))
+	}
+	{
+		const prefix string = ",\"origQuoteOrderQty\":"
+		out.RawString(prefix)
+		out.Raw((in.OrigQuoteOrderQuantity).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v OrderV2) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonE769a587EncodeGithubComWardCapGoBinanceServices3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v OrderV2) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonE769a587EncodeGithubComWardCapGoBinanceServices3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *OrderV2) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonE769a587DecodeGithubComWardCapGoBinanceServices3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *OrderV2) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonE769a587DecodeGithubComWardCapGoBinanceServices3(l, v)
+}
+func easyjsonE769a587DecodeGithubComWardCapGoBinanceServices4(in *jlexer.Lexer, out *FillV2) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "tradeId":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TradeID = int64(in.Int64())
+			}
+		case "price":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.Price).UnmarshalJSON(data))
+				}
+			}
+		case "qty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.Quantity).UnmarshalJSON(data))
+				}
+			}
+		case "commission":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.Commission).UnmarshalJSON(data))
+				}
+			}
+		case "commissionAsset":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.CommissionAsset = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonE769a587EncodeGithubComWardCapGoBinanceServices4(out *jwriter.Writer, in FillV2) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"tradeId\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.TradeID))
+	}
+	{
+		const prefix string = ",\"price\":"
+		out.RawString(prefix)
+		out.Raw((in.Price).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"qty\":"
+		out.RawString(prefix)
+		out.Raw((in.Quantity).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"commission\":"
+		out.RawString(prefix)
+		out.Raw((in.Commission).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"commissionAsset\":"
+		out.RawString(prefix)
+		out.String(string(in.CommissionAsset))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v FillV2) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonE769a587EncodeGithubComWardCapGoBinanceServices4(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v FillV2) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonE769a587EncodeGithubComWardCapGoBinanceServices4(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *FillV2) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonE769a587DecodeGithubComWardCapGoBinanceServices4(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *FillV2) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonE769a587DecodeGithubComWardCapGoBinanceServices4(l, v)
+}
+func easyjsonE769a587DecodeGithubComWardCapGoBinanceServices5(in *jlexer.Lexer, out *CreateOrderResponseV2) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "symbol":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Symbol = string(in.String())
+			}
+		case "orderId":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.OrderID = int64(in.Int64())
+			}
+		case "clientOrderId":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ClientOrderID = string(in.String())
+			}
+		case "transactTime":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TransactTime = int64(in.Int64())
+			}
+		case "price":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.Price).UnmarshalJSON(data))
+				}
+			}
+		case "origQty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.OrigQuantity).UnmarshalJSON(data))
+				}
+			}
+		case "executedQty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.ExecutedQuantity).UnmarshalJSON(data))
+				}
+			}
+		case "cummulativeQuoteQty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.CummulativeQuoteQuantity).UnmarshalJSON(data))
+				}
+			}
+		case "isIsolated":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.IsIsolated = bool(in.Bool())
+			}
+		case "status":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Status = OrderStatusType(in.String())
+			}
+		case "timeInForce":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TimeInForce = TimeInForceType(in.String())
+			}
+		case "type":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Type = OrderType(in.String())
+			}
+		case "side":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Side = SideType(in.String())
+			}
+		case "fills":
+			if in.IsNull() {
+				in.Skip()
+				out.Fills = nil
+			} else {
+				in.Delim('[')
+				if out.Fills == nil {
+					if !in.IsDelim(']') {
+						out.Fills = make([]*FillV2, 0, 8)
+					} else {
+						out.Fills = []*FillV2{}
+					}
+				} else {
+					out.Fills = (out.Fills)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 *FillV2
+					if in.IsNull() {
+						in.Skip()
+						v1 = nil
+					} else {
+						if v1 == nil {
+							v1 = new(FillV2)
+						}
+						if in.IsNull() {
+							in.Skip()
+						} else {
+							(*v1).UnmarshalEasyJSON(in)
+						}
+					}
+					out.Fills = append(out.Fills, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "marginBuyBorrowAmount":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.MarginBuyBorrowAmount).UnmarshalJSON(data))
+				}
+			}
+		case "marginBuyBorrowAsset":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.MarginBuyBorrowAsset = string(in.String())
+			}
+		case "recovered":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Recovered = bool(in.Bool())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonE769a587EncodeGithubComWardCapGoBinanceServices5(out *jwriter.Writer, in CreateOrderResponseV2) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"symbol\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Symbol))
+	}
+	{
+		const prefix string = ",\"orderId\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.OrderID))
+	}
+	{
+		const prefix string = ",\"clientOrderId\":"
+		out.RawString(prefix)
+		out.String(string(in.ClientOrderID))
+	}
+	{
+		const prefix string = ",\"transactTime\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.TransactTime))
+	}
+	{
+		const prefix string = ",\"price\":"
+		out.RawString(prefix)
+		out.Raw((in.Price).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"origQty\":"
+		out.RawString(prefix)
+		out.Raw((in.OrigQuantity).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"executedQty\":"
+		out.RawString(prefix)
+		out.Raw((in.ExecutedQuantity).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"cummulativeQuoteQty\":"
+		out.RawString(prefix)
+		out.Raw((in.CummulativeQuoteQuantity).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"isIsolated\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsIsolated))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	{
+		const prefix string = ",\"timeInForce\":"
+		out.RawString(prefix)
+		out.String(string(in.TimeInForce))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"side\":"
+		out.RawString(prefix)
+		out.String(string(in.Side))
+	}
+	{
+		const prefix string = ",\"fills\":"
+		out.RawString(prefix)
+		if in.Fills == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v2, v3 := range in.Fills {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				if v3 == nil {
+					out.RawString("null")
+				} else {
+					(*v3).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"marginBuyBorrowAmount\":"
+		out.RawString(prefix)
+		out.Raw((in.MarginBuyBorrowAmount).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"marginBuyBorrowAsset\":"
+		out.RawString(prefix)
+		out.String(string(in.MarginBuyBorrowAsset))
+	}
+	if in.Recovered {
+		const prefix string = ",\"recovered\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Recovered))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CreateOrderResponseV2) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonE769a587EncodeGithubComWardCapGoBinanceServices5(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CreateOrderResponseV2) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonE769a587EncodeGithubComWardCapGoBinanceServices5(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CreateOrderResponseV2) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonE769a587DecodeGithubComWardCapGoBinanceServices5(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CreateOrderResponseV2) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonE769a587DecodeGithubComWardCapGoBinanceServices5(l, v)
+}
+func easyjsonE769a587DecodeGithubComWardCapGoBinanceServices6(in *jlexer.Lexer, out *CommissionRatesV2) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "maker":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.Maker).UnmarshalJSON(data))
+				}
+			}
+		case "taker":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.Taker).UnmarshalJSON(data))
+				}
+			}
+		case "buyer":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.Buyer).UnmarshalJSON(data))
+				}
+			}
+		case "seller":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.Seller).UnmarshalJSON(data))
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonE769a587EncodeGithubComWardCapGoBinanceServices6(out *jwriter.Writer, in CommissionRatesV2) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"maker\":"
+		out.RawString(prefix[1:])
+		out.Raw((in.Maker).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"taker\":"
+		out.RawString(prefix)
+		out.Raw((in.Taker).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"buyer\":"
+		out.RawString(prefix)
+		out.Raw((in.Buyer).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"seller\":"
+		out.RawString(prefix)
+		out.Raw((in.Seller).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CommissionRatesV2) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonE769a587EncodeGithubComWardCapGoBinanceServices6(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CommissionRatesV2) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonE769a587EncodeGithubComWardCapGoBinanceServices6(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CommissionRatesV2) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonE769a587DecodeGithubComWardCapGoBinanceServices6(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CommissionRatesV2) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonE769a587DecodeGithubComWardCapGoBinanceServices6(l, v)
+}
+func easyjsonE769a587DecodeGithubComWardCapGoBinanceServices7(in *jlexer.Lexer, out *CancelOrderResponseV2) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "symbol":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Symbol = string(in.String())
+			}
+		case "origClientOrderId":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.OrigClientOrderID = string(in.String())
+			}
+		case "orderId":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.OrderID = int64(in.Int64())
+			}
+		case "orderListId":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.OrderListID = int64(in.Int64())
+			}
+		case "clientOrderId":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ClientOrderID = string(in.String())
+			}
+		case "transactTime":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TransactTime = int64(in.Int64())
+			}
+		case "price":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.Price).UnmarshalJSON(data))
+				}
+			}
+		case "origQty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.OrigQuantity).UnmarshalJSON(data))
+				}
+			}
+		case "executedQty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.ExecutedQuantity).UnmarshalJSON(data))
+				}
+			}
+		case "cummulativeQuoteQty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.CummulativeQuoteQuantity).UnmarshalJSON(data))
+				}
+			}
+		case "status":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Status = OrderStatusType(in.String())
+			}
+		case "timeInForce":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TimeInForce = TimeInForceType(in.String())
+			}
+		case "type":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Type = OrderType(in.String())
+			}
+		case "side":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Side = SideType(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonE769a587EncodeGithubComWardCapGoBinanceServices7(out *jwriter.Writer, in CancelOrderResponseV2) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"symbol\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Symbol))
+	}
+	{
+		const prefix string = ",\"origClientOrderId\":"
+		out.RawString(prefix)
+		out.String(string(in.OrigClientOrderID))
+	}
+	{
+		const prefix string = ",\"orderId\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.OrderID))
+	}
+	{
+		const prefix string = ",\"orderListId\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.OrderListID))
+	}
+	{
+		const prefix string = ",\"clientOrderId\":"
+		out.RawString(prefix)
+		out.String(string(in.ClientOrderID))
+	}
+	{
+		const prefix string = ",\"transactTime\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.TransactTime))
+	}
+	{
+		const prefix string = ",\"price\":"
+		out.RawString(prefix)
+		out.Raw((in.Price).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"origQty\":"
+		out.RawString(prefix)
+		out.Raw((in.OrigQuantity).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"executedQty\":"
+		out.RawString(prefix)
+		out.Raw((in.ExecutedQuantity).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"cummulativeQuoteQty\":"
+		out.RawString(prefix)
+		out.Raw((in.CummulativeQuoteQuantity).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	{
+		const prefix string = ",\"timeInForce\":"
+		out.RawString(prefix)
+		out.String(string(in.TimeInForce))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"side\":"
+		out.RawString(prefix)
+		out.String(string(in.Side))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CancelOrderResponseV2) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonE769a587EncodeGithubComWardCapGoBinanceServices7(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CancelOrderResponseV2) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonE769a587EncodeGithubComWardCapGoBinanceServices7(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CancelOrderResponseV2) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonE769a587DecodeGithubComWardCapGoBinanceServices7(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CancelOrderResponseV2) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonE769a587DecodeGithubComWardCapGoBinanceServices7(l, v)
+}
+func easyjsonE769a587DecodeGithubComWardCapGoBinanceServices8(in *jlexer.Lexer, out *BookTickerV2) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "symbol":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Symbol = string(in.String())
+			}
+		case "bidPrice":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.BidPrice).UnmarshalJSON(data))
+				}
+			}
+		case "bidQty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.BidQuantity).UnmarshalJSON(data))
+				}
+			}
+		case "askPrice":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.AskPrice).UnmarshalJSON(data))
+				}
+			}
+		case "askQty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.AskQuantity).UnmarshalJSON(data))
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonE769a587EncodeGithubComWardCapGoBinanceServices8(out *jwriter.Writer, in BookTickerV2) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"symbol\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Symbol))
+	}
+	{
+		const prefix string = ",\"bidPrice\":"
+		out.RawString(prefix)
+		out.Raw((in.BidPrice).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"bidQty\":"
+		out.RawString(prefix)
+		out.Raw((in.BidQuantity).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"askPrice\":"
+		out.RawString(prefix)
+		out.Raw((in.AskPrice).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"askQty\":"
+		out.RawString(prefix)
+		out.Raw((in.AskQuantity).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v BookTickerV2) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonE769a587EncodeGithubComWardCapGoBinanceServices8(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v BookTickerV2) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonE769a587EncodeGithubComWardCapGoBinanceServices8(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *BookTickerV2) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonE769a587DecodeGithubComWardCapGoBinanceServices8(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *BookTickerV2) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonE769a587DecodeGithubComWardCapGoBinanceServices8(l, v)
+}
+func easyjsonE769a587DecodeGithubComWardCapGoBinanceServices9(in *jlexer.Lexer, out *BalanceV2) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "asset":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Asset = string(in.String())
+			}
+		case "free":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.Free).UnmarshalJSON(data))
+				}
+			}
+		case "locked":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.Locked).UnmarshalJSON(data))
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonE769a587EncodeGithubComWardCapGoBinanceServices9(out *jwriter.Writer, in BalanceV2) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"asset\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Asset))
+	}
+	{
+		const prefix string = ",\"free\":"
+		out.RawString(prefix)
+		out.Raw((in.Free).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"locked\":"
+		out.RawString(prefix)
+		out.Raw((in.Locked).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v BalanceV2) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonE769a587EncodeGithubComWardCapGoBinanceServices9(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v BalanceV2) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonE769a587EncodeGithubComWardCapGoBinanceServices9(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *BalanceV2) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonE769a587DecodeGithubComWardCapGoBinanceServices9(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *BalanceV2) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonE769a587DecodeGithubComWardCapGoBinanceServices9(l, v)
+}
+func easyjsonE769a587DecodeGithubComWardCapGoBinanceServices10(in *jlexer.Lexer, out *AmendedOrderV2) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "symbol":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Symbol = string(in.String())
+			}
+		case "orderId":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.OrderID = int64(in.Int64())
+			}
+		case "orderListId":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.OrderListID = int64(in.Int64())
+			}
+		case "origClientOrderId":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.OrigClientOrderID = string(in.String())
+			}
+		case "clientOrderId":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ClientOrderID = string(in.String())
+			}
+		case "price":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.Price).UnmarshalJSON(data))
+				}
+			}
+		case "qty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.Quantity).UnmarshalJSON(data))
+				}
+			}
+		case "executedQty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.ExecutedQuantity).UnmarshalJSON(data))
+				}
+			}
+		case "preventedQty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.PreventedQuantity).UnmarshalJSON(data))
+				}
+			}
+		case "quoteOrderQty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.QuoteOrderQuantity).UnmarshalJSON(data))
+				}
+			}
+		case "cumulativeQuoteQty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.CumulativeQuoteQuantity).UnmarshalJSON(data))
+				}
+			}
+		case "status":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Status = OrderStatusType(in.String())
+			}
+		case "timeInForce":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TimeInForce = TimeInForceType(in.String())
+			}
+		case "type":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Type = OrderType(in.String())
+			}
+		case "side":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Side = SideType(in.String())
+			}
+		case "workingTime":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.WorkingTime = int64(in.Int64())
+			}
+		case "selfTradePreventionMode":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.SelfTradePreventionMode = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonE769a587EncodeGithubComWardCapGoBinanceServices10(out *jwriter.Writer, in AmendedOrderV2) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"symbol\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Symbol))
+	}
+	{
+		const prefix string = ",\"orderId\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.OrderID))
+	}
+	{
+		const prefix string = ",\"orderListId\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.OrderListID))
+	}
+	{
+		const prefix string = ",\"origClientOrderId\":"
+		out.RawString(prefix)
+		out.String(string(in.OrigClientOrderID))
+	}
+	{
+		const prefix string = ",\"clientOrderId\":"
+		out.RawString(prefix)
+		out.String(string(in.ClientOrderID))
+	}
+	{
+		const prefix string = ",\"price\":"
+		out.RawString(prefix)
+		out.Raw((in.Price).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"qty\":"
+		out.RawString(prefix)
+		out.Raw((in.Quantity).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"executedQty\":"
+		out.RawString(prefix)
+		out.Raw((in.ExecutedQuantity).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"preventedQty\":"
+		out.RawString(prefix)
+		out.Raw((in.PreventedQuantity).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"quoteOrderQty\":"
+		out.RawString(prefix)
+		out.Raw((in.QuoteOrderQuantity).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"cumulativeQuoteQty\":"
+		out.RawString(prefix)
+		out.Raw((in.CumulativeQuoteQuantity).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	{
+		const prefix string = ",\"timeInForce\":"
+		out.RawString(prefix)
+		out.String(string(in.TimeInForce))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"side\":"
+		out.RawString(prefix)
+		out.String(string(in.Side))
+	}
+	{
+		const prefix string = ",\"workingTime\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.WorkingTime))
+	}
+	{
+		const prefix string = ",\"selfTradePreventionMode\":"
+		out.RawString(prefix)
+		out.String(string(in.SelfTradePreventionMode))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AmendedOrderV2) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonE769a587EncodeGithubComWardCapGoBinanceServices10(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v AmendedOrderV2) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonE769a587EncodeGithubComWardCapGoBinanceServices10(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AmendedOrderV2) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonE769a587DecodeGithubComWardCapGoBinanceServices10(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *AmendedOrderV2) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonE769a587DecodeGithubComWardCapGoBinanceServices10(l, v)
+}
+func easyjsonE769a587DecodeGithubComWardCapGoBinanceServices11(in *jlexer.Lexer, out *AmendOrderResponseV2) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "transactTime":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TransactTime = int64(in.Int64())
+			}
+		case "executionId":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ExecutionID = int64(in.Int64())
+			}
+		case "amendedOrder":
+			if in.IsNull() {
+				in.Skip()
+				out.AmendedOrder = nil
+			} else {
+				if out.AmendedOrder == nil {
+					out.AmendedOrder = new(AmendedOrderV2)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.AmendedOrder).UnmarshalEasyJSON(in)
+				}
+			}
+		case "listStatus":
+			if in.IsNull() {
+				in.Skip()
+				out.ListStatus = nil
+			} else {
+				if out.ListStatus == nil {
+					out.ListStatus = new(AmendOrderListStatus)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.ListStatus).UnmarshalEasyJSON(in)
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonE769a587EncodeGithubComWardCapGoBinanceServices11(out *jwriter.Writer, in AmendOrderResponseV2) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"transactTime\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.TransactTime))
+	}
+	{
+		const prefix string = ",\"executionId\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.ExecutionID))
+	}
+	{
+		const prefix string = ",\"amendedOrder\":"
+		out.RawString(prefix)
+		if in.AmendedOrder == nil {
+			out.RawString("null")
+		} else {
+			(*in.AmendedOrder).MarshalEasyJSON(out)
+		}
+	}
+	if in.ListStatus != nil {
+		const prefix string = ",\"listStatus\":"
+		out.RawString(prefix)
+		(*in.ListStatus).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AmendOrderResponseV2) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonE769a587EncodeGithubComWardCapGoBinanceServices11(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v AmendOrderResponseV2) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonE769a587EncodeGithubComWardCapGoBinanceServices11(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AmendOrderResponseV2) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonE769a587DecodeGithubComWardCapGoBinanceServices11(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *AmendOrderResponseV2) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonE769a587DecodeGithubComWardCapGoBinanceServices11(l, v)
+}
+func easyjsonE769a587DecodeGithubComWardCapGoBinanceServices12(in *jlexer.Lexer, out *AggTradeV2) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "a":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.AggTradeID = int64(in.Int64())
+			}
+		case "p":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.Price).UnmarshalJSON(data))
+				}
+			}
+		case "q":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.Quantity).UnmarshalJSON(data))
+				}
+			}
+		case "f":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.FirstTradeID = int64(in.Int64())
+			}
+		case "l":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.LastTradeID = int64(in.Int64())
+			}
+		case "T":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Timestamp = int64(in.Int64())
+			}
+		case "m":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.IsBuyerMaker = bool(in.Bool())
+			}
+		case "M":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.IsBestPriceMatch = bool(in.Bool())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonE769a587EncodeGithubComWardCapGoBinanceServices12(out *jwriter.Writer, in AggTradeV2) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"a\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.AggTradeID))
+	}
+	{
+		const prefix string = ",\"p\":"
+		out.RawString(prefix)
+		out.Raw((in.Price).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"q\":"
+		out.RawString(prefix)
+		out.Raw((in.Quantity).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"f\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.FirstTradeID))
+	}
+	{
+		const prefix string = ",\"l\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.LastTradeID))
+	}
+	{
+		const prefix string = ",\"T\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Timestamp))
+	}
+	{
+		const prefix string = ",\"m\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsBuyerMaker))
+	}
+	{
+		const prefix string = ",\"M\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsBestPriceMatch))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AggTradeV2) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonE769a587EncodeGithubComWardCapGoBinanceServices12(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v AggTradeV2) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonE769a587EncodeGithubComWardCapGoBinanceServices12(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AggTradeV2) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonE769a587DecodeGithubComWardCapGoBinanceServices12(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *AggTradeV2) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonE769a587DecodeGithubComWardCapGoBinanceServices12(l, v)
+}
+func easyjsonE769a587DecodeGithubComWardCapGoBinanceServices13(in *jlexer.Lexer, out *AccountV2) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "makerCommission":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.MakerCommission = int64(in.Int64())
+			}
+		case "takerCommission":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.TakerCommission = int64(in.Int64())
+			}
+		case "buyerCommission":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.BuyerCommission = int64(in.Int64())
+			}
+		case "sellerCommission":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.SellerCommission = int64(in.Int64())
+			}
+		case "commissionRates":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.CommissionRates).UnmarshalEasyJSON(in)
+			}
+		case "canTrade":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.CanTrade = bool(in.Bool())
+			}
+		case "canWithdraw":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.CanWithdraw = bool(in.Bool())
+			}
+		case "canDeposit":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.CanDeposit = bool(in.Bool())
+			}
+		case "updateTime":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.UpdateTime = uint64(in.Uint64())
+			}
+		case "accountType":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.AccountType = string(in.String())
+			}
+		case "balances":
+			if in.IsNull() {
+				in.Skip()
+				out.Balances = nil
+			} else {
+				in.Delim('[')
+				if out.Balances == nil {
+					if !in.IsDelim(']') {
+						out.Balances = make([]BalanceV2, 0, 1)
+					} else {
+						out.Balances = []BalanceV2{}
+					}
+				} else {
+					out.Balances = (out.Balances)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v4 BalanceV2
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v4).UnmarshalEasyJSON(in)
+					}
+					out.Balances = append(out.Balances, v4)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "permissions":
+			if in.IsNull() {
+				in.Skip()
+				out.Permissions = nil
+			} else {
+				in.Delim('[')
+				if out.Permissions == nil {
+					if !in.IsDelim(']') {
+						out.Permissions = make([]string, 0, 4)
+					} else {
+						out.Permissions = []string{}
+					}
+				} else {
+					out.Permissions = (out.Permissions)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v5 string
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						v5 = string(in.String())
+					}
+					out.Permissions = append(out.Permissions, v5)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonE769a587EncodeGithubComWardCapGoBinanceServices13(out *jwriter.Writer, in AccountV2) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"makerCommission\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.MakerCommission))
+	}
+	{
+		const prefix string = ",\"takerCommission\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.TakerCommission))
+	}
+	{
+		const prefix string = ",\"buyerCommission\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.BuyerCommission))
+	}
+	{
+		const prefix string = ",\"sellerCommission\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.SellerCommission))
+	}
+	{
+		const prefix string = ",\"commissionRates\":"
+		out.RawString(prefix)
+		(in.CommissionRates).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"canTrade\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CanTrade))
+	}
+	{
+		const prefix string = ",\"canWithdraw\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CanWithdraw))
+	}
+	{
+		const prefix string = ",\"canDeposit\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CanDeposit))
+	}
+	{
+		const prefix string = ",\"updateTime\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.UpdateTime))
+	}
+	{
+		const prefix string = ",\"accountType\":"
+		out.RawString(prefix)
+		out.String(string(in.AccountType))
+	}
+	{
+		const prefix string = ",\"balances\":"
+		out.RawString(prefix)
+		if in.Balances == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v6, v7 := range in.Balances {
+				if v6 > 0 {
+					out.RawByte(',')
+				}
+				(v7).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"permissions\":"
+		out.RawString(prefix)
+		if in.Permissions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v8, v9 := range in.Permissions {
+				if v8 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v9))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AccountV2) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonE769a587EncodeGithubComWardCapGoBinanceServices13(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v AccountV2) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonE769a587EncodeGithubComWardCapGoBinanceServices13(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AccountV2) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonE769a587DecodeGithubComWardCapGoBinanceServices13(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *AccountV2) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonE769a587DecodeGithubComWardCapGoBinanceServices13(l, v)
+}
+func easyjsonE769a587DecodeGithubComWardCapGoBinanceServices14(in *jlexer.Lexer, out *AccountTradeV2) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "id":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ID = int64(in.Int64())
+			}
+		case "symbol":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Symbol = string(in.String())
+			}
+		case "orderId":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.OrderID = int64(in.Int64())
+			}
+		case "orderListId":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.OrderListId = int64(in.Int64())
+			}
+		case "price":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.Price).UnmarshalJSON(data))
+				}
+			}
+		case "qty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.Quantity).UnmarshalJSON(data))
+				}
+			}
+		case "quoteQty":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.QuoteQuantity).UnmarshalJSON(data))
+				}
+			}
+		case "commission":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddError((out.Commission).UnmarshalJSON(data))
+				}
+			}
+		case "commissionAsset":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.CommissionAsset = string(in.String())
+			}
+		case "time":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Time = int64(in.Int64())
+			}
+		case "isBuyer":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.IsBuyer = bool(in.Bool())
+			}
+		case "isMaker":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.IsMaker = bool(in.Bool())
+			}
+		case "isBestMatch":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.IsBestMatch = bool(in.Bool())
+			}
+		case "isIsolated":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.IsIsolated = bool(in.Bool())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonE769a587EncodeGithubComWardCapGoBinanceServices14(out *jwriter.Writer, in AccountTradeV2) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.ID))
+	}
+	{
+		const prefix string = ",\"symbol\":"
+		out.RawString(prefix)
+		out.String(string(in.Symbol))
+	}
+	{
+		const prefix string = ",\"orderId\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.OrderID))
+	}
+	{
+		const prefix string = ",\"orderListId\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.OrderListId))
+	}
+	{
+		const prefix string = ",\"price\":"
+		out.RawString(prefix)
+		out.Raw((in.Price).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"qty\":"
+		out.RawString(prefix)
+		out.Raw((in.Quantity).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"quoteQty\":"
+		out.RawString(prefix)
+		out.Raw((in.QuoteQuantity).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"commission\":"
+		out.RawString(prefix)
+		out.Raw((in.Commission).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"commissionAsset\":"
+		out.RawString(prefix)
+		out.String(string(in.CommissionAsset))
+	}
+	{
+		const prefix string = ",\"time\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Time))
+	}
+	{
+		const prefix string = ",\"isBuyer\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsBuyer))
+	}
+	{
+		const prefix string = ",\"isMaker\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsMaker))
+	}
+	{
+		const prefix string = ",\"isBestMatch\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsBestMatch))
+	}
+	{
+		const prefix string = ",\"isIsolated\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsIsolated))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AccountTradeV2) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonE769a587EncodeGithubComWardCapGoBinanceServices14(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v AccountTradeV2) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonE769a587EncodeGithubComWardCapGoBinanceServices14(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AccountTradeV2) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonE769a587DecodeGithubComWardCapGoBinanceServices14(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *AccountTradeV2) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonE769a587DecodeGithubComWardCapGoBinanceServices14(l, v)
+}
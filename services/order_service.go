@@ -3,7 +3,11 @@ package binance
 import (
 	"context"
 	stdjson "encoding/json"
+	"errors"
 	"net/http"
+
+	"github.com/shopspring/decimal"
+	"github.com/ward-cap/go-binance/common"
 )
 
 // CreateOrderService create order
@@ -53,18 +57,33 @@ func (s *CreateOrderService) Quantity(quantity string) *CreateOrderService {
 	return s
 }
 
+// QuantityDecimal set quantity from a decimal
+func (s *CreateOrderService) QuantityDecimal(quantity decimal.Decimal) *CreateOrderService {
+	return s.Quantity(quantity.String())
+}
+
 // QuoteOrderQty set quoteOrderQty
 func (s *CreateOrderService) QuoteOrderQty(quoteOrderQty string) *CreateOrderService {
 	s.quoteOrderQty = &quoteOrderQty
 	return s
 }
 
+// QuoteOrderQtyDecimal set quoteOrderQty from a decimal
+func (s *CreateOrderService) QuoteOrderQtyDecimal(quoteOrderQty decimal.Decimal) *CreateOrderService {
+	return s.QuoteOrderQty(quoteOrderQty.String())
+}
+
 // Price set price
 func (s *CreateOrderService) Price(price string) *CreateOrderService {
 	s.price = &price
 	return s
 }
 
+// PriceDecimal set price from a decimal
+func (s *CreateOrderService) PriceDecimal(price decimal.Decimal) *CreateOrderService {
+	return s.Price(price.String())
+}
+
 // NewClientOrderID set newClientOrderID
 func (s *CreateOrderService) NewClientOrderID(newClientOrderID string) *CreateOrderService {
 	s.newClientOrderID = &newClientOrderID
@@ -77,6 +96,11 @@ func (s *CreateOrderService) StopPrice(stopPrice string) *CreateOrderService {
 	return s
 }
 
+// StopPriceDecimal set stopPrice from a decimal
+func (s *CreateOrderService) StopPriceDecimal(stopPrice decimal.Decimal) *CreateOrderService {
+	return s.StopPrice(stopPrice.String())
+}
+
 // TrailingDelta set trailingDelta
 func (s *CreateOrderService) TrailingDelta(trailingDelta string) *CreateOrderService {
 	s.trailingDelta = &trailingDelta
@@ -89,19 +113,18 @@ func (s *CreateOrderService) IcebergQuantity(icebergQuantity string) *CreateOrde
 	return s
 }
 
+// IcebergQuantityDecimal set icebergQuantity from a decimal
+func (s *CreateOrderService) IcebergQuantityDecimal(icebergQuantity decimal.Decimal) *CreateOrderService {
+	return s.IcebergQuantity(icebergQuantity.String())
+}
+
 // NewOrderRespType set icebergQuantity
 func (s *CreateOrderService) NewOrderRespType(newOrderRespType NewOrderRespType) *CreateOrderService {
 	s.newOrderRespType = &newOrderRespType
 	return s
 }
 
-func (s *CreateOrderService) createOrder(ctx context.Context, endpoint string, opts ...RequestOption) (data []byte, err error) {
-	r := &request{
-		service:  "CreateOrderService",
-		method:   http.MethodPost,
-		endpoint: endpoint,
-		secType:  secTypeSigned,
-	}
+func (s *CreateOrderService) params() params {
 	m := params{
 		"symbol": s.symbol,
 		"side":   s.side,
@@ -134,8 +157,22 @@ func (s *CreateOrderService) createOrder(ctx context.Context, endpoint string, o
 	if s.newOrderRespType != nil {
 		m["newOrderRespType"] = *s.newOrderRespType
 	}
-	r.setFormParams(m)
-	data, err = s.c.callAPI(ctx, r, opts...)
+	return m
+}
+
+func (s *CreateOrderService) newRequest(endpoint string) *request {
+	r := &request{
+		service:  "CreateOrderService",
+		method:   http.MethodPost,
+		endpoint: endpoint,
+		secType:  secTypeSigned,
+	}
+	r.setFormParams(s.params())
+	return r
+}
+
+func (s *CreateOrderService) createOrder(ctx context.Context, endpoint string, opts ...RequestOption) (data []byte, err error) {
+	data, err = s.c.callAPI(ctx, s.newRequest(endpoint), opts...)
 	if err != nil {
 		return []byte{}, err
 	}
@@ -156,7 +193,22 @@ func (s *CreateOrderService) Do(ctx context.Context, opts ...RequestOption) (res
 	return res, nil
 }
 
-// Test send test api to check if the request is valid
+// DoV2 send request and return the decimal typed CreateOrderResponseV2
+func (s *CreateOrderService) DoV2(ctx context.Context, opts ...RequestOption) (res *CreateOrderResponseV2, err error) {
+	data, err := s.createOrder(ctx, "/api/v3/order", opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(CreateOrderResponseV2)
+	err = jsonCodec.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Test send test api to check if the request is valid, use TestOrderService
+// to also compute the commission rates
 func (s *CreateOrderService) Test(ctx context.Context, opts ...RequestOption) (err error) {
 	_, err = s.createOrder(ctx, "/api/v3/order/test", opts...)
 	return err
@@ -198,6 +250,11 @@ func (s *CreateOCOService) Quantity(quantity string) *CreateOCOService {
 	return s
 }
 
+// QuantityDecimal set quantity from a decimal
+func (s *CreateOCOService) QuantityDecimal(quantity decimal.Decimal) *CreateOCOService {
+	return s.Quantity(quantity.String())
+}
+
 // ListClientOrderID set listClientOrderID
 func (s *CreateOCOService) ListClientOrderID(listClientOrderID string) *CreateOCOService {
 	s.listClientOrderID = &listClientOrderID
@@ -216,12 +273,22 @@ func (s *CreateOCOService) Price(price string) *CreateOCOService {
 	return s
 }
 
+// PriceDecimal set price from a decimal
+func (s *CreateOCOService) PriceDecimal(price decimal.Decimal) *CreateOCOService {
+	return s.Price(price.String())
+}
+
 // LimitIcebergQuantity set limitIcebergQuantity
 func (s *CreateOCOService) LimitIcebergQuantity(limitIcebergQty string) *CreateOCOService {
 	s.limitIcebergQty = &limitIcebergQty
 	return s
 }
 
+// LimitIcebergQuantityDecimal set limitIcebergQty from a decimal
+func (s *CreateOCOService) LimitIcebergQuantityDecimal(limitIcebergQty decimal.Decimal) *CreateOCOService {
+	return s.LimitIcebergQuantity(limitIcebergQty.String())
+}
+
 // StopClientOrderID set stopClientOrderID
 func (s *CreateOCOService) StopClientOrderID(stopClientOrderID string) *CreateOCOService {
 	s.stopClientOrderID = &stopClientOrderID
@@ -234,18 +301,33 @@ func (s *CreateOCOService) StopPrice(stopPrice string) *CreateOCOService {
 	return s
 }
 
+// StopPriceDecimal set stopPrice from a decimal
+func (s *CreateOCOService) StopPriceDecimal(stopPrice decimal.Decimal) *CreateOCOService {
+	return s.StopPrice(stopPrice.String())
+}
+
 // StopLimitPrice set stop limit price
 func (s *CreateOCOService) StopLimitPrice(stopLimitPrice string) *CreateOCOService {
 	s.stopLimitPrice = &stopLimitPrice
 	return s
 }
 
+// StopLimitPriceDecimal set stopLimitPrice from a decimal
+func (s *CreateOCOService) StopLimitPriceDecimal(stopLimitPrice decimal.Decimal) *CreateOCOService {
+	return s.StopLimitPrice(stopLimitPrice.String())
+}
+
 // StopIcebergQty set stop limit price
 func (s *CreateOCOService) StopIcebergQty(stopIcebergQty string) *CreateOCOService {
 	s.stopIcebergQty = &stopIcebergQty
 	return s
 }
 
+// StopIcebergQtyDecimal set stopIcebergQty from a decimal
+func (s *CreateOCOService) StopIcebergQtyDecimal(stopIcebergQty decimal.Decimal) *CreateOCOService {
+	return s.StopIcebergQty(stopIcebergQty.String())
+}
+
 // StopLimitTimeInForce set stopLimitTimeInForce
 func (s *CreateOCOService) StopLimitTimeInForce(stopLimitTimeInForce TimeInForceType) *CreateOCOService {
 	s.stopLimitTimeInForce = &stopLimitTimeInForce
@@ -356,8 +438,7 @@ func (s *ListOpenOrdersService) Symbol(symbol string) *ListOpenOrdersService {
 	return s
 }
 
-// Do send request
-func (s *ListOpenOrdersService) Do(ctx context.Context, opts ...RequestOption) (res []*Order, err error) {
+func (s *ListOpenOrdersService) newRequest() *request {
 	r := &request{
 		service:  "ListOpenOrdersService",
 		method:   http.MethodGet,
@@ -367,7 +448,12 @@ func (s *ListOpenOrdersService) Do(ctx context.Context, opts ...RequestOption) (
 	if s.symbol != "" {
 		r.setParam("symbol", s.symbol)
 	}
-	data, err := s.c.callAPI(ctx, r, opts...)
+	return r
+}
+
+// Do send request
+func (s *ListOpenOrdersService) Do(ctx context.Context, opts ...RequestOption) (res []*Order, err error) {
+	data, err := s.c.callAPI(ctx, s.newRequest(), opts...)
 	if err != nil {
 		return []*Order{}, err
 	}
@@ -379,6 +465,20 @@ func (s *ListOpenOrdersService) Do(ctx context.Context, opts ...RequestOption) (
 	return res, nil
 }
 
+// DoV2 send request and return the decimal typed OrderV2
+func (s *ListOpenOrdersService) DoV2(ctx context.Context, opts ...RequestOption) (res []*OrderV2, err error) {
+	data, err := s.c.callAPI(ctx, s.newRequest(), opts...)
+	if err != nil {
+		return []*OrderV2{}, err
+	}
+	res = make([]*OrderV2, 0)
+	err = jsonCodec.Unmarshal(data, &res)
+	if err != nil {
+		return []*OrderV2{}, err
+	}
+	return res, nil
+}
+
 // GetOrderService get an order
 type GetOrderService struct {
 	c                 *Client
@@ -405,8 +505,7 @@ func (s *GetOrderService) OrigClientOrderID(origClientOrderID string) *GetOrderS
 	return s
 }
 
-// Do send request
-func (s *GetOrderService) Do(ctx context.Context, opts ...RequestOption) (res *Order, err error) {
+func (s *GetOrderService) newRequest() *request {
 	r := &request{
 		service:  "GetOrderService",
 		method:   http.MethodGet,
@@ -420,7 +519,12 @@ func (s *GetOrderService) Do(ctx context.Context, opts ...RequestOption) (res *O
 	if s.origClientOrderID != nil {
 		r.setParam("origClientOrderId", *s.origClientOrderID)
 	}
-	data, err := s.c.callAPI(ctx, r, opts...)
+	return r
+}
+
+// Do send request
+func (s *GetOrderService) Do(ctx context.Context, opts ...RequestOption) (res *Order, err error) {
+	data, err := s.c.callAPI(ctx, s.newRequest(), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -432,6 +536,20 @@ func (s *GetOrderService) Do(ctx context.Context, opts ...RequestOption) (res *O
 	return res, nil
 }
 
+// DoV2 send request and return the decimal typed OrderV2
+func (s *GetOrderService) DoV2(ctx context.Context, opts ...RequestOption) (res *OrderV2, err error) {
+	data, err := s.c.callAPI(ctx, s.newRequest(), opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(OrderV2)
+	err = jsonCodec.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
 // ListOrdersService all account orders; active, canceled, or filled
 type ListOrdersService struct {
 	c         *Client
@@ -472,8 +590,7 @@ func (s *ListOrdersService) Limit(limit int) *ListOrdersService {
 	return s
 }
 
-// Do send request
-func (s *ListOrdersService) Do(ctx context.Context, opts ...RequestOption) (res []*Order, err error) {
+func (s *ListOrdersService) newRequest() *request {
 	r := &request{
 		service:  "ListOrdersService",
 		method:   http.MethodGet,
@@ -493,7 +610,12 @@ func (s *ListOrdersService) Do(ctx context.Context, opts ...RequestOption) (res
 	if s.limit != nil {
 		r.setParam("limit", *s.limit)
 	}
-	data, err := s.c.callAPI(ctx, r, opts...)
+	return r
+}
+
+// Do send request
+func (s *ListOrdersService) Do(ctx context.Context, opts ...RequestOption) (res []*Order, err error) {
+	data, err := s.c.callAPI(ctx, s.newRequest(), opts...)
 	if err != nil {
 		return []*Order{}, err
 	}
@@ -505,6 +627,20 @@ func (s *ListOrdersService) Do(ctx context.Context, opts ...RequestOption) (res
 	return res, nil
 }
 
+// DoV2 send request and return the decimal typed OrderV2
+func (s *ListOrdersService) DoV2(ctx context.Context, opts ...RequestOption) (res []*OrderV2, err error) {
+	data, err := s.c.callAPI(ctx, s.newRequest(), opts...)
+	if err != nil {
+		return []*OrderV2{}, err
+	}
+	res = make([]*OrderV2, 0)
+	err = jsonCodec.Unmarshal(data, &res)
+	if err != nil {
+		return []*OrderV2{}, err
+	}
+	return res, nil
+}
+
 // CancelOrderService cancel an order
 type CancelOrderService struct {
 	c                 *Client
@@ -538,8 +674,7 @@ func (s *CancelOrderService) NewClientOrderID(newClientOrderID string) *CancelOr
 	return s
 }
 
-// Do send request
-func (s *CancelOrderService) Do(ctx context.Context, opts ...RequestOption) (res *CancelOrderResponse, err error) {
+func (s *CancelOrderService) newRequest() *request {
 	r := &request{
 		service:  "CancelOrderService",
 		method:   http.MethodDelete,
@@ -556,7 +691,12 @@ func (s *CancelOrderService) Do(ctx context.Context, opts ...RequestOption) (res
 	if s.newClientOrderID != nil {
 		r.setFormParam("newClientOrderId", *s.newClientOrderID)
 	}
-	data, err := s.c.callAPI(ctx, r, opts...)
+	return r
+}
+
+// Do send request
+func (s *CancelOrderService) Do(ctx context.Context, opts ...RequestOption) (res *CancelOrderResponse, err error) {
+	data, err := s.c.callAPI(ctx, s.newRequest(), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -568,6 +708,259 @@ func (s *CancelOrderService) Do(ctx context.Context, opts ...RequestOption) (res
 	return res, nil
 }
 
+// DoV2 send request and return the decimal typed CancelOrderResponseV2
+func (s *CancelOrderService) DoV2(ctx context.Context, opts ...RequestOption) (res *CancelOrderResponseV2, err error) {
+	data, err := s.c.callAPI(ctx, s.newRequest(), opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(CancelOrderResponseV2)
+	err = jsonCodec.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CancelReplaceOrderService cancel an order and place a new order on the same
+// symbol in one request. The new order is built with a CreateOrderService.
+type CancelReplaceOrderService struct {
+	c                       *Client
+	order                   *CreateOrderService
+	cancelReplaceMode       CancelReplaceModeType
+	cancelOrderID           *int64
+	cancelOrigClientOrderID *string
+	cancelNewClientOrderID  *string
+}
+
+// Order set the new order, its symbol is also the symbol of the canceled
+// order
+func (s *CancelReplaceOrderService) Order(order *CreateOrderService) *CancelReplaceOrderService {
+	s.order = order
+	return s
+}
+
+// CancelReplaceMode set cancelReplaceMode, STOP_ON_FAILURE when not set
+func (s *CancelReplaceOrderService) CancelReplaceMode(cancelReplaceMode CancelReplaceModeType) *CancelReplaceOrderService {
+	s.cancelReplaceMode = cancelReplaceMode
+	return s
+}
+
+// CancelOrderID set cancelOrderId
+func (s *CancelReplaceOrderService) CancelOrderID(cancelOrderID int64) *CancelReplaceOrderService {
+	s.cancelOrderID = &cancelOrderID
+	return s
+}
+
+// CancelOrigClientOrderID set cancelOrigClientOrderId
+func (s *CancelReplaceOrderService) CancelOrigClientOrderID(cancelOrigClientOrderID string) *CancelReplaceOrderService {
+	s.cancelOrigClientOrderID = &cancelOrigClientOrderID
+	return s
+}
+
+// CancelNewClientOrderID set cancelNewClientOrderId, the id of the cancel
+func (s *CancelReplaceOrderService) CancelNewClientOrderID(cancelNewClientOrderID string) *CancelReplaceOrderService {
+	s.cancelNewClientOrderID = &cancelNewClientOrderID
+	return s
+}
+
+// Do send request. When the cancel or the new order fails, res is returned
+// with the *common.APIError and tells which step failed.
+func (s *CancelReplaceOrderService) Do(ctx context.Context, opts ...RequestOption) (res *CancelReplaceOrderResponse, err error) {
+	if s.order == nil {
+		return nil, errors.New("order must be set")
+	}
+	if s.cancelOrderID == nil && s.cancelOrigClientOrderID == nil {
+		return nil, errors.New("either cancelOrderId or cancelOrigClientOrderId must be sent")
+	}
+	r := &request{
+		service:  "CancelReplaceOrderService",
+		method:   http.MethodPost,
+		endpoint: "/api/v3/order/cancelReplace",
+		secType:  secTypeSigned,
+	}
+	m := s.order.params()
+	m["cancelReplaceMode"] = CancelReplaceModeTypeStopOnFailure
+	if s.cancelReplaceMode != "" {
+		m["cancelReplaceMode"] = s.cancelReplaceMode
+	}
+	if s.cancelOrderID != nil {
+		m["cancelOrderId"] = *s.cancelOrderID
+	}
+	if s.cancelOrigClientOrderID != nil {
+		m["cancelOrigClientOrderId"] = *s.cancelOrigClientOrderID
+	}
+	if s.cancelNewClientOrderID != nil {
+		m["cancelNewClientOrderId"] = *s.cancelNewClientOrderID
+	}
+	r.setFormParams(m)
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		var apiErr *common.APIError
+		if errors.As(err, &apiErr) && len(apiErr.Data) > 0 {
+			if res, perr := parseCancelReplaceOrderResponse(apiErr.Data); perr == nil {
+				return res, err
+			}
+		}
+		return nil, err
+	}
+	return parseCancelReplaceOrderResponse(data)
+}
+
+// AmendOrderKeepPriorityService reduce the quantity of an open order while
+// keeping its priority in the order book
+type AmendOrderKeepPriorityService struct {
+	c                 *Client
+	symbol            string
+	orderID           *int64
+	origClientOrderID *string
+	newClientOrderID  *string
+	newQuantity       string
+}
+
+// Symbol set symbol
+func (s *AmendOrderKeepPriorityService) Symbol(symbol string) *AmendOrderKeepPriorityService {
+	s.symbol = symbol
+	return s
+}
+
+// OrderID set orderID
+func (s *AmendOrderKeepPriorityService) OrderID(orderID int64) *AmendOrderKeepPriorityService {
+	s.orderID = &orderID
+	return s
+}
+
+// OrigClientOrderID set origClientOrderID
+func (s *AmendOrderKeepPriorityService) OrigClientOrderID(origClientOrderID string) *AmendOrderKeepPriorityService {
+	s.origClientOrderID = &origClientOrderID
+	return s
+}
+
+// NewClientOrderID set newClientOrderID, the new client order id of the order
+func (s *AmendOrderKeepPriorityService) NewClientOrderID(newClientOrderID string) *AmendOrderKeepPriorityService {
+	s.newClientOrderID = &newClientOrderID
+	return s
+}
+
+// NewQuantity set newQty, it must be less than the quantity of the order
+func (s *AmendOrderKeepPriorityService) NewQuantity(newQuantity string) *AmendOrderKeepPriorityService {
+	s.newQuantity = newQuantity
+	return s
+}
+
+// NewQuantityDecimal set newQty from a decimal
+func (s *AmendOrderKeepPriorityService) NewQuantityDecimal(newQuantity decimal.Decimal) *AmendOrderKeepPriorityService {
+	return s.NewQuantity(newQuantity.String())
+}
+
+func (s *AmendOrderKeepPriorityService) validate() error {
+	if s.orderID == nil && s.origClientOrderID == nil {
+		return errors.New("either orderId or origClientOrderId must be sent")
+	}
+	return nil
+}
+
+func (s *AmendOrderKeepPriorityService) newRequest() *request {
+	r := &request{
+		service:  "AmendOrderKeepPriorityService",
+		method:   http.MethodPut,
+		endpoint: "/api/v3/order/amend/keepPriority",
+		secType:  secTypeSigned,
+	}
+	m := params{
+		"symbol": s.symbol,
+		"newQty": s.newQuantity,
+	}
+	if s.orderID != nil {
+		m["orderId"] = *s.orderID
+	}
+	if s.origClientOrderID != nil {
+		m["origClientOrderId"] = *s.origClientOrderID
+	}
+	if s.newClientOrderID != nil {
+		m["newClientOrderId"] = *s.newClientOrderID
+	}
+	r.setFormParams(m)
+	return r
+}
+
+// Do send request
+func (s *AmendOrderKeepPriorityService) Do(ctx context.Context, opts ...RequestOption) (res *AmendOrderResponse, err error) {
+	if err := s.validate(); err != nil {
+		return nil, err
+	}
+	data, err := s.c.callAPI(ctx, s.newRequest(), opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(AmendOrderResponse)
+	err = jsonCodec.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// DoV2 send request and return the decimal typed AmendOrderResponseV2
+func (s *AmendOrderKeepPriorityService) DoV2(ctx context.Context, opts ...RequestOption) (res *AmendOrderResponseV2, err error) {
+	if err := s.validate(); err != nil {
+		return nil, err
+	}
+	data, err := s.c.callAPI(ctx, s.newRequest(), opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(AmendOrderResponseV2)
+	err = jsonCodec.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// TestOrderService validate an order built with a CreateOrderService without
+// sending it to the matching engine
+type TestOrderService struct {
+	c                      *Client
+	order                  *CreateOrderService
+	computeCommissionRates *bool
+}
+
+// Order set the order to test
+func (s *TestOrderService) Order(order *CreateOrderService) *TestOrderService {
+	s.order = order
+	return s
+}
+
+// ComputeCommissionRates set computeCommissionRates, the commission rates of
+// the order are then returned
+func (s *TestOrderService) ComputeCommissionRates(computeCommissionRates bool) *TestOrderService {
+	s.computeCommissionRates = &computeCommissionRates
+	return s
+}
+
+// Do send request
+func (s *TestOrderService) Do(ctx context.Context, opts ...RequestOption) (res *TestOrderResponse, err error) {
+	if s.order == nil {
+		return nil, errors.New("order must be set")
+	}
+	r := s.order.newRequest("/api/v3/order/test")
+	r.service = "TestOrderService"
+	if s.computeCommissionRates != nil {
+		r.setFormParam("computeCommissionRates", *s.computeCommissionRates)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(TestOrderResponse)
+	err = jsonCodec.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
 // CancelOCOService cancel all active orders on the list order.
 type CancelOCOService struct {
 	c                 *Client
@@ -0,0 +1,170 @@
+package binance
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/shopspring/decimal"
+	"github.com/ward-cap/go-binance/common"
+)
+
+// OrderValidator normalize orders to the exchange filters of their symbol and
+// check them before they are sent, so filter failures (-1013) are caught
+// client side. Violations are returned as a *common.FilterError.
+type OrderValidator struct {
+	// PriceRounding round price and stopPrice to tickSize
+	PriceRounding common.RoundingMode
+	// QuantityRounding round quantity and icebergQty to stepSize
+	QuantityRounding common.RoundingMode
+
+	mu         sync.RWMutex
+	symbols    map[string]*SymbolInfo
+	registry   *SymbolRegistry
+	avgPrices  map[string]decimal.Decimal
+	openOrders map[string]int
+}
+
+// NewOrderValidator init an order validator with the symbols of exchangeInfo.
+// Prices are rounded half up and quantities down.
+func NewOrderValidator(info *ExchangeInfo) *OrderValidator {
+	v := &OrderValidator{
+		PriceRounding:    common.RoundHalfUp,
+		QuantityRounding: common.RoundDown,
+		avgPrices:        map[string]decimal.Decimal{},
+		openOrders:       map[string]int{},
+	}
+	v.SetExchangeInfo(info)
+	return v
+}
+
+// NewRegistryOrderValidator init an order validator reading the symbols of
+// r, so it follows the refreshes of the registry and shares its parsed
+// filters. Prices are rounded half up and quantities down.
+func NewRegistryOrderValidator(r *SymbolRegistry) *OrderValidator {
+	v := NewOrderValidator(&ExchangeInfo{})
+	v.registry = r
+	return v
+}
+
+// SetExchangeInfo replace the cached symbols, their filters are parsed once
+// here. A validator reading a SymbolRegistry ignores them.
+func (v *OrderValidator) SetExchangeInfo(info *ExchangeInfo) {
+	symbols := make(map[string]*SymbolInfo, len(info.Symbols))
+	for _, s := range info.Symbols {
+		symbols[s.Symbol] = newSymbolInfo(s)
+	}
+	v.mu.Lock()
+	v.symbols = symbols
+	v.mu.Unlock()
+}
+
+func (v *OrderValidator) symbol(name string) (*SymbolInfo, bool) {
+	if v.registry != nil {
+		return v.registry.Get(name)
+	}
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+	sym, ok := v.symbols[name]
+	return sym, ok
+}
+
+// SetAveragePrice set the average price of symbol, used by the
+// PERCENT_PRICE_BY_SIDE filter and for the notional of market orders. Those
+// checks are skipped until it is set.
+func (v *OrderValidator) SetAveragePrice(symbol string, price decimal.Decimal) {
+	v.mu.Lock()
+	v.avgPrices[symbol] = price
+	v.mu.Unlock()
+}
+
+// SetOpenOrders set the number of open orders on symbol, used by the
+// MAX_NUM_ORDERS filter. The check is skipped until it is set.
+func (v *OrderValidator) SetOpenOrders(symbol string, openOrders int) {
+	v.mu.Lock()
+	v.openOrders[symbol] = openOrders
+	v.mu.Unlock()
+}
+
+// Validate round the price, stopPrice, quantity and icebergQty of s in place
+// and check s against the filters of its symbol
+func (v *OrderValidator) Validate(s *CreateOrderService) error {
+	sym, ok := v.symbol(s.symbol)
+	v.mu.RLock()
+	avgPrice, hasAvgPrice := v.avgPrices[s.symbol]
+	openOrders, hasOpenOrders := v.openOrders[s.symbol]
+	v.mu.RUnlock()
+	if !ok {
+		return fmt.Errorf("binance: symbol %s not found in exchangeInfo", s.symbol)
+	}
+
+	var vs common.FilterViolations
+	var price, quantity decimal.Decimal
+	var hasPrice, hasQuantity bool
+	if f := sym.Price; f != nil {
+		filter := string(SymbolFilterTypePriceFilter)
+		min, max, tick := common.ParseFilterDecimal(f.MinPrice), common.ParseFilterDecimal(f.MaxPrice), common.ParseFilterDecimal(f.TickSize)
+		price, hasPrice = vs.Step(filter, "price", s.price, min, max, tick, v.PriceRounding)
+		vs.Step(filter, "stopPrice", s.stopPrice, min, max, tick, v.PriceRounding)
+	} else if s.price != nil {
+		price, hasPrice = vs.Parse(string(SymbolFilterTypePriceFilter), "price", *s.price)
+	}
+	if f := sym.LotSize; f != nil {
+		filter := string(SymbolFilterTypeLotSize)
+		min, max, step := common.ParseFilterDecimal(f.MinQuantity), common.ParseFilterDecimal(f.MaxQuantity), common.ParseFilterDecimal(f.StepSize)
+		quantity, hasQuantity = vs.Step(filter, "quantity", s.quantity, min, max, step, v.QuantityRounding)
+		vs.Step(filter, "icebergQty", s.icebergQuantity, min, max, step, v.QuantityRounding)
+	} else if s.quantity != nil {
+		quantity, hasQuantity = vs.Parse(string(SymbolFilterTypeLotSize), "quantity", *s.quantity)
+	}
+
+	market := s.orderType == OrderTypeMarket
+	if f := sym.MarketLotSize; f != nil && market && hasQuantity {
+		min, max, step := common.ParseFilterDecimal(f.MinQuantity), common.ParseFilterDecimal(f.MaxQuantity), common.ParseFilterDecimal(f.StepSize)
+		quantity, hasQuantity = vs.Step(string(SymbolFilterTypeMarketLotSize), "quantity", s.quantity, min, max, step, v.QuantityRounding)
+	}
+
+	if f := sym.Notional; f != nil {
+		filter := string(SymbolFilterTypeNotional)
+		min, max := common.ParseFilterDecimal(f.MinNotional), common.ParseFilterDecimal(f.MaxNotional)
+		var notional decimal.Decimal
+		var hasNotional bool
+		switch {
+		case market && s.quoteOrderQty != nil:
+			notional, hasNotional = vs.Parse(filter, "quoteOrderQty", *s.quoteOrderQty)
+		case market && hasQuantity && hasAvgPrice:
+			notional, hasNotional = quantity.Mul(avgPrice), true
+		case !market && hasQuantity && hasPrice:
+			notional, hasNotional = quantity.Mul(price), true
+		}
+		if hasNotional {
+			if !market || f.ApplyMinToMarket {
+				vs.Min(filter, "notional", notional, min)
+			}
+			if !market || f.ApplyMaxToMarket {
+				vs.Max(filter, "notional", notional, max)
+			}
+		}
+	}
+
+	if f := sym.PercentPriceBySide; f != nil && hasPrice && hasAvgPrice && !market {
+		up, down := f.BidMultiplierUp, f.BidMultiplierDown
+		if s.side == SideTypeSell {
+			up, down = f.AskMultiplierUp, f.AskMultiplierDown
+		}
+		filter := string(SymbolFilterTypePercentPriceBySide)
+		vs.Min(filter, "price", price, avgPrice.Mul(common.ParseFilterDecimal(down)))
+		vs.Max(filter, "price", price, avgPrice.Mul(common.ParseFilterDecimal(up)))
+	}
+
+	if f := sym.IcebergParts; f != nil && s.icebergQuantity != nil && hasQuantity {
+		if icebergQty := common.ParseFilterDecimal(*s.icebergQuantity); icebergQty.Sign() > 0 {
+			parts := quantity.Div(icebergQty).Ceil()
+			vs.Max(string(SymbolFilterTypeIcebergParts), "icebergParts", parts, decimal.NewFromInt(int64(f.Limit)))
+		}
+	}
+
+	if f := sym.MaxNumOrders; f != nil && hasOpenOrders {
+		vs.Max(string(SymbolFilterTypeMaxNumOrders), "openOrders", decimal.NewFromInt(int64(openOrders+1)), decimal.NewFromInt(int64(f.MaxNumOrders)))
+	}
+	return vs.Err(s.symbol)
+}
@@ -0,0 +1,172 @@
+package binance
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/ward-cap/go-binance/common"
+)
+
+const validatorExchangeInfo = `{"symbols":[
+	{"symbol":"BTCUSDT","status":"TRADING","baseAsset":"BTC","quoteAsset":"USDT","filters":[
+		{"filterType":"PRICE_FILTER","minPrice":"0.01","maxPrice":"1000000","tickSize":"0.01"},
+		{"filterType":"LOT_SIZE","minQty":"0.001","maxQty":"1000","stepSize":"0.001"},
+		{"filterType":"NOTIONAL","minNotional":"10","applyMinToMarket":true,"maxNotional":"1000000","applyMaxToMarket":false,"avgPriceMins":5},
+		{"filterType":"PERCENT_PRICE_BY_SIDE","bidMultiplierUp":"1.1","bidMultiplierDown":"0.9","askMultiplierUp":"1.2","askMultiplierDown":"0.8","avgPriceMins":5}
+	]},
+	{"symbol":"ETHUSDT","status":"TRADING","baseAsset":"ETH","quoteAsset":"USDT","filters":[
+		{"filterType":"LOT_SIZE","minQty":"0.001","maxQty":"1000","stepSize":"0.001"},
+		{"filterType":"NOTIONAL","minNotional":"10","applyMinToMarket":false,"maxNotional":"1000000","applyMaxToMarket":false,"avgPriceMins":5}
+	]}
+]}`
+
+func newTestOrderValidator(t *testing.T) *OrderValidator {
+	t.Helper()
+	info := new(ExchangeInfo)
+	if err := jsonCodec.Unmarshal([]byte(validatorExchangeInfo), info); err != nil {
+		t.Fatal(err)
+	}
+	return NewOrderValidator(info)
+}
+
+func newLimitOrder(symbol string, side SideType, price, quantity string) *CreateOrderService {
+	return (&CreateOrderService{}).Symbol(symbol).Side(side).Type(OrderTypeLimit).
+		TimeInForce(TimeInForceTypeGTC).Price(price).Quantity(quantity)
+}
+
+func newMarketOrder(symbol string, quantity string) *CreateOrderService {
+	return (&CreateOrderService{}).Symbol(symbol).Side(SideTypeBuy).Type(OrderTypeMarket).Quantity(quantity)
+}
+
+// violations return the filter and field of every violation of err
+func violations(t *testing.T, err error) []string {
+	t.Helper()
+	if err == nil {
+		return nil
+	}
+	var filterErr *common.FilterError
+	if !errors.As(err, &filterErr) {
+		t.Fatalf("error = %v, want a *common.FilterError", err)
+	}
+	res := make([]string, len(filterErr.Violations))
+	for i, v := range filterErr.Violations {
+		res[i] = v.Filter + " " + v.Field
+	}
+	return res
+}
+
+func TestOrderValidatorRounding(t *testing.T) {
+	for _, tt := range []struct {
+		mode     common.RoundingMode
+		price    string
+		quantity string
+	}{
+		{common.RoundDown, "100", "1"},
+		{common.RoundUp, "100.01", "1.001"},
+		{common.RoundHalfUp, "100", "1.001"},
+	} {
+		v := newTestOrderValidator(t)
+		v.PriceRounding, v.QuantityRounding = tt.mode, tt.mode
+		s := newLimitOrder("BTCUSDT", SideTypeBuy, "100.0049", "1.0005")
+		if err := v.Validate(s); err != nil {
+			t.Fatalf("mode %d: %v", tt.mode, err)
+		}
+		if !decimal.RequireFromString(*s.price).Equal(decimal.RequireFromString(tt.price)) {
+			t.Fatalf("mode %d: price = %s, want %s", tt.mode, *s.price, tt.price)
+		}
+		if !decimal.RequireFromString(*s.quantity).Equal(decimal.RequireFromString(tt.quantity)) {
+			t.Fatalf("mode %d: quantity = %s, want %s", tt.mode, *s.quantity, tt.quantity)
+		}
+	}
+}
+
+func TestOrderValidatorNotional(t *testing.T) {
+	v := newTestOrderValidator(t)
+	v.SetAveragePrice("BTCUSDT", decimal.NewFromInt(100))
+	v.SetAveragePrice("ETHUSDT", decimal.NewFromInt(100))
+
+	for _, tt := range []struct {
+		name  string
+		order *CreateOrderService
+		want  []string
+	}{
+		{"limit below min", newLimitOrder("BTCUSDT", SideTypeBuy, "100", "0.05"), []string{"NOTIONAL notional"}},
+		{"limit above min", newLimitOrder("BTCUSDT", SideTypeBuy, "100", "0.2"), nil},
+		{"market below min, applyMinToMarket", newMarketOrder("BTCUSDT", "0.05"), []string{"NOTIONAL notional"}},
+		{"market below min, quoteOrderQty", (&CreateOrderService{}).Symbol("BTCUSDT").Side(SideTypeBuy).Type(OrderTypeMarket).QuoteOrderQty("5"), []string{"NOTIONAL notional"}},
+		{"market below min, no applyMinToMarket", newMarketOrder("ETHUSDT", "0.05"), nil},
+		{"limit below min, no applyMinToMarket", newLimitOrder("ETHUSDT", SideTypeBuy, "100", "0.05"), []string{"NOTIONAL notional"}},
+	} {
+		got := violations(t, v.Validate(tt.order))
+		if len(got) != len(tt.want) || (len(got) > 0 && got[0] != tt.want[0]) {
+			t.Fatalf("%s: violations = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+
+	// without an average price the notional of a market order is unknown
+	v = newTestOrderValidator(t)
+	if err := v.Validate(newMarketOrder("BTCUSDT", "0.05")); err != nil {
+		t.Fatalf("market order without average price: %v", err)
+	}
+}
+
+func TestOrderValidatorPercentPriceBySide(t *testing.T) {
+	v := newTestOrderValidator(t)
+	v.SetAveragePrice("BTCUSDT", decimal.NewFromInt(100))
+	for _, tt := range []struct {
+		side  SideType
+		price string
+		ok    bool
+	}{
+		{SideTypeBuy, "105", true},
+		{SideTypeBuy, "110", true},
+		{SideTypeBuy, "110.01", false},
+		{SideTypeBuy, "89.99", false},
+		{SideTypeSell, "119", true},
+		{SideTypeSell, "120.01", false},
+		{SideTypeSell, "80", true},
+		{SideTypeSell, "79.99", false},
+	} {
+		// the band is only checked once the average price is known
+		if err := newTestOrderValidator(t).Validate(newLimitOrder("BTCUSDT", tt.side, tt.price, "1")); err != nil {
+			t.Fatalf("%s %s without average price: %v", tt.side, tt.price, err)
+		}
+
+		got := violations(t, v.Validate(newLimitOrder("BTCUSDT", tt.side, tt.price, "1")))
+		if tt.ok != (len(got) == 0) {
+			t.Fatalf("%s %s: violations = %v, want ok %v", tt.side, tt.price, got, tt.ok)
+		}
+		if !tt.ok && got[0] != "PERCENT_PRICE_BY_SIDE price" {
+			t.Fatalf("%s %s: violations = %v", tt.side, tt.price, got)
+		}
+	}
+}
+
+func TestRegistryOrderValidator(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(validatorExchangeInfo))
+	}))
+	defer srv.Close()
+	c := NewClient("", "", nil)
+	c.SetEnvironment(Environment{BaseURL: srv.URL})
+
+	registry := c.NewSymbolRegistry()
+	v := NewRegistryOrderValidator(registry)
+	if err := v.Validate(newLimitOrder("BTCUSDT", SideTypeBuy, "100", "1")); err == nil {
+		t.Fatal("validated an order before the registry was loaded")
+	}
+	if err := registry.Refresh(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	s := newLimitOrder("BTCUSDT", SideTypeBuy, "100.004", "1")
+	if err := v.Validate(s); err != nil {
+		t.Fatalf("Validate: %v", err)
+	}
+	if *s.price != "100" {
+		t.Fatalf("price = %s, want 100", *s.price)
+	}
+}
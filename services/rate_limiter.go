@@ -0,0 +1,131 @@
+package binance
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/ward-cap/go-binance/common"
+)
+
+// endpointWeights lists the request weight of /api endpoints, keyed by
+// method and endpoint. Endpoints missing here weigh 1.
+var endpointWeights = map[string]int64{
+	"GET /api/v3/exchangeInfo":             20,
+	"GET /api/v3/trades":                   25,
+	"GET /api/v3/historicalTrades":         25,
+	"GET /api/v3/aggTrades":                2,
+	"GET /api/v3/klines":                   2,
+	"GET /api/v3/uiKlines":                 2,
+	"GET /api/v3/avgPrice":                 2,
+	"GET /api/v3/order":                    4,
+	"GET /api/v3/allOrders":                20,
+	"GET /api/v3/orderList":                4,
+	"GET /api/v3/allOrderList":             20,
+	"GET /api/v3/openOrderList":            6,
+	"GET /api/v3/account":                  20,
+	"GET /api/v3/myTrades":                 20,
+	"GET /api/v3/myPreventedMatches":       4,
+	"GET /api/v3/myAllocations":            20,
+	"GET /api/v3/account/commission":       20,
+	"GET /api/v3/rateLimit/order":          40,
+	"POST /api/v3/userDataStream":          2,
+	"PUT /api/v3/userDataStream":           2,
+	"DELETE /api/v3/userDataStream":        2,
+	"POST /api/v3/order/test":              1,
+	"POST /api/v3/order/cancelReplace":     1,
+	"PUT /api/v3/order/amend/keepPriority": 4,
+}
+
+// endpointOrders lists the endpoints counting against the order rate limits
+var endpointOrders = map[string]int64{
+	"POST /api/v3/order":               1,
+	"POST /api/v3/order/oco":           2,
+	"POST /api/v3/orderList/oco":       2,
+	"POST /api/v3/orderList/oto":       2,
+	"POST /api/v3/orderList/otoco":     3,
+	"POST /api/v3/sor/order":           1,
+	"POST /api/v3/order/cancelReplace": 1,
+}
+
+// requestCost return the weight and order count of r. Only /api endpoints
+// count against the exchangeInfo limits, /sapi endpoints have their own
+// per-endpoint limits and get the zero cost.
+func requestCost(r *request) common.RequestCost {
+	if !strings.HasPrefix(r.endpoint, "/api/") {
+		return common.RequestCost{}
+	}
+	key := r.method + " " + r.endpoint
+	cost := common.RequestCost{Requests: 1, Weight: 1, Orders: endpointOrders[key]}
+	if weight, ok := endpointWeights[key]; ok {
+		cost.Weight = weight
+	}
+
+	hasSymbol := r.query.Get("symbol") != "" || r.query.Get("symbols") != ""
+	switch key {
+	case "GET /api/v3/depth":
+		cost.Weight = depthWeight(r.query.Get("limit"))
+	case "GET /api/v3/ticker/24hr":
+		if !hasSymbol {
+			cost.Weight = 80
+		} else {
+			cost.Weight = 2
+		}
+	case "GET /api/v3/ticker/price", "GET /api/v3/ticker/bookTicker":
+		if !hasSymbol {
+			cost.Weight = 4
+		} else {
+			cost.Weight = 2
+		}
+	case "GET /api/v3/openOrders":
+		if !hasSymbol {
+			cost.Weight = 80
+		} else {
+			cost.Weight = 6
+		}
+	case "POST /api/v3/order/test":
+		if r.param("computeCommissionRates") == "true" {
+			cost.Weight = 20
+		}
+	}
+	return cost
+}
+
+func depthWeight(limit string) int64 {
+	n, err := strconv.Atoi(limit)
+	if err != nil {
+		n = 100
+	}
+	switch {
+	case n <= 100:
+		return 5
+	case n <= 500:
+		return 25
+	case n <= 1000:
+		return 50
+	default:
+		return 250
+	}
+}
+
+// EnableRateLimiter fetch the rate limits from exchangeInfo and throttle
+// every later request with them. In fail fast mode requests that would exceed
+// a limit return a *common.RateLimitError instead of waiting. Call it before
+// the client is shared between goroutines.
+func (c *Client) EnableRateLimiter(ctx context.Context, failFast bool) error {
+	info, err := c.NewExchangeInfoService().Do(ctx)
+	if err != nil {
+		return err
+	}
+	limits := make([]common.RateLimit, len(info.RateLimits))
+	for i, limit := range info.RateLimits {
+		limits[i] = common.RateLimit{
+			RateLimitType: limit.RateLimitType,
+			Interval:      limit.Interval,
+			IntervalNum:   limit.IntervalNum,
+			Limit:         limit.Limit,
+		}
+	}
+	c.RateLimiter = common.NewRateLimiter(limits, failFast)
+	return nil
+}
@@ -0,0 +1,54 @@
+package binance
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ward-cap/go-binance/common"
+)
+
+func TestRateLimiterSkipsSapi(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/sapi/v1/account/apiRestrictions" {
+			// /sapi reports its own usage and limits, they must not reach
+			// the /api windows
+			w.Header().Set("X-SAPI-USED-IP-WEIGHT-1M", "500")
+			w.Header().Set("X-MBX-USED-WEIGHT-1M", "500")
+			w.WriteHeader(http.StatusTooManyRequests)
+			_, _ = w.Write([]byte(`{"code":-1003,"msg":"Too many requests."}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+
+	c := NewClient("api-key", "secret", nil)
+	c.SetEnvironment(Environment{BaseURL: srv.URL})
+	c.RateLimiter = common.NewRateLimiter([]common.RateLimit{
+		{RateLimitType: common.RateLimitTypeRawRequests, Interval: "MINUTE", IntervalNum: 1, Limit: 2},
+		{RateLimitType: common.RateLimitTypeRequestWeight, Interval: "MINUTE", IntervalNum: 1, Limit: 100},
+	}, true)
+
+	for i := 0; i < 3; i++ {
+		_, err := c.NewGetAPIKeyPermission().Do(context.Background())
+		var apiErr *common.APIError
+		if !errors.As(err, &apiErr) || apiErr.Code != common.ErrCodeTooManyRequests {
+			t.Fatalf("sapi request %d: error = %v, want the -1003 of the server", i, err)
+		}
+	}
+	if !c.RateLimiter.BannedUntil().IsZero() {
+		t.Fatal("a /sapi 429 started a backoff of the /api limits")
+	}
+
+	for i := 0; i < 2; i++ {
+		if err := c.NewPingService().Do(context.Background()); err != nil {
+			t.Fatalf("ping %d: %v", i, err)
+		}
+	}
+	if err := c.NewPingService().Do(context.Background()); !errors.Is(err, common.ErrRateLimited) {
+		t.Fatalf("third ping error = %v, want the RAW_REQUESTS limit", err)
+	}
+}
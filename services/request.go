@@ -125,3 +125,11 @@ func WithHeaders(header http.Header) RequestOption {
 		r.header = header.Clone()
 	}
 }
+
+// param return the value of key from the form or the query string
+func (r *request) param(key string) string {
+	if v := r.form.Get(key); v != "" {
+		return v
+	}
+	return r.query.Get(key)
+}
@@ -0,0 +1,93 @@
+package binance
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/ward-cap/go-binance/common"
+)
+
+// retryOrderEndpoints lists the order placement endpoints retried when the
+// order carries a newClientOrderId. The order is first queried with GET on
+// the same endpoint so it is never placed twice; when found, a placement
+// response marked Recovered is built from the queried order.
+var retryOrderEndpoints = map[string]bool{
+	"/api/v3/order":         true,
+	"/sapi/v1/margin/order": true,
+}
+
+// retrySafe report whether r may be sent again after failing with err
+func retrySafe(r *request, err error) bool {
+	if r.method == http.MethodGet || isTimestampError(err) {
+		return true
+	}
+	return r.method == http.MethodPost && retryOrderEndpoints[r.endpoint] && r.param("newClientOrderId") != ""
+}
+
+// isTimestampError report whether the request was rejected for a timestamp
+// outside recvWindow, such a request was never executed
+func isTimestampError(err error) bool {
+	return errors.Is(err, common.ErrInvalidTimestamp)
+}
+
+// lookupOrder query the order placed by r using its newClientOrderId, data
+// is the placement response built from the queried order
+func (c *Client) lookupOrder(ctx context.Context, r *request) (data []byte, found bool, err error) {
+	q := &request{
+		service:    r.service,
+		method:     http.MethodGet,
+		endpoint:   r.endpoint,
+		secType:    secTypeSigned,
+		recvWindow: r.recvWindow,
+	}
+	q.setParam("symbol", r.param("symbol"))
+	q.setParam("origClientOrderId", r.param("newClientOrderId"))
+	if isIsolated := r.param("isIsolated"); isIsolated != "" {
+		q.setParam("isIsolated", isIsolated)
+	}
+	if err = c.parseRequest(q); err != nil {
+		return nil, false, err
+	}
+	data, _, err = c.doRequest(ctx, q)
+	if errors.Is(err, common.ErrNoSuchOrder) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	order := new(Order)
+	if err = jsonCodec.Unmarshal(data, order); err != nil {
+		return nil, false, err
+	}
+	data, err = jsonCodec.Marshal(recoveredOrderResponse(order))
+	if err != nil {
+		return nil, false, err
+	}
+	return data, true, nil
+}
+
+// recoveredOrderResponse convert a queried order to the placement response
+// of the order, the fills are not known
+func recoveredOrderResponse(o *Order) *CreateOrderResponse {
+	res := &CreateOrderResponse{
+		Symbol:                   o.Symbol,
+		OrderID:                  o.OrderID,
+		ClientOrderID:            o.ClientOrderID,
+		TransactTime:             o.Time,
+		Price:                    o.Price.String(),
+		OrigQuantity:             o.OrigQuantity.String(),
+		CummulativeQuoteQuantity: o.CummulativeQuoteQuantity,
+		IsIsolated:               o.IsIsolated,
+		Status:                   o.Status,
+		TimeInForce:              o.TimeInForce,
+		Type:                     o.Type,
+		Side:                     o.Side,
+		Fills:                    []*Fill{},
+		Recovered:                true,
+	}
+	if o.ExecutedQuantity.Valid {
+		res.ExecutedQuantity = o.ExecutedQuantity.Decimal.String()
+	}
+	return res
+}
@@ -3,6 +3,9 @@ package binance
 import (
 	"context"
 	"net/http"
+	"sync/atomic"
+
+	"github.com/ward-cap/go-binance/common"
 )
 
 // PingService ping server
@@ -47,11 +50,10 @@ type SetServerTimeService struct {
 
 // Do send request
 func (s *SetServerTimeService) Do(ctx context.Context) (timeOffset int64, err error) {
-	serverTime, err := s.c.NewServerTimeService().Do(ctx)
+	sample, err := common.MeasureTimeOffset(ctx, s.c.serverTime)
 	if err != nil {
 		return 0, err
 	}
-	timeOffset = currentTimestamp() - serverTime
-	s.c.TimeOffset = timeOffset
-	return timeOffset, nil
+	atomic.StoreInt64(&s.c.TimeOffset, sample.Offset)
+	return sample.Offset, nil
 }
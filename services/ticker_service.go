@@ -18,8 +18,7 @@ func (s *ListBookTickersService) Symbol(symbol string) *ListBookTickersService {
 	return s
 }
 
-// Do send request
-func (s *ListBookTickersService) Do(ctx context.Context, opts ...RequestOption) (res []*BookTicker, err error) {
+func (s *ListBookTickersService) newRequest() *request {
 	r := &request{
 		service:  "ListBookTickersService",
 		method:   http.MethodGet,
@@ -28,7 +27,12 @@ func (s *ListBookTickersService) Do(ctx context.Context, opts ...RequestOption)
 	if s.symbol != nil {
 		r.setParam("symbol", *s.symbol)
 	}
-	data, err := s.c.callAPI(ctx, r, opts...)
+	return r
+}
+
+// Do send request
+func (s *ListBookTickersService) Do(ctx context.Context, opts ...RequestOption) (res []*BookTicker, err error) {
+	data, err := s.c.callAPI(ctx, s.newRequest(), opts...)
 	data = common.ToJSONList(data)
 	if err != nil {
 		return []*BookTicker{}, err
@@ -41,6 +45,21 @@ func (s *ListBookTickersService) Do(ctx context.Context, opts ...RequestOption)
 	return res, nil
 }
 
+// DoV2 send request and return the decimal typed BookTickerV2
+func (s *ListBookTickersService) DoV2(ctx context.Context, opts ...RequestOption) (res []*BookTickerV2, err error) {
+	data, err := s.c.callAPI(ctx, s.newRequest(), opts...)
+	if err != nil {
+		return []*BookTickerV2{}, err
+	}
+	data = common.ToJSONList(data)
+	res = make([]*BookTickerV2, 0)
+	err = jsonCodec.Unmarshal(data, &res)
+	if err != nil {
+		return []*BookTickerV2{}, err
+	}
+	return res, nil
+}
+
 // ListPricesService list latest price for a symbol or symbols
 type ListPricesService struct {
 	c       *Client
@@ -54,8 +73,7 @@ func (s *ListPricesService) Symbol(symbol string) *ListPricesService {
 	return s
 }
 
-// Do send request
-func (s *ListPricesService) Do(ctx context.Context, opts ...RequestOption) (res []*SymbolPrice, err error) {
+func (s *ListPricesService) newRequest() *request {
 	r := &request{
 		service:  "ListPricesService",
 		method:   http.MethodGet,
@@ -67,7 +85,12 @@ func (s *ListPricesService) Do(ctx context.Context, opts ...RequestOption) (res
 		s, _ := jsonCodec.Marshal(s.symbols)
 		r.setParam("symbols", string(s))
 	}
-	data, err := s.c.callAPI(ctx, r, opts...)
+	return r
+}
+
+// Do send request
+func (s *ListPricesService) Do(ctx context.Context, opts ...RequestOption) (res []*SymbolPrice, err error) {
+	data, err := s.c.callAPI(ctx, s.newRequest(), opts...)
 	if err != nil {
 		return []*SymbolPrice{}, err
 	}
@@ -80,6 +103,21 @@ func (s *ListPricesService) Do(ctx context.Context, opts ...RequestOption) (res
 	return res, nil
 }
 
+// DoV2 send request and return the decimal typed SymbolPriceV2
+func (s *ListPricesService) DoV2(ctx context.Context, opts ...RequestOption) (res []*SymbolPriceV2, err error) {
+	data, err := s.c.callAPI(ctx, s.newRequest(), opts...)
+	if err != nil {
+		return []*SymbolPriceV2{}, err
+	}
+	data = common.ToJSONList(data)
+	res = make([]*SymbolPriceV2, 0)
+	err = jsonCodec.Unmarshal(data, &res)
+	if err != nil {
+		return []*SymbolPriceV2{}, err
+	}
+	return res, nil
+}
+
 // ListPriceChangeStatsService show stats of price change in last 24 hours for all symbols
 type ListPriceChangeStatsService struct {
 	c       *Client
@@ -105,8 +143,7 @@ func (s *ListPricesService) Symbols(symbols []string) *ListPricesService {
 	return s
 }
 
-// Do send request
-func (s *ListPriceChangeStatsService) Do(ctx context.Context, opts ...RequestOption) (res []*PriceChangeStats, err error) {
+func (s *ListPriceChangeStatsService) newRequest() *request {
 	r := &request{
 		service:  "ListPriceChangeStatsService",
 		method:   http.MethodGet,
@@ -118,8 +155,12 @@ func (s *ListPriceChangeStatsService) Do(ctx context.Context, opts ...RequestOpt
 	} else if s.symbols != nil {
 		r.setParam("symbols", s.symbols)
 	}
+	return r
+}
 
-	data, err := s.c.callAPI(ctx, r, opts...)
+// Do send request
+func (s *ListPriceChangeStatsService) Do(ctx context.Context, opts ...RequestOption) (res []*PriceChangeStats, err error) {
+	data, err := s.c.callAPI(ctx, s.newRequest(), opts...)
 	if err != nil {
 		return res, err
 	}
@@ -132,6 +173,21 @@ func (s *ListPriceChangeStatsService) Do(ctx context.Context, opts ...RequestOpt
 	return res, nil
 }
 
+// DoV2 send request and return the decimal typed PriceChangeStatsV2
+func (s *ListPriceChangeStatsService) DoV2(ctx context.Context, opts ...RequestOption) (res []*PriceChangeStatsV2, err error) {
+	data, err := s.c.callAPI(ctx, s.newRequest(), opts...)
+	if err != nil {
+		return []*PriceChangeStatsV2{}, err
+	}
+	data = common.ToJSONList(data)
+	res = make([]*PriceChangeStatsV2, 0)
+	err = jsonCodec.Unmarshal(data, &res)
+	if err != nil {
+		return []*PriceChangeStatsV2{}, err
+	}
+	return res, nil
+}
+
 // AveragePriceService show current average price for a symbol
 type AveragePriceService struct {
 	c      *Client
@@ -52,8 +52,7 @@ func (s *ListTradesService) OrderId(OrderId int64) *ListTradesService {
 	return s
 }
 
-// Do send request
-func (s *ListTradesService) Do(ctx context.Context, opts ...RequestOption) (res []*TradeV3, err error) {
+func (s *ListTradesService) newRequest() *request {
 	r := &request{
 		service:  "ListTradesService",
 		method:   http.MethodGet,
@@ -76,7 +75,12 @@ func (s *ListTradesService) Do(ctx context.Context, opts ...RequestOption) (res
 	if s.orderId != nil {
 		r.setParam("orderId", *s.orderId)
 	}
-	data, err := s.c.callAPI(ctx, r, opts...)
+	return r
+}
+
+// Do send request
+func (s *ListTradesService) Do(ctx context.Context, opts ...RequestOption) (res []*TradeV3, err error) {
+	data, err := s.c.callAPI(ctx, s.newRequest(), opts...)
 	if err != nil {
 		return []*TradeV3{}, err
 	}
@@ -88,6 +92,20 @@ func (s *ListTradesService) Do(ctx context.Context, opts ...RequestOption) (res
 	return res, nil
 }
 
+// DoV2 send request and return the decimal typed AccountTradeV2
+func (s *ListTradesService) DoV2(ctx context.Context, opts ...RequestOption) (res []*AccountTradeV2, err error) {
+	data, err := s.c.callAPI(ctx, s.newRequest(), opts...)
+	if err != nil {
+		return []*AccountTradeV2{}, err
+	}
+	res = make([]*AccountTradeV2, 0)
+	err = jsonCodec.Unmarshal(data, &res)
+	if err != nil {
+		return []*AccountTradeV2{}, err
+	}
+	return res, nil
+}
+
 // HistoricalTradesService trades
 type HistoricalTradesService struct {
 	c      *Client
@@ -114,8 +132,7 @@ func (s *HistoricalTradesService) FromID(fromID int64) *HistoricalTradesService
 	return s
 }
 
-// Do send request
-func (s *HistoricalTradesService) Do(ctx context.Context, opts ...RequestOption) (res []*Trade, err error) {
+func (s *HistoricalTradesService) newRequest() *request {
 	r := &request{
 		service:  "HistoricalTradesService",
 		method:   http.MethodGet,
@@ -129,8 +146,12 @@ func (s *HistoricalTradesService) Do(ctx context.Context, opts ...RequestOption)
 	if s.fromID != nil {
 		r.setParam("fromId", *s.fromID)
 	}
+	return r
+}
 
-	data, err := s.c.callAPI(ctx, r, opts...)
+// Do send request
+func (s *HistoricalTradesService) Do(ctx context.Context, opts ...RequestOption) (res []*Trade, err error) {
+	data, err := s.c.callAPI(ctx, s.newRequest(), opts...)
 	if err != nil {
 		return
 	}
@@ -142,6 +163,20 @@ func (s *HistoricalTradesService) Do(ctx context.Context, opts ...RequestOption)
 	return
 }
 
+// DoV2 send request and return the decimal typed TradeV2
+func (s *HistoricalTradesService) DoV2(ctx context.Context, opts ...RequestOption) (res []*TradeV2, err error) {
+	data, err := s.c.callAPI(ctx, s.newRequest(), opts...)
+	if err != nil {
+		return []*TradeV2{}, err
+	}
+	res = make([]*TradeV2, 0)
+	err = jsonCodec.Unmarshal(data, &res)
+	if err != nil {
+		return []*TradeV2{}, err
+	}
+	return res, nil
+}
+
 // AggTradesService list aggregate trades
 type AggTradesService struct {
 	c         *Client
@@ -182,8 +217,7 @@ func (s *AggTradesService) Limit(limit int) *AggTradesService {
 	return s
 }
 
-// Do send request
-func (s *AggTradesService) Do(ctx context.Context, opts ...RequestOption) (res []*AggTrade, err error) {
+func (s *AggTradesService) newRequest() *request {
 	r := &request{
 		service:  "AggTradesService",
 		method:   http.MethodGet,
@@ -202,7 +236,12 @@ func (s *AggTradesService) Do(ctx context.Context, opts ...RequestOption) (res [
 	if s.limit != nil {
 		r.setParam("limit", *s.limit)
 	}
-	data, err := s.c.callAPI(ctx, r, opts...)
+	return r
+}
+
+// Do send request
+func (s *AggTradesService) Do(ctx context.Context, opts ...RequestOption) (res []*AggTrade, err error) {
+	data, err := s.c.callAPI(ctx, s.newRequest(), opts...)
 	if err != nil {
 		return []*AggTrade{}, err
 	}
@@ -214,6 +253,20 @@ func (s *AggTradesService) Do(ctx context.Context, opts ...RequestOption) (res [
 	return res, nil
 }
 
+// DoV2 send request and return the decimal typed AggTradeV2
+func (s *AggTradesService) DoV2(ctx context.Context, opts ...RequestOption) (res []*AggTradeV2, err error) {
+	data, err := s.c.callAPI(ctx, s.newRequest(), opts...)
+	if err != nil {
+		return []*AggTradeV2{}, err
+	}
+	res = make([]*AggTradeV2, 0)
+	err = jsonCodec.Unmarshal(data, &res)
+	if err != nil {
+		return []*AggTradeV2{}, err
+	}
+	return res, nil
+}
+
 // RecentTradesService list recent trades
 type RecentTradesService struct {
 	c      *Client
@@ -233,8 +286,7 @@ func (s *RecentTradesService) Limit(limit int) *RecentTradesService {
 	return s
 }
 
-// Do send request
-func (s *RecentTradesService) Do(ctx context.Context, opts ...RequestOption) (res []*Trade, err error) {
+func (s *RecentTradesService) newRequest() *request {
 	r := &request{
 		service:  "RecentTradesService",
 		method:   http.MethodGet,
@@ -244,7 +296,12 @@ func (s *RecentTradesService) Do(ctx context.Context, opts ...RequestOption) (re
 	if s.limit != nil {
 		r.setParam("limit", *s.limit)
 	}
-	data, err := s.c.callAPI(ctx, r, opts...)
+	return r
+}
+
+// Do send request
+func (s *RecentTradesService) Do(ctx context.Context, opts ...RequestOption) (res []*Trade, err error) {
+	data, err := s.c.callAPI(ctx, s.newRequest(), opts...)
 	if err != nil {
 		return []*Trade{}, err
 	}
@@ -255,3 +312,17 @@ func (s *RecentTradesService) Do(ctx context.Context, opts ...RequestOption) (re
 	}
 	return res, nil
 }
+
+// DoV2 send request and return the decimal typed TradeV2
+func (s *RecentTradesService) DoV2(ctx context.Context, opts ...RequestOption) (res []*TradeV2, err error) {
+	data, err := s.c.callAPI(ctx, s.newRequest(), opts...)
+	if err != nil {
+		return []*TradeV2{}, err
+	}
+	res = make([]*TradeV2, 0)
+	err = jsonCodec.Unmarshal(data, &res)
+	if err != nil {
+		return []*TradeV2{}, err
+	}
+	return res, nil
+}
@@ -0,0 +1,146 @@
+package binance
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ward-cap/go-binance/common"
+)
+
+// DefaultListenKeyKeepaliveInterval is how often the listen key is extended,
+// Binance expires a listen key 60 minutes after the last keepalive.
+const DefaultListenKeyKeepaliveInterval = common.DefaultListenKeyKeepaliveInterval
+
+// WsUserDataEvent define websocket user data event. Event tells which of the
+// typed fields is set; unknown event types are delivered with only Event and
+// Time filled in.
+type WsUserDataEvent struct {
+	Event            UserDataEventType
+	Time             int64
+	AccountUpdate    *WsAccountUpdateEvent
+	BalanceUpdate    *WsBalanceUpdateEvent
+	ExecutionReport  *WsExecutionReportEvent
+	ListStatus       *WsListStatusEvent
+	ListenKeyExpired *WsListenKeyExpiredEvent
+}
+
+// UnmarshalJSON decode the event header and the typed event it announces
+func (e *WsUserDataEvent) UnmarshalJSON(data []byte) error {
+	event, err := parseWsUserDataEvent(data)
+	if err != nil {
+		return err
+	}
+	*e = *event
+	return nil
+}
+
+// WsUserDataHandler handle websocket user data event
+type WsUserDataHandler func(event *WsUserDataEvent)
+
+// UserStream consumes the user data stream of a spot, cross margin or
+// isolated margin account. It creates the listen key, keeps it alive, and
+// rotates it when Binance expires it.
+type UserStream struct {
+	c           *Client
+	accountType UserStreamAccountType
+	symbol      string
+	stream      *common.UserStream[*WsUserDataEvent]
+}
+
+// NewUserStream init user data stream consumer, the spot account is used by
+// default. handler may be nil when events are read from the Events channel.
+func (c *Client) NewUserStream(handler WsUserDataHandler, errHandler ErrHandler) *UserStream {
+	s := &UserStream{
+		c:           c,
+		accountType: UserStreamAccountTypeSpot,
+	}
+	cfg := common.UserStreamConfig[*WsUserDataEvent]{
+		BaseWsURL: c.BaseWsURL,
+		Logger:    c.Logger,
+		Package:   "services",
+		Start:     s.startUserStream,
+		Keepalive: s.keepaliveUserStream,
+		Close:     s.closeUserStream,
+		Parse:     parseWsUserDataEvent,
+		Expired: func(event *WsUserDataEvent) bool {
+			return event.Event == UserDataEventTypeListenKeyExpired
+		},
+	}
+	s.stream = common.NewUserStream(cfg, handler, common.WsErrHandler(errHandler))
+	return s
+}
+
+// AccountType set account type
+func (s *UserStream) AccountType(accountType UserStreamAccountType) *UserStream {
+	s.accountType = accountType
+	return s
+}
+
+// Symbol set isolated margin symbol
+func (s *UserStream) Symbol(symbol string) *UserStream {
+	s.symbol = symbol
+	return s
+}
+
+// KeepaliveInterval set the listen key keepalive interval
+func (s *UserStream) KeepaliveInterval(interval time.Duration) *UserStream {
+	s.stream.KeepaliveInterval(interval)
+	return s
+}
+
+// Events set a channel that receives every event after the handler. A send
+// blocks the stream until the channel is read or Run returns.
+func (s *UserStream) Events(events chan<- *WsUserDataEvent) *UserStream {
+	s.stream.Events(events)
+	return s
+}
+
+// ListenKey return the listen key in use, empty when not running
+func (s *UserStream) ListenKey() string {
+	return s.stream.ListenKey()
+}
+
+// Run create the listen key and deliver events until ctx is done. Only a
+// failure to create the first listen key is returned; later failures are
+// reported to the error handler and retried with backoff. On return the
+// listen key is closed and ctx.Err() is returned.
+func (s *UserStream) Run(ctx context.Context) error {
+	if s.accountType == UserStreamAccountTypeIsolatedMargin && s.symbol == "" {
+		return fmt.Errorf("user stream: symbol is required for %s", s.accountType)
+	}
+	return s.stream.Run(ctx)
+}
+
+func (s *UserStream) startUserStream(ctx context.Context) (string, error) {
+	switch s.accountType {
+	case UserStreamAccountTypeMargin:
+		return s.c.NewStartMarginUserStreamService().Do(ctx)
+	case UserStreamAccountTypeIsolatedMargin:
+		return s.c.NewStartIsolatedMarginUserStreamService().Symbol(s.symbol).Do(ctx)
+	default:
+		return s.c.NewStartUserStreamService().Do(ctx)
+	}
+}
+
+func (s *UserStream) keepaliveUserStream(ctx context.Context, listenKey string) error {
+	switch s.accountType {
+	case UserStreamAccountTypeMargin:
+		return s.c.NewKeepaliveMarginUserStreamService().ListenKey(listenKey).Do(ctx)
+	case UserStreamAccountTypeIsolatedMargin:
+		return s.c.NewKeepaliveIsolatedMarginUserStreamService().Symbol(s.symbol).ListenKey(listenKey).Do(ctx)
+	default:
+		return s.c.NewKeepaliveUserStreamService().ListenKey(listenKey).Do(ctx)
+	}
+}
+
+func (s *UserStream) closeUserStream(ctx context.Context, listenKey string) error {
+	switch s.accountType {
+	case UserStreamAccountTypeMargin:
+		return s.c.NewCloseMarginUserStreamService().ListenKey(listenKey).Do(ctx)
+	case UserStreamAccountTypeIsolatedMargin:
+		return s.c.NewCloseIsolatedMarginUserStreamService().Symbol(s.symbol).ListenKey(listenKey).Do(ctx)
+	default:
+		return s.c.NewCloseUserStreamService().ListenKey(listenKey).Do(ctx)
+	}
+}
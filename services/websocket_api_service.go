@@ -0,0 +1,257 @@
+package binance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"sync"
+	"sync/atomic"
+
+	"github.com/ward-cap/go-binance/common"
+)
+
+// wsAPIIntParams lists the parameters the WebSocket API expects as JSON
+// numbers, every other parameter is sent as a string
+var wsAPIIntParams = map[string]bool{
+	"orderId":       true,
+	"orderListId":   true,
+	"recvWindow":    true,
+	"timestamp":     true,
+	"trailingDelta": true,
+	"strategyId":    true,
+	"strategyType":  true,
+	"startTime":     true,
+	"endTime":       true,
+	"fromId":        true,
+	"limit":         true,
+}
+
+// WsAPIClient runs spot services over one persistent WebSocket API connection
+// instead of one HTTP request per call. Signed requests are signed with
+// the client signer unless the session has been authenticated with SessionLogon.
+type WsAPIClient struct {
+	c    *Client
+	conn *common.WsAPIConn
+
+	mu       sync.Mutex
+	loggedOn bool
+}
+
+// NewWsAPIClient init WebSocket API client, the connection is opened by
+// Connect or by the first call
+func (c *Client) NewWsAPIClient() *WsAPIClient {
+	w := &WsAPIClient{c: c}
+	w.conn = common.NewWsAPIConn(common.WsConfig{
+		Endpoint: c.BaseWsAPIURL,
+		Logger:   c.Logger,
+		Package:  "services",
+	}, func(error) {
+		w.setLoggedOn(false)
+	})
+	return w
+}
+
+// Connect open the connection
+func (w *WsAPIClient) Connect(ctx context.Context) error {
+	return w.conn.Connect(ctx)
+}
+
+// Close close the connection
+func (w *WsAPIClient) Close() error {
+	return w.conn.Close()
+}
+
+// LoggedOn report whether the session is authenticated with SessionLogon.
+// The session is reset when the connection drops.
+func (w *WsAPIClient) LoggedOn() bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.loggedOn
+}
+
+// SessionLogon authenticate the connection, later signed requests are sent
+// without apiKey and signature. The client Signer must be a
+// common.Ed25519Signer, ErrSessionLogonSigner is returned otherwise.
+func (w *WsAPIClient) SessionLogon(ctx context.Context, opts ...RequestOption) error {
+	if _, ok := w.c.Signer.(*common.Ed25519Signer); !ok {
+		return common.ErrSessionLogonSigner
+	}
+	r := &request{service: "SessionLogon", secType: secTypeSigned}
+	m, err := w.params(r, false, opts...)
+	if err != nil {
+		return err
+	}
+	if _, err = w.conn.Call(ctx, "session.logon", m); err != nil {
+		return err
+	}
+	w.setLoggedOn(true)
+	return nil
+}
+
+// SessionLogout forget the session authentication, the connection stays open
+func (w *WsAPIClient) SessionLogout(ctx context.Context) error {
+	if _, err := w.conn.Call(ctx, "session.logout", nil); err != nil {
+		return err
+	}
+	w.setLoggedOn(false)
+	return nil
+}
+
+// CreateOrder send the order of s with order.place
+func (w *WsAPIClient) CreateOrder(ctx context.Context, s *CreateOrderService, opts ...RequestOption) (res *CreateOrderResponse, err error) {
+	data, err := w.call(ctx, "order.place", s.newRequest("/api/v3/order"), opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(CreateOrderResponse)
+	err = jsonCodec.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CancelOrder cancel the order of s with order.cancel
+func (w *WsAPIClient) CancelOrder(ctx context.Context, s *CancelOrderService, opts ...RequestOption) (res *CancelOrderResponse, err error) {
+	data, err := w.call(ctx, "order.cancel", s.newRequest(), opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(CancelOrderResponse)
+	err = jsonCodec.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetOrder query the order of s with order.status
+func (w *WsAPIClient) GetOrder(ctx context.Context, s *GetOrderService, opts ...RequestOption) (res *Order, err error) {
+	data, err := w.call(ctx, "order.status", s.newRequest(), opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(Order)
+	err = jsonCodec.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ListOpenOrders list the open orders of s with openOrders.status
+func (w *WsAPIClient) ListOpenOrders(ctx context.Context, s *ListOpenOrdersService, opts ...RequestOption) (res []*Order, err error) {
+	data, err := w.call(ctx, "openOrders.status", s.newRequest(), opts...)
+	if err != nil {
+		return []*Order{}, err
+	}
+	res = make([]*Order, 0)
+	err = jsonCodec.Unmarshal(data, &res)
+	if err != nil {
+		return []*Order{}, err
+	}
+	return res, nil
+}
+
+// GetAccount query the account with account.status
+func (w *WsAPIClient) GetAccount(ctx context.Context, s *GetAccountService, opts ...RequestOption) (res *Account, err error) {
+	data, err := w.call(ctx, "account.status", s.newRequest(), opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(Account)
+	err = jsonCodec.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (w *WsAPIClient) call(ctx context.Context, method string, r *request, opts ...RequestOption) (data []byte, err error) {
+	ctx, span := common.StartRequestSpan(ctx, "go-binance/services", r.service)
+	defer func() { common.EndRequestSpan(span, err) }()
+
+	m, err := w.params(r, w.LoggedOn(), opts...)
+	if err != nil {
+		return nil, err
+	}
+	res, err := w.conn.Call(ctx, method, m)
+	if err != nil {
+		var apiErr *common.APIError
+		if errors.As(err, &apiErr) {
+			apiErr.Service = r.service
+		}
+		if w.c.ClockSync != nil && isTimestampError(err) {
+			_ = w.c.ClockSync.Resync(ctx)
+		}
+		return nil, err
+	}
+	return res.Result, nil
+}
+
+// params turn the query and form of r into WebSocket API params, adding the
+// timestamp, apiKey and signature the security type of r asks for
+func (w *WsAPIClient) params(r *request, loggedOn bool, opts ...RequestOption) (map[string]any, error) {
+	for _, opt := range opts {
+		opt(r)
+	}
+	if err := r.validate(); err != nil {
+		return nil, err
+	}
+
+	// a JSON params object holds one value per key, a key set more than
+	// once, or in both the query and the form, is rejected rather than
+	// sending only its first value
+	values := url.Values{}
+	for _, params := range []url.Values{r.query, r.form} {
+		for k, vs := range params {
+			for _, v := range vs {
+				if v != "" {
+					values[k] = append(values[k], v)
+				}
+			}
+		}
+	}
+	for k, vs := range values {
+		if len(vs) > 1 {
+			return nil, fmt.Errorf("param %s has %d values, the WebSocket API takes one", k, len(vs))
+		}
+	}
+	if r.recvWindow > 0 {
+		values.Set(recvWindowKey, strconv.FormatInt(r.recvWindow, 10))
+	}
+	if r.secType == secTypeSigned {
+		values.Set(timestampKey, strconv.FormatInt(currentTimestamp()-atomic.LoadInt64(&w.c.TimeOffset), 10))
+	}
+	if (r.secType == secTypeAPIKey || r.secType == secTypeSigned) && !loggedOn {
+		values.Set("apiKey", w.c.APIKey)
+	}
+	if r.secType == secTypeSigned && !loggedOn {
+		signature, err := w.c.sign(values.Encode())
+		if err != nil {
+			return nil, err
+		}
+		values.Set(signatureKey, signature)
+	}
+
+	m := make(map[string]any, len(values))
+	for k := range values {
+		v := values.Get(k)
+		if wsAPIIntParams[k] {
+			if n, err := strconv.ParseInt(v, 10, 64); err == nil {
+				m[k] = n
+				continue
+			}
+		}
+		m[k] = v
+	}
+	return m, nil
+}
+
+func (w *WsAPIClient) setLoggedOn(loggedOn bool) {
+	w.mu.Lock()
+	w.loggedOn = loggedOn
+	w.mu.Unlock()
+}
@@ -0,0 +1,323 @@
+package binance
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/ward-cap/go-binance/common"
+)
+
+type wsAPITestRequest struct {
+	ID     int64          `json:"id"`
+	Method string         `json:"method"`
+	Params map[string]any `json:"params"`
+}
+
+// param return a param as sent on the wire, empty when missing
+func (r wsAPITestRequest) param(key string) string {
+	v, ok := r.Params[key]
+	if !ok {
+		return ""
+	}
+	return fmt.Sprint(v)
+}
+
+// wsAPITestServer is a fake WebSocket API endpoint. session.logon checks the
+// Ed25519 signature, every other method answers with the result set for it.
+type wsAPITestServer struct {
+	*httptest.Server
+	t   *testing.T
+	key ed25519.PublicKey
+
+	mu       sync.Mutex
+	conns    []*websocket.Conn
+	requests []wsAPITestRequest
+	results  map[string]string
+	errors   map[string]string
+}
+
+func newWsAPITestServer(t *testing.T, key ed25519.PublicKey) *wsAPITestServer {
+	s := &wsAPITestServer{t: t, key: key, results: map[string]string{}, errors: map[string]string{}}
+	upgrader := websocket.Upgrader{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		s.mu.Lock()
+		s.conns = append(s.conns, conn)
+		s.mu.Unlock()
+		for {
+			_, message, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			dec := json.NewDecoder(bytes.NewReader(message))
+			dec.UseNumber()
+			var req wsAPITestRequest
+			if err := dec.Decode(&req); err != nil {
+				t.Errorf("decode request: %v", err)
+				return
+			}
+			s.mu.Lock()
+			s.requests = append(s.requests, req)
+			result, apiErr := s.results[req.Method], s.errors[req.Method]
+			s.mu.Unlock()
+
+			switch {
+			case req.Method == "session.logon" && !s.verify(req):
+				apiErr = `{"code":-1022,"msg":"Signature for this request is not valid."}`
+			case result == "":
+				result = "{}"
+			}
+			resp := fmt.Sprintf(`{"id":%d,"status":200,"result":%s}`, req.ID, result)
+			if apiErr != "" {
+				resp = fmt.Sprintf(`{"id":%d,"status":400,"error":%s}`, req.ID, apiErr)
+			}
+			if err := conn.WriteMessage(websocket.TextMessage, []byte(resp)); err != nil {
+				return
+			}
+		}
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+// verify check the signature over the other params sorted by key
+func (s *wsAPITestServer) verify(req wsAPITestRequest) bool {
+	values := url.Values{}
+	for k := range req.Params {
+		if k != signatureKey {
+			values.Set(k, req.param(k))
+		}
+	}
+	sig, err := base64.StdEncoding.DecodeString(req.param(signatureKey))
+	if err != nil {
+		return false
+	}
+	return ed25519.Verify(s.key, []byte(values.Encode()), sig)
+}
+
+func (s *wsAPITestServer) requestLog() []wsAPITestRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]wsAPITestRequest(nil), s.requests...)
+}
+
+func (s *wsAPITestServer) lastRequest() wsAPITestRequest {
+	s.t.Helper()
+	requests := s.requestLog()
+	if len(requests) == 0 {
+		s.t.Fatal("no request received")
+	}
+	return requests[len(requests)-1]
+}
+
+func newTestWsAPIClient(t *testing.T) (*WsAPIClient, *wsAPITestServer) {
+	t.Helper()
+	pub, priv, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := newWsAPITestServer(t, pub)
+	c := NewClient("api-key", "", nil)
+	c.Signer = common.NewEd25519Signer(priv)
+	c.BaseWsAPIURL = "ws" + strings.TrimPrefix(srv.URL, "http")
+	w := c.NewWsAPIClient()
+	t.Cleanup(func() { _ = w.Close() })
+	return w, srv
+}
+
+func TestWsAPISessionLogonRejectsNonEd25519Signer(t *testing.T) {
+	w, srv := newTestWsAPIClient(t)
+	for name, signer := range map[string]common.Signer{
+		"default": nil,
+		"hmac":    common.NewHMACSigner("secret"),
+		"rsa":     &common.RSASigner{},
+	} {
+		w.c.Signer = signer
+		if err := w.SessionLogon(context.Background()); !errors.Is(err, common.ErrSessionLogonSigner) {
+			t.Fatalf("%s: SessionLogon error = %v, want ErrSessionLogonSigner", name, err)
+		}
+	}
+	if n := len(srv.requestLog()); n != 0 {
+		t.Fatalf("requests sent = %d, want 0", n)
+	}
+}
+
+func TestWsAPISessionLogon(t *testing.T) {
+	w, srv := newTestWsAPIClient(t)
+	if err := w.SessionLogon(context.Background()); err != nil {
+		t.Fatalf("SessionLogon: %v", err)
+	}
+	if !w.LoggedOn() {
+		t.Fatal("LoggedOn = false after SessionLogon")
+	}
+	logon := srv.lastRequest()
+	if logon.Method != "session.logon" || logon.param("apiKey") != "api-key" || logon.param(timestampKey) == "" {
+		t.Fatalf("logon request = %+v", logon)
+	}
+
+	srv.mu.Lock()
+	srv.results["account.status"] = `{"canTrade":true}`
+	srv.mu.Unlock()
+	account, err := w.GetAccount(context.Background(), w.c.NewGetAccountService())
+	if err != nil {
+		t.Fatalf("GetAccount: %v", err)
+	}
+	if !account.CanTrade {
+		t.Fatalf("account = %+v", account)
+	}
+	req := srv.lastRequest()
+	if _, ok := req.Params["apiKey"]; ok {
+		t.Fatalf("apiKey sent after logon: %+v", req.Params)
+	}
+	if _, ok := req.Params[signatureKey]; ok {
+		t.Fatalf("signature sent after logon: %+v", req.Params)
+	}
+	if req.param(timestampKey) == "" {
+		t.Fatalf("timestamp missing after logon: %+v", req.Params)
+	}
+
+	if err := w.SessionLogout(context.Background()); err != nil {
+		t.Fatalf("SessionLogout: %v", err)
+	}
+	if w.LoggedOn() {
+		t.Fatal("LoggedOn = true after SessionLogout")
+	}
+}
+
+func TestWsAPISessionLogonError(t *testing.T) {
+	w, srv := newTestWsAPIClient(t)
+	_, other, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w.c.Signer = common.NewEd25519Signer(other)
+
+	err = w.SessionLogon(context.Background())
+	var apiErr *common.APIError
+	if !errors.As(err, &apiErr) || apiErr.Code != common.ErrCodeInvalidSignature {
+		t.Fatalf("SessionLogon error = %v, want -1022", err)
+	}
+	if apiErr.StatusCode != http.StatusBadRequest || apiErr.Endpoint != "session.logon" {
+		t.Fatalf("api error = %+v", apiErr)
+	}
+	if w.LoggedOn() {
+		t.Fatal("LoggedOn = true after a failed SessionLogon")
+	}
+	if n := len(srv.requestLog()); n != 1 {
+		t.Fatalf("requests sent = %d, want 1", n)
+	}
+}
+
+func TestWsAPISignedRequestWithoutLogon(t *testing.T) {
+	w, srv := newTestWsAPIClient(t)
+	srv.mu.Lock()
+	srv.results["order.place"] = `{"symbol":"BTCUSDT","orderId":7,"clientOrderId":"abc"}`
+	srv.mu.Unlock()
+
+	s := w.c.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).
+		Type(OrderTypeLimit).TimeInForce(TimeInForceTypeGTC).
+		Quantity("1").Price("10").NewClientOrderID("abc")
+	res, err := w.CreateOrder(context.Background(), s, WithRecvWindow(5000))
+	if err != nil {
+		t.Fatalf("CreateOrder: %v", err)
+	}
+	if res.OrderID != 7 || res.ClientOrderID != "abc" {
+		t.Fatalf("response = %+v", res)
+	}
+
+	req := srv.lastRequest()
+	if req.Method != "order.place" {
+		t.Fatalf("method = %s", req.Method)
+	}
+	if !srv.verify(req) {
+		t.Fatalf("signature does not verify: %+v", req.Params)
+	}
+	if req.param("apiKey") != "api-key" || req.param("symbol") != "BTCUSDT" || req.param(recvWindowKey) != "5000" {
+		t.Fatalf("params = %+v", req.Params)
+	}
+	if _, ok := req.Params[recvWindowKey].(json.Number); !ok {
+		t.Fatalf("recvWindow sent as %T, want a JSON number", req.Params[recvWindowKey])
+	}
+	for k := range req.Params {
+		if req.param(k) == "" {
+			t.Fatalf("empty param %s sent", k)
+		}
+	}
+}
+
+func TestWsAPIErrorResponse(t *testing.T) {
+	w, srv := newTestWsAPIClient(t)
+	srv.mu.Lock()
+	srv.errors["order.status"] = `{"code":-2013,"msg":"Order does not exist."}`
+	srv.mu.Unlock()
+
+	_, err := w.GetOrder(context.Background(), w.c.NewGetOrderService().Symbol("BTCUSDT").OrderID(1))
+	var apiErr *common.APIError
+	if !errors.As(err, &apiErr) || apiErr.Code != common.ErrCodeNoSuchOrder {
+		t.Fatalf("GetOrder error = %v, want -2013", err)
+	}
+	if apiErr.Service != "GetOrderService" {
+		t.Fatalf("service = %q", apiErr.Service)
+	}
+	if !errors.Is(err, common.ErrNoSuchOrder) {
+		t.Fatalf("error %v is not ErrNoSuchOrder", err)
+	}
+}
+
+func TestWsAPIDisconnectResetsLogon(t *testing.T) {
+	w, srv := newTestWsAPIClient(t)
+	if err := w.SessionLogon(context.Background()); err != nil {
+		t.Fatalf("SessionLogon: %v", err)
+	}
+	srv.mu.Lock()
+	conn := srv.conns[len(srv.conns)-1]
+	srv.mu.Unlock()
+	_ = conn.Close()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for w.LoggedOn() && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if w.LoggedOn() {
+		t.Fatal("LoggedOn = true after disconnect")
+	}
+
+	// the next call dials again and is signed since the session is gone
+	if _, err := w.GetAccount(context.Background(), w.c.NewGetAccountService()); err != nil {
+		t.Fatalf("GetAccount after reconnect: %v", err)
+	}
+	req := srv.lastRequest()
+	if _, ok := req.Params[signatureKey]; !ok {
+		t.Fatalf("request after disconnect not signed: %+v", req.Params)
+	}
+}
+
+func TestWsAPIRejectsMultiValueParam(t *testing.T) {
+	w, srv := newTestWsAPIClient(t)
+	s := w.c.NewGetOrderService().Symbol("BTCUSDT").OrderID(1)
+	_, err := w.GetOrder(context.Background(), s, func(r *request) {
+		r.addParam("symbol", "ETHUSDT")
+	})
+	if err == nil || !strings.Contains(err.Error(), "symbol") {
+		t.Fatalf("GetOrder error = %v, want a multi-value symbol error", err)
+	}
+	if n := len(srv.requestLog()); n != 0 {
+		t.Fatalf("requests sent = %d, want 0", n)
+	}
+}
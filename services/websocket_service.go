@@ -0,0 +1,275 @@
+package binance
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ward-cap/go-binance/common"
+)
+
+// WsHandler handle raw websocket message
+type WsHandler func(message []byte)
+
+// ErrHandler handles errors
+type ErrHandler func(err error)
+
+// WsAggTradeHandler handle websocket aggregate trade event
+type WsAggTradeHandler func(event *WsAggTradeEvent)
+
+// WsTradeHandler handle websocket trade event
+type WsTradeHandler func(event *WsTradeEvent)
+
+// WsKlineHandler handle websocket kline event
+type WsKlineHandler func(event *WsKlineEvent)
+
+// WsMiniMarketStatHandler handle websocket mini ticker event
+type WsMiniMarketStatHandler func(event *WsMiniMarketStatEvent)
+
+// WsAllMiniMarketStatHandler handle websocket all market mini ticker event
+type WsAllMiniMarketStatHandler func(event WsAllMiniMarketStatEvent)
+
+// WsMarketStatHandler handle websocket ticker event
+type WsMarketStatHandler func(event *WsMarketStatEvent)
+
+// WsAllMarketStatHandler handle websocket all market ticker event
+type WsAllMarketStatHandler func(event WsAllMarketStatEvent)
+
+// WsBookTickerHandler handle websocket book ticker event
+type WsBookTickerHandler func(event *WsBookTickerEvent)
+
+// WsPartialDepthHandler handle websocket partial depth event
+type WsPartialDepthHandler func(event *WsPartialDepthEvent)
+
+// WsDepthHandler handle websocket diff depth event
+type WsDepthHandler func(event *WsDepthEvent)
+
+// WsMarketStream streams market data over one combined stream connection.
+// Streams may be added and removed while connected; the connection is
+// re-established and resubscribed automatically.
+type WsMarketStream struct {
+	ws         *common.WsStream
+	mu         sync.RWMutex
+	handlers   map[string]WsHandler
+	errHandler ErrHandler
+}
+
+// NewWsMarketStream init market data websocket stream
+func (c *Client) NewWsMarketStream(errHandler ErrHandler) *WsMarketStream {
+	s := &WsMarketStream{
+		handlers:   map[string]WsHandler{},
+		errHandler: errHandler,
+	}
+	s.ws = common.NewWsStream(common.WsConfig{
+		Endpoint: fmt.Sprintf("%s/stream", c.BaseWsURL),
+		Logger:   c.Logger,
+		Package:  "services",
+	}, s.dispatch, s.handleError)
+	return s
+}
+
+// Connect open the connection and subscribe the streams registered so far
+func (s *WsMarketStream) Connect(ctx context.Context) error {
+	return s.ws.Connect(ctx)
+}
+
+// Close close the connection, no reconnect is attempted afterwards
+func (s *WsMarketStream) Close() error {
+	return s.ws.Close()
+}
+
+// Done is closed once the stream has been closed
+func (s *WsMarketStream) Done() <-chan struct{} {
+	return s.ws.Done()
+}
+
+// Streams return the subscribed stream names
+func (s *WsMarketStream) Streams() []string {
+	return s.ws.Streams()
+}
+
+// Subscribe subscribe a raw stream by name, e.g. "btcusdt@aggTrade"
+func (s *WsMarketStream) Subscribe(ctx context.Context, stream string, handler WsHandler) error {
+	s.mu.Lock()
+	s.handlers[stream] = handler
+	s.mu.Unlock()
+
+	if err := s.ws.Subscribe(ctx, stream); err != nil {
+		s.mu.Lock()
+		delete(s.handlers, stream)
+		s.mu.Unlock()
+		return err
+	}
+	return nil
+}
+
+// Unsubscribe unsubscribe streams by name. The handlers are kept until the
+// unsubscription is acknowledged, so a failed request leaves them in place.
+func (s *WsMarketStream) Unsubscribe(ctx context.Context, streams ...string) error {
+	if err := s.ws.Unsubscribe(ctx, streams...); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	for _, stream := range streams {
+		delete(s.handlers, stream)
+	}
+	s.mu.Unlock()
+	return nil
+}
+
+// SubscribeAggTrade subscribe <symbol>@aggTrade and return the stream name
+func (s *WsMarketStream) SubscribeAggTrade(ctx context.Context, symbol string, handler WsAggTradeHandler) (string, error) {
+	stream := fmt.Sprintf("%s@aggTrade", strings.ToLower(symbol))
+	return stream, s.Subscribe(ctx, stream, func(message []byte) {
+		event := new(WsAggTradeEvent)
+		if err := event.UnmarshalJSON(message); err != nil {
+			s.handleError(err)
+			return
+		}
+		handler(event)
+	})
+}
+
+// SubscribeTrade subscribe <symbol>@trade and return the stream name
+func (s *WsMarketStream) SubscribeTrade(ctx context.Context, symbol string, handler WsTradeHandler) (string, error) {
+	stream := fmt.Sprintf("%s@trade", strings.ToLower(symbol))
+	return stream, s.Subscribe(ctx, stream, func(message []byte) {
+		event := new(WsTradeEvent)
+		if err := event.UnmarshalJSON(message); err != nil {
+			s.handleError(err)
+			return
+		}
+		handler(event)
+	})
+}
+
+// SubscribeKline subscribe <symbol>@kline_<interval> and return the stream name
+func (s *WsMarketStream) SubscribeKline(ctx context.Context, symbol, interval string, handler WsKlineHandler) (string, error) {
+	stream := fmt.Sprintf("%s@kline_%s", strings.ToLower(symbol), interval)
+	return stream, s.Subscribe(ctx, stream, func(message []byte) {
+		event := new(WsKlineEvent)
+		if err := event.UnmarshalJSON(message); err != nil {
+			s.handleError(err)
+			return
+		}
+		handler(event)
+	})
+}
+
+// SubscribeMiniMarketStat subscribe <symbol>@miniTicker and return the stream name
+func (s *WsMarketStream) SubscribeMiniMarketStat(ctx context.Context, symbol string, handler WsMiniMarketStatHandler) (string, error) {
+	stream := fmt.Sprintf("%s@miniTicker", strings.ToLower(symbol))
+	return stream, s.Subscribe(ctx, stream, func(message []byte) {
+		event := new(WsMiniMarketStatEvent)
+		if err := event.UnmarshalJSON(message); err != nil {
+			s.handleError(err)
+			return
+		}
+		handler(event)
+	})
+}
+
+// SubscribeAllMiniMarketStat subscribe !miniTicker@arr and return the stream name
+func (s *WsMarketStream) SubscribeAllMiniMarketStat(ctx context.Context, handler WsAllMiniMarketStatHandler) (string, error) {
+	stream := "!miniTicker@arr"
+	return stream, s.Subscribe(ctx, stream, func(message []byte) {
+		var event WsAllMiniMarketStatEvent
+		if err := event.UnmarshalJSON(message); err != nil {
+			s.handleError(err)
+			return
+		}
+		handler(event)
+	})
+}
+
+// SubscribeMarketStat subscribe <symbol>@ticker and return the stream name
+func (s *WsMarketStream) SubscribeMarketStat(ctx context.Context, symbol string, handler WsMarketStatHandler) (string, error) {
+	stream := fmt.Sprintf("%s@ticker", strings.ToLower(symbol))
+	return stream, s.Subscribe(ctx, stream, func(message []byte) {
+		event := new(WsMarketStatEvent)
+		if err := event.UnmarshalJSON(message); err != nil {
+			s.handleError(err)
+			return
+		}
+		handler(event)
+	})
+}
+
+// SubscribeAllMarketStat subscribe !ticker@arr and return the stream name
+func (s *WsMarketStream) SubscribeAllMarketStat(ctx context.Context, handler WsAllMarketStatHandler) (string, error) {
+	stream := "!ticker@arr"
+	return stream, s.Subscribe(ctx, stream, func(message []byte) {
+		var event WsAllMarketStatEvent
+		if err := event.UnmarshalJSON(message); err != nil {
+			s.handleError(err)
+			return
+		}
+		handler(event)
+	})
+}
+
+// SubscribeBookTicker subscribe <symbol>@bookTicker and return the stream name
+func (s *WsMarketStream) SubscribeBookTicker(ctx context.Context, symbol string, handler WsBookTickerHandler) (string, error) {
+	stream := fmt.Sprintf("%s@bookTicker", strings.ToLower(symbol))
+	return stream, s.Subscribe(ctx, stream, func(message []byte) {
+		event := new(WsBookTickerEvent)
+		if err := event.UnmarshalJSON(message); err != nil {
+			s.handleError(err)
+			return
+		}
+		handler(event)
+	})
+}
+
+// SubscribePartialDepth subscribe <symbol>@depth<levels> and return the stream name.
+// levels must be 5, 10 or 20; rate may be 0 (1000ms) or 100ms.
+func (s *WsMarketStream) SubscribePartialDepth(ctx context.Context, symbol string, levels int, rate time.Duration, handler WsPartialDepthHandler) (string, error) {
+	stream := fmt.Sprintf("%s@depth%d%s", strings.ToLower(symbol), levels, depthRateSuffix(rate))
+	return stream, s.Subscribe(ctx, stream, func(message []byte) {
+		event, err := parseWsPartialDepthEvent(symbol, message)
+		if err != nil {
+			s.handleError(err)
+			return
+		}
+		handler(event)
+	})
+}
+
+// SubscribeDepth subscribe <symbol>@depth diff stream and return the stream name.
+// rate may be 0 (1000ms) or 100ms.
+func (s *WsMarketStream) SubscribeDepth(ctx context.Context, symbol string, rate time.Duration, handler WsDepthHandler) (string, error) {
+	stream := fmt.Sprintf("%s@depth%s", strings.ToLower(symbol), depthRateSuffix(rate))
+	return stream, s.Subscribe(ctx, stream, func(message []byte) {
+		event, err := parseWsDepthEvent(message)
+		if err != nil {
+			s.handleError(err)
+			return
+		}
+		handler(event)
+	})
+}
+
+func (s *WsMarketStream) dispatch(stream string, data []byte) {
+	s.mu.RLock()
+	handler, ok := s.handlers[stream]
+	s.mu.RUnlock()
+	if !ok {
+		return
+	}
+	handler(data)
+}
+
+func (s *WsMarketStream) handleError(err error) {
+	if s.errHandler != nil {
+		s.errHandler(err)
+	}
+}
+
+func depthRateSuffix(rate time.Duration) string {
+	if rate <= 0 || rate == time.Second {
+		return ""
+	}
+	return fmt.Sprintf("@%dms", rate.Milliseconds())
+}
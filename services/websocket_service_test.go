@@ -0,0 +1,95 @@
+package binance
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// wsStreamTestConn serialize the writes of the server and the test
+type wsStreamTestConn struct {
+	mu   sync.Mutex
+	conn *websocket.Conn
+}
+
+func (c *wsStreamTestConn) send(message []byte) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.conn.WriteMessage(websocket.TextMessage, message)
+}
+
+// newRejectUnsubscribeServer acknowledge SUBSCRIBE and reject UNSUBSCRIBE,
+// the accepted connections are sent to conns
+func newRejectUnsubscribeServer(t *testing.T, conns chan<- *wsStreamTestConn) *httptest.Server {
+	upgrader := websocket.Upgrader{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ws, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		conn := &wsStreamTestConn{conn: ws}
+		conns <- conn
+		for {
+			var req struct {
+				Method string `json:"method"`
+				ID     int64  `json:"id"`
+			}
+			if err := ws.ReadJSON(&req); err != nil {
+				return
+			}
+			resp := map[string]any{"result": nil, "id": req.ID}
+			if req.Method == "UNSUBSCRIBE" {
+				resp = map[string]any{"error": map[string]any{"code": -1121, "msg": "Invalid symbol."}, "id": req.ID}
+			}
+			b, _ := json.Marshal(resp)
+			if err := conn.send(b); err != nil {
+				return
+			}
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestWsMarketStreamUnsubscribeErrorKeepsHandler(t *testing.T) {
+	conns := make(chan *wsStreamTestConn, 1)
+	srv := newRejectUnsubscribeServer(t, conns)
+	c := NewClient("", "", nil)
+	c.BaseWsURL = "ws" + strings.TrimPrefix(srv.URL, "http")
+
+	s := c.NewWsMarketStream(nil)
+	defer s.Close()
+	if err := s.Connect(context.Background()); err != nil {
+		t.Fatalf("connect: %v", err)
+	}
+	conn := <-conns
+
+	messages := make(chan string, 1)
+	if err := s.Subscribe(context.Background(), "btcusdt@trade", func(message []byte) {
+		messages <- string(message)
+	}); err != nil {
+		t.Fatalf("subscribe: %v", err)
+	}
+	if err := s.Unsubscribe(context.Background(), "btcusdt@trade"); err == nil {
+		t.Fatal("unsubscribe succeeded, want error")
+	}
+
+	if err := conn.send([]byte(`{"stream":"btcusdt@trade","data":{"e":"trade"}}`)); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case got := <-messages:
+		if got != `{"e":"trade"}` {
+			t.Fatalf("message = %s", got)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("handler of a stream still subscribed not called")
+	}
+}